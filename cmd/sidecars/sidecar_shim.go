@@ -31,6 +31,7 @@ import (
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"strconv"
 	"syscall"
 
 	"github.com/spf13/pflag"
@@ -46,6 +47,7 @@ import (
 	hooksV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/v1alpha1"
 	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
 )
 
 const (
@@ -55,6 +57,14 @@ const (
 
 	onDefineDomainBin  = "onDefineDomain"
 	preCloudInitIsoBin = "preCloudInitIso"
+
+	// Lifecycle hooks, available since v1alpha4
+	postStartBin     = "postStart"
+	onPauseBin       = "onPause"
+	onUnpauseBin     = "onUnpause"
+	preMigrationBin  = "preMigration"
+	postMigrationBin = "postMigration"
+	onHotplugBin     = "onHotplug"
 )
 
 type infoServer struct {
@@ -67,6 +77,14 @@ func (s infoServer) Info(ctx context.Context, params *hooksInfo.InfoParams) (*ho
 		hooksInfo.OnDefineDomainHookPointName:  onDefineDomainBin,
 		hooksInfo.PreCloudInitIsoHookPointName: preCloudInitIsoBin,
 	}
+	if s.Version == hooksV1alpha4.Version {
+		supportedHookPoints[hooksInfo.PostStartHookPointName] = postStartBin
+		supportedHookPoints[hooksInfo.OnPauseHookPointName] = onPauseBin
+		supportedHookPoints[hooksInfo.OnUnpauseHookPointName] = onUnpauseBin
+		supportedHookPoints[hooksInfo.PreMigrationHookPointName] = preMigrationBin
+		supportedHookPoints[hooksInfo.PostMigrationHookPointName] = postMigrationBin
+		supportedHookPoints[hooksInfo.OnHotplugHookPointName] = onHotplugBin
+	}
 	var hookPoints = []*hooksInfo.HookPoint{}
 
 	// Shutdown fixes proper termination of Sidecars. It isn't related to
@@ -106,6 +124,86 @@ type v1Alpha2Server struct{}
 type v1Alpha3Server struct {
 	done chan struct{}
 }
+type v1Alpha4Server struct {
+	done chan struct{}
+}
+
+func (s v1Alpha4Server) OnDefineDomain(_ context.Context, params *hooksV1alpha4.OnDefineDomainParams) (*hooksV1alpha4.OnDefineDomainResult, error) {
+	log.Log.Info(onDefineDomainLoggingMessage)
+	newDomainXML, err := runOnDefineDomain(params.GetVmi(), params.GetDomainXML())
+	if err != nil {
+		log.Log.Reason(err).Error("Failed OnDefineDomain")
+		return nil, err
+	}
+	return &hooksV1alpha4.OnDefineDomainResult{
+		DomainXML: newDomainXML,
+	}, nil
+}
+
+func (s v1Alpha4Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha4.PreCloudInitIsoParams) (*hooksV1alpha4.PreCloudInitIsoResult, error) {
+	log.Log.Info(preCloudInitIsoLoggingMessage)
+	cloudInitData, err := runPreCloudInitIso(params.GetVmi(), params.GetCloudInitData())
+	if err != nil {
+		log.Log.Reason(err).Error("Failed ProCloudInitIso")
+		return nil, err
+	}
+	return &hooksV1alpha4.PreCloudInitIsoResult{
+		CloudInitData: cloudInitData,
+	}, nil
+}
+
+func (s v1Alpha4Server) Shutdown(_ context.Context, _ *hooksV1alpha4.ShutdownParams) (*hooksV1alpha4.ShutdownResult, error) {
+	log.Log.Info(onShutdownMessage)
+	s.done <- struct{}{}
+	return &hooksV1alpha4.ShutdownResult{}, nil
+}
+
+func (s v1Alpha4Server) PostStart(_ context.Context, params *hooksV1alpha4.LifecycleParams) (*hooksV1alpha4.LifecycleResult, error) {
+	if err := runLifecycleHook(postStartBin, params.GetVmi()); err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha4.LifecycleResult{}, nil
+}
+
+func (s v1Alpha4Server) OnPause(_ context.Context, params *hooksV1alpha4.LifecycleParams) (*hooksV1alpha4.LifecycleResult, error) {
+	if err := runLifecycleHook(onPauseBin, params.GetVmi()); err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha4.LifecycleResult{}, nil
+}
+
+func (s v1Alpha4Server) OnUnpause(_ context.Context, params *hooksV1alpha4.LifecycleParams) (*hooksV1alpha4.LifecycleResult, error) {
+	if err := runLifecycleHook(onUnpauseBin, params.GetVmi()); err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha4.LifecycleResult{}, nil
+}
+
+func (s v1Alpha4Server) PreMigration(_ context.Context, params *hooksV1alpha4.MigrationParams) (*hooksV1alpha4.MigrationResult, error) {
+	if err := runLifecycleHook(preMigrationBin, params.GetVmi(), "--role", params.GetRole()); err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha4.MigrationResult{}, nil
+}
+
+func (s v1Alpha4Server) PostMigration(_ context.Context, params *hooksV1alpha4.MigrationParams) (*hooksV1alpha4.MigrationResult, error) {
+	if err := runLifecycleHook(postMigrationBin, params.GetVmi(),
+		"--role", params.GetRole(),
+		"--succeeded", strconv.FormatBool(params.GetSucceeded())); err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha4.MigrationResult{}, nil
+}
+
+func (s v1Alpha4Server) OnHotplug(_ context.Context, params *hooksV1alpha4.HotplugParams) (*hooksV1alpha4.HotplugResult, error) {
+	if err := runLifecycleHook(onHotplugBin, params.GetVmi(),
+		"--device-type", params.GetDeviceType(),
+		"--device-name", params.GetDeviceName(),
+		"--action", params.GetAction()); err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha4.HotplugResult{}, nil
+}
 
 func (s v1Alpha3Server) OnDefineDomain(_ context.Context, params *hooksV1alpha3.OnDefineDomainParams) (*hooksV1alpha3.OnDefineDomainResult, error) {
 	log.Log.Info(onDefineDomainLoggingMessage)
@@ -229,6 +327,35 @@ func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
 	return command.Output()
 }
 
+// runLifecycleHook executes the binary of a lifecycle hook point. The VMI is
+// passed as --vmi followed by the hook point arguments, the output is ignored.
+func runLifecycleHook(binName string, vmiJSON []byte, args ...string) error {
+	log.Log.Infof("%s method has been called", binName)
+	if _, err := exec.LookPath(binName); err != nil {
+		return fmt.Errorf("Failed in finding %s in $PATH due %v", binName, err)
+	}
+
+	vmiSpec := virtv1.VirtualMachineInstance{}
+	if err := json.Unmarshal(vmiJSON, &vmiSpec); err != nil {
+		return fmt.Errorf("Failed to unmarshal given VMI spec: %s due %v", vmiJSON, err)
+	}
+
+	args = append([]string{"--vmi", string(vmiJSON)}, args...)
+
+	log.Log.Infof("Executing %s", binName)
+	command := exec.Command(binName, args...)
+	if reader, err := command.StderrPipe(); err != nil {
+		log.Log.Reason(err).Infof("Could not pipe stderr")
+	} else {
+		go logStderr(reader, binName)
+	}
+	if _, err := command.Output(); err != nil {
+		log.Log.Reason(err).Errorf("Failed %s", binName)
+		return err
+	}
+	return nil
+}
+
 func logStderr(reader io.Reader, hookName string) {
 	scanner := bufio.NewScanner(reader)
 	scanner.Buffer(make([]byte, 1024), 512*1024)
@@ -242,7 +369,7 @@ func logStderr(reader io.Reader, hookName string) {
 }
 
 func parseCommandLineArgs() (string, error) {
-	supportedVersions := []string{"v1alpha1", "v1alpha2", "v1alpha3"}
+	supportedVersions := []string{"v1alpha1", "v1alpha2", "v1alpha3", "v1alpha4"}
 	version := ""
 
 	pflag.StringVar(&version, "version", "", "hook version to use")
@@ -314,6 +441,7 @@ func main() {
 
 	shutdownChan := make(chan struct{})
 	hooksV1alpha3.RegisterCallbacksServer(server, v1Alpha3Server{done: shutdownChan})
+	hooksV1alpha4.RegisterCallbacksServer(server, v1Alpha4Server{done: shutdownChan})
 
 	// Handle signals to properly shutdown process
 	signalStopChan := make(chan os.Signal, 1)
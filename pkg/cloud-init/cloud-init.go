@@ -20,6 +20,7 @@
 package cloudinit
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -27,6 +28,8 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -73,6 +76,7 @@ type CloudInitData struct {
 	ConfigDriveMetaData *ConfigDriveMetadata
 	UserData            string
 	NetworkData         string
+	VendorData          string
 	DevicesData         *[]DeviceData
 	VolumeName          string
 }
@@ -82,6 +86,47 @@ type NoCloudMetadata struct {
 	InstanceID    string            `json:"instance-id"`
 	LocalHostname string            `json:"local-hostname,omitempty"`
 	PublicSSHKeys map[string]string `json:"public-keys,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+	// Keys are the user defined entries, added at the top level
+	Keys map[string]string `json:"-"`
+}
+
+// noCloudReservedMetaDataKeys are the NoCloud metadata keys which user defined entries cannot override
+var noCloudReservedMetaDataKeys = []string{"instance-type", "instance-id", "local-hostname", "public-keys", "labels", "annotations"}
+
+// IsReservedNoCloudMetaDataKey returns true if the key is generated by KubeVirt in the NoCloud metadata
+func IsReservedNoCloudMetaDataKey(key string) bool {
+	return slices.Contains(noCloudReservedMetaDataKeys, key)
+}
+
+// MarshalJSON adds the user defined entries after the generated ones
+func (m NoCloudMetadata) MarshalJSON() ([]byte, error) {
+	type noCloudMetadata NoCloudMetadata
+	metaData, err := json.Marshal(noCloudMetadata(m))
+	if err != nil || len(m.Keys) == 0 {
+		return metaData, err
+	}
+
+	keys := make([]string, 0, len(m.Keys))
+	for key := range m.Keys {
+		if !IsReservedNoCloudMetaDataKey(key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	buf := bytes.NewBuffer(metaData[:len(metaData)-1])
+	for _, key := range keys {
+		entry, err := json.Marshal(map[string]string{key: m.Keys[key]})
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteByte(',')
+		buf.Write(entry[1 : len(entry)-1])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
 }
 
 type ConfigDriveMetadata struct {
@@ -92,6 +137,9 @@ type ConfigDriveMetadata struct {
 	UUID          string            `json:"uuid,omitempty"`
 	Devices       *[]DeviceData     `json:"devices,omitempty"`
 	PublicSSHKeys map[string]string `json:"public_keys,omitempty"`
+	Meta          map[string]string `json:"meta,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
 }
 
 type DeviceData struct {
@@ -139,7 +187,15 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 
 			cloudInitData, err = readCloudInitNoCloudSource(volume.CloudInitNoCloud)
 			cloudInitData.NoCloudMetaData = readCloudInitNoCloudMetaData(hostname, cloudInitUUIDFromVMI(vmi), instancetype, keys)
+			if userMetaData := volume.CloudInitNoCloud.MetaData; userMetaData != nil {
+				cloudInitData.NoCloudMetaData.Keys = userMetaData.Keys
+				cloudInitData.NoCloudMetaData.Labels = selectEntries(vmi.Labels, userMetaData.Labels)
+				cloudInitData.NoCloudMetaData.Annotations = selectEntries(vmi.Annotations, userMetaData.Annotations)
+			}
 			cloudInitData.VolumeName = volume.Name
+			if err == nil && GetVolumeUpdatePolicy(&volume) != v1.CloudInitUpdatePolicyNone {
+				setRevisionInstanceID(cloudInitData)
+			}
 			return cloudInitData, err
 		}
 		if volume.CloudInitConfigDrive != nil {
@@ -152,13 +208,35 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 			uuid := cloudInitUUIDFromVMI(vmi)
 			cloudInitData, err = readCloudInitConfigDriveSource(volume.CloudInitConfigDrive)
 			cloudInitData.ConfigDriveMetaData = readCloudInitConfigDriveMetaData(vmi.Name, uuid, hostname, vmi.Namespace, keys, instancetype)
+			if userMetaData := volume.CloudInitConfigDrive.MetaData; userMetaData != nil {
+				cloudInitData.ConfigDriveMetaData.Meta = userMetaData.Keys
+				cloudInitData.ConfigDriveMetaData.Labels = selectEntries(vmi.Labels, userMetaData.Labels)
+				cloudInitData.ConfigDriveMetaData.Annotations = selectEntries(vmi.Annotations, userMetaData.Annotations)
+			}
 			cloudInitData.VolumeName = volume.Name
+			if err == nil && GetVolumeUpdatePolicy(&volume) != v1.CloudInitUpdatePolicyNone {
+				setRevisionInstanceID(cloudInitData)
+			}
 			return cloudInitData, err
 		}
 	}
 	return nil, nil
 }
 
+// selectEntries returns the entries of the given keys, the missing ones are skipped
+func selectEntries(entries map[string]string, keys []string) map[string]string {
+	selected := map[string]string{}
+	for _, key := range keys {
+		if value, exists := entries[key]; exists {
+			selected[key] = value
+		}
+	}
+	if len(selected) == 0 {
+		return nil
+	}
+	return selected
+}
+
 func isNoCloudAccessCredential(accessCred v1.AccessCredential) bool {
 	return accessCred.SSHPublicKey != nil && accessCred.SSHPublicKey.PropagationMethod.NoCloud != nil
 }
@@ -227,18 +305,23 @@ func resolveNoCloudSecrets(vmi *v1.VirtualMachineInstance, secretSourceDir strin
 		return keys, nil
 	}
 
-	baseDir := filepath.Join(secretSourceDir, volume.Name)
+	userDataDir, networkDataDir, vendorDataDir := secretDataDirs(volume, secretSourceDir)
 	var userDataError, networkDataError error
-	var userData, networkData string
+	var userData, networkData, vendorData string
 	if volume.CloudInitNoCloud.UserDataSecretRef != nil {
-		userData, userDataError = readFirstFoundFileFromDir(baseDir, []string{"userdata", "userData"})
+		userData, userDataError = readFirstFoundFileFromDir(userDataDir, []string{"userdata", "userData"})
 	}
 	if volume.CloudInitNoCloud.NetworkDataSecretRef != nil {
-		networkData, networkDataError = readFirstFoundFileFromDir(baseDir, []string{"networkdata", "networkData"})
+		networkData, networkDataError = readFirstFoundFileFromDir(networkDataDir, []string{"networkdata", "networkData"})
 	}
 	if userDataError != nil && networkDataError != nil {
 		return keys, fmt.Errorf("no cloud-init data-source found at volume: %s", volume.Name)
 	}
+	if volume.CloudInitNoCloud.VendorDataSecretRef != nil {
+		if vendorData, err = readFirstFoundFileFromDir(vendorDataDir, []string{"vendordata", "vendorData"}); err != nil {
+			return keys, fmt.Errorf("no cloud-init vendor data found at volume: %s", volume.Name)
+		}
+	}
 
 	if userData != "" {
 		volume.CloudInitNoCloud.UserData = userData
@@ -246,6 +329,9 @@ func resolveNoCloudSecrets(vmi *v1.VirtualMachineInstance, secretSourceDir strin
 	if networkData != "" {
 		volume.CloudInitNoCloud.NetworkData = networkData
 	}
+	if vendorData != "" {
+		volume.CloudInitNoCloud.VendorData = vendorData
+	}
 
 	return keys, nil
 }
@@ -266,37 +352,59 @@ func resolveConfigDriveSecrets(vmi *v1.VirtualMachineInstance, secretSourceDir s
 		return keys, nil
 	}
 
-	baseDir := filepath.Join(secretSourceDir, volume.Name)
+	userDataDir, networkDataDir, vendorDataDir := secretDataDirs(volume, secretSourceDir)
 	var userDataError, networkDataError error
-	var userData, networkData string
+	var userData, networkData, vendorData string
 	if volume.CloudInitConfigDrive.UserDataSecretRef != nil {
-		userData, userDataError = readFirstFoundFileFromDir(baseDir, []string{"userdata", "userData"})
+		userData, userDataError = readFirstFoundFileFromDir(userDataDir, []string{"userdata", "userData"})
 	}
 	if volume.CloudInitConfigDrive.NetworkDataSecretRef != nil {
-		networkData, networkDataError = readFirstFoundFileFromDir(baseDir, []string{"networkdata", "networkData"})
+		networkData, networkDataError = readFirstFoundFileFromDir(networkDataDir, []string{"networkdata", "networkData"})
 	}
 	if userDataError != nil && networkDataError != nil {
 		return keys, fmt.Errorf("no cloud-init data-source found at volume: %s", volume.Name)
 	}
+	if volume.CloudInitConfigDrive.VendorDataSecretRef != nil {
+		if vendorData, err = readFirstFoundFileFromDir(vendorDataDir, []string{"vendordata", "vendorData"}); err != nil {
+			return keys, fmt.Errorf("no cloud-init vendor data found at volume: %s", volume.Name)
+		}
+	}
 	if userData != "" {
 		volume.CloudInitConfigDrive.UserData = userData
 	}
 	if networkData != "" {
 		volume.CloudInitConfigDrive.NetworkData = networkData
 	}
+	if vendorData != "" {
+		volume.CloudInitConfigDrive.VendorData = vendorData
+	}
 
 	return keys, nil
 }
 
+// secretDataDirs returns the directories the user, network and vendor data secrets of the volume are found at.
+// The secrets are mounted as files of a directory named after the volume. With the Live update policy, they
+// are mounted as whole secret volumes instead, which the kubelet refreshes when the secrets change.
+func secretDataDirs(volume *v1.Volume, secretSourceDir string) (userDataDir, networkDataDir, vendorDataDir string) {
+	if GetVolumeUpdatePolicy(volume) == v1.CloudInitUpdatePolicyLive {
+		return filepath.Join(secretSourceDir, volume.Name+UserDataSecretVolumeSuffix),
+			filepath.Join(secretSourceDir, volume.Name+NetworkDataSecretVolumeSuffix),
+			filepath.Join(secretSourceDir, volume.Name+VendorDataSecretVolumeSuffix)
+	}
+	baseDir := filepath.Join(secretSourceDir, volume.Name)
+	return baseDir, baseDir, baseDir
+}
+
 // findCloudInitConfigDriveSecretVolume loops over a given list of volumes and return a pointer
-// to the first volume with a CloudInitConfigDrive source and UserDataSecretRef field set.
+// to the first volume with a CloudInitConfigDrive source and a secret ref field set.
 func findCloudInitConfigDriveSecretVolume(volumes []v1.Volume) *v1.Volume {
 	for _, volume := range volumes {
 		if volume.CloudInitConfigDrive == nil {
 			continue
 		}
 		if volume.CloudInitConfigDrive.UserDataSecretRef != nil ||
-			volume.CloudInitConfigDrive.NetworkDataSecretRef != nil {
+			volume.CloudInitConfigDrive.NetworkDataSecretRef != nil ||
+			volume.CloudInitConfigDrive.VendorDataSecretRef != nil {
 			return &volume
 		}
 	}
@@ -327,14 +435,15 @@ func readFileFromDir(basedir, file string) (string, error) {
 }
 
 // findCloudInitNoCloudSecretVolume loops over a given list of volumes and return a pointer
-// to the first CloudInitNoCloud volume with a UserDataSecretRef field set.
+// to the first CloudInitNoCloud volume with a secret ref field set.
 func findCloudInitNoCloudSecretVolume(volumes []v1.Volume) *v1.Volume {
 	for _, volume := range volumes {
 		if volume.CloudInitNoCloud == nil {
 			continue
 		}
 		if volume.CloudInitNoCloud.UserDataSecretRef != nil ||
-			volume.CloudInitNoCloud.NetworkDataSecretRef != nil {
+			volume.CloudInitNoCloud.NetworkDataSecretRef != nil ||
+			volume.CloudInitNoCloud.VendorDataSecretRef != nil {
 			return &volume
 		}
 	}
@@ -377,11 +486,16 @@ func readCloudInitNoCloudSource(source *v1.CloudInitNoCloudSource) (*CloudInitDa
 	if err != nil {
 		return &CloudInitData{}, err
 	}
+	vendorData, err := readRawOrBase64Data(source.VendorData, source.VendorDataBase64)
+	if err != nil {
+		return &CloudInitData{}, err
+	}
 
 	return &CloudInitData{
 		DataSource:  DataSourceNoCloud,
 		UserData:    userData,
 		NetworkData: networkData,
+		VendorData:  vendorData,
 	}, nil
 }
 
@@ -391,11 +505,16 @@ func readCloudInitConfigDriveSource(source *v1.CloudInitConfigDriveSource) (*Clo
 	if err != nil {
 		return &CloudInitData{}, err
 	}
+	vendorData, err := readRawOrBase64Data(source.VendorData, source.VendorDataBase64)
+	if err != nil {
+		return &CloudInitData{}, err
+	}
 
 	return &CloudInitData{
 		DataSource:  DataSourceConfigDrive,
 		UserData:    userData,
 		NetworkData: networkData,
+		VendorData:  vendorData,
 	}, nil
 }
 
@@ -562,6 +681,16 @@ func GenerateEmptyIso(vmiName string, namespace string, data *CloudInitData, siz
 	return nil
 }
 
+func ensureNoCloudMetaData(vmi *v1.VirtualMachineInstance, instanceType string, data *CloudInitData) {
+	if data.NoCloudMetaData == nil {
+		log.Log.V(2).Infof("No metadata found in cloud-init data. Create minimal metadata with instance-id.")
+		data.NoCloudMetaData = &NoCloudMetadata{
+			InstanceID: cloudInitUUIDFromVMI(vmi),
+		}
+		data.NoCloudMetaData.InstanceType = instanceType
+	}
+}
+
 func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data *CloudInitData) error {
 	precond.MustNotBeEmpty(vmi.Name)
 	precond.MustNotBeNil(data)
@@ -572,22 +701,21 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 	domainBasePath := getDomainBasePath(vmi.Name, vmi.Namespace)
 	dataBasePath := fmt.Sprintf("%s/data", domainBasePath)
 
-	var dataPath, metaFile, userFile, networkFile, iso, isoStaging string
+	var dataPath, metaFile, userFile, networkFile, vendorFile, iso, isoStaging string
+	var vendorData []byte
 	switch data.DataSource {
 	case DataSourceNoCloud:
 		dataPath = dataBasePath
 		metaFile = fmt.Sprintf("%s/%s", dataPath, "meta-data")
 		userFile = fmt.Sprintf("%s/%s", dataPath, "user-data")
 		networkFile = fmt.Sprintf("%s/%s", dataPath, "network-config")
+		vendorFile = fmt.Sprintf("%s/%s", dataPath, "vendor-data")
+		if data.VendorData != "" {
+			vendorData = []byte(data.VendorData)
+		}
 		iso = GetIsoFilePath(DataSourceNoCloud, vmi.Name, vmi.Namespace)
 		isoStaging = fmt.Sprintf(isoStagingFmt, iso)
-		if data.NoCloudMetaData == nil {
-			log.Log.V(2).Infof("No metadata found in cloud-init data. Create minimal metadata with instance-id.")
-			data.NoCloudMetaData = &NoCloudMetadata{
-				InstanceID: cloudInitUUIDFromVMI(vmi),
-			}
-			data.NoCloudMetaData.InstanceType = instanceType
-		}
+		ensureNoCloudMetaData(vmi, instanceType, data)
 		metaData, err = json.Marshal(data.NoCloudMetaData)
 		if err != nil {
 			return err
@@ -597,6 +725,13 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 		metaFile = fmt.Sprintf("%s/%s", dataPath, "meta_data.json")
 		userFile = fmt.Sprintf("%s/%s", dataPath, "user_data")
 		networkFile = fmt.Sprintf("%s/%s", dataPath, "network_data.json")
+		vendorFile = fmt.Sprintf("%s/%s", dataPath, "vendor_data.json")
+		if data.VendorData != "" {
+			// cloud-init looks for its vendor data under the cloud-init key of the OpenStack vendor data
+			if vendorData, err = json.Marshal(map[string]string{"cloud-init": data.VendorData}); err != nil {
+				return err
+			}
+		}
 		iso = GetIsoFilePath(DataSourceConfigDrive, vmi.Name, vmi.Namespace)
 		isoStaging = fmt.Sprintf(isoStagingFmt, iso)
 		if data.ConfigDriveMetaData == nil {
@@ -634,7 +769,7 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 		networkData = []byte(data.NetworkData)
 	}
 
-	err = diskutils.RemoveFilesIfExist(userFile, metaFile, networkFile, isoStaging)
+	err = diskutils.RemoveFilesIfExist(userFile, metaFile, networkFile, vendorFile, isoStaging)
 	if err != nil {
 		return err
 	}
@@ -659,6 +794,14 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 		defer os.Remove(networkFile)
 	}
 
+	if len(vendorData) > 0 {
+		err = os.WriteFile(vendorFile, vendorData, 0600)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(vendorFile)
+	}
+
 	switch data.DataSource {
 	case DataSourceNoCloud:
 		err = cloudInitIsoFunc(isoStaging, "cidata", dataBasePath)
@@ -207,6 +207,31 @@ var _ = Describe("CloudInit", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(string(buf)).To(Equal(exampleJSONParsed))
 			})
+			It("should add the user defined entries to the nocloud metadata", func() {
+				exampleJSONParsed := `{
+  "instance-id": "fake.fake-namespace",
+  "local-hostname": "fake",
+  "labels": {
+    "app": "db"
+  },
+  "annotations": {
+    "example.com/owner": "team-a"
+  },
+  "environment": "production",
+  "zone": "zone-a"
+}`
+
+				metadataStruct := NoCloudMetadata{
+					InstanceID:    "fake.fake-namespace",
+					LocalHostname: "fake",
+					Labels:        map[string]string{"app": "db"},
+					Annotations:   map[string]string{"example.com/owner": "team-a"},
+					Keys:          map[string]string{"zone": "zone-a", "environment": "production", "instance-id": "ignored"},
+				}
+				buf, err := json.MarshalIndent(metadataStruct, "", "  ")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(buf)).To(Equal(exampleJSONParsed))
+			})
 		})
 	})
 	Describe("Volume-based data source", func() {
@@ -429,6 +454,31 @@ var _ = Describe("CloudInit", func() {
 						Expect(keys).To(HaveLen(2))
 					})
 
+					It("should resolve no-cloud vendor data from volume", func() {
+						testVolume := createCloudInitSecretRefVolume("test-volume", "test-secret")
+						testVolume.CloudInitNoCloud.VendorDataSecretRef = &k8sv1.LocalObjectReference{Name: "test-vendor-secret"}
+						vmi := createEmptyVMIWithVolumes([]v1.Volume{*testVolume})
+						fakeVolumeMountDir("test-volume", map[string]string{
+							"userdata":   "secret-userdata",
+							"vendorData": "secret-vendordata",
+						})
+						_, err := resolveNoCloudSecrets(vmi, tmpDir)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(testVolume.CloudInitNoCloud.UserData).To(Equal("secret-userdata"))
+						Expect(testVolume.CloudInitNoCloud.VendorData).To(Equal("secret-vendordata"))
+					})
+
+					It("should fail if the referenced vendor data does not exist", func() {
+						testVolume := createCloudInitSecretRefVolume("test-volume", "test-secret")
+						testVolume.CloudInitNoCloud.VendorDataSecretRef = &k8sv1.LocalObjectReference{Name: "test-vendor-secret"}
+						vmi := createEmptyVMIWithVolumes([]v1.Volume{*testVolume})
+						fakeVolumeMountDir("test-volume", map[string]string{
+							"userdata": "secret-userdata",
+						})
+						_, err := resolveNoCloudSecrets(vmi, tmpDir)
+						Expect(err).To(MatchError("no cloud-init vendor data found at volume: test-volume"))
+					})
+
 					It("should resolve camel-case no-cloud data from volume", func() {
 						testVolume := createCloudInitSecretRefVolume("test-volume", "test-secret")
 						vmi := createEmptyVMIWithVolumes([]v1.Volume{*testVolume})
@@ -612,6 +662,103 @@ var _ = Describe("CloudInit", func() {
 		})
 	})
 
+	Describe("GenerateLocalData with vendor data", func() {
+		var isoFiles map[string]string
+
+		BeforeEach(func() {
+			isoFiles = map[string]string{}
+			isoCreationFunc = func(isoOutFile, _ string, inDir string) error {
+				err := filepath.WalkDir(inDir, func(path string, entry os.DirEntry, err error) error {
+					if err != nil || entry.IsDir() {
+						return err
+					}
+					content, err := os.ReadFile(path)
+					if err != nil {
+						return err
+					}
+					relPath, err := filepath.Rel(inDir, path)
+					isoFiles[relPath] = string(content)
+					return err
+				})
+				if err != nil {
+					return err
+				}
+				_, err = os.Create(isoOutFile)
+				return err
+			}
+		})
+
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-domain",
+				Namespace: "fake-namespace",
+			},
+		}
+
+		It("should add the vendor data to the nocloud iso", func() {
+			cloudInitData, err := readCloudInitNoCloudSource(&v1.CloudInitNoCloudSource{
+				UserData:         "#cloud-config\n",
+				VendorDataBase64: base64.StdEncoding.EncodeToString([]byte("#cloud-config\npackages: [qemu-guest-agent]\n")),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(GenerateLocalData(vmi, "", cloudInitData)).To(Succeed())
+			Expect(isoFiles).To(HaveKeyWithValue("vendor-data", "#cloud-config\npackages: [qemu-guest-agent]\n"))
+		})
+
+		It("should add the vendor data to the config drive iso under the cloud-init key", func() {
+			cloudInitData, err := readCloudInitConfigDriveSource(&v1.CloudInitConfigDriveSource{
+				UserData:   "#cloud-config\n",
+				VendorData: "#cloud-config\npackages: [qemu-guest-agent]\n",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(GenerateLocalData(vmi, "", cloudInitData)).To(Succeed())
+			Expect(isoFiles).To(HaveKeyWithValue(filepath.Join("openstack", "latest", "vendor_data.json"),
+				`{"cloud-init":"#cloud-config\npackages: [qemu-guest-agent]\n"}`))
+		})
+
+		It("should not add vendor data when there is none", func() {
+			cloudInitData, err := readCloudInitNoCloudSource(&v1.CloudInitNoCloudSource{UserData: "#cloud-config\n"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(GenerateLocalData(vmi, "", cloudInitData)).To(Succeed())
+			Expect(isoFiles).ToNot(HaveKey("vendor-data"))
+		})
+	})
+
+	Describe("ReadCloudInitVolumeDataSource with user defined metadata", func() {
+		metaData := &v1.CloudInitMetaData{
+			Keys:        map[string]string{"zone": "zone-a"},
+			Labels:      []string{"app", "missing"},
+			Annotations: []string{"example.com/owner"},
+		}
+
+		newVMI := func(volumeSource v1.VolumeSource) *v1.VirtualMachineInstance {
+			vmi := createEmptyVMIWithVolumes([]v1.Volume{{Name: "cloudinit", VolumeSource: volumeSource}})
+			vmi.Name = "fake-domain"
+			vmi.Namespace = "fake-namespace"
+			vmi.Labels = map[string]string{"app": "db", "tier": "backend"}
+			vmi.Annotations = map[string]string{"example.com/owner": "team-a", "example.com/other": "other"}
+			return vmi
+		}
+
+		It("should expose the entries in the nocloud metadata", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "#cloud-config\n", MetaData: metaData}})
+			cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloudInitData.NoCloudMetaData.Keys).To(Equal(map[string]string{"zone": "zone-a"}))
+			Expect(cloudInitData.NoCloudMetaData.Labels).To(Equal(map[string]string{"app": "db"}))
+			Expect(cloudInitData.NoCloudMetaData.Annotations).To(Equal(map[string]string{"example.com/owner": "team-a"}))
+		})
+
+		It("should expose the entries in the config drive metadata", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UserData: "#cloud-config\n", MetaData: metaData}})
+			cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloudInitData.ConfigDriveMetaData.Meta).To(Equal(map[string]string{"zone": "zone-a"}))
+			Expect(cloudInitData.ConfigDriveMetaData.Labels).To(Equal(map[string]string{"app": "db"}))
+			Expect(cloudInitData.ConfigDriveMetaData.Annotations).To(Equal(map[string]string{"example.com/owner": "team-a"}))
+		})
+	})
+
 	Describe("PrepareLocalPath", func() {
 		It("should create the correct directory structure", func() {
 			namespace := "fake-namespace"
@@ -0,0 +1,269 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// MetadataServiceAddress is the link-local address the guest reaches the metadata service on
+	MetadataServiceAddress = "169.254.169.254"
+	// MetadataServiceIPv6Address is the address the guest reaches the metadata service on over IPv6,
+	// the same as on EC2
+	MetadataServiceIPv6Address = "fd00:ec2::254"
+	// MetadataServiceGuestPort is the port the guest reaches the metadata service on
+	MetadataServiceGuestPort = 80
+	// MetadataServicePort is the port virt-launcher serves the metadata service on.
+	// The guest traffic to MetadataServiceAddress is redirected to it.
+	MetadataServicePort = 8775
+)
+
+// ec2VersionPattern matches the EC2 metadata API versions, e.g. latest or 2009-04-04
+var ec2VersionPattern = regexp.MustCompile(`^(latest|\d{4}-\d{2}-\d{2})$`)
+
+// HasMetadataService returns true if the VMI requests the cloud-init metadata service
+func HasMetadataService(vmi *v1.VirtualMachineInstance) bool {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.CloudInitNoCloud != nil && volume.CloudInitNoCloud.MetadataService != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// MetadataService serves the NoCloud data of a VMI over HTTP.
+// The data is served in the NoCloud-net layout at the root, e.g. /meta-data and /user-data,
+// and in the EC2 layout under the API version, e.g. /latest/meta-data/instance-id.
+type MetadataService struct {
+	files  map[string][]byte
+	ec2    map[string]any
+	server *http.Server
+}
+
+// NewMetadataService snapshots the given NoCloud data, later changes to it are not served
+func NewMetadataService(vmi *v1.VirtualMachineInstance, instanceType string, data *CloudInitData) (*MetadataService, error) {
+	if data.DataSource != DataSourceNoCloud {
+		return nil, fmt.Errorf("the metadata service requires a %s data source, got %s", DataSourceNoCloud, data.DataSource)
+	}
+	ensureNoCloudMetaData(vmi, instanceType, data)
+	metaData, err := json.Marshal(data.NoCloudMetaData)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{
+		"meta-data": metaData,
+		"user-data": []byte(data.UserData),
+	}
+	if data.VendorData != "" {
+		files["vendor-data"] = []byte(data.VendorData)
+	}
+	if data.NetworkData != "" {
+		files["network-config"] = []byte(data.NetworkData)
+	}
+
+	return &MetadataService{
+		files: files,
+		ec2:   ec2MetaData(data.NoCloudMetaData),
+	}, nil
+}
+
+func ec2MetaData(metaData *NoCloudMetadata) map[string]any {
+	tree := map[string]any{
+		"instance-id":    metaData.InstanceID,
+		"hostname":       metaData.LocalHostname,
+		"local-hostname": metaData.LocalHostname,
+	}
+	if metaData.InstanceType != "" {
+		tree["instance-type"] = metaData.InstanceType
+	}
+	if len(metaData.PublicSSHKeys) > 0 {
+		publicKeys := map[string]any{}
+		for idx, key := range metaData.PublicSSHKeys {
+			publicKeys[idx] = map[string]any{"openssh-key": key}
+		}
+		tree["public-keys"] = publicKeys
+	}
+	return tree
+}
+
+// Start serves the metadata service on the given addresses, until Stop is called.
+// The addresses do not need to be usable yet, e.g. an IPv6 address may still be tentative
+// until the guest is started and the bridge it is assigned to gets a carrier.
+func (s *MetadataService) Start(addresses ...string) error {
+	var listeners []net.Listener
+	for _, address := range addresses {
+		listener, err := freeBindListenConfig.Listen(context.Background(), "tcp", address)
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return fmt.Errorf("failed to listen on %s: %v", address, err)
+		}
+		listeners = append(listeners, listener)
+	}
+
+	s.server = &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	for _, listener := range listeners {
+		go func(listener net.Listener) {
+			if err := s.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+				log.Log.Reason(err).Error("cloud-init metadata service failed")
+			}
+		}(listener)
+	}
+	log.Log.Infof("serving the cloud-init metadata service on %s", strings.Join(addresses, ", "))
+	return nil
+}
+
+// freeBindListenConfig allows binding to addresses which are not assigned or usable yet
+var freeBindListenConfig = net.ListenConfig{
+	Control: func(network, _ string, c syscall.RawConn) error {
+		var sockErr error
+		err := c.Control(func(fd uintptr) {
+			if network == "tcp6" {
+				sockErr = unix.SetsockoptInt(int(fd), unix.SOL_IPV6, unix.IPV6_FREEBIND, 1)
+			} else {
+				sockErr = unix.SetsockoptInt(int(fd), unix.SOL_IP, unix.IP_FREEBIND, 1)
+			}
+		})
+		if err != nil {
+			return err
+		}
+		return sockErr
+	},
+}
+
+func (s *MetadataService) Stop() {
+	if s.server != nil {
+		s.server.Close()
+	}
+}
+
+func (s *MetadataService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		// EC2 clients asking for an IMDSv2 token fall back to IMDSv1 on this status
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	reqPath := strings.TrimPrefix(r.URL.Path, "/")
+	if file, exists := s.files[reqPath]; exists {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write(file)
+		return
+	}
+
+	version, ec2Path, _ := strings.Cut(reqPath, "/")
+	if !ec2VersionPattern.MatchString(version) {
+		http.NotFound(w, r)
+		return
+	}
+	content, found := s.ec2Content(ec2Path)
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.Write([]byte(content))
+}
+
+// ec2Content returns the content of a path of the EC2 layout.
+// Directories list their entries, one per line, with a trailing slash for sub directories.
+func (s *MetadataService) ec2Content(ec2Path string) (string, bool) {
+	switch strings.TrimSuffix(ec2Path, "/") {
+	case "":
+		entries := []string{"meta-data/"}
+		if len(s.files["user-data"]) > 0 {
+			entries = append(entries, "user-data")
+		}
+		return strings.Join(entries, "\n"), true
+	case "user-data":
+		userData := s.files["user-data"]
+		return string(userData), len(userData) > 0
+	}
+
+	metaDataPath, isMetaData := strings.CutPrefix(ec2Path, "meta-data")
+	if !isMetaData || (metaDataPath != "" && !strings.HasPrefix(metaDataPath, "/")) {
+		return "", false
+	}
+
+	var node any = s.ec2
+	for _, step := range strings.Split(strings.Trim(metaDataPath, "/"), "/") {
+		if step == "" {
+			continue
+		}
+		dir, isDir := node.(map[string]any)
+		if !isDir {
+			return "", false
+		}
+		if node = dir[step]; node == nil {
+			return "", false
+		}
+	}
+
+	switch node := node.(type) {
+	case string:
+		return node, true
+	case map[string]any:
+		return listEC2Directory(ec2Path, node), true
+	}
+	return "", false
+}
+
+func listEC2Directory(ec2Path string, dir map[string]any) string {
+	isPublicKeys := strings.TrimSuffix(ec2Path, "/") == "meta-data/public-keys"
+	entries := make([]string, 0, len(dir))
+	for name, node := range dir {
+		switch {
+		case isPublicKeys:
+			// EC2 lists the public keys as <index>=<key name>
+			entries = append(entries, fmt.Sprintf("%s=key-%s", name, name))
+		case isDirectory(node):
+			entries = append(entries, name+"/")
+		default:
+			entries = append(entries, name)
+		}
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, "\n")
+}
+
+func isDirectory(node any) bool {
+	_, isDir := node.(map[string]any)
+	return isDir
+}
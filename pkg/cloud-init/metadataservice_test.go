@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Metadata service", func() {
+	var server *httptest.Server
+
+	newVMI := func() *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default"},
+		}
+	}
+
+	startServer := func(data *CloudInitData) {
+		service, err := NewMetadataService(newVMI(), "u1.small", data)
+		Expect(err).ToNot(HaveOccurred())
+		server = httptest.NewServer(service)
+		DeferCleanup(server.Close)
+	}
+
+	get := func(path string) (int, string) {
+		resp, err := http.Get(server.URL + path)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return resp.StatusCode, string(body)
+	}
+
+	Context("with NoCloud data", func() {
+		BeforeEach(func() {
+			startServer(&CloudInitData{
+				DataSource: DataSourceNoCloud,
+				NoCloudMetaData: &NoCloudMetadata{
+					InstanceID:    "1234",
+					LocalHostname: "testvmi",
+					PublicSSHKeys: map[string]string{"0": "ssh-rsa AAAA", "1": "ssh-ed25519 BBBB"},
+					Keys:          map[string]string{"zone": "zone-a"},
+				},
+				UserData:   "#cloud-config\n",
+				VendorData: "#cloud-config\npackages: [qemu-guest-agent]\n",
+			})
+		})
+
+		DescribeTable("should serve the NoCloud-net layout", func(path string, expectedStatus int, expectedBody string) {
+			status, body := get(path)
+			Expect(status).To(Equal(expectedStatus))
+			if expectedStatus == http.StatusOK {
+				Expect(body).To(Equal(expectedBody))
+			}
+		},
+			Entry("meta-data", "/meta-data", http.StatusOK,
+				`{"instance-id":"1234","local-hostname":"testvmi","public-keys":{"0":"ssh-rsa AAAA","1":"ssh-ed25519 BBBB"},"zone":"zone-a"}`),
+			Entry("user-data", "/user-data", http.StatusOK, "#cloud-config\n"),
+			Entry("vendor-data", "/vendor-data", http.StatusOK, "#cloud-config\npackages: [qemu-guest-agent]\n"),
+			Entry("missing network-config", "/network-config", http.StatusNotFound, ""),
+		)
+
+		DescribeTable("should serve the EC2 layout", func(path string, expectedStatus int, expectedBody string) {
+			status, body := get(path)
+			Expect(status).To(Equal(expectedStatus))
+			if expectedStatus == http.StatusOK {
+				Expect(body).To(Equal(expectedBody))
+			}
+		},
+			Entry("version root", "/latest/", http.StatusOK, "meta-data/\nuser-data"),
+			Entry("meta-data", "/2009-04-04/meta-data/", http.StatusOK, "hostname\ninstance-id\nlocal-hostname\npublic-keys/"),
+			Entry("meta-data without trailing slash", "/latest/meta-data", http.StatusOK, "hostname\ninstance-id\nlocal-hostname\npublic-keys/"),
+			Entry("instance-id", "/latest/meta-data/instance-id", http.StatusOK, "1234"),
+			Entry("hostname", "/latest/meta-data/hostname", http.StatusOK, "testvmi"),
+			Entry("public keys", "/latest/meta-data/public-keys/", http.StatusOK, "0=key-0\n1=key-1"),
+			Entry("public key", "/latest/meta-data/public-keys/1/", http.StatusOK, "openssh-key"),
+			Entry("openssh key", "/latest/meta-data/public-keys/1/openssh-key", http.StatusOK, "ssh-ed25519 BBBB"),
+			Entry("user-data", "/latest/user-data", http.StatusOK, "#cloud-config\n"),
+			Entry("missing key", "/latest/meta-data/ami-id", http.StatusNotFound, ""),
+			Entry("path below a key", "/latest/meta-data/instance-id/foo", http.StatusNotFound, ""),
+			Entry("invalid version", "/foo/meta-data/instance-id", http.StatusNotFound, ""),
+		)
+
+		It("should reject IMDSv2 token requests", func() {
+			req, err := http.NewRequest(http.MethodPut, server.URL+"/latest/api/token", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp, err := http.DefaultClient.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+			resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusMethodNotAllowed))
+		})
+	})
+
+	It("should generate the metadata when there is none", func() {
+		data := &CloudInitData{DataSource: DataSourceNoCloud, NetworkData: "version: 2\n"}
+		startServer(data)
+		Expect(data.NoCloudMetaData).ToNot(BeNil())
+		Expect(data.NoCloudMetaData.InstanceType).To(Equal("u1.small"))
+
+		status, body := get("/latest/meta-data/instance-id")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body).To(Equal(data.NoCloudMetaData.InstanceID))
+		status, body = get("/network-config")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body).To(Equal("version: 2\n"))
+		status, _ = get("/latest/user-data")
+		Expect(status).To(Equal(http.StatusNotFound))
+	})
+
+	It("should start on addresses which are not assigned yet", func() {
+		service, err := NewMetadataService(newVMI(), "", &CloudInitData{DataSource: DataSourceNoCloud})
+		Expect(err).ToNot(HaveOccurred())
+		// 192.0.2.0/24 is reserved for documentation and never assigned
+		Expect(service.Start("127.0.0.1:0", "192.0.2.1:0")).To(Succeed())
+		service.Stop()
+	})
+
+	It("should reject a config drive data source", func() {
+		_, err := NewMetadataService(newVMI(), "", &CloudInitData{DataSource: DataSourceConfigDrive})
+		Expect(err).To(HaveOccurred())
+	})
+})
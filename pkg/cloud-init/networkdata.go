@@ -0,0 +1,185 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"sigs.k8s.io/yaml"
+)
+
+// NetworkDataInterface describes the addressing of a guest interface, matched by its MAC address.
+// An interface without addresses is configured with DHCP.
+type NetworkDataInterface struct {
+	MAC string
+	// Addresses are in CIDR notation.
+	Addresses   []string
+	Gateway     string
+	Nameservers []string
+}
+
+// GenerateNetworkData returns the network data of the data source, configuring the given interfaces.
+// NoCloud uses the network config version 2, ConfigDrive uses the OpenStack network_data.json format.
+func GenerateNetworkData(dataSource DataSourceType, interfaces []NetworkDataInterface) (string, error) {
+	switch dataSource {
+	case DataSourceNoCloud:
+		return generateNetworkConfigV2(interfaces)
+	case DataSourceConfigDrive:
+		return generateOpenStackNetworkData(interfaces)
+	default:
+		return "", fmt.Errorf("Invalid cloud-init data source: '%v'", dataSource)
+	}
+}
+
+type networkConfigV2 struct {
+	Version   int                           `json:"version"`
+	Ethernets map[string]networkConfigV2Eth `json:"ethernets"`
+}
+
+type networkConfigV2Eth struct {
+	Match       networkConfigV2Match        `json:"match"`
+	DHCP4       bool                        `json:"dhcp4,omitempty"`
+	Addresses   []string                    `json:"addresses,omitempty"`
+	Routes      []networkConfigV2Route      `json:"routes,omitempty"`
+	Nameservers *networkConfigV2Nameservers `json:"nameservers,omitempty"`
+}
+
+type networkConfigV2Match struct {
+	MACAddress string `json:"macaddress"`
+}
+
+type networkConfigV2Route struct {
+	To  string `json:"to"`
+	Via string `json:"via"`
+}
+
+type networkConfigV2Nameservers struct {
+	Addresses []string `json:"addresses"`
+}
+
+func generateNetworkConfigV2(interfaces []NetworkDataInterface) (string, error) {
+	config := networkConfigV2{Version: 2, Ethernets: map[string]networkConfigV2Eth{}}
+	for i, iface := range interfaces {
+		eth := networkConfigV2Eth{
+			Match:     networkConfigV2Match{MACAddress: iface.MAC},
+			Addresses: iface.Addresses,
+			DHCP4:     len(iface.Addresses) == 0,
+		}
+		if iface.Gateway != "" {
+			eth.Routes = []networkConfigV2Route{{To: "default", Via: iface.Gateway}}
+		}
+		if len(iface.Nameservers) > 0 {
+			eth.Nameservers = &networkConfigV2Nameservers{Addresses: iface.Nameservers}
+		}
+		config.Ethernets[fmt.Sprintf("iface%d", i)] = eth
+	}
+	networkData, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(networkData), nil
+}
+
+type openStackNetworkData struct {
+	Links    []openStackLink    `json:"links"`
+	Networks []openStackNetwork `json:"networks"`
+	Services []openStackService `json:"services,omitempty"`
+}
+
+type openStackLink struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	EthernetMAC string `json:"ethernet_mac_address"`
+}
+
+type openStackNetwork struct {
+	ID        string           `json:"id"`
+	Link      string           `json:"link"`
+	Type      string           `json:"type"`
+	IPAddress string           `json:"ip_address,omitempty"`
+	Netmask   string           `json:"netmask,omitempty"`
+	Routes    []openStackRoute `json:"routes,omitempty"`
+}
+
+type openStackRoute struct {
+	Network string `json:"network"`
+	Netmask string `json:"netmask"`
+	Gateway string `json:"gateway"`
+}
+
+type openStackService struct {
+	Type    string `json:"type"`
+	Address string `json:"address"`
+}
+
+func generateOpenStackNetworkData(interfaces []NetworkDataInterface) (string, error) {
+	var (
+		networkData openStackNetworkData
+		nameservers = map[string]struct{}{}
+	)
+	for i, iface := range interfaces {
+		linkID := fmt.Sprintf("iface%d", i)
+		networkData.Links = append(networkData.Links, openStackLink{ID: linkID, Type: "phy", EthernetMAC: iface.MAC})
+
+		if len(iface.Addresses) == 0 {
+			networkData.Networks = append(networkData.Networks, openStackNetwork{
+				ID:   fmt.Sprintf("network%d", i),
+				Link: linkID,
+				Type: "ipv4_dhcp",
+			})
+		}
+		for j, cidr := range iface.Addresses {
+			ip, ipNet, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return "", err
+			}
+			network := openStackNetwork{
+				ID:        fmt.Sprintf("network%d-%d", i, j),
+				Link:      linkID,
+				Type:      "ipv4",
+				IPAddress: ip.String(),
+				Netmask:   net.IP(ipNet.Mask).String(),
+			}
+			defaultNetwork := "0.0.0.0"
+			if ip.To4() == nil {
+				network.Type = "ipv6"
+				defaultNetwork = "::"
+			}
+			if gateway := net.ParseIP(iface.Gateway); gateway != nil && (gateway.To4() == nil) == (ip.To4() == nil) {
+				network.Routes = []openStackRoute{{Network: defaultNetwork, Netmask: defaultNetwork, Gateway: iface.Gateway}}
+			}
+			networkData.Networks = append(networkData.Networks, network)
+		}
+
+		for _, nameserver := range iface.Nameservers {
+			if _, exists := nameservers[nameserver]; !exists {
+				nameservers[nameserver] = struct{}{}
+				networkData.Services = append(networkData.Services, openStackService{Type: "dns", Address: nameserver})
+			}
+		}
+	}
+	data, err := json.Marshal(networkData)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Network data", func() {
+	interfaces := []NetworkDataInterface{{
+		MAC: "02:00:00:00:00:00",
+	}, {
+		MAC:         "02:00:00:00:00:01",
+		Addresses:   []string{"10.10.0.2/24"},
+		Gateway:     "10.10.0.1",
+		Nameservers: []string{"10.10.0.53"},
+	}}
+
+	It("should generate network config version 2 for NoCloud", func() {
+		networkData, err := GenerateNetworkData(DataSourceNoCloud, interfaces)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(networkData).To(MatchYAML(`
+version: 2
+ethernets:
+  iface0:
+    match:
+      macaddress: "02:00:00:00:00:00"
+    dhcp4: true
+  iface1:
+    match:
+      macaddress: "02:00:00:00:00:01"
+    addresses: ["10.10.0.2/24"]
+    routes:
+    - to: default
+      via: 10.10.0.1
+    nameservers:
+      addresses: ["10.10.0.53"]
+`))
+	})
+
+	It("should generate OpenStack network data for ConfigDrive", func() {
+		networkData, err := GenerateNetworkData(DataSourceConfigDrive, interfaces)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(networkData).To(MatchJSON(`{
+			"links": [
+				{"id": "iface0", "type": "phy", "ethernet_mac_address": "02:00:00:00:00:00"},
+				{"id": "iface1", "type": "phy", "ethernet_mac_address": "02:00:00:00:00:01"}
+			],
+			"networks": [{
+				"id": "network0", "link": "iface0", "type": "ipv4_dhcp"
+			}, {
+				"id": "network1-0", "link": "iface1", "type": "ipv4",
+				"ip_address": "10.10.0.2", "netmask": "255.255.255.0",
+				"routes": [{"network": "0.0.0.0", "netmask": "0.0.0.0", "gateway": "10.10.0.1"}]
+			}],
+			"services": [{"type": "dns", "address": "10.10.0.53"}]
+		}`))
+	})
+
+	It("should fail on an invalid address", func() {
+		_, err := GenerateNetworkData(DataSourceConfigDrive, []NetworkDataInterface{{MAC: "02:00:00:00:00:01", Addresses: []string{"10.10.0.2"}}})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"sort"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// The pod volumes the user, network and vendor data secrets of a cloud-init volume are mounted from
+	UserDataSecretVolumeSuffix    = "-udata"
+	NetworkDataSecretVolumeSuffix = "-ndata"
+	VendorDataSecretVolumeSuffix  = "-vdata"
+
+	// dataRevisionLength is the number of hex digits of the data hash used as revision
+	dataRevisionLength = 10
+)
+
+// GetUpdatePolicy returns the update policy of the cloud-init volume of the VMI.
+// It defaults to None.
+func GetUpdatePolicy(vmi *v1.VirtualMachineInstance) v1.CloudInitUpdatePolicy {
+	for _, volume := range vmi.Spec.Volumes {
+		if policy := GetVolumeUpdatePolicy(&volume); policy != "" {
+			return policy
+		}
+	}
+	return v1.CloudInitUpdatePolicyNone
+}
+
+// GetVolumeUpdatePolicy returns the update policy of a cloud-init volume, and an empty policy for other volumes
+func GetVolumeUpdatePolicy(volume *v1.Volume) v1.CloudInitUpdatePolicy {
+	var policy *v1.CloudInitUpdatePolicy
+	switch {
+	case volume.CloudInitNoCloud != nil:
+		policy = volume.CloudInitNoCloud.UpdatePolicy
+	case volume.CloudInitConfigDrive != nil:
+		policy = volume.CloudInitConfigDrive.UpdatePolicy
+	default:
+		return ""
+	}
+	if policy == nil || *policy == "" {
+		return v1.CloudInitUpdatePolicyNone
+	}
+	return *policy
+}
+
+// IsLiveUpdatePolicy returns true if the cloud-init data of the running VMI follows the changes of its secrets
+func IsLiveUpdatePolicy(vmi *v1.VirtualMachineInstance) bool {
+	return GetUpdatePolicy(vmi) == v1.CloudInitUpdatePolicyLive
+}
+
+// DataRevision returns a hash of the user provided cloud-init data: the user, network and
+// vendor data, and the public SSH keys. It changes when the referenced secrets change.
+func DataRevision(data *CloudInitData) string {
+	digest := sha256.New()
+	for _, entry := range []string{data.UserData, data.NetworkData, data.VendorData} {
+		writeHashEntry(digest, entry)
+	}
+
+	var publicSSHKeys map[string]string
+	switch {
+	case data.NoCloudMetaData != nil:
+		publicSSHKeys = data.NoCloudMetaData.PublicSSHKeys
+	case data.ConfigDriveMetaData != nil:
+		publicSSHKeys = data.ConfigDriveMetaData.PublicSSHKeys
+	}
+	keys := make([]string, 0, len(publicSSHKeys))
+	for key := range publicSSHKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		writeHashEntry(digest, key)
+		writeHashEntry(digest, publicSSHKeys[key])
+	}
+
+	return hex.EncodeToString(digest.Sum(nil))[:dataRevisionLength]
+}
+
+// writeHashEntry writes the entry prefixed by its length, so that the boundaries of the entries are part of the hash
+func writeHashEntry(digest hash.Hash, entry string) {
+	fmt.Fprintf(digest, "%d:%s", len(entry), entry)
+}
+
+// setRevisionInstanceID appends the data revision to the instance-id, so that the guest
+// runs cloud-init as a new instance when the data changes
+func setRevisionInstanceID(data *CloudInitData) {
+	revision := DataRevision(data)
+	if data.NoCloudMetaData != nil {
+		data.NoCloudMetaData.InstanceID += "-" + revision
+	}
+	if data.ConfigDriveMetaData != nil {
+		data.ConfigDriveMetaData.InstanceID += "-" + revision
+	}
+}
@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Cloud-init update policy", func() {
+	const firmwareUUID = "5d307ca9-b3ef-428c-8861-06e72d69f223"
+
+	newVMI := func(source v1.VolumeSource) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default"},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Domain:  v1.DomainSpec{Firmware: &v1.Firmware{UUID: firmwareUUID}},
+				Volumes: []v1.Volume{{Name: "cloudinit", VolumeSource: source}},
+			},
+		}
+	}
+
+	Context("DataRevision", func() {
+		It("should be stable", func() {
+			data := &CloudInitData{
+				UserData:        "#cloud-config\n",
+				NoCloudMetaData: &NoCloudMetadata{PublicSSHKeys: map[string]string{"0": "ssh-rsa AAA", "1": "ssh-rsa BBB"}},
+			}
+			Expect(DataRevision(data)).To(HaveLen(dataRevisionLength))
+			Expect(DataRevision(data)).To(Equal(DataRevision(data)))
+		})
+
+		DescribeTable("should change when the data changes", func(changed *CloudInitData) {
+			data := &CloudInitData{UserData: "#cloud-config\n", NoCloudMetaData: &NoCloudMetadata{}}
+			Expect(DataRevision(changed)).ToNot(Equal(DataRevision(data)))
+		},
+			Entry("user data", &CloudInitData{UserData: "#cloud-config\npassword: fedora\n", NoCloudMetaData: &NoCloudMetadata{}}),
+			Entry("network data", &CloudInitData{UserData: "#cloud-config\n", NetworkData: "version: 2", NoCloudMetaData: &NoCloudMetadata{}}),
+			Entry("vendor data", &CloudInitData{UserData: "#cloud-config\n", VendorData: "#cloud-config\n", NoCloudMetaData: &NoCloudMetadata{}}),
+			Entry("public SSH keys", &CloudInitData{UserData: "#cloud-config\n", NoCloudMetaData: &NoCloudMetadata{PublicSSHKeys: map[string]string{"0": "ssh-rsa AAA"}}}),
+			Entry("boundaries of the entries", &CloudInitData{UserData: "#cloud-config", NetworkData: "\n", NoCloudMetaData: &NoCloudMetadata{}}),
+		)
+
+		It("should ignore the generated metadata", func() {
+			data := &CloudInitData{UserData: "#cloud-config\n", NoCloudMetaData: &NoCloudMetadata{InstanceID: "a"}}
+			other := &CloudInitData{UserData: "#cloud-config\n", NoCloudMetaData: &NoCloudMetadata{InstanceID: "b", LocalHostname: "b"}}
+			Expect(DataRevision(data)).To(Equal(DataRevision(other)))
+		})
+	})
+
+	DescribeTable("GetUpdatePolicy should return", func(source v1.VolumeSource, expected v1.CloudInitUpdatePolicy) {
+		Expect(GetUpdatePolicy(newVMI(source))).To(Equal(expected))
+	},
+		Entry("None without a cloud-init volume", v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{}}, v1.CloudInitUpdatePolicyNone),
+		Entry("None by default", v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{}}, v1.CloudInitUpdatePolicyNone),
+		Entry("the NoCloud policy",
+			v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UpdatePolicy: pointer.P(v1.CloudInitUpdatePolicyLive)}},
+			v1.CloudInitUpdatePolicyLive,
+		),
+		Entry("the config drive policy",
+			v1.VolumeSource{CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UpdatePolicy: pointer.P(v1.CloudInitUpdatePolicyRestart)}},
+			v1.CloudInitUpdatePolicyRestart,
+		),
+	)
+
+	Context("ReadCloudInitVolumeDataSource", func() {
+		var secretSourceDir string
+
+		BeforeEach(func() {
+			secretSourceDir = GinkgoT().TempDir()
+		})
+
+		writeSecret := func(dir string, files map[string]string) {
+			Expect(os.MkdirAll(filepath.Join(secretSourceDir, dir), 0755)).To(Succeed())
+			for name, content := range files {
+				Expect(os.WriteFile(filepath.Join(secretSourceDir, dir, name), []byte(content), 0644)).To(Succeed())
+			}
+		}
+
+		It("should keep the instance-id with the None policy", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "#cloud-config\n"}})
+			data, err := ReadCloudInitVolumeDataSource(vmi, secretSourceDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data.NoCloudMetaData.InstanceID).To(Equal(firmwareUUID))
+		})
+
+		It("should derive the NoCloud instance-id from the data with the Restart policy", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+				UserData:     "#cloud-config\n",
+				UpdatePolicy: pointer.P(v1.CloudInitUpdatePolicyRestart),
+			}})
+			data, err := ReadCloudInitVolumeDataSource(vmi, secretSourceDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data.NoCloudMetaData.InstanceID).To(Equal(firmwareUUID + "-" + DataRevision(data)))
+		})
+
+		It("should derive the config drive instance-id from the data with the Restart policy", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{
+				UserData:     "#cloud-config\n",
+				UpdatePolicy: pointer.P(v1.CloudInitUpdatePolicyRestart),
+			}})
+			data, err := ReadCloudInitVolumeDataSource(vmi, secretSourceDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data.ConfigDriveMetaData.InstanceID).To(Equal("testvmi.default-" + DataRevision(data)))
+			Expect(data.ConfigDriveMetaData.UUID).To(Equal(firmwareUUID))
+		})
+
+		It("should read the whole secret volumes with the Live policy", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+				UserDataSecretRef:    &k8sv1.LocalObjectReference{Name: "userdata"},
+				NetworkDataSecretRef: &k8sv1.LocalObjectReference{Name: "networkdata"},
+				VendorDataSecretRef:  &k8sv1.LocalObjectReference{Name: "vendordata"},
+				UpdatePolicy:         pointer.P(v1.CloudInitUpdatePolicyLive),
+			}})
+			writeSecret("cloudinit"+UserDataSecretVolumeSuffix, map[string]string{"userdata": "secret-userdata"})
+			writeSecret("cloudinit"+NetworkDataSecretVolumeSuffix, map[string]string{"networkData": "secret-networkdata"})
+			writeSecret("cloudinit"+VendorDataSecretVolumeSuffix, map[string]string{"vendordata": "secret-vendordata"})
+
+			data, err := ReadCloudInitVolumeDataSource(vmi, secretSourceDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data.UserData).To(Equal("secret-userdata"))
+			Expect(data.NetworkData).To(Equal("secret-networkdata"))
+			Expect(data.VendorData).To(Equal("secret-vendordata"))
+		})
+
+		It("should change the instance-id when a secret changes", func() {
+			newLiveVMI := func() *v1.VirtualMachineInstance {
+				return newVMI(v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+					UserDataSecretRef: &k8sv1.LocalObjectReference{Name: "userdata"},
+					UpdatePolicy:      pointer.P(v1.CloudInitUpdatePolicyLive),
+				}})
+			}
+			writeSecret("cloudinit"+UserDataSecretVolumeSuffix, map[string]string{"userdata": "#cloud-config\n"})
+			data, err := ReadCloudInitVolumeDataSource(newLiveVMI(), secretSourceDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			writeSecret("cloudinit"+UserDataSecretVolumeSuffix, map[string]string{"userdata": "#cloud-config\npassword: fedora\n"})
+			updatedData, err := ReadCloudInitVolumeDataSource(newLiveVMI(), secretSourceDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedData.NoCloudMetaData.InstanceID).ToNot(Equal(data.NoCloudMetaData.InstanceID))
+		})
+	})
+})
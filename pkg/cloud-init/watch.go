@@ -0,0 +1,124 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// secretsChangeAggregationInterval is how long the changes of the secrets are collected before they are
+// reported once, the kubelet refreshes the secrets of a pod one after the other
+var secretsChangeAggregationInterval = 5 * time.Second
+
+// SecretDirs returns the directories the secrets of the cloud-init volume of the VMI are mounted at,
+// when the volume has the Live update policy. The data of the other policies is only read on start.
+func SecretDirs(vmi *v1.VirtualMachineInstance, secretSourceDir string) []string {
+	var dirs []string
+	for idx := range vmi.Spec.Volumes {
+		volume := &vmi.Spec.Volumes[idx]
+		if GetVolumeUpdatePolicy(volume) != v1.CloudInitUpdatePolicyLive {
+			continue
+		}
+
+		var userDataSecretRef, networkDataSecretRef, vendorDataSecretRef bool
+		isAccessCredentialValid := isNoCloudAccessCredential
+		if source := volume.CloudInitNoCloud; source != nil {
+			userDataSecretRef = source.UserDataSecretRef != nil
+			networkDataSecretRef = source.NetworkDataSecretRef != nil
+			vendorDataSecretRef = source.VendorDataSecretRef != nil
+		} else {
+			source := volume.CloudInitConfigDrive
+			userDataSecretRef = source.UserDataSecretRef != nil
+			networkDataSecretRef = source.NetworkDataSecretRef != nil
+			vendorDataSecretRef = source.VendorDataSecretRef != nil
+			isAccessCredentialValid = isConfigDriveAccessCredential
+		}
+
+		userDataDir, networkDataDir, vendorDataDir := secretDataDirs(volume, secretSourceDir)
+		if userDataSecretRef {
+			dirs = append(dirs, userDataDir)
+		}
+		if networkDataSecretRef {
+			dirs = append(dirs, networkDataDir)
+		}
+		if vendorDataSecretRef {
+			dirs = append(dirs, vendorDataDir)
+		}
+		for _, accessCred := range vmi.Spec.AccessCredentials {
+			if isAccessCredentialValid(accessCred) && accessCred.SSHPublicKey.Source.Secret != nil {
+				dirs = append(dirs, filepath.Join(secretSourceDir, accessCred.SSHPublicKey.Source.Secret.SecretName+"-access-cred"))
+			}
+		}
+	}
+	return dirs
+}
+
+// WatchSecrets calls onChange when the secrets of the cloud-init volume of the VMI change, until stop is closed.
+// The kubelet refreshes the secrets mounted in the pod some time after they changed in the cluster.
+// Nothing is watched when the cloud-init volume does not have the Live update policy.
+func WatchSecrets(vmi *v1.VirtualMachineInstance, secretSourceDir string, stop <-chan struct{}, onChange func()) error {
+	dirs := SecretDirs(vmi, secretSourceDir)
+	if len(dirs) == 0 {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			// Ignoring the error of Close, the error of Add is returned
+			_ = watcher.Close()
+			return err
+		}
+	}
+
+	go func() {
+		// Ignoring the error of Close, the watch has stopped
+		defer func() { _ = watcher.Close() }()
+		ticker := time.NewTicker(secretsChangeAggregationInterval)
+		defer ticker.Stop()
+
+		changed := false
+		for {
+			select {
+			case <-watcher.Events:
+				changed = true
+			case err := <-watcher.Errors:
+				log.Log.Object(vmi).Reason(err).Error("Error encountered while watching the cloud-init secrets")
+			case <-ticker.C:
+				if changed {
+					changed = false
+					onChange()
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return nil
+}
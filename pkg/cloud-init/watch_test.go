@@ -0,0 +1,95 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Cloud-init secrets watch", func() {
+	var secretSourceDir string
+
+	newVMI := func(updatePolicy v1.CloudInitUpdatePolicy) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Volumes = []v1.Volume{{
+			Name: "cloudinit",
+			VolumeSource: v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+				UserDataSecretRef:   &k8sv1.LocalObjectReference{Name: "userdata-secret"},
+				VendorDataSecretRef: &k8sv1.LocalObjectReference{Name: "vendordata-secret"},
+				UpdatePolicy:        pointer.P(updatePolicy),
+			}},
+		}}
+		vmi.Spec.AccessCredentials = []v1.AccessCredential{{
+			SSHPublicKey: &v1.SSHPublicKeyAccessCredential{
+				Source:            v1.SSHPublicKeyAccessCredentialSource{Secret: &v1.AccessCredentialSecretSource{SecretName: "keys-secret"}},
+				PropagationMethod: v1.SSHPublicKeyAccessCredentialPropagationMethod{NoCloud: &v1.NoCloudSSHPublicKeyAccessCredentialPropagation{}},
+			},
+		}}
+		return vmi
+	}
+
+	BeforeEach(func() {
+		secretSourceDir = GinkgoT().TempDir()
+	})
+
+	It("should list the secret volumes of the Live update policy", func() {
+		Expect(SecretDirs(newVMI(v1.CloudInitUpdatePolicyLive), secretSourceDir)).To(ConsistOf(
+			filepath.Join(secretSourceDir, "cloudinit-udata"),
+			filepath.Join(secretSourceDir, "cloudinit-vdata"),
+			filepath.Join(secretSourceDir, "keys-secret-access-cred"),
+		))
+	})
+
+	It("should not list the secrets of the other update policies", func() {
+		Expect(SecretDirs(newVMI(v1.CloudInitUpdatePolicyRestart), secretSourceDir)).To(BeEmpty())
+	})
+
+	It("should report the changes of the secrets once", func() {
+		originalInterval := secretsChangeAggregationInterval
+		secretsChangeAggregationInterval = 100 * time.Millisecond
+		DeferCleanup(func() { secretsChangeAggregationInterval = originalInterval })
+
+		vmi := newVMI(v1.CloudInitUpdatePolicyLive)
+		for _, dir := range SecretDirs(vmi, secretSourceDir) {
+			Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+		}
+
+		changes := make(chan struct{}, 10)
+		stop := make(chan struct{})
+		DeferCleanup(func() { close(stop) })
+		Expect(WatchSecrets(vmi, secretSourceDir, stop, func() { changes <- struct{}{} })).To(Succeed())
+
+		Expect(os.WriteFile(filepath.Join(secretSourceDir, "cloudinit-udata", "userdata"), []byte("#cloud-config\n"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(secretSourceDir, "cloudinit-vdata", "vendordata"), []byte("#cloud-config\n"), 0644)).To(Succeed())
+		Eventually(changes).Should(Receive())
+		Consistently(changes, 300*time.Millisecond).ShouldNot(Receive())
+	})
+})
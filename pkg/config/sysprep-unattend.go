@@ -0,0 +1,391 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package config
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util/net/dns"
+)
+
+const (
+	// SysprepAdminPasswordVolumeSuffix is appended to the name of a Sysprep volume to name the volume
+	// of the Administrator password secret of a generated answer file
+	SysprepAdminPasswordVolumeSuffix = "-admin"
+	// SysprepDomainJoinVolumeSuffix is appended to the name of a Sysprep volume to name the volume
+	// of the domain join credentials secret of a generated answer file
+	SysprepDomainJoinVolumeSuffix = "-domain"
+
+	sysprepUsernameKey = "username"
+	sysprepPasswordKey = "password"
+
+	// Windows limits computer names to the 15 characters of a NetBIOS name
+	maxComputerNameLength = 15
+
+	wcmNamespace = "http://schemas.microsoft.com/WMIConfig/2002/State"
+	// The public key token of all the Windows components
+	componentPublicKeyToken = "31bf3856ad364e35"
+	// ProtectYourPC level which only installs the important updates
+	protectYourPCImportantUpdates = 3
+)
+
+type unattend struct {
+	XMLName  xml.Name           `xml:"urn:schemas-microsoft-com:unattend unattend"`
+	XMLNSWcm string             `xml:"xmlns:wcm,attr"`
+	Settings []unattendSettings `xml:"settings"`
+}
+
+type unattendSettings struct {
+	Pass       string        `xml:"pass,attr"`
+	Components []interface{} `xml:"component"`
+}
+
+type unattendComponent struct {
+	Name                  string `xml:"name,attr"`
+	ProcessorArchitecture string `xml:"processorArchitecture,attr"`
+	PublicKeyToken        string `xml:"publicKeyToken,attr"`
+	Language              string `xml:"language,attr"`
+	VersionScope          string `xml:"versionScope,attr"`
+}
+
+type internationalCoreComponent struct {
+	unattendComponent
+	SetupUILanguage *setupUILanguage `xml:"SetupUILanguage,omitempty"`
+	InputLocale     string           `xml:"InputLocale"`
+	SystemLocale    string           `xml:"SystemLocale"`
+	UILanguage      string           `xml:"UILanguage"`
+	UserLocale      string           `xml:"UserLocale"`
+}
+
+type setupUILanguage struct {
+	UILanguage string `xml:"UILanguage"`
+}
+
+type pnpCustomizationsComponent struct {
+	unattendComponent
+	DriverPaths []pathAndCredentials `xml:"DriverPaths>PathAndCredentials"`
+}
+
+type pathAndCredentials struct {
+	Action   string `xml:"wcm:action,attr"`
+	KeyValue string `xml:"wcm:keyValue,attr"`
+	Path     string `xml:"Path"`
+}
+
+type setupComponent struct {
+	unattendComponent
+	UserData setupUserData `xml:"UserData"`
+}
+
+type setupUserData struct {
+	AcceptEula bool             `xml:"AcceptEula"`
+	ProductKey *setupProductKey `xml:"ProductKey,omitempty"`
+}
+
+type setupProductKey struct {
+	Key        string `xml:"Key"`
+	WillShowUI string `xml:"WillShowUI"`
+}
+
+type shellSetupSpecializeComponent struct {
+	unattendComponent
+	ComputerName string `xml:"ComputerName"`
+	ProductKey   string `xml:"ProductKey,omitempty"`
+	TimeZone     string `xml:"TimeZone,omitempty"`
+}
+
+type unattendedJoinComponent struct {
+	unattendComponent
+	Identification joinIdentification `xml:"Identification"`
+}
+
+type joinIdentification struct {
+	Credentials     joinCredentials `xml:"Credentials"`
+	JoinDomain      string          `xml:"JoinDomain"`
+	MachineObjectOU string          `xml:"MachineObjectOU,omitempty"`
+}
+
+type joinCredentials struct {
+	Domain   string `xml:"Domain"`
+	Username string `xml:"Username"`
+	Password string `xml:"Password"`
+}
+
+type shellSetupOOBEComponent struct {
+	unattendComponent
+	OOBE               oobe                `xml:"OOBE"`
+	UserAccounts       *userAccounts       `xml:"UserAccounts,omitempty"`
+	AutoLogon          *autoLogon          `xml:"AutoLogon,omitempty"`
+	FirstLogonCommands *firstLogonCommands `xml:"FirstLogonCommands,omitempty"`
+}
+
+type firstLogonCommands struct {
+	SynchronousCommands []synchronousCommand `xml:"SynchronousCommand"`
+}
+
+type oobe struct {
+	HideEULAPage             bool `xml:"HideEULAPage"`
+	HideOnlineAccountScreens bool `xml:"HideOnlineAccountScreens"`
+	HideWirelessSetupInOOBE  bool `xml:"HideWirelessSetupInOOBE"`
+	ProtectYourPC            int  `xml:"ProtectYourPC"`
+}
+
+type userAccounts struct {
+	AdministratorPassword unattendPassword `xml:"AdministratorPassword"`
+}
+
+type unattendPassword struct {
+	Value     string `xml:"Value"`
+	PlainText bool   `xml:"PlainText"`
+}
+
+type autoLogon struct {
+	Enabled    bool             `xml:"Enabled"`
+	LogonCount int              `xml:"LogonCount"`
+	Username   string           `xml:"Username"`
+	Password   unattendPassword `xml:"Password"`
+}
+
+type synchronousCommand struct {
+	Action      string `xml:"wcm:action,attr"`
+	Order       int    `xml:"Order"`
+	CommandLine string `xml:"CommandLine"`
+}
+
+// unattendSecrets holds the values the answer file reads from secrets
+type unattendSecrets struct {
+	adminPassword  string
+	domainUsername string
+	domainPassword string
+}
+
+func createGeneratedSysprepDisk(vmi *v1.VirtualMachineInstance, volume *v1.Volume, size int64) error {
+	secrets, err := readUnattendSecrets(volume.Name, volume.Sysprep.Unattend)
+	if err != nil {
+		return err
+	}
+	data, err := generateUnattend(vmi, volume.Sysprep.Unattend, secrets)
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp(SysprepDisksDir, volume.Name)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, autounattendFilename)
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		return err
+	}
+
+	return createIsoImageAndSetFileOwnership(volume.Name, []string{autounattendFilename + "=" + filePath}, size)
+}
+
+func readUnattendSecrets(volumeName string, source *v1.SysprepUnattend) (*unattendSecrets, error) {
+	secrets := &unattendSecrets{}
+	var err error
+	if source.AdminPasswordSecretRef != nil {
+		dir := GetSysprepSourcePath(volumeName + SysprepAdminPasswordVolumeSuffix)
+		if secrets.adminPassword, err = readSysprepSecretKey(dir, sysprepPasswordKey); err != nil {
+			return nil, err
+		}
+	}
+	if source.DomainJoin != nil {
+		dir := GetSysprepSourcePath(volumeName + SysprepDomainJoinVolumeSuffix)
+		if secrets.domainUsername, err = readSysprepSecretKey(dir, sysprepUsernameKey); err != nil {
+			return nil, err
+		}
+		if secrets.domainPassword, err = readSysprepSecretKey(dir, sysprepPasswordKey); err != nil {
+			return nil, err
+		}
+	}
+	return secrets, nil
+}
+
+// readSysprepSecretKey ignores the trailing newline which files often end with
+func readSysprepSecretKey(dir, key string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, key))
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s of the Sysprep answer file: %w", key, err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// GetSysprepComputerName returns the computer name of a generated Sysprep answer file
+func GetSysprepComputerName(vmi *v1.VirtualMachineInstance, source *v1.SysprepUnattend) string {
+	if source.ComputerName != "" {
+		return source.ComputerName
+	}
+	computerName := dns.SanitizeHostname(vmi)
+	if len(computerName) > maxComputerNameLength {
+		computerName = computerName[:maxComputerNameLength]
+	}
+	return strings.TrimRight(computerName, "-")
+}
+
+func generateUnattend(vmi *v1.VirtualMachineInstance, source *v1.SysprepUnattend, secrets *unattendSecrets) ([]byte, error) {
+	arch := "amd64"
+	if vmi.Spec.Architecture == "arm64" {
+		arch = "arm64"
+	}
+	component := func(name string) unattendComponent {
+		return unattendComponent{
+			Name:                  name,
+			ProcessorArchitecture: arch,
+			PublicKeyToken:        componentPublicKeyToken,
+			Language:              "neutral",
+			VersionScope:          "nonSxS",
+		}
+	}
+
+	// windowsPE only applies when Windows is installed from the setup media
+	windowsPE := unattendSettings{Pass: "windowsPE"}
+	if source.Locale != "" {
+		windowsPE.Components = append(windowsPE.Components, &internationalCoreComponent{
+			unattendComponent: component("Microsoft-Windows-International-Core-WinPE"),
+			SetupUILanguage:   &setupUILanguage{UILanguage: source.Locale},
+			InputLocale:       source.Locale,
+			SystemLocale:      source.Locale,
+			UILanguage:        source.Locale,
+			UserLocale:        source.Locale,
+		})
+	}
+	if len(source.DriverPaths) > 0 {
+		windowsPE.Components = append(windowsPE.Components, &pnpCustomizationsComponent{
+			unattendComponent: component("Microsoft-Windows-PnpCustomizationsWinPE"),
+			DriverPaths:       driverPaths(source.DriverPaths),
+		})
+	}
+	setup := &setupComponent{
+		unattendComponent: component("Microsoft-Windows-Setup"),
+		UserData:          setupUserData{AcceptEula: true},
+	}
+	if source.ProductKey != "" {
+		setup.UserData.ProductKey = &setupProductKey{Key: source.ProductKey, WillShowUI: "OnError"}
+	}
+	windowsPE.Components = append(windowsPE.Components, setup)
+
+	settings := []unattendSettings{windowsPE}
+
+	if len(source.DriverPaths) > 0 {
+		settings = append(settings, unattendSettings{
+			Pass: "offlineServicing",
+			Components: []interface{}{&pnpCustomizationsComponent{
+				unattendComponent: component("Microsoft-Windows-PnpCustomizationsNonWinPE"),
+				DriverPaths:       driverPaths(source.DriverPaths),
+			}},
+		})
+	}
+
+	specialize := unattendSettings{Pass: "specialize"}
+	specialize.Components = append(specialize.Components, &shellSetupSpecializeComponent{
+		unattendComponent: component("Microsoft-Windows-Shell-Setup"),
+		ComputerName:      GetSysprepComputerName(vmi, source),
+		ProductKey:        source.ProductKey,
+		TimeZone:          source.TimeZone,
+	})
+	if source.DomainJoin != nil {
+		specialize.Components = append(specialize.Components, &unattendedJoinComponent{
+			unattendComponent: component("Microsoft-Windows-UnattendedJoin"),
+			Identification: joinIdentification{
+				Credentials: joinCredentials{
+					Domain:   source.DomainJoin.Domain,
+					Username: secrets.domainUsername,
+					Password: secrets.domainPassword,
+				},
+				JoinDomain:      source.DomainJoin.Domain,
+				MachineObjectOU: source.DomainJoin.OrganizationalUnit,
+			},
+		})
+	}
+	settings = append(settings, specialize)
+
+	oobeSystem := unattendSettings{Pass: "oobeSystem"}
+	if source.Locale != "" {
+		oobeSystem.Components = append(oobeSystem.Components, &internationalCoreComponent{
+			unattendComponent: component("Microsoft-Windows-International-Core"),
+			InputLocale:       source.Locale,
+			SystemLocale:      source.Locale,
+			UILanguage:        source.Locale,
+			UserLocale:        source.Locale,
+		})
+	}
+	shellSetup := &shellSetupOOBEComponent{
+		unattendComponent: component("Microsoft-Windows-Shell-Setup"),
+		OOBE: oobe{
+			HideEULAPage:             true,
+			HideOnlineAccountScreens: true,
+			HideWirelessSetupInOOBE:  true,
+			ProtectYourPC:            protectYourPCImportantUpdates,
+		},
+	}
+	if source.AdminPasswordSecretRef != nil {
+		password := unattendPassword{Value: secrets.adminPassword, PlainText: true}
+		shellSetup.UserAccounts = &userAccounts{AdministratorPassword: password}
+		// The first logon commands only run once a user logs on
+		if len(source.FirstLogonCommands) > 0 {
+			shellSetup.AutoLogon = &autoLogon{
+				Enabled:    true,
+				LogonCount: 1,
+				Username:   "Administrator",
+				Password:   password,
+			}
+		}
+	}
+	if len(source.FirstLogonCommands) > 0 {
+		shellSetup.FirstLogonCommands = &firstLogonCommands{}
+		for i, command := range source.FirstLogonCommands {
+			shellSetup.FirstLogonCommands.SynchronousCommands = append(shellSetup.FirstLogonCommands.SynchronousCommands, synchronousCommand{
+				Action:      "add",
+				Order:       i + 1,
+				CommandLine: command,
+			})
+		}
+	}
+	oobeSystem.Components = append(oobeSystem.Components, shellSetup)
+	settings = append(settings, oobeSystem)
+
+	data, err := xml.MarshalIndent(&unattend{XMLNSWcm: wcmNamespace, Settings: settings}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the Sysprep answer file: %w", err)
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+func driverPaths(paths []string) []pathAndCredentials {
+	var driverPaths []pathAndCredentials
+	for i, path := range paths {
+		driverPaths = append(driverPaths, pathAndCredentials{
+			Action:   "add",
+			KeyValue: strconv.Itoa(i + 1),
+			Path:     path,
+		})
+	}
+	return driverPaths
+}
@@ -44,7 +44,7 @@ func GetSysprepDiskPath(volumeName string) string {
 }
 
 func sysprepVolumeHasContents(sysprepVolume *v1.SysprepSource) bool {
-	return sysprepVolume.ConfigMap != nil || sysprepVolume.Secret != nil
+	return sysprepVolume.ConfigMap != nil || sysprepVolume.Secret != nil || sysprepVolume.Unattend != nil
 }
 
 // Explained here: https://docs.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-setup-automation-overview
@@ -64,7 +64,8 @@ func validateUnattendPresence(dirPath string) error {
 	return fmt.Errorf("Sysprep drive should contain %s or %s but neither were found.", autounattendFilename, unattendFilename)
 }
 
-// CreateSysprepDisks creates Sysprep iso disks which are attached to vmis from either ConfigMap or Secret as a source
+// CreateSysprepDisks creates Sysprep iso disks which are attached to vmis from either ConfigMap or Secret as a source,
+// or from an answer file generated from the Unattend settings
 func CreateSysprepDisks(vmi *v1.VirtualMachineInstance, emptyIso bool) error {
 	for _, volume := range vmi.Spec.Volumes {
 		if !shouldCreateSysprepDisk(volume.Sysprep) {
@@ -74,6 +75,12 @@ func CreateSysprepDisks(vmi *v1.VirtualMachineInstance, emptyIso bool) error {
 		if err != nil {
 			return err
 		}
+		if volume.Sysprep.Unattend != nil {
+			if err := createGeneratedSysprepDisk(vmi, &volume, vmiIsoSize); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := createSysprepDisk(volume.Name, vmiIsoSize); err != nil {
 			return err
 		}
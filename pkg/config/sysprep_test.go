@@ -22,10 +22,13 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	k8sv1 "k8s.io/api/core/v1"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
@@ -95,3 +98,112 @@ var _ = Describe("SysprepConfigMap", func() {
 		Entry("Should fail when using a secret and finding incorrect filenames", vmiSecret, []string{"wrongname.xml", "foobar.xml"}),
 	)
 })
+
+var _ = Describe("SysprepUnattend", func() {
+	var generated string
+
+	BeforeEach(func() {
+		var err error
+
+		SysprepSourceDir, err = os.MkdirTemp("", "sysprep")
+		Expect(err).NotTo(HaveOccurred())
+		SysprepDisksDir, err = os.MkdirTemp("", "sysprep-disks")
+		Expect(err).NotTo(HaveOccurred())
+
+		generated = ""
+		setIsoCreationFunction(func(output string, _ string, files []string) error {
+			Expect(files).To(HaveLen(1))
+			name, path, _ := strings.Cut(files[0], "=")
+			Expect(name).To(Equal("autounattend.xml"))
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			generated = string(data)
+			return mockCreateISOImage(output, "", files)
+		})
+		DeferCleanup(setIsoCreationFunction, mockCreateISOImage)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(SysprepSourceDir)
+		os.RemoveAll(SysprepDisksDir)
+	})
+
+	createSecret := func(volumeName string, data map[string]string) {
+		dir := filepath.Join(SysprepSourceDir, volumeName)
+		Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+		for key, value := range data {
+			Expect(os.WriteFile(filepath.Join(dir, key), []byte(value), 0600)).To(Succeed())
+		}
+	}
+
+	It("should generate the answer file and create the ISO", func() {
+		createSecret("sysprep-volume-admin", map[string]string{"password": "s3cr<t\n"})
+		createSecret("sysprep-volume-domain", map[string]string{"username": "joiner", "password": "joinpass"})
+		vmi := libvmi.New(
+			libvmi.WithName("windows-server-2022"),
+			libvmi.WithSysprepUnattend("sysprep-volume", &v1.SysprepUnattend{
+				AdminPasswordSecretRef: &k8sv1.LocalObjectReference{Name: "admin"},
+				ProductKey:             "AAAAA-BBBBB-CCCCC-DDDDD-EEEEE",
+				Locale:                 "de-DE",
+				TimeZone:               "UTC",
+				DomainJoin: &v1.SysprepDomainJoin{
+					Domain:               "ad.example.com",
+					CredentialsSecretRef: &k8sv1.LocalObjectReference{Name: "domain"},
+					OrganizationalUnit:   "OU=VMs,DC=ad,DC=example,DC=com",
+				},
+				FirstLogonCommands: []string{"powershell -Command Enable-PSRemoting", "shutdown /r /t 0"},
+				DriverPaths:        []string{`E:\amd64\2k22`},
+			}),
+		)
+
+		Expect(CreateSysprepDisks(vmi, false)).To(Succeed())
+		_, err := os.Stat(filepath.Join(SysprepDisksDir, "sysprep-volume.iso"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(generated).To(HavePrefix(`<?xml version="1.0" encoding="UTF-8"?>`))
+		Expect(generated).To(ContainSubstring(`<unattend xmlns="urn:schemas-microsoft-com:unattend" xmlns:wcm="http://schemas.microsoft.com/WMIConfig/2002/State">`))
+		Expect(generated).To(ContainSubstring(`<ComputerName>windows-server</ComputerName>`))
+		Expect(generated).To(ContainSubstring(`<Key>AAAAA-BBBBB-CCCCC-DDDDD-EEEEE</Key>`))
+		Expect(generated).To(ContainSubstring(`<UserLocale>de-DE</UserLocale>`))
+		Expect(generated).To(ContainSubstring(`<TimeZone>UTC</TimeZone>`))
+		Expect(generated).To(ContainSubstring(`<Value>s3cr&lt;t</Value>`))
+		Expect(generated).To(ContainSubstring(`<LogonCount>1</LogonCount>`))
+		Expect(generated).To(ContainSubstring(`<Username>joiner</Username>`))
+		Expect(generated).To(ContainSubstring(`<JoinDomain>ad.example.com</JoinDomain>`))
+		Expect(generated).To(ContainSubstring(`<MachineObjectOU>OU=VMs,DC=ad,DC=example,DC=com</MachineObjectOU>`))
+		Expect(generated).To(ContainSubstring(`<PathAndCredentials wcm:action="add" wcm:keyValue="1">`))
+		Expect(generated).To(ContainSubstring(`<Path>E:\amd64\2k22</Path>`))
+		Expect(generated).To(ContainSubstring(`<SynchronousCommand wcm:action="add">`))
+		Expect(generated).To(ContainSubstring(`<CommandLine>shutdown /r /t 0</CommandLine>`))
+	})
+
+	It("should only generate the requested settings", func() {
+		vmi := libvmi.New(
+			libvmi.WithName("testvmi"),
+			libvmi.WithSysprepUnattend("sysprep-volume", &v1.SysprepUnattend{
+				ComputerName: "WIN-TEST",
+			}),
+		)
+
+		Expect(CreateSysprepDisks(vmi, false)).To(Succeed())
+		Expect(generated).To(ContainSubstring(`<ComputerName>WIN-TEST</ComputerName>`))
+		Expect(generated).To(ContainSubstring(`<AcceptEula>true</AcceptEula>`))
+		Expect(generated).ToNot(ContainSubstring("ProductKey"))
+		Expect(generated).ToNot(ContainSubstring("UserAccounts"))
+		Expect(generated).ToNot(ContainSubstring("AutoLogon"))
+		Expect(generated).ToNot(ContainSubstring("FirstLogonCommands"))
+		Expect(generated).ToNot(ContainSubstring("UnattendedJoin"))
+		Expect(generated).ToNot(ContainSubstring("offlineServicing"))
+		Expect(generated).ToNot(ContainSubstring("International-Core"))
+	})
+
+	It("should fail when the Administrator password secret is missing", func() {
+		vmi := libvmi.New(
+			libvmi.WithSysprepUnattend("sysprep-volume", &v1.SysprepUnattend{
+				AdminPasswordSecretRef: &k8sv1.LocalObjectReference{Name: "admin"},
+			}),
+		)
+
+		Expect(CreateSysprepDisks(vmi, false)).To(MatchError(ContainSubstring("failed to read password of the Sysprep answer file")))
+	})
+})
@@ -26,6 +26,7 @@ import (
 	"sync"
 	"time"
 
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	secv1 "github.com/openshift/api/security/v1"
@@ -185,6 +186,9 @@ type KubeInformerFactory interface {
 	// Watches for the export route config map
 	ExportRouteConfigMap() cache.SharedIndexInformer
 
+	// Watches for the config maps holding the NUMA topology of the nodes
+	NUMATopologyConfigMap() cache.SharedIndexInformer
+
 	// Watches for the kubevirt export service
 	ExportService() cache.SharedIndexInformer
 
@@ -209,6 +213,12 @@ type KubeInformerFactory interface {
 	// Fake CDI DataSource informer used when feature gate is disabled
 	DummyDataSource() cache.SharedIndexInformer
 
+	// Watches for NetworkAttachmentDefinition objects
+	NetworkAttachmentDefinition() cache.SharedIndexInformer
+
+	// Fake NetworkAttachmentDefinition informer used when the API is not available
+	DummyNetworkAttachmentDefinition() cache.SharedIndexInformer
+
 	// Watches for CDI StorageProfile objects
 	StorageProfile() cache.SharedIndexInformer
 
@@ -998,6 +1008,21 @@ func (f *kubeInformerFactory) DummyDataSource() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) NetworkAttachmentDefinition() cache.SharedIndexInformer {
+	return f.getInformer("networkAttachmentDefinitionInformer", func() cache.SharedIndexInformer {
+		restClient := f.clientSet.NetworkClient().K8sCniCncfIoV1().RESTClient()
+		lw := cache.NewListWatchFromClient(restClient, "network-attachment-definitions", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &networkv1.NetworkAttachmentDefinition{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) DummyNetworkAttachmentDefinition() cache.SharedIndexInformer {
+	return f.getInformer("fakeNetworkAttachmentDefinitionInformer", func() cache.SharedIndexInformer {
+		informer, _ := testutils.NewFakeInformerFor(&networkv1.NetworkAttachmentDefinition{})
+		return informer
+	})
+}
+
 func (f *kubeInformerFactory) StorageProfile() cache.SharedIndexInformer {
 	return f.getInformer("storageProfileInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.CdiClient().CdiV1beta1().RESTClient(), "storageprofiles", k8sv1.NamespaceAll, fields.Everything())
@@ -1105,6 +1130,18 @@ func (f *kubeInformerFactory) ExportRouteConfigMap() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) NUMATopologyConfigMap() cache.SharedIndexInformer {
+	return f.getInformer("numaTopologyConfigMapInformer", func() cache.SharedIndexInformer {
+		labelSelector, err := labels.Parse(kubev1.NUMATopologyNodeLabel)
+		if err != nil {
+			panic(err)
+		}
+
+		lw := NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "configmaps", f.kubevirtNamespace, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &k8sv1.ConfigMap{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) ExportService() cache.SharedIndexInformer {
 	return f.getInformer("exportService", func() cache.SharedIndexInformer {
 		// Watch all service with the kubevirt app label
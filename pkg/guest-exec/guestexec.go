@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestexec
+
+import (
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// UserParam is the query parameter virt-api passes the requesting user to virt-handler with
+	UserParam = "user"
+	// MaxOutputBytesParam is the query parameter virt-api passes the output size limit to virt-handler with
+	MaxOutputBytesParam = "maxOutputBytes"
+)
+
+// NamespaceLabelsFunc returns the labels of the namespace of the VMI
+type NamespaceLabelsFunc func() (map[string]string, error)
+
+// IsCommandAllowed reports whether the command is allowed by the cluster wide allow-list, or by
+// an allow-list selecting the namespace of the VMI. The namespace labels are only fetched when
+// the latter have to be consulted.
+func IsCommandAllowed(config *v1.GuestExecConfiguration, command string, namespaceLabels NamespaceLabelsFunc) (bool, error) {
+	if config == nil {
+		return false, nil
+	}
+	if slices.Contains(config.AllowedCommands, command) {
+		return true, nil
+	}
+
+	var nsLabels labels.Set
+	for _, allowList := range config.NamespaceAllowedCommands {
+		if !slices.Contains(allowList.AllowedCommands, command) {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(&allowList.NamespaceSelector)
+		if err != nil {
+			return false, err
+		}
+		if nsLabels == nil {
+			l, err := namespaceLabels()
+			if err != nil {
+				return false, err
+			}
+			nsLabels = labels.Set(l)
+		}
+		if selector.Matches(nsLabels) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Timeout returns the timeout the command is run with: the requested one, or the default one
+// when none is requested. A timeout above the configured maximum is rejected.
+func Timeout(config *v1.GuestExecConfiguration, requested *int32) (int32, error) {
+	defaultTimeout := virtconfig.DefaultGuestExecTimeoutSeconds
+	maxTimeout := virtconfig.DefaultGuestExecMaxTimeoutSeconds
+	if config != nil && config.DefaultTimeoutSeconds != nil {
+		defaultTimeout = *config.DefaultTimeoutSeconds
+	}
+	if config != nil && config.MaxTimeoutSeconds != nil {
+		maxTimeout = *config.MaxTimeoutSeconds
+	}
+
+	if requested == nil {
+		return min(defaultTimeout, maxTimeout), nil
+	}
+	if *requested <= 0 {
+		return 0, fmt.Errorf("timeoutSeconds must be positive")
+	}
+	if *requested > maxTimeout {
+		return 0, fmt.Errorf("timeoutSeconds must not be greater than %d", maxTimeout)
+	}
+	return *requested, nil
+}
+
+// MaxOutputBytes returns the maximum size of the command output returned
+func MaxOutputBytes(config *v1.GuestExecConfiguration) int64 {
+	if config != nil && config.MaxOutputBytes != nil {
+		return *config.MaxOutputBytes
+	}
+	return virtconfig.DefaultGuestExecMaxOutputBytes
+}
+
+// TruncateOutput cuts the output to at most maxBytes, without splitting a UTF-8 encoded
+// character, and reports whether it was truncated
+func TruncateOutput(output string, maxBytes int64) (string, bool) {
+	if maxBytes < 0 || int64(len(output)) <= maxBytes {
+		return output, false
+	}
+	end := int(maxBytes)
+	for end > 0 && !utf8.RuneStart(output[end]) {
+		end--
+	}
+	return output[:end], true
+}
@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestexec_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	guestexec "kubevirt.io/kubevirt/pkg/guest-exec"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Guest exec", func() {
+	config := &v1.GuestExecConfiguration{
+		AllowedCommands: []string{"/usr/bin/uptime"},
+		NamespaceAllowedCommands: []v1.GuestExecNamespaceAllowList{{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "ops"}},
+			AllowedCommands:   []string{"/usr/bin/journalctl"},
+		}},
+		DefaultTimeoutSeconds: pointer.P(int32(5)),
+		MaxTimeoutSeconds:     pointer.P(int32(30)),
+	}
+
+	namespaceLabels := func(nsLabels map[string]string) guestexec.NamespaceLabelsFunc {
+		return func() (map[string]string, error) {
+			return nsLabels, nil
+		}
+	}
+
+	DescribeTable("should check the command against the allow-lists", func(config *v1.GuestExecConfiguration, command string, nsLabels map[string]string, expected bool) {
+		allowed, err := guestexec.IsCommandAllowed(config, command, namespaceLabels(nsLabels))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(Equal(expected))
+	},
+		Entry("without configuration", nil, "/usr/bin/uptime", nil, false),
+		Entry("with a command allowed in all namespaces", config, "/usr/bin/uptime", nil, true),
+		Entry("with a command allowed in the namespace", config, "/usr/bin/journalctl", map[string]string{"team": "ops"}, true),
+		Entry("with a command allowed in other namespaces", config, "/usr/bin/journalctl", map[string]string{"team": "dev"}, false),
+		Entry("with a command which is not allowed", config, "/usr/bin/rm", map[string]string{"team": "ops"}, false),
+		Entry("with a command prefixed by an allowed one", config, "/usr/bin/uptime2", nil, false),
+	)
+
+	It("should not fetch the namespace labels for a command allowed in all namespaces", func() {
+		allowed, err := guestexec.IsCommandAllowed(config, "/usr/bin/uptime", func() (map[string]string, error) {
+			return nil, errors.New("unexpected namespace lookup")
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("should fail if the namespace labels cannot be fetched", func() {
+		_, err := guestexec.IsCommandAllowed(config, "/usr/bin/journalctl", func() (map[string]string, error) {
+			return nil, errors.New("namespace lookup failed")
+		})
+		Expect(err).To(MatchError("namespace lookup failed"))
+	})
+
+	DescribeTable("should resolve the timeout", func(config *v1.GuestExecConfiguration, requested *int32, expected int32) {
+		Expect(guestexec.Timeout(config, requested)).To(Equal(expected))
+	},
+		Entry("with the configured default", config, nil, int32(5)),
+		Entry("with the requested timeout", config, pointer.P(int32(30)), int32(30)),
+		Entry("with the built-in default", nil, nil, virtconfig.DefaultGuestExecTimeoutSeconds),
+	)
+
+	DescribeTable("should reject the timeout", func(requested int32, expectedMessage string) {
+		_, err := guestexec.Timeout(config, &requested)
+		Expect(err).To(MatchError(expectedMessage))
+	},
+		Entry("when it is not positive", int32(0), "timeoutSeconds must be positive"),
+		Entry("when it exceeds the maximum", int32(31), "timeoutSeconds must not be greater than 30"),
+	)
+
+	DescribeTable("should truncate the output", func(output string, maxBytes int64, expectedOutput string, expectedTruncated bool) {
+		truncatedOutput, truncated := guestexec.TruncateOutput(output, maxBytes)
+		Expect(truncatedOutput).To(Equal(expectedOutput))
+		Expect(truncated).To(Equal(expectedTruncated))
+	},
+		Entry("when it fits", "uptime", int64(6), "uptime", false),
+		Entry("when it exceeds the limit", "uptime", int64(2), "up", true),
+		Entry("without splitting a character", "añb", int64(2), "a", true),
+	)
+})
@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestfile
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	PathParam   = "path"
+	OffsetParam = "offset"
+	LengthParam = "length"
+	// UserParam is the query parameter virt-api passes the requesting user to virt-handler with
+	UserParam = "user"
+	// MaxSizeParam is the query parameter virt-api passes the maximum size of the files read to virt-handler with
+	MaxSizeParam = "maxSize"
+
+	// MaxChunkBytes is the maximum size of the chunks read from and written to the guest files
+	MaxChunkBytes int64 = 1024 * 1024
+)
+
+// ParseReadQuery parses and validates the read options of the subresource request query.
+// The length defaults to, and is limited by, MaxChunkBytes.
+func ParseReadQuery(query url.Values) (*v1.VirtualMachineInstanceGuestFileReadOptions, error) {
+	opts := &v1.VirtualMachineInstanceGuestFileReadOptions{
+		Path:   query.Get(PathParam),
+		Length: MaxChunkBytes,
+	}
+	if opts.Path == "" {
+		return nil, fmt.Errorf("%s must not be empty", PathParam)
+	}
+
+	if offset := query.Get(OffsetParam); offset != "" {
+		value, err := strconv.ParseInt(offset, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", OffsetParam, offset, err)
+		}
+		if value < 0 {
+			return nil, fmt.Errorf("%s must not be negative", OffsetParam)
+		}
+		opts.Offset = value
+	}
+
+	if length := query.Get(LengthParam); length != "" {
+		value, err := strconv.ParseInt(length, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", LengthParam, length, err)
+		}
+		if value <= 0 {
+			return nil, fmt.Errorf("%s must be positive", LengthParam)
+		}
+		opts.Length = min(value, MaxChunkBytes)
+	}
+	return opts, nil
+}
+
+// ReadQuery returns the subresource request query of the read options
+func ReadQuery(opts *v1.VirtualMachineInstanceGuestFileReadOptions) url.Values {
+	query := url.Values{}
+	query.Set(PathParam, opts.Path)
+	query.Set(OffsetParam, strconv.FormatInt(opts.Offset, 10))
+	query.Set(LengthParam, strconv.FormatInt(opts.Length, 10))
+	return query
+}
+
+// ValidateWrite validates the chunk written to a guest file
+func ValidateWrite(opts *v1.VirtualMachineInstanceGuestFileWriteOptions) error {
+	if opts.Path == "" {
+		return fmt.Errorf("%s must not be empty", PathParam)
+	}
+	if opts.Offset < 0 {
+		return fmt.Errorf("%s must not be negative", OffsetParam)
+	}
+	if int64(len(opts.Data)) > MaxChunkBytes {
+		return fmt.Errorf("chunks must not be larger than %d bytes", MaxChunkBytes)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestfile_test
+
+import (
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	guestfile "kubevirt.io/kubevirt/pkg/guest-file"
+)
+
+var _ = Describe("Guest file", func() {
+	DescribeTable("should parse the read query", func(query url.Values, expected *v1.VirtualMachineInstanceGuestFileReadOptions) {
+		opts, err := guestfile.ParseReadQuery(query)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(opts).To(Equal(expected))
+	},
+		Entry("with the default length",
+			url.Values{"path": {"/etc/hostname"}},
+			&v1.VirtualMachineInstanceGuestFileReadOptions{Path: "/etc/hostname", Length: guestfile.MaxChunkBytes},
+		),
+		Entry("with an offset and a length",
+			url.Values{"path": {`C:\Windows\win.ini`}, "offset": {"1024"}, "length": {"512"}},
+			&v1.VirtualMachineInstanceGuestFileReadOptions{Path: `C:\Windows\win.ini`, Offset: 1024, Length: 512},
+		),
+		Entry("with a length above the chunk size",
+			url.Values{"path": {"/etc/hostname"}, "length": {"10485760"}},
+			&v1.VirtualMachineInstanceGuestFileReadOptions{Path: "/etc/hostname", Length: guestfile.MaxChunkBytes},
+		),
+	)
+
+	DescribeTable("should reject an invalid read query", func(query url.Values, expectedErr string) {
+		_, err := guestfile.ParseReadQuery(query)
+		Expect(err).To(MatchError(expectedErr))
+	},
+		Entry("without a path", url.Values{}, "path must not be empty"),
+		Entry("with an invalid offset", url.Values{"path": {"/f"}, "offset": {"x"}}, `invalid offset "x": strconv.ParseInt: parsing "x": invalid syntax`),
+		Entry("with a negative offset", url.Values{"path": {"/f"}, "offset": {"-1"}}, "offset must not be negative"),
+		Entry("with a zero length", url.Values{"path": {"/f"}, "length": {"0"}}, "length must be positive"),
+	)
+
+	It("should build the query the read options are parsed from", func() {
+		opts := &v1.VirtualMachineInstanceGuestFileReadOptions{Path: "/etc/hostname", Offset: 10, Length: 20}
+		Expect(guestfile.ParseReadQuery(guestfile.ReadQuery(opts))).To(Equal(opts))
+	})
+
+	DescribeTable("should validate the chunks written", func(opts *v1.VirtualMachineInstanceGuestFileWriteOptions, expectedErr string) {
+		err := guestfile.ValidateWrite(opts)
+		if expectedErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(expectedErr))
+		}
+	},
+		Entry("with a valid chunk", &v1.VirtualMachineInstanceGuestFileWriteOptions{Path: "/tmp/f", Offset: 10, Data: []byte("data")}, ""),
+		Entry("with an empty chunk", &v1.VirtualMachineInstanceGuestFileWriteOptions{Path: "/tmp/f"}, ""),
+		Entry("without a path", &v1.VirtualMachineInstanceGuestFileWriteOptions{Data: []byte("data")}, "path must not be empty"),
+		Entry("with a negative offset", &v1.VirtualMachineInstanceGuestFileWriteOptions{Path: "/tmp/f", Offset: -1}, "offset must not be negative"),
+		Entry("with a chunk too large",
+			&v1.VirtualMachineInstanceGuestFileWriteOptions{Path: "/tmp/f", Data: make([]byte, guestfile.MaxChunkBytes+1)},
+			"chunks must not be larger than 1048576 bytes",
+		),
+	)
+})
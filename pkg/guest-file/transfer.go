@@ -0,0 +1,102 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestfile
+
+import (
+	"sync"
+	"time"
+)
+
+// Transfer is a guest file opened for a copy from or to the guest. All the chunks of a copy are
+// read or written in order through the same guest agent handle.
+type Transfer struct {
+	Handle int64
+	// Size of the guest file copied from the guest
+	Size int64
+	// Offset of the next chunk
+	Offset int64
+
+	close func() error
+	timer *time.Timer
+}
+
+// NewTransfer returns the transfer of the guest file with the given handle, closed with closeFile
+func NewTransfer(handle, size int64, closeFile func() error) *Transfer {
+	return &Transfer{
+		Handle: handle,
+		Size:   size,
+		close:  closeFile,
+	}
+}
+
+// Close closes the guest file of the transfer
+func (t *Transfer) Close() error {
+	return t.close()
+}
+
+// Transfers keeps the transfers in progress between their chunks. A transfer which is not
+// continued within the idle timeout is abandoned, and its guest file is closed.
+type Transfers struct {
+	lock        sync.Mutex
+	transfers   map[string]*Transfer
+	idleTimeout time.Duration
+}
+
+func NewTransfers(idleTimeout time.Duration) *Transfers {
+	return &Transfers{
+		transfers:   map[string]*Transfer{},
+		idleTimeout: idleTimeout,
+	}
+}
+
+// Take removes and returns the transfer of the key which continues at offset, if any.
+// The transfer is owned by the caller until it is kept again or closed.
+func (t *Transfers) Take(key string, offset int64) *Transfer {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	transfer, exists := t.transfers[key]
+	if !exists || transfer.Offset != offset || !transfer.timer.Stop() {
+		return nil
+	}
+	delete(t.transfers, key)
+	return transfer
+}
+
+// Keep keeps the transfer of the key until its next chunk. A transfer the key already has
+// is replaced, and closed.
+func (t *Transfers) Keep(key string, transfer *Transfer) {
+	t.lock.Lock()
+	previous, exists := t.transfers[key]
+	t.transfers[key] = transfer
+	transfer.timer = time.AfterFunc(t.idleTimeout, func() {
+		t.lock.Lock()
+		if t.transfers[key] == transfer {
+			delete(t.transfers, key)
+		}
+		t.lock.Unlock()
+		_ = transfer.Close()
+	})
+	t.lock.Unlock()
+
+	if exists && previous.timer.Stop() {
+		_ = previous.Close()
+	}
+}
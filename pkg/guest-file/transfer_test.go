@@ -0,0 +1,86 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestfile_test
+
+import (
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	guestfile "kubevirt.io/kubevirt/pkg/guest-file"
+)
+
+var _ = Describe("Guest file transfers", func() {
+	const key = "vmi/read/jdoe//etc/hosts"
+
+	var closed atomic.Int32
+
+	newTransfer := func(handle int64) *guestfile.Transfer {
+		return guestfile.NewTransfer(handle, 10, func() error {
+			closed.Add(1)
+			return nil
+		})
+	}
+
+	BeforeEach(func() {
+		closed.Store(0)
+	})
+
+	It("should return the kept transfer continuing at the offset", func() {
+		transfers := guestfile.NewTransfers(time.Minute)
+		transfer := newTransfer(3)
+		transfer.Offset = 4
+		transfers.Keep(key, transfer)
+
+		Expect(transfers.Take(key, 2)).To(BeNil())
+		Expect(transfers.Take("vmi/read/other//etc/hosts", 4)).To(BeNil())
+		Expect(transfers.Take(key, 4)).To(BeIdenticalTo(transfer))
+		Expect(transfers.Take(key, 4)).To(BeNil())
+		Expect(closed.Load()).To(BeZero())
+	})
+
+	It("should close the transfer it replaces", func() {
+		transfers := guestfile.NewTransfers(time.Minute)
+		transfers.Keep(key, newTransfer(3))
+		transfer := newTransfer(4)
+		transfers.Keep(key, transfer)
+
+		Expect(closed.Load()).To(BeEquivalentTo(1))
+		Expect(transfers.Take(key, 0)).To(BeIdenticalTo(transfer))
+	})
+
+	It("should close the transfers which are not continued in time", func() {
+		transfers := guestfile.NewTransfers(10 * time.Millisecond)
+		transfers.Keep(key, newTransfer(3))
+
+		Eventually(closed.Load).Should(BeEquivalentTo(1))
+		Expect(transfers.Take(key, 0)).To(BeNil())
+	})
+
+	It("should not close the transfers taken in time", func() {
+		transfers := guestfile.NewTransfers(50 * time.Millisecond)
+		transfers.Keep(key, newTransfer(3))
+		Expect(transfers.Take(key, 0)).ToNot(BeNil())
+
+		Consistently(closed.Load, 100*time.Millisecond).Should(BeZero())
+	})
+})
@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestservice
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// MaxServices is the number of services that can be watched per VMI, it caps the series of the
+	// guest service metric each VMI adds
+	MaxServices = 10
+
+	DefaultPeriodSeconds int32 = 60
+	MinPeriodSeconds     int32 = 30
+
+	maxServiceNameLength = 256
+
+	// windowsOSID is the id the guest agent reports for Windows guests
+	windowsOSID = "mswindows"
+)
+
+// ValidateServiceName validates the name of a watched service. The name is passed as an argument to
+// the service manager of the guest, so it must not look like an option of it.
+func ValidateServiceName(name string) error {
+	if name == "" {
+		return fmt.Errorf("service name must not be empty")
+	}
+	if len(name) > maxServiceNameLength {
+		return fmt.Errorf("service name must not be longer than %d characters", maxServiceNameLength)
+	}
+	if strings.HasPrefix(name, "-") || strings.HasPrefix(name, "/") {
+		return fmt.Errorf("service name must not start with - or /")
+	}
+	if strings.ContainsFunc(name, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r)
+	}) {
+		return fmt.Errorf("service name must not contain whitespace or control characters")
+	}
+	return nil
+}
+
+// Period returns how often the services of the watch are checked
+func Period(watch *v1.GuestServiceWatch) time.Duration {
+	periodSeconds := DefaultPeriodSeconds
+	if watch.PeriodSeconds != 0 {
+		periodSeconds = max(watch.PeriodSeconds, MinPeriodSeconds)
+	}
+	return time.Duration(periodSeconds) * time.Second
+}
+
+// Command returns the command which checks whether the service is running in a guest, osID is the
+// operating system id reported by the guest agent
+func Command(osID, service string) (string, []string) {
+	if osID == windowsOSID {
+		return "sc.exe", []string{"query", service}
+	}
+	return "systemctl", []string{"is-active", "--quiet", service}
+}
+
+// IsRunning tells from the result of the command returned by Command whether the service is running
+func IsRunning(osID string, exitCode int, stdout string) bool {
+	if exitCode != 0 {
+		return false
+	}
+	if osID == windowsOSID {
+		// sc.exe succeeds for every installed service, the state is part of its output
+		return strings.Contains(stdout, "RUNNING")
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestservice_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	guestservice "kubevirt.io/kubevirt/pkg/guest-service"
+)
+
+var _ = Describe("Guest service", func() {
+	DescribeTable("should accept valid service names", func(name string) {
+		Expect(guestservice.ValidateServiceName(name)).To(Succeed())
+	},
+		Entry("a systemd unit", "sshd.service"),
+		Entry("a systemd unit without suffix", "nginx"),
+		Entry("a templated systemd unit", "getty@tty1.service"),
+		Entry("a Windows service", "MSSQL$SQLEXPRESS"),
+	)
+
+	DescribeTable("should reject invalid service names", func(name, expectedErr string) {
+		Expect(guestservice.ValidateServiceName(name)).To(MatchError(expectedErr))
+	},
+		Entry("an empty name", "", "service name must not be empty"),
+		Entry("a too long name", strings.Repeat("a", 257), "service name must not be longer than 256 characters"),
+		Entry("an option", "--all", "service name must not start with - or /"),
+		Entry("a path", "/etc/passwd", "service name must not start with - or /"),
+		Entry("whitespace", "sshd nginx", "service name must not contain whitespace or control characters"),
+		Entry("a newline", "sshd\n", "service name must not contain whitespace or control characters"),
+	)
+
+	DescribeTable("should compute the period", func(periodSeconds int32, expected time.Duration) {
+		Expect(guestservice.Period(&v1.GuestServiceWatch{PeriodSeconds: periodSeconds})).To(Equal(expected))
+	},
+		Entry("with the default", int32(0), time.Minute),
+		Entry("with a custom period", int32(300), 5*time.Minute),
+		Entry("with at least the minimum period", int32(5), 30*time.Second),
+	)
+
+	DescribeTable("should tell whether the service is running", func(osID string, exitCode int, stdout string, expected bool) {
+		Expect(guestservice.IsRunning(osID, exitCode, stdout)).To(Equal(expected))
+	},
+		Entry("on Linux when systemctl succeeds", "fedora", 0, "", true),
+		Entry("on Linux when systemctl fails", "fedora", 3, "", false),
+		Entry("on Windows when the service runs", "mswindows", 0, "        STATE              : 4  RUNNING\n", true),
+		Entry("on Windows when the service is stopped", "mswindows", 0, "        STATE              : 1  STOPPED\n", false),
+		Entry("on Windows when the service does not exist", "mswindows", 1060, "", false),
+	)
+
+	It("should check systemd units on Linux", func() {
+		command, args := guestservice.Command("rhel", "sshd.service")
+		Expect(command).To(Equal("systemctl"))
+		Expect(args).To(Equal([]string{"is-active", "--quiet", "sshd.service"}))
+	})
+
+	It("should check services on Windows", func() {
+		command, args := guestservice.Command("mswindows", "W32Time")
+		Expect(command).To(Equal("sc.exe"))
+		Expect(args).To(Equal([]string{"query", "W32Time"}))
+	})
+})
@@ -0,0 +1,63 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestuser
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// UserParam is the query parameter virt-api passes the requesting user to virt-handler with
+const UserParam = "user"
+
+// Validate validates the change made to a guest user. The username is passed as an argument to
+// the user management commands of the guest, so it must not look like an option of them.
+func Validate(opts *v1.VirtualMachineInstanceGuestUserOptions) error {
+	switch opts.Action {
+	case v1.GuestUserSetPassword:
+		if opts.Password == "" {
+			return fmt.Errorf("password must not be empty to set the password")
+		}
+	case v1.GuestUserCreate:
+	case v1.GuestUserLock, v1.GuestUserUnlock:
+		if opts.Password != "" {
+			return fmt.Errorf("password must not be set to %s a user", strings.ToLower(string(opts.Action)))
+		}
+	default:
+		return fmt.Errorf("unsupported action %q, must be one of %s, %s, %s or %s",
+			opts.Action, v1.GuestUserSetPassword, v1.GuestUserCreate, v1.GuestUserLock, v1.GuestUserUnlock)
+	}
+
+	if opts.Username == "" {
+		return fmt.Errorf("username must not be empty")
+	}
+	if strings.HasPrefix(opts.Username, "-") || strings.HasPrefix(opts.Username, "/") {
+		return fmt.Errorf("username must not start with - or /")
+	}
+	if strings.ContainsFunc(opts.Username, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r) || r == ':'
+	}) {
+		return fmt.Errorf("username must not contain whitespace, control characters or :")
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestuser_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	guestuser "kubevirt.io/kubevirt/pkg/guest-user"
+)
+
+var _ = Describe("Guest user", func() {
+	DescribeTable("should accept valid changes", func(opts *v1.VirtualMachineInstanceGuestUserOptions) {
+		Expect(guestuser.Validate(opts)).To(Succeed())
+	},
+		Entry("setting a password", &v1.VirtualMachineInstanceGuestUserOptions{Action: v1.GuestUserSetPassword, Username: "jdoe", Password: "secret"}),
+		Entry("creating a user with a password", &v1.VirtualMachineInstanceGuestUserOptions{Action: v1.GuestUserCreate, Username: "jdoe", Password: "secret"}),
+		Entry("creating a user without password", &v1.VirtualMachineInstanceGuestUserOptions{Action: v1.GuestUserCreate, Username: "jdoe"}),
+		Entry("locking a user", &v1.VirtualMachineInstanceGuestUserOptions{Action: v1.GuestUserLock, Username: "Administrator"}),
+		Entry("unlocking a user", &v1.VirtualMachineInstanceGuestUserOptions{Action: v1.GuestUserUnlock, Username: "j.doe"}),
+	)
+
+	DescribeTable("should reject invalid changes", func(opts *v1.VirtualMachineInstanceGuestUserOptions, expectedErr string) {
+		Expect(guestuser.Validate(opts)).To(MatchError(expectedErr))
+	},
+		Entry("with an unsupported action",
+			&v1.VirtualMachineInstanceGuestUserOptions{Action: "Delete", Username: "jdoe"},
+			`unsupported action "Delete", must be one of SetPassword, Create, Lock or Unlock`,
+		),
+		Entry("setting an empty password",
+			&v1.VirtualMachineInstanceGuestUserOptions{Action: v1.GuestUserSetPassword, Username: "jdoe"},
+			"password must not be empty to set the password",
+		),
+		Entry("locking a user with a password",
+			&v1.VirtualMachineInstanceGuestUserOptions{Action: v1.GuestUserLock, Username: "jdoe", Password: "secret"},
+			"password must not be set to lock a user",
+		),
+		Entry("without username",
+			&v1.VirtualMachineInstanceGuestUserOptions{Action: v1.GuestUserUnlock},
+			"username must not be empty",
+		),
+		Entry("with a username looking like an option",
+			&v1.VirtualMachineInstanceGuestUserOptions{Action: v1.GuestUserCreate, Username: "--system"},
+			"username must not start with - or /",
+		),
+		Entry("with a username looking like a Windows option",
+			&v1.VirtualMachineInstanceGuestUserOptions{Action: v1.GuestUserLock, Username: "/delete"},
+			"username must not start with - or /",
+		),
+		Entry("with whitespace in the username",
+			&v1.VirtualMachineInstanceGuestUserOptions{Action: v1.GuestUserLock, Username: "j doe"},
+			"username must not contain whitespace, control characters or :",
+		),
+	)
+})
@@ -36,6 +36,15 @@ It has these top-level messages:
 	ExecResponse
 	GuestPingRequest
 	GuestPingResponse
+	GuestFileOpenRequest
+	GuestFileOpenResponse
+	GuestFileReadRequest
+	GuestFileReadResponse
+	GuestFileWriteRequest
+	GuestFileCloseRequest
+	GuestUserRequest
+	GuestTimeSyncRequest
+	GuestTimeSyncResponse
 	FreezeRequest
 	MemoryDumpRequest
 	SEVInfoResponse
@@ -567,6 +576,7 @@ type ExecRequest struct {
 	Command        string   `protobuf:"bytes,2,opt,name=Command" json:"Command,omitempty"`
 	Args           []string `protobuf:"bytes,3,rep,name=Args" json:"Args,omitempty"`
 	TimeoutSeconds int32    `protobuf:"varint,4,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
+	MaxOutputBytes int64    `protobuf:"varint,5,opt,name=maxOutputBytes" json:"maxOutputBytes,omitempty"`
 }
 
 func (m *ExecRequest) Reset()                    { *m = ExecRequest{} }
@@ -602,6 +612,13 @@ func (m *ExecRequest) GetTimeoutSeconds() int32 {
 	return 0
 }
 
+func (m *ExecRequest) GetMaxOutputBytes() int64 {
+	if m != nil {
+		return m.MaxOutputBytes
+	}
+	return 0
+}
+
 type EmptyRequest struct {
 }
 
@@ -826,6 +843,278 @@ func (m *GuestPingResponse) GetResponse() *Response {
 	return nil
 }
 
+type GuestFileOpenRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Write      bool   `protobuf:"varint,3,opt,name=write" json:"write,omitempty"`
+}
+
+func (m *GuestFileOpenRequest) Reset()                    { *m = GuestFileOpenRequest{} }
+func (m *GuestFileOpenRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileOpenRequest) ProtoMessage()               {}
+func (*GuestFileOpenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *GuestFileOpenRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileOpenRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GuestFileOpenRequest) GetWrite() bool {
+	if m != nil {
+		return m.Write
+	}
+	return false
+}
+
+type GuestFileOpenResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Handle   int64     `protobuf:"varint,2,opt,name=handle" json:"handle,omitempty"`
+	Size     int64     `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *GuestFileOpenResponse) Reset()                    { *m = GuestFileOpenResponse{} }
+func (m *GuestFileOpenResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileOpenResponse) ProtoMessage()               {}
+func (*GuestFileOpenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *GuestFileOpenResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestFileOpenResponse) GetHandle() int64 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
+}
+
+func (m *GuestFileOpenResponse) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type GuestFileReadRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Handle     int64  `protobuf:"varint,2,opt,name=handle" json:"handle,omitempty"`
+	Length     int64  `protobuf:"varint,3,opt,name=length" json:"length,omitempty"`
+}
+
+func (m *GuestFileReadRequest) Reset()                    { *m = GuestFileReadRequest{} }
+func (m *GuestFileReadRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileReadRequest) ProtoMessage()               {}
+func (*GuestFileReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *GuestFileReadRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileReadRequest) GetHandle() int64 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
+}
+
+func (m *GuestFileReadRequest) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+type GuestFileReadResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Data     []byte    `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Eof      bool      `protobuf:"varint,3,opt,name=eof" json:"eof,omitempty"`
+}
+
+func (m *GuestFileReadResponse) Reset()                    { *m = GuestFileReadResponse{} }
+func (m *GuestFileReadResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileReadResponse) ProtoMessage()               {}
+func (*GuestFileReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *GuestFileReadResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestFileReadResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GuestFileReadResponse) GetEof() bool {
+	if m != nil {
+		return m.Eof
+	}
+	return false
+}
+
+type GuestFileWriteRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Handle     int64  `protobuf:"varint,2,opt,name=handle" json:"handle,omitempty"`
+	Data       []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *GuestFileWriteRequest) Reset()                    { *m = GuestFileWriteRequest{} }
+func (m *GuestFileWriteRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileWriteRequest) ProtoMessage()               {}
+func (*GuestFileWriteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *GuestFileWriteRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileWriteRequest) GetHandle() int64 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
+}
+
+func (m *GuestFileWriteRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type GuestFileCloseRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Handle     int64  `protobuf:"varint,2,opt,name=handle" json:"handle,omitempty"`
+}
+
+func (m *GuestFileCloseRequest) Reset()                    { *m = GuestFileCloseRequest{} }
+func (m *GuestFileCloseRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileCloseRequest) ProtoMessage()               {}
+func (*GuestFileCloseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *GuestFileCloseRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileCloseRequest) GetHandle() int64 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
+}
+
+type GuestUserRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Action     string `protobuf:"bytes,2,opt,name=action" json:"action,omitempty"`
+	Username   string `protobuf:"bytes,3,opt,name=username" json:"username,omitempty"`
+	Password   string `protobuf:"bytes,4,opt,name=password" json:"password,omitempty"`
+}
+
+func (m *GuestUserRequest) Reset()                    { *m = GuestUserRequest{} }
+func (m *GuestUserRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestUserRequest) ProtoMessage()               {}
+func (*GuestUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *GuestUserRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestUserRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *GuestUserRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GuestUserRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type GuestTimeSyncRequest struct {
+	DomainName     string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	TimeoutSeconds int32  `protobuf:"varint,2,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
+}
+
+func (m *GuestTimeSyncRequest) Reset()                    { *m = GuestTimeSyncRequest{} }
+func (m *GuestTimeSyncRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestTimeSyncRequest) ProtoMessage()               {}
+func (*GuestTimeSyncRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *GuestTimeSyncRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestTimeSyncRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type GuestTimeSyncResponse struct {
+	Response         *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	DriftNanoseconds int64     `protobuf:"varint,2,opt,name=driftNanoseconds" json:"driftNanoseconds,omitempty"`
+}
+
+func (m *GuestTimeSyncResponse) Reset()                    { *m = GuestTimeSyncResponse{} }
+func (m *GuestTimeSyncResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestTimeSyncResponse) ProtoMessage()               {}
+func (*GuestTimeSyncResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *GuestTimeSyncResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestTimeSyncResponse) GetDriftNanoseconds() int64 {
+	if m != nil {
+		return m.DriftNanoseconds
+	}
+	return 0
+}
+
 type FreezeRequest struct {
 	Vmi                    *VMI  `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
 	UnfreezeTimeoutSeconds int32 `protobuf:"varint,2,opt,name=unfreezeTimeoutSeconds" json:"unfreezeTimeoutSeconds,omitempty"`
@@ -834,7 +1123,7 @@ type FreezeRequest struct {
 func (m *FreezeRequest) Reset()                    { *m = FreezeRequest{} }
 func (m *FreezeRequest) String() string            { return proto.CompactTextString(m) }
 func (*FreezeRequest) ProtoMessage()               {}
-func (*FreezeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*FreezeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *FreezeRequest) GetVmi() *VMI {
 	if m != nil {
@@ -858,7 +1147,7 @@ type MemoryDumpRequest struct {
 func (m *MemoryDumpRequest) Reset()                    { *m = MemoryDumpRequest{} }
 func (m *MemoryDumpRequest) String() string            { return proto.CompactTextString(m) }
 func (*MemoryDumpRequest) ProtoMessage()               {}
-func (*MemoryDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*MemoryDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *MemoryDumpRequest) GetVmi() *VMI {
 	if m != nil {
@@ -882,7 +1171,7 @@ type SEVInfoResponse struct {
 func (m *SEVInfoResponse) Reset()                    { *m = SEVInfoResponse{} }
 func (m *SEVInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*SEVInfoResponse) ProtoMessage()               {}
-func (*SEVInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*SEVInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *SEVInfoResponse) GetResponse() *Response {
 	if m != nil {
@@ -906,7 +1195,7 @@ type LaunchMeasurementResponse struct {
 func (m *LaunchMeasurementResponse) Reset()                    { *m = LaunchMeasurementResponse{} }
 func (m *LaunchMeasurementResponse) String() string            { return proto.CompactTextString(m) }
 func (*LaunchMeasurementResponse) ProtoMessage()               {}
-func (*LaunchMeasurementResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*LaunchMeasurementResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *LaunchMeasurementResponse) GetResponse() *Response {
 	if m != nil {
@@ -930,7 +1219,7 @@ type InjectLaunchSecretRequest struct {
 func (m *InjectLaunchSecretRequest) Reset()                    { *m = InjectLaunchSecretRequest{} }
 func (m *InjectLaunchSecretRequest) String() string            { return proto.CompactTextString(m) }
 func (*InjectLaunchSecretRequest) ProtoMessage()               {}
-func (*InjectLaunchSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*InjectLaunchSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *InjectLaunchSecretRequest) GetVmi() *VMI {
 	if m != nil {
@@ -954,7 +1243,7 @@ type DirtyRateStatsResponse struct {
 func (m *DirtyRateStatsResponse) Reset()                    { *m = DirtyRateStatsResponse{} }
 func (m *DirtyRateStatsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DirtyRateStatsResponse) ProtoMessage()               {}
-func (*DirtyRateStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*DirtyRateStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *DirtyRateStatsResponse) GetResponse() *Response {
 	if m != nil {
@@ -979,7 +1268,7 @@ type ScreenshotResponse struct {
 func (m *ScreenshotResponse) Reset()                    { *m = ScreenshotResponse{} }
 func (m *ScreenshotResponse) String() string            { return proto.CompactTextString(m) }
 func (*ScreenshotResponse) ProtoMessage()               {}
-func (*ScreenshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*ScreenshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 func (m *ScreenshotResponse) GetResponse() *Response {
 	if m != nil {
@@ -1010,7 +1299,7 @@ type BackupRequest struct {
 func (m *BackupRequest) Reset()                    { *m = BackupRequest{} }
 func (m *BackupRequest) String() string            { return proto.CompactTextString(m) }
 func (*BackupRequest) ProtoMessage()               {}
-func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *BackupRequest) GetVmi() *VMI {
 	if m != nil {
@@ -1034,7 +1323,7 @@ type RedefineCheckpointRequest struct {
 func (m *RedefineCheckpointRequest) Reset()                    { *m = RedefineCheckpointRequest{} }
 func (m *RedefineCheckpointRequest) String() string            { return proto.CompactTextString(m) }
 func (*RedefineCheckpointRequest) ProtoMessage()               {}
-func (*RedefineCheckpointRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*RedefineCheckpointRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *RedefineCheckpointRequest) GetVmi() *VMI {
 	if m != nil {
@@ -1058,7 +1347,7 @@ type RedefineCheckpointResponse struct {
 func (m *RedefineCheckpointResponse) Reset()                    { *m = RedefineCheckpointResponse{} }
 func (m *RedefineCheckpointResponse) String() string            { return proto.CompactTextString(m) }
 func (*RedefineCheckpointResponse) ProtoMessage()               {}
-func (*RedefineCheckpointResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*RedefineCheckpointResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *RedefineCheckpointResponse) GetResponse() *Response {
 	if m != nil {
@@ -1101,6 +1390,15 @@ func init() {
 	proto.RegisterType((*ExecResponse)(nil), "kubevirt.cmd.v1.ExecResponse")
 	proto.RegisterType((*GuestPingRequest)(nil), "kubevirt.cmd.v1.GuestPingRequest")
 	proto.RegisterType((*GuestPingResponse)(nil), "kubevirt.cmd.v1.GuestPingResponse")
+	proto.RegisterType((*GuestFileOpenRequest)(nil), "kubevirt.cmd.v1.GuestFileOpenRequest")
+	proto.RegisterType((*GuestFileOpenResponse)(nil), "kubevirt.cmd.v1.GuestFileOpenResponse")
+	proto.RegisterType((*GuestFileReadRequest)(nil), "kubevirt.cmd.v1.GuestFileReadRequest")
+	proto.RegisterType((*GuestFileReadResponse)(nil), "kubevirt.cmd.v1.GuestFileReadResponse")
+	proto.RegisterType((*GuestFileWriteRequest)(nil), "kubevirt.cmd.v1.GuestFileWriteRequest")
+	proto.RegisterType((*GuestFileCloseRequest)(nil), "kubevirt.cmd.v1.GuestFileCloseRequest")
+	proto.RegisterType((*GuestUserRequest)(nil), "kubevirt.cmd.v1.GuestUserRequest")
+	proto.RegisterType((*GuestTimeSyncRequest)(nil), "kubevirt.cmd.v1.GuestTimeSyncRequest")
+	proto.RegisterType((*GuestTimeSyncResponse)(nil), "kubevirt.cmd.v1.GuestTimeSyncResponse")
 	proto.RegisterType((*FreezeRequest)(nil), "kubevirt.cmd.v1.FreezeRequest")
 	proto.RegisterType((*MemoryDumpRequest)(nil), "kubevirt.cmd.v1.MemoryDumpRequest")
 	proto.RegisterType((*SEVInfoResponse)(nil), "kubevirt.cmd.v1.SEVInfoResponse")
@@ -1148,6 +1446,7 @@ type CmdClient interface {
 	Ping(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Response, error)
 	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error)
 	GuestPing(ctx context.Context, in *GuestPingRequest, opts ...grpc.CallOption) (*GuestPingResponse, error)
+	SyncGuestTime(ctx context.Context, in *GuestTimeSyncRequest, opts ...grpc.CallOption) (*GuestTimeSyncResponse, error)
 	VirtualMachineMemoryDump(ctx context.Context, in *MemoryDumpRequest, opts ...grpc.CallOption) (*Response, error)
 	GetQemuVersion(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*QemuVersionResponse, error)
 	SyncVirtualMachineCPUs(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
@@ -1159,6 +1458,11 @@ type CmdClient interface {
 	GetScreenshot(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*ScreenshotResponse, error)
 	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
 	RedefineCheckpoint(ctx context.Context, in *RedefineCheckpointRequest, opts ...grpc.CallOption) (*RedefineCheckpointResponse, error)
+	GuestFileOpen(ctx context.Context, in *GuestFileOpenRequest, opts ...grpc.CallOption) (*GuestFileOpenResponse, error)
+	GuestFileRead(ctx context.Context, in *GuestFileReadRequest, opts ...grpc.CallOption) (*GuestFileReadResponse, error)
+	GuestFileWrite(ctx context.Context, in *GuestFileWriteRequest, opts ...grpc.CallOption) (*Response, error)
+	GuestFileClose(ctx context.Context, in *GuestFileCloseRequest, opts ...grpc.CallOption) (*Response, error)
+	GuestUser(ctx context.Context, in *GuestUserRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1385,6 +1689,15 @@ func (c *cmdClient) GuestPing(ctx context.Context, in *GuestPingRequest, opts ..
 	return out, nil
 }
 
+func (c *cmdClient) SyncGuestTime(ctx context.Context, in *GuestTimeSyncRequest, opts ...grpc.CallOption) (*GuestTimeSyncResponse, error) {
+	out := new(GuestTimeSyncResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/SyncGuestTime", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cmdClient) VirtualMachineMemoryDump(ctx context.Context, in *MemoryDumpRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/VirtualMachineMemoryDump", in, out, c.cc, opts...)
@@ -1484,6 +1797,51 @@ func (c *cmdClient) RedefineCheckpoint(ctx context.Context, in *RedefineCheckpoi
 	return out, nil
 }
 
+func (c *cmdClient) GuestFileOpen(ctx context.Context, in *GuestFileOpenRequest, opts ...grpc.CallOption) (*GuestFileOpenResponse, error) {
+	out := new(GuestFileOpenResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileOpen", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileRead(ctx context.Context, in *GuestFileReadRequest, opts ...grpc.CallOption) (*GuestFileReadResponse, error) {
+	out := new(GuestFileReadResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileRead", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileWrite(ctx context.Context, in *GuestFileWriteRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileWrite", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileClose(ctx context.Context, in *GuestFileCloseRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileClose", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestUser(ctx context.Context, in *GuestUserRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1511,6 +1869,7 @@ type CmdServer interface {
 	Ping(context.Context, *EmptyRequest) (*Response, error)
 	Exec(context.Context, *ExecRequest) (*ExecResponse, error)
 	GuestPing(context.Context, *GuestPingRequest) (*GuestPingResponse, error)
+	SyncGuestTime(context.Context, *GuestTimeSyncRequest) (*GuestTimeSyncResponse, error)
 	VirtualMachineMemoryDump(context.Context, *MemoryDumpRequest) (*Response, error)
 	GetQemuVersion(context.Context, *EmptyRequest) (*QemuVersionResponse, error)
 	SyncVirtualMachineCPUs(context.Context, *VMIRequest) (*Response, error)
@@ -1522,6 +1881,11 @@ type CmdServer interface {
 	GetScreenshot(context.Context, *VMIRequest) (*ScreenshotResponse, error)
 	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
 	RedefineCheckpoint(context.Context, *RedefineCheckpointRequest) (*RedefineCheckpointResponse, error)
+	GuestFileOpen(context.Context, *GuestFileOpenRequest) (*GuestFileOpenResponse, error)
+	GuestFileRead(context.Context, *GuestFileReadRequest) (*GuestFileReadResponse, error)
+	GuestFileWrite(context.Context, *GuestFileWriteRequest) (*Response, error)
+	GuestFileClose(context.Context, *GuestFileCloseRequest) (*Response, error)
+	GuestUser(context.Context, *GuestUserRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -1960,6 +2324,24 @@ func _Cmd_GuestPing_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_SyncGuestTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestTimeSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).SyncGuestTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/SyncGuestTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).SyncGuestTime(ctx, req.(*GuestTimeSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cmd_VirtualMachineMemoryDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MemoryDumpRequest)
 	if err := dec(in); err != nil {
@@ -2158,6 +2540,96 @@ func _Cmd_RedefineCheckpoint_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_GuestFileOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileOpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileOpen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileOpen(ctx, req.(*GuestFileOpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileRead(ctx, req.(*GuestFileReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileWrite(ctx, req.(*GuestFileWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileClose(ctx, req.(*GuestFileCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestUser(ctx, req.(*GuestUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -2258,6 +2730,10 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GuestPing",
 			Handler:    _Cmd_GuestPing_Handler,
 		},
+		{
+			MethodName: "SyncGuestTime",
+			Handler:    _Cmd_SyncGuestTime_Handler,
+		},
 		{
 			MethodName: "VirtualMachineMemoryDump",
 			Handler:    _Cmd_VirtualMachineMemoryDump_Handler,
@@ -2302,6 +2778,26 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RedefineCheckpoint",
 			Handler:    _Cmd_RedefineCheckpoint_Handler,
 		},
+		{
+			MethodName: "GuestFileOpen",
+			Handler:    _Cmd_GuestFileOpen_Handler,
+		},
+		{
+			MethodName: "GuestFileRead",
+			Handler:    _Cmd_GuestFileRead_Handler,
+		},
+		{
+			MethodName: "GuestFileWrite",
+			Handler:    _Cmd_GuestFileWrite_Handler,
+		},
+		{
+			MethodName: "GuestFileClose",
+			Handler:    _Cmd_GuestFileClose_Handler,
+		},
+		{
+			MethodName: "GuestUser",
+			Handler:    _Cmd_GuestUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -2310,131 +2806,149 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2011 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xef, 0x72, 0x1b, 0xb7,
-	0x11, 0x37, 0x45, 0x4a, 0x26, 0x57, 0x7f, 0x62, 0xc3, 0x92, 0x7c, 0x62, 0x6a, 0x5b, 0x45, 0x3b,
-	0xae, 0xd3, 0x26, 0x52, 0xed, 0x38, 0x99, 0x8e, 0xa7, 0x93, 0xb1, 0x45, 0xd1, 0x8a, 0x12, 0x53,
-	0xa6, 0x8f, 0x92, 0x3c, 0x4d, 0x9b, 0xc9, 0x40, 0x77, 0x10, 0x89, 0xea, 0x0e, 0x60, 0x0e, 0x38,
-	0xc6, 0xcc, 0xa7, 0x76, 0xd2, 0xe9, 0x87, 0xce, 0xf4, 0x79, 0xfa, 0x0c, 0x7d, 0x82, 0x7e, 0xeb,
-	0xb3, 0x64, 0x80, 0xbb, 0xa3, 0x8e, 0xbc, 0x3b, 0xd1, 0x1a, 0xf2, 0x13, 0x01, 0xec, 0xee, 0x6f,
-	0x17, 0x8b, 0xc5, 0x62, 0xf7, 0x08, 0x1f, 0xf5, 0x2f, 0xba, 0xbb, 0x3d, 0xc2, 0x5d, 0x8f, 0x06,
-	0x9f, 0x78, 0x24, 0xe4, 0x4e, 0x8f, 0x06, 0x9f, 0x38, 0xc2, 0xdf, 0x75, 0x7c, 0x77, 0x77, 0xf0,
-	0x58, 0xff, 0xec, 0xf4, 0x03, 0xa1, 0x04, 0xfa, 0xe0, 0x22, 0x3c, 0xa3, 0x03, 0x16, 0xa8, 0x1d,
-	0xbd, 0x36, 0x78, 0x8c, 0xcf, 0xe1, 0xce, 0x1b, 0xea, 0x87, 0xa7, 0x34, 0x90, 0x4c, 0x70, 0x9b,
-	0xca, 0xbe, 0xe0, 0x92, 0xa2, 0xcf, 0xa0, 0x1a, 0xc4, 0x63, 0xab, 0xb4, 0x5d, 0x7a, 0xb4, 0xfc,
-	0x64, 0x6b, 0x67, 0x42, 0x74, 0x27, 0x61, 0xb6, 0x47, 0xac, 0xc8, 0x82, 0x9b, 0x83, 0x08, 0xc9,
-	0x5a, 0xd8, 0x2e, 0x3d, 0xaa, 0xd9, 0xc9, 0x14, 0x3f, 0x80, 0xf2, 0x69, 0xeb, 0xd0, 0x30, 0xf8,
-	0xec, 0x2b, 0x29, 0xb8, 0x81, 0x5d, 0xb1, 0x93, 0x29, 0x7e, 0x0c, 0xe5, 0x46, 0xfb, 0x04, 0xad,
-	0xc1, 0x02, 0x73, 0x0d, 0x6d, 0xd5, 0x5e, 0x60, 0x2e, 0xaa, 0x43, 0x55, 0xb2, 0x33, 0x8f, 0xf1,
-	0xae, 0xb4, 0x16, 0xb6, 0xcb, 0x8f, 0x56, 0xed, 0xd1, 0x1c, 0xef, 0xc2, 0xcd, 0x4e, 0x34, 0xce,
-	0x88, 0xad, 0xc3, 0xe2, 0x80, 0x78, 0x21, 0x35, 0x66, 0x54, 0xec, 0x68, 0x82, 0x9b, 0xb0, 0xd8,
-	0x26, 0x5d, 0x2a, 0x35, 0xd9, 0x11, 0x21, 0x57, 0x46, 0xa2, 0x62, 0x47, 0x13, 0x84, 0xa0, 0x12,
-	0x72, 0xa6, 0x62, 0xd3, 0xcd, 0x58, 0xaf, 0x49, 0xf6, 0x23, 0xb5, 0xca, 0x06, 0xda, 0x8c, 0xf1,
-	0x53, 0x58, 0x6a, 0x51, 0x5f, 0x04, 0x43, 0xb4, 0x09, 0x4b, 0xc4, 0x4f, 0x01, 0xc5, 0xb3, 0x3c,
-	0x24, 0xfc, 0xbf, 0x12, 0x54, 0x1a, 0xd4, 0xf3, 0x32, 0xb6, 0xee, 0xc2, 0x92, 0x6f, 0xe0, 0x0c,
-	0xfb, 0xf2, 0x93, 0xbb, 0x19, 0x4f, 0x47, 0xda, 0xec, 0x98, 0x0d, 0x7d, 0x0c, 0x8b, 0x7d, 0xbd,
-	0x0d, 0xab, 0xbc, 0x5d, 0x7e, 0xb4, 0xfc, 0x64, 0x33, 0xc3, 0x6f, 0x36, 0x69, 0x47, 0x4c, 0xe8,
-	0x73, 0xa8, 0xb9, 0x4c, 0x2a, 0xc2, 0x1d, 0x2a, 0xad, 0x8a, 0x91, 0xb0, 0x32, 0x12, 0xb1, 0x1f,
-	0xed, 0x4b, 0x56, 0xf4, 0x08, 0x2a, 0x4e, 0x3f, 0x94, 0xd6, 0xa2, 0x11, 0x59, 0xcf, 0x88, 0x34,
-	0xda, 0x27, 0xb6, 0xe1, 0xc0, 0xcf, 0xa1, 0x7a, 0x2c, 0xfa, 0xc2, 0x13, 0xdd, 0x21, 0x7a, 0x0a,
-	0xc0, 0x43, 0x9f, 0x7c, 0xe7, 0x50, 0xcf, 0x93, 0x56, 0xc9, 0xc8, 0x6e, 0x64, 0x65, 0xa9, 0xe7,
-	0xd9, 0x35, 0xcd, 0xa8, 0x47, 0x12, 0xff, 0xab, 0x04, 0x4b, 0x9d, 0xd6, 0x1e, 0x13, 0x12, 0x61,
-	0x58, 0xf1, 0x09, 0x0f, 0xcf, 0x89, 0xa3, 0xc2, 0x80, 0x06, 0xc6, 0x4f, 0x35, 0x7b, 0x6c, 0x4d,
-	0x47, 0x51, 0x3f, 0x10, 0x6e, 0xe8, 0x24, 0x1e, 0x4e, 0xa6, 0xe9, 0x00, 0x2c, 0x8f, 0x05, 0x20,
-	0xba, 0x05, 0x65, 0x79, 0x11, 0x5a, 0x15, 0xb3, 0xaa, 0x87, 0xfa, 0xf0, 0xce, 0x89, 0xcf, 0xbc,
-	0xa1, 0xb5, 0x68, 0x16, 0xe3, 0x19, 0xfe, 0x67, 0x09, 0xaa, 0xfb, 0x4c, 0x5e, 0x1c, 0xf2, 0x73,
-	0x61, 0x98, 0x44, 0xe0, 0x13, 0x15, 0x1b, 0x12, 0xcf, 0xd0, 0x36, 0x2c, 0x9f, 0x11, 0xe7, 0x82,
-	0xf1, 0xee, 0x4b, 0xe6, 0xd1, 0xd8, 0x8c, 0xf4, 0x12, 0xba, 0x0f, 0xa0, 0xed, 0x25, 0x5e, 0x27,
-	0x89, 0x9f, 0x8a, 0x9d, 0x5a, 0xd1, 0x08, 0xda, 0x25, 0x09, 0x43, 0xc5, 0x30, 0xa4, 0x97, 0xf0,
-	0x7f, 0x16, 0x60, 0xb5, 0xe1, 0x85, 0x52, 0xd1, 0xa0, 0x21, 0xf8, 0x39, 0xeb, 0xa2, 0x1d, 0x40,
-	0xcd, 0x77, 0x7d, 0xc2, 0x5d, 0x6d, 0x9f, 0x6c, 0x72, 0x72, 0xe6, 0xd1, 0x28, 0x94, 0xaa, 0x76,
-	0x0e, 0x05, 0xfd, 0x11, 0xb6, 0x5e, 0x06, 0x94, 0xea, 0x78, 0xb0, 0x69, 0x5f, 0x04, 0x8a, 0xf1,
-	0xee, 0x3e, 0x93, 0x91, 0xd8, 0x82, 0x11, 0x2b, 0x66, 0x40, 0xcf, 0xc0, 0xda, 0x13, 0x4e, 0x4f,
-	0xee, 0x33, 0xd9, 0xf7, 0xc8, 0xf0, 0xa5, 0x08, 0x9a, 0x2f, 0x0f, 0x0f, 0x42, 0x2a, 0x95, 0x34,
-	0xfb, 0xa9, 0xda, 0x85, 0x74, 0x2d, 0xdb, 0xa1, 0x01, 0x23, 0x5e, 0x43, 0x70, 0x29, 0x3c, 0xfa,
-	0x4a, 0x5c, 0x2a, 0xae, 0x44, 0xb2, 0x45, 0x74, 0xf4, 0x1c, 0x3e, 0x6c, 0x37, 0x0e, 0x8f, 0x4e,
-	0x5a, 0x2f, 0x5e, 0xfc, 0x40, 0x02, 0x9a, 0xc4, 0x56, 0xb2, 0xdd, 0x45, 0x23, 0x7e, 0x15, 0x0b,
-	0xfe, 0x14, 0xb6, 0x0e, 0xb9, 0xa2, 0xc1, 0x39, 0x71, 0xe8, 0x1e, 0xe3, 0x2e, 0xe3, 0xdd, 0x16,
-	0xeb, 0x06, 0x44, 0xe9, 0x48, 0xd8, 0xd4, 0xd7, 0x57, 0xf5, 0x84, 0x9b, 0x1c, 0x69, 0x34, 0xc3,
-	0xff, 0xbf, 0x09, 0x1b, 0xa7, 0x91, 0xfb, 0x5b, 0xc4, 0xe9, 0x31, 0x4e, 0x5f, 0xf7, 0xb5, 0x80,
-	0x44, 0x5f, 0xc3, 0xfa, 0x38, 0x21, 0x8a, 0xd5, 0x38, 0x33, 0x66, 0xef, 0x6b, 0x44, 0xb6, 0x73,
-	0x85, 0xd0, 0x53, 0xd8, 0x68, 0x51, 0x7f, 0x8f, 0x78, 0x9e, 0x10, 0xbc, 0xa3, 0x88, 0x92, 0x6d,
-	0x1a, 0x30, 0x11, 0x9d, 0xc7, 0xaa, 0x9d, 0x4f, 0x44, 0xbf, 0x87, 0x3b, 0xed, 0x80, 0xea, 0x75,
-	0x87, 0x28, 0xea, 0x9e, 0x0a, 0x2f, 0xf4, 0xe3, 0x0c, 0x50, 0xb3, 0xf3, 0x48, 0x3a, 0x85, 0xab,
-	0xd8, 0x2d, 0xc6, 0xe3, 0x79, 0x29, 0x3c, 0xf1, 0x9b, 0x3d, 0x62, 0x45, 0x1d, 0xa8, 0x99, 0x10,
-	0xd2, 0xd1, 0x1f, 0xdf, 0xfd, 0xcf, 0x32, 0x72, 0xb9, 0x6e, 0xda, 0x19, 0xc9, 0x35, 0xb9, 0x0a,
-	0x86, 0xf6, 0x25, 0x4e, 0x41, 0xdc, 0x2e, 0x15, 0xc6, 0xed, 0x3e, 0xac, 0x3a, 0xe9, 0xc0, 0xb7,
-	0x6e, 0x9a, 0x0d, 0xdc, 0xcf, 0x26, 0x92, 0x34, 0x97, 0x3d, 0x2e, 0x84, 0x7e, 0x2a, 0xc1, 0x16,
-	0x4b, 0xc2, 0x60, 0x5f, 0xf8, 0x84, 0xf1, 0x17, 0x4a, 0x11, 0xa7, 0xe7, 0x53, 0xae, 0xac, 0xaa,
-	0xd9, 0x5b, 0xf3, 0x3d, 0xf7, 0x76, 0x58, 0x84, 0x13, 0xed, 0xb5, 0x58, 0x0f, 0xe2, 0x80, 0x46,
-	0xc4, 0x51, 0x10, 0x5a, 0x35, 0xa3, 0xfd, 0x8b, 0xeb, 0x6a, 0x1f, 0x01, 0x44, 0x6a, 0x73, 0x90,
-	0xeb, 0x6f, 0x61, 0x6d, 0xfc, 0x20, 0x74, 0xea, 0xbb, 0xa0, 0xc3, 0x38, 0xda, 0xf5, 0x10, 0xed,
-	0xa6, 0x9f, 0xc7, 0xbc, 0xc0, 0x48, 0xf2, 0x5f, 0xfc, 0x72, 0x3e, 0x5b, 0xf8, 0x43, 0xa9, 0xfe,
-	0x0a, 0xee, 0x5f, 0xed, 0x85, 0x1c, 0x45, 0x63, 0xef, 0x70, 0x2d, 0x8d, 0xf6, 0x3d, 0xdc, 0x2d,
-	0xd8, 0x55, 0x0e, 0xcc, 0xf3, 0x71, 0x7b, 0x7f, 0x9b, 0xb1, 0xb7, 0xf0, 0xb6, 0xa7, 0x54, 0xe2,
-	0x01, 0xc0, 0x69, 0xeb, 0xd0, 0xa6, 0xdf, 0xeb, 0x14, 0x85, 0x1e, 0x42, 0x79, 0xe0, 0xb3, 0xf8,
-	0x0e, 0x67, 0x9f, 0x37, 0xcd, 0xa9, 0x19, 0xd0, 0x73, 0xb8, 0x29, 0xa2, 0x63, 0x88, 0xb5, 0x3f,
-	0x7c, 0xbf, 0x43, 0xb3, 0x13, 0x31, 0x7c, 0x0c, 0xb7, 0x2e, 0xed, 0xb9, 0xa6, 0x76, 0x6b, 0x5c,
-	0xfb, 0xca, 0x25, 0xea, 0x4f, 0x25, 0x58, 0x6e, 0xbe, 0xa3, 0x4e, 0x82, 0x78, 0x1f, 0xc0, 0x35,
-	0xa7, 0x72, 0x44, 0x7c, 0x1a, 0x3b, 0x2f, 0xb5, 0xa2, 0x91, 0x1a, 0xc2, 0xf7, 0x09, 0x77, 0x93,
-	0x47, 0x33, 0x9e, 0xea, 0x6a, 0xe5, 0x45, 0xd0, 0x4d, 0x92, 0x89, 0x19, 0xa3, 0x87, 0xb0, 0xa6,
-	0x98, 0x4f, 0x45, 0xa8, 0x3a, 0xd4, 0x11, 0xdc, 0x95, 0x26, 0x87, 0x2c, 0xda, 0x13, 0xab, 0x78,
-	0x0d, 0x56, 0x9a, 0x7e, 0x5f, 0x0d, 0x63, 0x2b, 0xf0, 0x17, 0x50, 0xb5, 0x53, 0xd5, 0xa0, 0x0c,
-	0x1d, 0x87, 0x4a, 0x19, 0x3f, 0x51, 0xc9, 0x54, 0x53, 0x7c, 0x2a, 0x25, 0xe9, 0x26, 0x81, 0x91,
-	0x4c, 0xf1, 0x77, 0xb0, 0x16, 0xc5, 0xd6, 0xac, 0xa5, 0xe8, 0x26, 0x2c, 0x45, 0x9b, 0x8f, 0x35,
-	0xc4, 0x33, 0xcc, 0xe1, 0x4e, 0xa4, 0xc0, 0x64, 0xd7, 0x59, 0xb5, 0x6c, 0xc3, 0xb2, 0x7b, 0x89,
-	0x96, 0x94, 0x01, 0xa9, 0x25, 0xfc, 0x0e, 0x6e, 0x9b, 0x27, 0xd1, 0xdc, 0xa6, 0x19, 0xb5, 0x7d,
-	0x0c, 0xb7, 0xbb, 0x93, 0x58, 0xb1, 0xce, 0x2c, 0x01, 0xff, 0xa3, 0x04, 0x1b, 0x46, 0xf5, 0x89,
-	0xa4, 0xc1, 0x2b, 0x26, 0xd5, 0xac, 0xea, 0x9f, 0xc2, 0x46, 0x37, 0x0f, 0x2f, 0x36, 0x21, 0x9f,
-	0x88, 0xff, 0x5d, 0x02, 0xcb, 0x98, 0xa1, 0xab, 0x22, 0x39, 0x94, 0x8a, 0xfa, 0x33, 0xbb, 0xfd,
-	0x19, 0x58, 0xdd, 0x02, 0xc8, 0xd8, 0x98, 0x42, 0x3a, 0x1e, 0xc2, 0x4a, 0x74, 0x6d, 0x66, 0x33,
-	0xa1, 0x0e, 0x55, 0xfa, 0x8e, 0xa9, 0x86, 0x70, 0x23, 0x95, 0x8b, 0xf6, 0x68, 0xae, 0x63, 0x4f,
-	0x2a, 0xf7, 0x75, 0xa8, 0xe2, 0x22, 0x34, 0x9e, 0xe1, 0x6f, 0xe0, 0x96, 0xf1, 0x44, 0x5b, 0x97,
-	0xda, 0xef, 0x79, 0x6d, 0xb3, 0x17, 0x71, 0x21, 0xf7, 0x22, 0x7e, 0x15, 0xc7, 0x59, 0x84, 0x3d,
-	0xd3, 0xde, 0xb0, 0x80, 0x55, 0x5d, 0x15, 0xfe, 0x48, 0xaf, 0x9b, 0xad, 0x3e, 0x87, 0xcd, 0x90,
-	0x9f, 0x1b, 0xd1, 0xe3, 0x3c, 0xa3, 0x0b, 0xa8, 0xf8, 0x2d, 0xdc, 0x8e, 0x7a, 0x9c, 0xfd, 0xd0,
-	0xef, 0x5f, 0x57, 0x69, 0x1d, 0xaa, 0x6e, 0xe8, 0xf7, 0xdb, 0x44, 0xf5, 0xe2, 0xc3, 0x1f, 0xcd,
-	0xf1, 0x19, 0x7c, 0xd0, 0x69, 0x9e, 0xce, 0xe3, 0xee, 0xe9, 0x64, 0x46, 0x07, 0xa6, 0x2a, 0x8a,
-	0x13, 0x71, 0x3c, 0xc5, 0x7f, 0x2b, 0xc1, 0xd6, 0x2b, 0xd3, 0x75, 0xb7, 0x28, 0x91, 0x61, 0x40,
-	0xf5, 0x83, 0x38, 0x87, 0xab, 0xee, 0x4d, 0x62, 0xc6, 0x8a, 0xb3, 0x04, 0xfc, 0xad, 0xae, 0x77,
-	0xff, 0x4a, 0x1d, 0x15, 0xd9, 0xd1, 0xa1, 0x4e, 0x40, 0xd5, 0xfc, 0x9e, 0x1a, 0x09, 0x9b, 0xfb,
-	0x2c, 0x50, 0x43, 0x9b, 0x28, 0x3a, 0x97, 0xb4, 0x89, 0x61, 0xc5, 0x4d, 0x00, 0x5b, 0x67, 0x91,
-	0xbe, 0xb2, 0x3d, 0xb6, 0x86, 0x25, 0xa0, 0x8e, 0x13, 0x50, 0xca, 0x65, 0x4f, 0xcc, 0xec, 0x4e,
-	0x04, 0x15, 0x9f, 0xf9, 0x49, 0x72, 0x30, 0x63, 0xbd, 0xe6, 0x12, 0x45, 0xcc, 0x1d, 0x5d, 0xb1,
-	0xcd, 0x18, 0xbf, 0x81, 0xd5, 0x3d, 0xe2, 0x5c, 0x84, 0xfd, 0xf9, 0x39, 0xcf, 0x81, 0x2d, 0x9b,
-	0xba, 0xf4, 0x9c, 0x71, 0xda, 0xe8, 0x51, 0xe7, 0xa2, 0x2f, 0x18, 0xbf, 0xf6, 0xd9, 0xdc, 0x07,
-	0x70, 0x46, 0xc2, 0xb1, 0x86, 0xd4, 0x0a, 0xfe, 0x7b, 0x09, 0xea, 0x79, 0x5a, 0x66, 0x0e, 0xc2,
-	0x4b, 0x1d, 0x87, 0x7c, 0x40, 0x3c, 0x96, 0xb4, 0x8d, 0x59, 0xc2, 0x93, 0xff, 0xde, 0x85, 0x72,
-	0xc3, 0x77, 0xd1, 0x11, 0xa0, 0xce, 0x90, 0x3b, 0xe3, 0x45, 0x11, 0xfa, 0x30, 0x77, 0x73, 0x91,
-	0x1b, 0xea, 0xc5, 0xd6, 0xe0, 0x1b, 0xe8, 0x35, 0xdc, 0x69, 0x93, 0x50, 0xd2, 0xb9, 0x01, 0xbe,
-	0x81, 0x8d, 0x13, 0xde, 0x9f, 0x2b, 0x64, 0x07, 0xd6, 0xa3, 0x8c, 0x39, 0x81, 0x98, 0xed, 0x58,
-	0xc6, 0x12, 0xeb, 0xd5, 0xa0, 0x36, 0x6c, 0x9e, 0xc4, 0xf9, 0x72, 0x9e, 0xce, 0xb4, 0xa9, 0xa4,
-	0x6a, 0x6e, 0x80, 0xc7, 0x60, 0x75, 0xc4, 0xb9, 0xb2, 0xe9, 0x99, 0x10, 0xf3, 0x43, 0xb5, 0x61,
-	0xb3, 0xd3, 0x0b, 0x95, 0x2b, 0x7e, 0xe0, 0x73, 0xc3, 0x3c, 0x02, 0xf4, 0x35, 0xf3, 0xbc, 0xb9,
-	0xe1, 0xb5, 0x61, 0x7d, 0x9f, 0x7a, 0x54, 0xcd, 0xef, 0x70, 0xde, 0xc2, 0x46, 0xd4, 0x28, 0x4c,
-	0x42, 0xfe, 0x32, 0xfb, 0x49, 0x70, 0xa2, 0xa1, 0x98, 0x7a, 0xea, 0xfa, 0x4a, 0x8e, 0x84, 0x8e,
-	0x49, 0xd0, 0xa5, 0x6a, 0x06, 0x4b, 0xff, 0x04, 0xf7, 0x1a, 0x84, 0x3b, 0x74, 0xc2, 0x9b, 0x97,
-	0x1f, 0x59, 0x66, 0x3b, 0x7a, 0xd6, 0xe5, 0xc4, 0x8b, 0x8c, 0x6c, 0x0b, 0xb7, 0xe1, 0x51, 0xc2,
-	0xc3, 0xfe, 0x0c, 0x98, 0x7f, 0x86, 0x07, 0x2f, 0x19, 0x27, 0x1e, 0x9b, 0xbc, 0x49, 0xf3, 0x30,
-	0xf8, 0x08, 0xd0, 0x97, 0x42, 0xf5, 0xbd, 0xb0, 0xfb, 0xa5, 0x90, 0x6a, 0x9f, 0x0e, 0x98, 0x43,
-	0xe5, 0x0c, 0x78, 0x2d, 0xa8, 0x1d, 0x50, 0x15, 0x35, 0x29, 0xe8, 0x5e, 0x86, 0x33, 0xdd, 0x6e,
-	0xd5, 0x1f, 0x64, 0x3b, 0xf7, 0xb1, 0xee, 0xc9, 0x04, 0xd5, 0xda, 0x08, 0xce, 0x3c, 0xde, 0xd3,
-	0x30, 0x7f, 0x5d, 0x80, 0x39, 0xf6, 0xf2, 0x9b, 0x9c, 0xb7, 0x72, 0x40, 0xd5, 0xa8, 0xb9, 0x99,
-	0x06, 0x8b, 0x33, 0xe4, 0x4c, 0x5f, 0x64, 0x40, 0xab, 0x07, 0xd4, 0x34, 0x11, 0x53, 0xed, 0x7c,
-	0x98, 0x0f, 0x98, 0x69, 0x40, 0x6e, 0xa0, 0xbf, 0x18, 0x17, 0xa4, 0x9a, 0x81, 0x69, 0xd0, 0x1f,
-	0xe5, 0x43, 0xe7, 0xb5, 0x13, 0x37, 0xd0, 0x1e, 0x54, 0x74, 0xd1, 0x3d, 0x0d, 0xf3, 0xca, 0x33,
-	0x6f, 0x42, 0x45, 0x37, 0x25, 0xe8, 0x17, 0x59, 0x8c, 0xcb, 0x16, 0xbf, 0x7e, 0xaf, 0x80, 0x9a,
-	0x4a, 0xc6, 0xb5, 0x51, 0x13, 0x90, 0x93, 0x34, 0x26, 0x9b, 0x8f, 0xa2, 0x33, 0x49, 0xf7, 0x10,
-	0xe6, 0xf6, 0x58, 0x13, 0xb7, 0x66, 0x54, 0xab, 0x23, 0x5c, 0xf0, 0x67, 0x45, 0xaa, 0x90, 0x9f,
-	0x96, 0xf3, 0xf4, 0xd9, 0xa4, 0xfe, 0x83, 0xba, 0x7e, 0x78, 0xe6, 0xfc, 0x81, 0x15, 0xe7, 0x91,
-	0x4c, 0x19, 0xd2, 0x68, 0x9f, 0xc8, 0x19, 0x1f, 0xbb, 0x0c, 0x66, 0xfc, 0x5f, 0xd0, 0x2c, 0x6f,
-	0x32, 0x1c, 0x50, 0x15, 0xf7, 0x29, 0xd3, 0xb6, 0xbf, 0x9d, 0xfd, 0xda, 0x3c, 0xde, 0xe0, 0xe0,
-	0x1b, 0x88, 0xc0, 0xfa, 0x01, 0x55, 0x99, 0x9e, 0xe4, 0x6a, 0x13, 0xb3, 0x1f, 0xd5, 0x0a, 0x9b,
-	0x1a, 0x7c, 0x03, 0x7d, 0x0b, 0x28, 0xdb, 0x71, 0xa0, 0xbc, 0x0f, 0x73, 0x05, 0x6d, 0xc9, 0xd5,
-	0x2e, 0x71, 0xe0, 0xee, 0x28, 0x69, 0x8d, 0xb7, 0x1e, 0xd3, 0xfc, 0xf3, 0x9b, 0x9c, 0x6f, 0x99,
-	0x79, 0xad, 0x8b, 0xc9, 0x35, 0xab, 0xda, 0xef, 0xa3, 0x26, 0xe3, 0x6a, 0xff, 0xfc, 0x2a, 0xeb,
-	0xf8, 0x4c, 0x7b, 0x12, 0x55, 0x82, 0x51, 0x07, 0x31, 0xb5, 0x12, 0x1c, 0x6b, 0x34, 0xae, 0x76,
-	0x87, 0x00, 0x94, 0xad, 0xee, 0x73, 0xbc, 0x5d, 0xd8, 0x68, 0xd4, 0x7f, 0xf7, 0x5e, 0xbc, 0x89,
-	0xc2, 0xbd, 0xca, 0x37, 0x0b, 0x83, 0xc7, 0x67, 0x4b, 0xe6, 0x4f, 0xe3, 0x4f, 0x7f, 0x0e, 0x00,
-	0x00, 0xff, 0xff, 0x46, 0x9c, 0xe3, 0x6c, 0x61, 0x1e, 0x00, 0x00,
+	// 2301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x6d, 0x53, 0x1b, 0xc9,
+	0xf1, 0xb7, 0x90, 0xc0, 0xd0, 0x3c, 0x9c, 0x3d, 0x06, 0xbc, 0xc8, 0x7f, 0xdb, 0xfc, 0x27, 0x09,
+	0xf1, 0x5d, 0xee, 0x20, 0xf6, 0xf9, 0xae, 0x52, 0xae, 0xd4, 0x95, 0x8d, 0xc0, 0x1c, 0x67, 0x03,
+	0xf2, 0x0a, 0x70, 0xe5, 0x92, 0xcb, 0xdd, 0xb0, 0x3b, 0x12, 0x1b, 0x76, 0x67, 0xf6, 0x76, 0x66,
+	0xb1, 0xe5, 0x57, 0x79, 0xaa, 0xbc, 0x48, 0x55, 0x3e, 0x49, 0x3e, 0x40, 0x3e, 0x4a, 0xde, 0xe5,
+	0x03, 0xe4, 0x53, 0xa4, 0x66, 0xf6, 0x41, 0x2b, 0xed, 0xae, 0x04, 0x25, 0xbd, 0x62, 0x7b, 0xa6,
+	0xfb, 0xd7, 0x3d, 0x3d, 0x3d, 0x3d, 0xd3, 0x2d, 0xe0, 0x63, 0xff, 0xa2, 0xb3, 0x75, 0x4e, 0x98,
+	0xed, 0xd2, 0xe0, 0x33, 0x97, 0x84, 0xcc, 0x3a, 0xa7, 0xc1, 0x67, 0x16, 0xf7, 0xb6, 0x2c, 0xcf,
+	0xde, 0xba, 0x7c, 0xac, 0xfe, 0x6c, 0xfa, 0x01, 0x97, 0x1c, 0x7d, 0x74, 0x11, 0x9e, 0xd1, 0x4b,
+	0x27, 0x90, 0x9b, 0x6a, 0xec, 0xf2, 0x31, 0x6e, 0xc3, 0x9d, 0x37, 0xd4, 0x0b, 0x4f, 0x69, 0x20,
+	0x1c, 0xce, 0x4c, 0x2a, 0x7c, 0xce, 0x04, 0x45, 0x5f, 0xc0, 0x6c, 0x10, 0x7f, 0x1b, 0x95, 0xf5,
+	0xca, 0xa3, 0xf9, 0x27, 0x6b, 0x9b, 0x03, 0xa2, 0x9b, 0x09, 0xb3, 0x99, 0xb2, 0x22, 0x03, 0x6e,
+	0x5e, 0x46, 0x48, 0xc6, 0xd4, 0x7a, 0xe5, 0xd1, 0x9c, 0x99, 0x90, 0xf8, 0x21, 0x54, 0x4f, 0x0f,
+	0xf6, 0x35, 0x83, 0xe7, 0x7c, 0x23, 0x38, 0xd3, 0xb0, 0x0b, 0x66, 0x42, 0xe2, 0xc7, 0x50, 0x6d,
+	0x34, 0x4f, 0xd0, 0x12, 0x4c, 0x39, 0xb6, 0x9e, 0x5b, 0x34, 0xa7, 0x1c, 0x1b, 0xd5, 0x61, 0x56,
+	0x38, 0x67, 0xae, 0xc3, 0x3a, 0xc2, 0x98, 0x5a, 0xaf, 0x3e, 0x5a, 0x34, 0x53, 0x1a, 0x6f, 0xc1,
+	0xcd, 0x56, 0xf4, 0x9d, 0x13, 0x5b, 0x86, 0xe9, 0x4b, 0xe2, 0x86, 0x54, 0x9b, 0x51, 0x33, 0x23,
+	0x02, 0xef, 0xc2, 0x74, 0x93, 0x74, 0xa8, 0x50, 0xd3, 0x16, 0x0f, 0x99, 0xd4, 0x12, 0x35, 0x33,
+	0x22, 0x10, 0x82, 0x5a, 0xc8, 0x1c, 0x19, 0x9b, 0xae, 0xbf, 0xd5, 0x98, 0x70, 0x3e, 0x50, 0xa3,
+	0xaa, 0xa1, 0xf5, 0x37, 0x7e, 0x0a, 0x33, 0x07, 0xd4, 0xe3, 0x41, 0x17, 0xad, 0xc2, 0x0c, 0xf1,
+	0x32, 0x40, 0x31, 0x55, 0x84, 0x84, 0xff, 0x5d, 0x81, 0x5a, 0x83, 0xba, 0x6e, 0xce, 0xd6, 0x2d,
+	0x98, 0xf1, 0x34, 0x9c, 0x66, 0x9f, 0x7f, 0x72, 0x37, 0xe7, 0xe9, 0x48, 0x9b, 0x19, 0xb3, 0xa1,
+	0x4f, 0x61, 0xda, 0x57, 0xcb, 0x30, 0xaa, 0xeb, 0xd5, 0x47, 0xf3, 0x4f, 0x56, 0x73, 0xfc, 0x7a,
+	0x91, 0x66, 0xc4, 0x84, 0xbe, 0x84, 0x39, 0xdb, 0x11, 0x92, 0x30, 0x8b, 0x0a, 0xa3, 0xa6, 0x25,
+	0x8c, 0x9c, 0x44, 0xec, 0x47, 0xb3, 0xc7, 0x8a, 0x1e, 0x41, 0xcd, 0xf2, 0x43, 0x61, 0x4c, 0x6b,
+	0x91, 0xe5, 0x9c, 0x48, 0xa3, 0x79, 0x62, 0x6a, 0x0e, 0xfc, 0x1c, 0x66, 0x8f, 0xb9, 0xcf, 0x5d,
+	0xde, 0xe9, 0xa2, 0xa7, 0x00, 0x2c, 0xf4, 0xc8, 0xf7, 0x16, 0x75, 0x5d, 0x61, 0x54, 0xb4, 0xec,
+	0x4a, 0x5e, 0x96, 0xba, 0xae, 0x39, 0xa7, 0x18, 0xd5, 0x97, 0xc0, 0x7f, 0xaf, 0xc0, 0x4c, 0xeb,
+	0x60, 0xdb, 0xe1, 0x02, 0x61, 0x58, 0xf0, 0x08, 0x0b, 0xdb, 0xc4, 0x92, 0x61, 0x40, 0x03, 0xed,
+	0xa7, 0x39, 0xb3, 0x6f, 0x4c, 0x45, 0x91, 0x1f, 0x70, 0x3b, 0xb4, 0x12, 0x0f, 0x27, 0x64, 0x36,
+	0x00, 0xab, 0x7d, 0x01, 0x88, 0x6e, 0x41, 0x55, 0x5c, 0x84, 0x46, 0x4d, 0x8f, 0xaa, 0x4f, 0xb5,
+	0x79, 0x6d, 0xe2, 0x39, 0x6e, 0xd7, 0x98, 0xd6, 0x83, 0x31, 0x85, 0xff, 0x56, 0x81, 0xd9, 0x1d,
+	0x47, 0x5c, 0xec, 0xb3, 0x36, 0xd7, 0x4c, 0x3c, 0xf0, 0x88, 0x8c, 0x0d, 0x89, 0x29, 0xb4, 0x0e,
+	0xf3, 0x67, 0xc4, 0xba, 0x70, 0x58, 0xe7, 0xa5, 0xe3, 0xd2, 0xd8, 0x8c, 0xec, 0x10, 0x7a, 0x00,
+	0xa0, 0xec, 0x25, 0x6e, 0x2b, 0x89, 0x9f, 0x9a, 0x99, 0x19, 0x51, 0x08, 0xca, 0x25, 0x09, 0x43,
+	0x4d, 0x33, 0x64, 0x87, 0xf0, 0xbf, 0xa6, 0x60, 0xb1, 0xe1, 0x86, 0x42, 0xd2, 0xa0, 0xc1, 0x59,
+	0xdb, 0xe9, 0xa0, 0x4d, 0x40, 0xbb, 0xef, 0x7d, 0xc2, 0x6c, 0x65, 0x9f, 0xd8, 0x65, 0xe4, 0xcc,
+	0xa5, 0x51, 0x28, 0xcd, 0x9a, 0x05, 0x33, 0xe8, 0xd7, 0xb0, 0xf6, 0x32, 0xa0, 0x54, 0xc5, 0x83,
+	0x49, 0x7d, 0x1e, 0x48, 0x87, 0x75, 0x76, 0x1c, 0x11, 0x89, 0x4d, 0x69, 0xb1, 0x72, 0x06, 0xf4,
+	0x0c, 0x8c, 0x6d, 0x6e, 0x9d, 0x8b, 0x1d, 0x47, 0xf8, 0x2e, 0xe9, 0xbe, 0xe4, 0xc1, 0xee, 0xcb,
+	0xfd, 0xbd, 0x90, 0x0a, 0x29, 0xf4, 0x7a, 0x66, 0xcd, 0xd2, 0x79, 0x25, 0xdb, 0xa2, 0x81, 0x43,
+	0xdc, 0x06, 0x67, 0x82, 0xbb, 0xf4, 0x35, 0xef, 0x29, 0xae, 0x45, 0xb2, 0x65, 0xf3, 0xe8, 0x39,
+	0xdc, 0x6b, 0x36, 0xf6, 0x0f, 0x4f, 0x0e, 0x5e, 0xbc, 0x78, 0x47, 0x02, 0x9a, 0xc4, 0x56, 0xb2,
+	0xdc, 0x69, 0x2d, 0x3e, 0x8c, 0x05, 0x7f, 0x0e, 0x6b, 0xfb, 0x4c, 0xd2, 0xa0, 0x4d, 0x2c, 0xba,
+	0xed, 0x30, 0xdb, 0x61, 0x9d, 0x03, 0xa7, 0x13, 0x10, 0xa9, 0x22, 0x61, 0x55, 0x1d, 0x5f, 0x79,
+	0xce, 0xed, 0x64, 0x4b, 0x23, 0x0a, 0xff, 0xe7, 0x26, 0xac, 0x9c, 0x46, 0xee, 0x3f, 0x20, 0xd6,
+	0xb9, 0xc3, 0xe8, 0x91, 0xaf, 0x04, 0x04, 0x7a, 0x05, 0xcb, 0xfd, 0x13, 0x51, 0xac, 0x1a, 0x95,
+	0x92, 0xf3, 0x1a, 0x4d, 0x9b, 0x85, 0x42, 0xe8, 0x29, 0xac, 0x1c, 0x50, 0x6f, 0x9b, 0xb8, 0x2e,
+	0xe7, 0xac, 0x25, 0x89, 0x14, 0x4d, 0x1a, 0x38, 0x3c, 0xda, 0x8f, 0x45, 0xb3, 0x78, 0x12, 0xfd,
+	0x12, 0xee, 0x34, 0x03, 0xaa, 0xc6, 0x2d, 0x22, 0xa9, 0x7d, 0xca, 0xdd, 0xd0, 0x8b, 0x33, 0xc0,
+	0x9c, 0x59, 0x34, 0xa5, 0x52, 0xb8, 0x8c, 0xdd, 0x62, 0xd4, 0x4a, 0x52, 0x78, 0xe2, 0x37, 0x33,
+	0x65, 0x45, 0x2d, 0x98, 0xd3, 0x21, 0xa4, 0xa2, 0x3f, 0x3e, 0xfb, 0x5f, 0xe4, 0xe4, 0x0a, 0xdd,
+	0xb4, 0x99, 0xca, 0xed, 0x32, 0x19, 0x74, 0xcd, 0x1e, 0x4e, 0x49, 0xdc, 0xce, 0x94, 0xc6, 0xed,
+	0x0e, 0x2c, 0x5a, 0xd9, 0xc0, 0x37, 0x6e, 0xea, 0x05, 0x3c, 0xc8, 0x27, 0x92, 0x2c, 0x97, 0xd9,
+	0x2f, 0x84, 0xfe, 0x52, 0x81, 0x35, 0x27, 0x09, 0x83, 0x1d, 0xee, 0x11, 0x87, 0xbd, 0x90, 0x92,
+	0x58, 0xe7, 0x1e, 0x65, 0xd2, 0x98, 0xd5, 0x6b, 0xdb, 0xbd, 0xe2, 0xda, 0xf6, 0xcb, 0x70, 0xa2,
+	0xb5, 0x96, 0xeb, 0x41, 0x0c, 0x50, 0x3a, 0x99, 0x06, 0xa1, 0x31, 0xa7, 0xb5, 0x7f, 0x75, 0x5d,
+	0xed, 0x29, 0x40, 0xa4, 0xb6, 0x00, 0xb9, 0xfe, 0x16, 0x96, 0xfa, 0x37, 0x42, 0xa5, 0xbe, 0x0b,
+	0xda, 0x8d, 0xa3, 0x5d, 0x7d, 0xa2, 0xad, 0xec, 0xf5, 0x58, 0x14, 0x18, 0x49, 0xfe, 0x8b, 0x6f,
+	0xce, 0x67, 0x53, 0xbf, 0xaa, 0xd4, 0x5f, 0xc3, 0x83, 0xe1, 0x5e, 0x28, 0x50, 0xd4, 0x77, 0x0f,
+	0xcf, 0x65, 0xd1, 0x7e, 0x84, 0xbb, 0x25, 0xab, 0x2a, 0x80, 0x79, 0xde, 0x6f, 0xef, 0x27, 0x39,
+	0x7b, 0x4b, 0x4f, 0x7b, 0x46, 0x25, 0xbe, 0x04, 0x38, 0x3d, 0xd8, 0x37, 0xe9, 0x8f, 0x2a, 0x45,
+	0xa1, 0x0d, 0xa8, 0x5e, 0x7a, 0x4e, 0x7c, 0x86, 0xf3, 0xd7, 0x9b, 0xe2, 0x54, 0x0c, 0xe8, 0x39,
+	0xdc, 0xe4, 0xd1, 0x36, 0xc4, 0xda, 0x37, 0xae, 0xb6, 0x69, 0x66, 0x22, 0x86, 0x8f, 0xe1, 0x56,
+	0xcf, 0x9e, 0x6b, 0x6a, 0x37, 0xfa, 0xb5, 0x2f, 0xf4, 0x50, 0xff, 0x59, 0x81, 0xf9, 0xdd, 0xf7,
+	0xd4, 0x4a, 0x10, 0x1f, 0x00, 0xd8, 0x7a, 0x57, 0x0e, 0x89, 0x47, 0x63, 0xe7, 0x65, 0x46, 0x14,
+	0x52, 0x83, 0x7b, 0x1e, 0x61, 0x76, 0x72, 0x69, 0xc6, 0xa4, 0x7a, 0xad, 0xbc, 0x08, 0x3a, 0x49,
+	0x32, 0xd1, 0xdf, 0x68, 0x03, 0x96, 0xa4, 0xe3, 0x51, 0x1e, 0xca, 0x16, 0xb5, 0x38, 0xb3, 0x85,
+	0xce, 0x21, 0xd3, 0xe6, 0xc0, 0xa8, 0xe2, 0xf3, 0xc8, 0xfb, 0xa3, 0x50, 0xfa, 0xa1, 0xdc, 0xee,
+	0x4a, 0x2a, 0x74, 0x7a, 0xae, 0x9a, 0x03, 0xa3, 0x78, 0x09, 0x16, 0x76, 0x3d, 0x5f, 0x76, 0x63,
+	0x6b, 0xf1, 0x57, 0x30, 0x6b, 0x66, 0x5e, 0x8d, 0x22, 0xb4, 0x2c, 0x2a, 0x44, 0x7c, 0x95, 0x25,
+	0xa4, 0x9a, 0xf1, 0xa8, 0x10, 0xa4, 0x93, 0x04, 0x50, 0x42, 0xe2, 0xef, 0x61, 0x29, 0x8a, 0xc1,
+	0x71, 0x9f, 0xac, 0xab, 0x30, 0x13, 0x39, 0x29, 0xd6, 0x10, 0x53, 0x98, 0xc1, 0x9d, 0x48, 0x81,
+	0xce, 0xc2, 0xe3, 0x6a, 0x59, 0x87, 0x79, 0xbb, 0x87, 0x96, 0x3c, 0x17, 0x32, 0x43, 0xf8, 0x3d,
+	0xdc, 0xd6, 0x57, 0xa7, 0x3e, 0x75, 0x63, 0x6a, 0xfb, 0x14, 0x6e, 0x77, 0x06, 0xb1, 0x62, 0x9d,
+	0xf9, 0x09, 0xfc, 0xd7, 0x0a, 0xac, 0x68, 0xd5, 0x27, 0x82, 0x06, 0xaf, 0x1d, 0x21, 0xc7, 0x55,
+	0xff, 0x14, 0x56, 0x3a, 0x45, 0x78, 0xb1, 0x09, 0xc5, 0x93, 0xf8, 0x1f, 0x15, 0x30, 0xb4, 0x19,
+	0xea, 0xf5, 0x24, 0xba, 0x42, 0x52, 0x6f, 0x6c, 0xb7, 0x3f, 0x03, 0xa3, 0x53, 0x02, 0x19, 0x1b,
+	0x53, 0x3a, 0x8f, 0xbb, 0xb0, 0x10, 0x1d, 0xaf, 0xf1, 0x4c, 0xa8, 0xc3, 0x2c, 0x7d, 0xef, 0xc8,
+	0x06, 0xb7, 0x23, 0x95, 0xd3, 0x66, 0x4a, 0xab, 0xd8, 0x13, 0xd2, 0x3e, 0x0a, 0x65, 0xfc, 0x58,
+	0x8d, 0x29, 0xfc, 0x2d, 0xdc, 0xd2, 0x9e, 0x68, 0xaa, 0x27, 0xf9, 0x15, 0x8f, 0x77, 0xfe, 0xc0,
+	0x4e, 0x15, 0x1d, 0x58, 0xfc, 0x0d, 0xdc, 0xce, 0x60, 0x8f, 0xb5, 0x36, 0xfc, 0x03, 0x2c, 0xa7,
+	0x3b, 0x76, 0xe4, 0x53, 0x76, 0x55, 0x5b, 0x11, 0xd4, 0x7c, 0x22, 0xcf, 0x93, 0xf2, 0x48, 0x7d,
+	0xab, 0x9b, 0xe2, 0x5d, 0xe0, 0x48, 0x1a, 0xbf, 0x2c, 0x23, 0x02, 0x7f, 0x80, 0x95, 0x01, 0x0d,
+	0x63, 0x9f, 0xf6, 0xa8, 0x50, 0xd6, 0xba, 0xab, 0x66, 0x4c, 0xf5, 0x95, 0x79, 0xd5, 0xb8, 0xcc,
+	0x6b, 0x67, 0x56, 0x67, 0x52, 0x62, 0x5f, 0x75, 0x75, 0x65, 0x3a, 0x56, 0x61, 0xc6, 0xa5, 0xac,
+	0x23, 0xcf, 0x63, 0x2d, 0x31, 0x85, 0x25, 0xac, 0x0c, 0xe8, 0x19, 0x6f, 0x8d, 0x08, 0x6a, 0x36,
+	0x91, 0x24, 0xbe, 0x2f, 0xf4, 0xb7, 0xba, 0x52, 0x29, 0x6f, 0xc7, 0xbe, 0x55, 0x9f, 0xd8, 0xca,
+	0x68, 0x7d, 0xab, 0x7c, 0x3d, 0xee, 0xf2, 0x12, 0xb5, 0xd5, 0x9e, 0x5a, 0x7c, 0x94, 0x51, 0xd2,
+	0x70, 0xb9, 0x18, 0x57, 0x09, 0xfe, 0x73, 0x25, 0x3e, 0x1a, 0x2a, 0x7d, 0x5c, 0x03, 0x8c, 0x58,
+	0xb2, 0xd7, 0x94, 0x88, 0x29, 0x75, 0x34, 0x43, 0x41, 0x03, 0xa6, 0xa4, 0xa2, 0x03, 0x98, 0xd2,
+	0x6a, 0xce, 0x27, 0x42, 0xbc, 0xe3, 0x81, 0x1d, 0xd7, 0x8c, 0x29, 0x8d, 0x7f, 0x1f, 0x07, 0xc6,
+	0xb1, 0xe3, 0xd1, 0x56, 0x97, 0x59, 0x93, 0x3e, 0xa2, 0x49, 0xd0, 0xf7, 0xf0, 0xc7, 0x0b, 0x88,
+	0x4f, 0xe0, 0x96, 0x1d, 0x38, 0x6d, 0x79, 0x48, 0x18, 0x17, 0x19, 0xcd, 0x55, 0x33, 0x37, 0x8e,
+	0x39, 0x2c, 0xaa, 0x82, 0xf0, 0x03, 0xbd, 0xee, 0x43, 0xe5, 0x4b, 0x58, 0x0d, 0x59, 0x5b, 0x8b,
+	0x1e, 0x17, 0x2d, 0xb2, 0x64, 0x16, 0xbf, 0x85, 0xdb, 0x51, 0x7b, 0x63, 0x27, 0xf4, 0xfc, 0xeb,
+	0x2a, 0xad, 0xc3, 0xac, 0x1d, 0x7a, 0x7e, 0xb3, 0x97, 0x4c, 0x52, 0x1a, 0x9f, 0xc1, 0x47, 0xad,
+	0xdd, 0xd3, 0x49, 0x5c, 0xa7, 0xea, 0x7d, 0x42, 0x2f, 0x75, 0x41, 0x14, 0xbf, 0xc1, 0x62, 0x12,
+	0xff, 0xb1, 0x02, 0x6b, 0xaf, 0x75, 0xc3, 0xed, 0x80, 0x12, 0x11, 0x06, 0x54, 0xbd, 0x85, 0x27,
+	0x70, 0x7b, 0xbb, 0x83, 0x98, 0xb1, 0xe2, 0xfc, 0x04, 0xfe, 0x4e, 0x95, 0xba, 0x7f, 0xa0, 0x96,
+	0x8c, 0xec, 0x68, 0x51, 0x2b, 0xa0, 0x72, 0x72, 0xaf, 0x4c, 0x01, 0xab, 0x3b, 0x4e, 0x20, 0xbb,
+	0x26, 0x91, 0x74, 0x22, 0x2f, 0x21, 0x0c, 0x0b, 0x76, 0x02, 0x78, 0x70, 0x96, 0x04, 0x62, 0xdf,
+	0x18, 0x16, 0x80, 0x5a, 0x56, 0x40, 0x29, 0x13, 0xe7, 0x5c, 0x4e, 0x20, 0x1d, 0x7a, 0x8e, 0x97,
+	0xdc, 0xf7, 0xfa, 0xbb, 0x30, 0x57, 0xbd, 0x81, 0xc5, 0x6d, 0x62, 0x5d, 0x84, 0xfe, 0xe4, 0x9c,
+	0x67, 0xc1, 0x9a, 0x49, 0x6d, 0xda, 0x76, 0x18, 0x6d, 0x9c, 0x53, 0xeb, 0xc2, 0xe7, 0x0e, 0xbb,
+	0xf6, 0xde, 0x3c, 0x00, 0xb0, 0x52, 0xe1, 0x58, 0x43, 0x66, 0x04, 0xff, 0xa9, 0x02, 0xf5, 0x22,
+	0x2d, 0x63, 0x07, 0x61, 0x4f, 0xc7, 0x3e, 0xbb, 0x24, 0xae, 0x93, 0x74, 0x8c, 0xf2, 0x13, 0x4f,
+	0xfe, 0x7b, 0x0f, 0xaa, 0x0d, 0xcf, 0x46, 0x87, 0x80, 0x54, 0xc2, 0xea, 0xaf, 0x87, 0xd0, 0xbd,
+	0xc2, 0xc5, 0x45, 0x6e, 0xa8, 0x97, 0x5b, 0x83, 0x6f, 0xa0, 0x23, 0xb8, 0xd3, 0x24, 0xa1, 0xa0,
+	0x13, 0x03, 0x7c, 0x03, 0x2b, 0x27, 0xcc, 0x9f, 0x28, 0x64, 0x0b, 0x96, 0xa3, 0x8c, 0x39, 0x80,
+	0x98, 0x6f, 0x56, 0xf4, 0x25, 0xd6, 0xe1, 0xa0, 0x26, 0xac, 0x9e, 0xb0, 0x76, 0x11, 0xec, 0x58,
+	0xce, 0x34, 0xa9, 0xa0, 0x72, 0x62, 0x80, 0xc7, 0x60, 0xb4, 0x78, 0x5b, 0x9a, 0xf4, 0x8c, 0xf3,
+	0xc9, 0xa1, 0x9a, 0xb0, 0xda, 0x3a, 0x0f, 0xa5, 0xcd, 0xdf, 0xb1, 0x89, 0x61, 0x1e, 0x02, 0x7a,
+	0xe5, 0xb8, 0xee, 0xc4, 0xf0, 0x9a, 0xb0, 0xbc, 0x43, 0x5d, 0x2a, 0x27, 0xb7, 0x39, 0x6f, 0x61,
+	0x25, 0xea, 0x11, 0x0c, 0x42, 0xfe, 0x7f, 0x4e, 0x6a, 0xb0, 0x97, 0x30, 0x72, 0xd7, 0xd5, 0x91,
+	0x4c, 0x85, 0x8e, 0x49, 0xd0, 0xa1, 0x72, 0x0c, 0x4b, 0x7f, 0x03, 0xf7, 0x1b, 0xea, 0x17, 0x82,
+	0x01, 0x6f, 0xa6, 0x0a, 0xc6, 0xdc, 0x7a, 0xa7, 0xc3, 0x88, 0x1b, 0x19, 0xd9, 0xe4, 0x76, 0xc3,
+	0xa5, 0x84, 0x85, 0xfe, 0x18, 0x98, 0xbf, 0x85, 0x87, 0x2f, 0x1d, 0x46, 0x5c, 0xe7, 0x03, 0x9d,
+	0xbc, 0xc1, 0x87, 0x80, 0xbe, 0xe6, 0xd2, 0x77, 0xc3, 0xce, 0xd7, 0x5c, 0xc8, 0x1d, 0x7a, 0xe9,
+	0x58, 0x54, 0x8c, 0x81, 0x77, 0x00, 0x73, 0x7b, 0x54, 0x46, 0x7d, 0x07, 0x74, 0x3f, 0xc7, 0x99,
+	0xed, 0xa0, 0xd4, 0x1f, 0xe6, 0xa6, 0xfb, 0x1b, 0x22, 0x3a, 0xa8, 0x96, 0x52, 0x38, 0x7d, 0x79,
+	0x8f, 0xc2, 0xfc, 0x69, 0x09, 0x66, 0xdf, 0xcd, 0xaf, 0x73, 0xde, 0xc2, 0x1e, 0x95, 0x69, 0xbf,
+	0x62, 0x14, 0x2c, 0xce, 0x4d, 0xe7, 0x5a, 0x1d, 0x1a, 0x74, 0x76, 0x8f, 0xea, 0x87, 0xfd, 0x48,
+	0x3b, 0x37, 0x8a, 0x01, 0x73, 0x3d, 0x85, 0x1b, 0xe8, 0x77, 0xda, 0x05, 0x99, 0xfa, 0x7e, 0x14,
+	0xf4, 0xc7, 0xc5, 0xd0, 0x45, 0x1d, 0x82, 0x1b, 0x68, 0x1b, 0x6a, 0xaa, 0x8e, 0x1e, 0x85, 0x39,
+	0x74, 0xcf, 0x77, 0xa1, 0xa6, 0xfa, 0x0c, 0xe8, 0xff, 0xf2, 0x18, 0xbd, 0xee, 0x5e, 0xfd, 0x7e,
+	0xc9, 0x6c, 0x26, 0x19, 0xcf, 0xa5, 0x75, 0x7d, 0x41, 0xd2, 0x18, 0xec, 0x27, 0xd4, 0xf1, 0x30,
+	0x96, 0x14, 0xf5, 0x07, 0x58, 0x54, 0xd9, 0x23, 0x2d, 0x47, 0xd0, 0xcf, 0x8a, 0xc5, 0x06, 0x4a,
+	0xa1, 0xfa, 0xc6, 0x28, 0xb6, 0xcc, 0xf9, 0x34, 0x06, 0xce, 0x65, 0x5a, 0x0d, 0x20, 0x5c, 0xf2,
+	0x4b, 0x68, 0xa6, 0x54, 0x18, 0x95, 0x55, 0xd5, 0xee, 0x67, 0x7e, 0xe0, 0xbe, 0xfe, 0x01, 0x28,
+	0xf8, 0x75, 0x3c, 0xce, 0x54, 0xb9, 0x87, 0x4e, 0xa3, 0x79, 0x22, 0xc6, 0xbc, 0x4e, 0x73, 0x98,
+	0xd1, 0x82, 0xc7, 0xba, 0xf5, 0x61, 0x8f, 0xca, 0xb8, 0x12, 0x1a, 0xb5, 0xfc, 0xf5, 0xdc, 0xf4,
+	0x40, 0x09, 0x85, 0x6f, 0x20, 0x02, 0xcb, 0x7b, 0x54, 0xe6, 0xaa, 0x9e, 0xe1, 0x26, 0xe6, 0x3b,
+	0xf6, 0xa5, 0x65, 0x13, 0xbe, 0x81, 0xbe, 0x03, 0x94, 0xaf, 0x69, 0x50, 0x51, 0xd7, 0xbf, 0xa4,
+	0xf0, 0x19, 0xee, 0x12, 0x0b, 0xee, 0xa6, 0x69, 0xb1, 0xbf, 0xb8, 0x19, 0xe5, 0x9f, 0x9f, 0x17,
+	0xfc, 0x50, 0x52, 0x54, 0x1c, 0xe9, 0x6c, 0xb6, 0xa8, 0xfc, 0x9e, 0x96, 0x31, 0xc3, 0xfd, 0xf3,
+	0x93, 0xbc, 0xe3, 0x73, 0x05, 0x50, 0xf4, 0xd6, 0x8c, 0x6a, 0x94, 0x91, 0x6f, 0xcd, 0xbe, 0x52,
+	0x66, 0xb8, 0x3b, 0x38, 0xa0, 0x7c, 0xfd, 0x50, 0xe0, 0xed, 0xd2, 0x52, 0xa6, 0xfe, 0x8b, 0x2b,
+	0xf1, 0x66, 0x93, 0x4a, 0x5f, 0x53, 0xaf, 0x2c, 0xa9, 0x0c, 0xb4, 0x15, 0xeb, 0x1b, 0xa3, 0xd8,
+	0x0a, 0x35, 0xa8, 0x96, 0xda, 0x30, 0x0d, 0x99, 0xd6, 0x5e, 0x7d, 0x63, 0x14, 0x5b, 0xaa, 0xe1,
+	0x04, 0x96, 0xfa, 0xdb, 0x67, 0x68, 0x88, 0x6c, 0xb6, 0xbf, 0x36, 0x7c, 0x2f, 0xb2, 0xb0, 0xba,
+	0x61, 0x36, 0x0c, 0x36, 0xdb, 0x51, 0x1b, 0x0e, 0xfb, 0x0a, 0xe6, 0xd2, 0x0b, 0xb2, 0xec, 0x72,
+	0xc8, 0x74, 0xd4, 0x86, 0x82, 0x6d, 0xd7, 0xbe, 0x9d, 0xba, 0x7c, 0x7c, 0x36, 0xa3, 0xff, 0xa1,
+	0xe8, 0xf3, 0xff, 0x0d, 0x00, 0x91, 0x8b, 0x1b, 0xb2, 0x7d, 0x24, 0x00, 0x00,
 }
@@ -361,6 +361,106 @@ func (mr *MockCmdClientMockRecorder) GetUsers(ctx, in any, opts ...any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockCmdClient)(nil).GetUsers), varargs...)
 }
 
+// GuestFileClose mocks base method.
+func (m *MockCmdClient) GuestFileClose(ctx context.Context, in *GuestFileCloseRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestFileClose", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileClose indicates an expected call of GuestFileClose.
+func (mr *MockCmdClientMockRecorder) GuestFileClose(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileClose", reflect.TypeOf((*MockCmdClient)(nil).GuestFileClose), varargs...)
+}
+
+// GuestFileOpen mocks base method.
+func (m *MockCmdClient) GuestFileOpen(ctx context.Context, in *GuestFileOpenRequest, opts ...grpc.CallOption) (*GuestFileOpenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestFileOpen", varargs...)
+	ret0, _ := ret[0].(*GuestFileOpenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileOpen indicates an expected call of GuestFileOpen.
+func (mr *MockCmdClientMockRecorder) GuestFileOpen(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileOpen", reflect.TypeOf((*MockCmdClient)(nil).GuestFileOpen), varargs...)
+}
+
+// GuestFileRead mocks base method.
+func (m *MockCmdClient) GuestFileRead(ctx context.Context, in *GuestFileReadRequest, opts ...grpc.CallOption) (*GuestFileReadResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestFileRead", varargs...)
+	ret0, _ := ret[0].(*GuestFileReadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockCmdClientMockRecorder) GuestFileRead(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockCmdClient)(nil).GuestFileRead), varargs...)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockCmdClient) GuestFileWrite(ctx context.Context, in *GuestFileWriteRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestFileWrite", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockCmdClientMockRecorder) GuestFileWrite(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockCmdClient)(nil).GuestFileWrite), varargs...)
+}
+
+// GuestUser mocks base method.
+func (m *MockCmdClient) GuestUser(ctx context.Context, in *GuestUserRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestUser", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestUser indicates an expected call of GuestUser.
+func (mr *MockCmdClientMockRecorder) GuestUser(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestUser", reflect.TypeOf((*MockCmdClient)(nil).GuestUser), varargs...)
+}
+
 // GuestPing mocks base method.
 func (m *MockCmdClient) GuestPing(ctx context.Context, in *GuestPingRequest, opts ...grpc.CallOption) (*GuestPingResponse, error) {
 	m.ctrl.T.Helper()
@@ -601,6 +701,26 @@ func (mr *MockCmdClientMockRecorder) SoftRebootVirtualMachine(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftRebootVirtualMachine", reflect.TypeOf((*MockCmdClient)(nil).SoftRebootVirtualMachine), varargs...)
 }
 
+// SyncGuestTime mocks base method.
+func (m *MockCmdClient) SyncGuestTime(ctx context.Context, in *GuestTimeSyncRequest, opts ...grpc.CallOption) (*GuestTimeSyncResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncGuestTime", varargs...)
+	ret0, _ := ret[0].(*GuestTimeSyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncGuestTime indicates an expected call of SyncGuestTime.
+func (mr *MockCmdClientMockRecorder) SyncGuestTime(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncGuestTime", reflect.TypeOf((*MockCmdClient)(nil).SyncGuestTime), varargs...)
+}
+
 // SyncMigrationTarget mocks base method.
 func (m *MockCmdClient) SyncMigrationTarget(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -1005,6 +1125,81 @@ func (mr *MockCmdServerMockRecorder) GetUsers(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockCmdServer)(nil).GetUsers), arg0, arg1)
 }
 
+// GuestFileClose mocks base method.
+func (m *MockCmdServer) GuestFileClose(arg0 context.Context, arg1 *GuestFileCloseRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileClose", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileClose indicates an expected call of GuestFileClose.
+func (mr *MockCmdServerMockRecorder) GuestFileClose(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileClose", reflect.TypeOf((*MockCmdServer)(nil).GuestFileClose), arg0, arg1)
+}
+
+// GuestFileOpen mocks base method.
+func (m *MockCmdServer) GuestFileOpen(arg0 context.Context, arg1 *GuestFileOpenRequest) (*GuestFileOpenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileOpen", arg0, arg1)
+	ret0, _ := ret[0].(*GuestFileOpenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileOpen indicates an expected call of GuestFileOpen.
+func (mr *MockCmdServerMockRecorder) GuestFileOpen(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileOpen", reflect.TypeOf((*MockCmdServer)(nil).GuestFileOpen), arg0, arg1)
+}
+
+// GuestFileRead mocks base method.
+func (m *MockCmdServer) GuestFileRead(arg0 context.Context, arg1 *GuestFileReadRequest) (*GuestFileReadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", arg0, arg1)
+	ret0, _ := ret[0].(*GuestFileReadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockCmdServerMockRecorder) GuestFileRead(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockCmdServer)(nil).GuestFileRead), arg0, arg1)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockCmdServer) GuestFileWrite(arg0 context.Context, arg1 *GuestFileWriteRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockCmdServerMockRecorder) GuestFileWrite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockCmdServer)(nil).GuestFileWrite), arg0, arg1)
+}
+
+// GuestUser mocks base method.
+func (m *MockCmdServer) GuestUser(arg0 context.Context, arg1 *GuestUserRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestUser", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestUser indicates an expected call of GuestUser.
+func (mr *MockCmdServerMockRecorder) GuestUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestUser", reflect.TypeOf((*MockCmdServer)(nil).GuestUser), arg0, arg1)
+}
+
 // GuestPing mocks base method.
 func (m *MockCmdServer) GuestPing(arg0 context.Context, arg1 *GuestPingRequest) (*GuestPingResponse, error) {
 	m.ctrl.T.Helper()
@@ -1185,6 +1380,21 @@ func (mr *MockCmdServerMockRecorder) SoftRebootVirtualMachine(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftRebootVirtualMachine", reflect.TypeOf((*MockCmdServer)(nil).SoftRebootVirtualMachine), arg0, arg1)
 }
 
+// SyncGuestTime mocks base method.
+func (m *MockCmdServer) SyncGuestTime(arg0 context.Context, arg1 *GuestTimeSyncRequest) (*GuestTimeSyncResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncGuestTime", arg0, arg1)
+	ret0, _ := ret[0].(*GuestTimeSyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncGuestTime indicates an expected call of SyncGuestTime.
+func (mr *MockCmdServerMockRecorder) SyncGuestTime(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncGuestTime", reflect.TypeOf((*MockCmdServer)(nil).SyncGuestTime), arg0, arg1)
+}
+
 // SyncMigrationTarget mocks base method.
 func (m *MockCmdServer) SyncMigrationTarget(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,208 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainpatch
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// element is a node of the domain XML tree. Names are kept as written,
+// including their namespace prefix, so that elements like qemu:commandline
+// round-trip unchanged.
+type element struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []any
+}
+
+// document holds the root element, and the tokens around it like the XML declaration
+type document struct {
+	prolog   []xml.Token
+	root     *element
+	epilogue []xml.Token
+}
+
+func parseDocument(data string) (*document, error) {
+	nodes, err := parseNodes(data)
+	if err != nil {
+		return nil, err
+	}
+	doc := &document{}
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case *element:
+			if doc.root != nil {
+				return nil, errors.New("the document has more than one root element")
+			}
+			doc.root = n
+		case xml.CharData:
+			// Whitespace around the root element is not significant
+		default:
+			if doc.root == nil {
+				doc.prolog = append(doc.prolog, n)
+			} else {
+				doc.epilogue = append(doc.epilogue, n)
+			}
+		}
+	}
+	if doc.root == nil {
+		return nil, errors.New("the document has no root element")
+	}
+	return doc, nil
+}
+
+// parseFragment parses a sequence of XML nodes, e.g. the value of an add operation
+func parseFragment(data string) ([]any, error) {
+	return parseNodes(data)
+}
+
+func parseNodes(data string) ([]any, error) {
+	decoder := xml.NewDecoder(bytes.NewBufferString(data))
+	top := &element{}
+	stack := []*element{top}
+	for {
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		parent := stack[len(stack)-1]
+		switch t := token.(type) {
+		case xml.StartElement:
+			child := &element{name: t.Name, attrs: append([]xml.Attr(nil), t.Attr...)}
+			parent.children = append(parent.children, child)
+			stack = append(stack, child)
+		case xml.EndElement:
+			if len(stack) == 1 || parent.name != t.Name {
+				return nil, fmt.Errorf("unexpected end element </%s>", qualifiedName(t.Name))
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			parent.children = append(parent.children, xml.CopyToken(t))
+		}
+	}
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("element <%s> is not closed", qualifiedName(stack[len(stack)-1].name))
+	}
+	return top.children, nil
+}
+
+func (d *document) String() string {
+	var buf bytes.Buffer
+	for _, token := range d.prolog {
+		writeNode(&buf, token)
+	}
+	writeNode(&buf, d.root)
+	for _, token := range d.epilogue {
+		writeNode(&buf, token)
+	}
+	return buf.String()
+}
+
+func writeNode(buf *bytes.Buffer, node any) {
+	switch n := node.(type) {
+	case *element:
+		buf.WriteString("<" + qualifiedName(n.name))
+		for _, attr := range n.attrs {
+			buf.WriteString(" " + qualifiedName(attr.Name) + `="`)
+			_ = xml.EscapeText(buf, []byte(attr.Value))
+			buf.WriteString(`"`)
+		}
+		if len(n.children) == 0 {
+			buf.WriteString("/>")
+			return
+		}
+		buf.WriteString(">")
+		for _, child := range n.children {
+			writeNode(buf, child)
+		}
+		buf.WriteString("</" + qualifiedName(n.name) + ">")
+	case xml.CharData:
+		_ = xml.EscapeText(buf, n)
+	case xml.Comment:
+		buf.WriteString("<!--" + string(n) + "-->")
+	case xml.ProcInst:
+		buf.WriteString("<?" + n.Target)
+		if len(n.Inst) > 0 {
+			buf.WriteString(" " + string(n.Inst))
+		}
+		buf.WriteString("?>")
+	case xml.Directive:
+		buf.WriteString("<!" + string(n) + ">")
+	}
+}
+
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+func (e *element) attr(name xml.Name) (int, bool) {
+	for idx, attr := range e.attrs {
+		if attr.Name == name {
+			return idx, true
+		}
+	}
+	return 0, false
+}
+
+func (e *element) setAttr(name xml.Name, value string) {
+	if idx, exists := e.attr(name); exists {
+		e.attrs[idx].Value = value
+		return
+	}
+	e.attrs = append(e.attrs, xml.Attr{Name: name, Value: value})
+}
+
+func (e *element) removeAttr(name xml.Name) {
+	if idx, exists := e.attr(name); exists {
+		e.attrs = append(e.attrs[:idx], e.attrs[idx+1:]...)
+	}
+}
+
+func (e *element) childElements() []*element {
+	var elements []*element
+	for _, child := range e.children {
+		if childElement, isElement := child.(*element); isElement {
+			elements = append(elements, childElement)
+		}
+	}
+	return elements
+}
+
+// replaceChild replaces the child with the given nodes, no nodes removes it
+func (e *element) replaceChild(child *element, nodes []any) {
+	for idx, c := range e.children {
+		if c == child {
+			children := append([]any{}, e.children[:idx]...)
+			children = append(children, nodes...)
+			e.children = append(children, e.children[idx+1:]...)
+			return
+		}
+	}
+}
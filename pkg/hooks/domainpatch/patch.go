@@ -0,0 +1,165 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainpatch
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// Select returns the domain patches selecting a VMI with the given labels,
+// in a namespace with the given labels
+func Select(patches []v1.DomainPatch, vmiLabels, namespaceLabels map[string]string) ([]v1.VirtualMachineInstanceDomainPatch, error) {
+	var selected []v1.VirtualMachineInstanceDomainPatch
+	for _, patch := range patches {
+		selector, err := selectorOf(patch.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of domain patch %q: %v", patch.Name, err)
+		}
+		namespaceSelector, err := selectorOf(patch.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace selector of domain patch %q: %v", patch.Name, err)
+		}
+		if selector.Matches(labels.Set(vmiLabels)) && namespaceSelector.Matches(labels.Set(namespaceLabels)) {
+			selected = append(selected, v1.VirtualMachineInstanceDomainPatch{
+				Name:       patch.Name,
+				Operations: patch.Operations,
+			})
+		}
+	}
+	return selected, nil
+}
+
+func selectorOf(labelSelector *metav1.LabelSelector) (labels.Selector, error) {
+	if labelSelector == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(labelSelector)
+}
+
+// ValidateOperation checks an operation is well formed, regardless of the domain it is applied to
+func ValidateOperation(operation v1.DomainPatchOperation) error {
+	p, err := parsePath(operation.Path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q: %v", operation.Path, err)
+	}
+
+	switch operation.Op {
+	case v1.DomainPatchOperationAdd, v1.DomainPatchOperationReplace:
+		if p.attr != nil {
+			return nil
+		}
+		if operation.Op == v1.DomainPatchOperationReplace && len(p.steps) == 1 {
+			return errors.New("the root element cannot be replaced")
+		}
+		nodes, err := parseFragment(operation.Value)
+		if err != nil {
+			return fmt.Errorf("invalid XML fragment: %v", err)
+		}
+		if len(nodes) == 0 {
+			return fmt.Errorf("%s of an element requires an XML fragment value", operation.Op)
+		}
+	case v1.DomainPatchOperationRemove:
+		if p.attr == nil && len(p.steps) == 1 {
+			return errors.New("the root element cannot be removed")
+		}
+		if operation.Value != "" {
+			return errors.New("remove does not take a value")
+		}
+	default:
+		return fmt.Errorf("unsupported operation %q", operation.Op)
+	}
+	return nil
+}
+
+// Apply applies the domain patches, in order, to the domain XML
+func Apply(domainXML string, patches []v1.VirtualMachineInstanceDomainPatch) (string, error) {
+	if len(patches) == 0 {
+		return domainXML, nil
+	}
+
+	doc, err := parseDocument(domainXML)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the domain XML: %v", err)
+	}
+	for _, patch := range patches {
+		for idx, operation := range patch.Operations {
+			if err := applyOperation(doc, operation); err != nil {
+				return "", fmt.Errorf("domain patch %q, operation %d: %v", patch.Name, idx, err)
+			}
+		}
+	}
+	return doc.String(), nil
+}
+
+func applyOperation(doc *document, operation v1.DomainPatchOperation) error {
+	if err := ValidateOperation(operation); err != nil {
+		return err
+	}
+	p, err := parsePath(operation.Path)
+	if err != nil {
+		return err
+	}
+	matches := p.selectElements(doc.root)
+	if len(matches) == 0 {
+		return fmt.Errorf("path %q does not select any element", operation.Path)
+	}
+
+	if p.attr != nil {
+		return applyAttributeOperation(matches, p, operation)
+	}
+
+	for _, m := range matches {
+		var nodes []any
+		if operation.Op != v1.DomainPatchOperationRemove {
+			// Every target gets its own copy of the fragment
+			if nodes, err = parseFragment(operation.Value); err != nil {
+				return err
+			}
+		}
+		switch operation.Op {
+		case v1.DomainPatchOperationAdd:
+			m.element.children = append(m.element.children, nodes...)
+		case v1.DomainPatchOperationReplace, v1.DomainPatchOperationRemove:
+			m.parent.replaceChild(m.element, nodes)
+		}
+	}
+	return nil
+}
+
+func applyAttributeOperation(matches []match, p *path, operation v1.DomainPatchOperation) error {
+	for _, m := range matches {
+		if _, exists := m.element.attr(*p.attr); !exists && operation.Op != v1.DomainPatchOperationAdd {
+			return fmt.Errorf("path %q selects an element without the attribute", operation.Path)
+		}
+		switch operation.Op {
+		case v1.DomainPatchOperationAdd, v1.DomainPatchOperationReplace:
+			m.element.setAttr(*p.attr, operation.Value)
+		case v1.DomainPatchOperationRemove:
+			m.element.removeAttr(*p.attr)
+		}
+	}
+	return nil
+}
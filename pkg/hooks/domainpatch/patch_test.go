@@ -0,0 +1,251 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainpatch_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/hooks/domainpatch"
+)
+
+const domainXML = `<domain type="kvm" xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">` +
+	`<name>default_testvmi</name>` +
+	`<devices>` +
+	`<interface type="ethernet"><model type="virtio"/></interface>` +
+	`<interface type="ethernet"><model type="virtio"/><rom enabled="no"/></interface>` +
+	`<disk type="file" device="disk"><driver name="qemu" type="raw"/><target bus="virtio" dev="vda"/></disk>` +
+	`</devices>` +
+	`</domain>`
+
+var _ = Describe("Domain patches", func() {
+	applyOperations := func(operations ...v1.DomainPatchOperation) (string, error) {
+		return domainpatch.Apply(domainXML, []v1.VirtualMachineInstanceDomainPatch{{
+			Name:       "test",
+			Operations: operations,
+		}})
+	}
+
+	It("should not touch the domain without patches", func() {
+		Expect(domainpatch.Apply(domainXML, nil)).To(Equal(domainXML))
+	})
+
+	DescribeTable("should apply", func(operation v1.DomainPatchOperation, expectedXML string) {
+		Expect(applyOperations(operation)).To(Equal(expectedXML))
+	},
+		Entry("replace of an attribute on every selected element",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationReplace, Path: "/domain/devices/interface/model/@type", Value: "e1000e"},
+			`<domain type="kvm" xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">`+
+				`<name>default_testvmi</name>`+
+				`<devices>`+
+				`<interface type="ethernet"><model type="e1000e"/></interface>`+
+				`<interface type="ethernet"><model type="e1000e"/><rom enabled="no"/></interface>`+
+				`<disk type="file" device="disk"><driver name="qemu" type="raw"/><target bus="virtio" dev="vda"/></disk>`+
+				`</devices>`+
+				`</domain>`,
+		),
+		Entry("add of an attribute on a positional selection",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationAdd, Path: "/domain/devices/interface[1]/model/@queues", Value: "4"},
+			`<domain type="kvm" xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">`+
+				`<name>default_testvmi</name>`+
+				`<devices>`+
+				`<interface type="ethernet"><model type="virtio" queues="4"/></interface>`+
+				`<interface type="ethernet"><model type="virtio"/><rom enabled="no"/></interface>`+
+				`<disk type="file" device="disk"><driver name="qemu" type="raw"/><target bus="virtio" dev="vda"/></disk>`+
+				`</devices>`+
+				`</domain>`,
+		),
+		Entry("remove of an attribute",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/disk[@device='disk']/driver/@type"},
+			`<domain type="kvm" xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">`+
+				`<name>default_testvmi</name>`+
+				`<devices>`+
+				`<interface type="ethernet"><model type="virtio"/></interface>`+
+				`<interface type="ethernet"><model type="virtio"/><rom enabled="no"/></interface>`+
+				`<disk type="file" device="disk"><driver name="qemu"/><target bus="virtio" dev="vda"/></disk>`+
+				`</devices>`+
+				`</domain>`,
+		),
+		Entry("add of a namespaced element",
+			v1.DomainPatchOperation{
+				Op:    v1.DomainPatchOperationAdd,
+				Path:  "/domain",
+				Value: `<qemu:commandline><qemu:arg value="-no-hpet"/></qemu:commandline>`,
+			},
+			`<domain type="kvm" xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">`+
+				`<name>default_testvmi</name>`+
+				`<devices>`+
+				`<interface type="ethernet"><model type="virtio"/></interface>`+
+				`<interface type="ethernet"><model type="virtio"/><rom enabled="no"/></interface>`+
+				`<disk type="file" device="disk"><driver name="qemu" type="raw"/><target bus="virtio" dev="vda"/></disk>`+
+				`</devices>`+
+				`<qemu:commandline><qemu:arg value="-no-hpet"/></qemu:commandline>`+
+				`</domain>`,
+		),
+		Entry("replace of an element",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationReplace, Path: "/domain/devices/interface[2]/rom", Value: `<rom bar="off"/>`},
+			`<domain type="kvm" xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">`+
+				`<name>default_testvmi</name>`+
+				`<devices>`+
+				`<interface type="ethernet"><model type="virtio"/></interface>`+
+				`<interface type="ethernet"><model type="virtio"/><rom bar="off"/></interface>`+
+				`<disk type="file" device="disk"><driver name="qemu" type="raw"/><target bus="virtio" dev="vda"/></disk>`+
+				`</devices>`+
+				`</domain>`,
+		),
+		Entry("remove of an element",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/interface[2]"},
+			`<domain type="kvm" xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">`+
+				`<name>default_testvmi</name>`+
+				`<devices>`+
+				`<interface type="ethernet"><model type="virtio"/></interface>`+
+				`<disk type="file" device="disk"><driver name="qemu" type="raw"/><target bus="virtio" dev="vda"/></disk>`+
+				`</devices>`+
+				`</domain>`,
+		),
+	)
+
+	It("should apply the patches in order", func() {
+		patchedXML, err := domainpatch.Apply(domainXML, []v1.VirtualMachineInstanceDomainPatch{
+			{
+				Name: "first",
+				Operations: []v1.DomainPatchOperation{
+					{Op: v1.DomainPatchOperationAdd, Path: "/domain/devices/interface[1]", Value: `<mtu size="9000"/>`},
+				},
+			},
+			{
+				Name: "second",
+				Operations: []v1.DomainPatchOperation{
+					{Op: v1.DomainPatchOperationReplace, Path: "/domain/devices/interface/mtu/@size", Value: "1400"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patchedXML).To(ContainSubstring(`<interface type="ethernet"><model type="virtio"/><mtu size="1400"/></interface>`))
+	})
+
+	DescribeTable("should fail to apply", func(operation v1.DomainPatchOperation, expectedError string) {
+		_, err := applyOperations(operation)
+		Expect(err).To(MatchError(`domain patch "test", operation 0: ` + expectedError))
+	},
+		Entry("a path selecting no element",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/interface[3]"},
+			`path "/domain/devices/interface[3]" does not select any element`,
+		),
+		Entry("a replace of a missing attribute",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationReplace, Path: "/domain/devices/interface/@trustGuestRxFilters", Value: "yes"},
+			`path "/domain/devices/interface/@trustGuestRxFilters" selects an element without the attribute`,
+		),
+		Entry("an invalid operation",
+			v1.DomainPatchOperation{Op: "move", Path: "/domain/name"},
+			`unsupported operation "move"`,
+		),
+	)
+
+	DescribeTable("should reject the operation", func(operation v1.DomainPatchOperation, expectedError string) {
+		Expect(domainpatch.ValidateOperation(operation)).To(MatchError(expectedError))
+	},
+		Entry("with a relative path",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationRemove, Path: "domain/name"},
+			`invalid path "domain/name": the path must be absolute`,
+		),
+		Entry("with a descendant path",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationRemove, Path: "//interface"},
+			`invalid path "//interface": the path must be absolute`,
+		),
+		Entry("with an attribute step in the middle",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationRemove, Path: "/domain/@type/name"},
+			`invalid path "/domain/@type/name": an attribute can only be selected by the last step`,
+		),
+		Entry("with an unsupported predicate",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/interface[last()]"},
+			`invalid path "/domain/devices/interface[last()]": unsupported predicate [last()]`,
+		),
+		Entry("with an unquoted predicate value",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/interface[@type=bridge]"},
+			`invalid path "/domain/devices/interface[@type=bridge]": the value of predicate [@type=bridge] must be quoted`,
+		),
+		Entry("with an unbalanced predicate",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/interface[@type='bridge'"},
+			`invalid path "/domain/devices/interface[@type='bridge'": unbalanced quotes or brackets`,
+		),
+		Entry("adding an invalid XML fragment",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationAdd, Path: "/domain/devices", Value: "<watchdog>"},
+			`invalid XML fragment: element <watchdog> is not closed`,
+		),
+		Entry("adding an element without a value",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationAdd, Path: "/domain/devices"},
+			`add of an element requires an XML fragment value`,
+		),
+		Entry("removing the root element",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationRemove, Path: "/domain"},
+			`the root element cannot be removed`,
+		),
+		Entry("removing with a value",
+			v1.DomainPatchOperation{Op: v1.DomainPatchOperationRemove, Path: "/domain/name", Value: "name"},
+			`remove does not take a value`,
+		),
+	)
+
+	It("should select the patches matching the VMI labels", func() {
+		patches := []v1.DomainPatch{
+			{
+				Name:       "all",
+				Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/memballoon"}},
+			},
+			{
+				Name:       "gpu",
+				Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"workload": "gpu"}},
+				Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationAdd, Path: "/domain/features/kvm/hidden/@state", Value: "on"}},
+			},
+			{
+				Name:       "database",
+				Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"workload": "database"}},
+				Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/rng"}},
+			},
+		}
+
+		Expect(domainpatch.Select(patches, map[string]string{"workload": "gpu"}, nil)).To(Equal([]v1.VirtualMachineInstanceDomainPatch{
+			{Name: "all", Operations: patches[0].Operations},
+			{Name: "gpu", Operations: patches[1].Operations},
+		}))
+	})
+
+	It("should select the patches matching the namespace labels", func() {
+		patches := []v1.DomainPatch{
+			{
+				Name:              "gpu",
+				Selector:          &metav1.LabelSelector{MatchLabels: map[string]string{"workload": "gpu"}},
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "trusted"}},
+				Operations:        []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationAdd, Path: "/domain/features/kvm/hidden/@state", Value: "on"}},
+			},
+		}
+		vmiLabels := map[string]string{"workload": "gpu"}
+
+		Expect(domainpatch.Select(patches, vmiLabels, map[string]string{"tenant": "trusted"})).To(Equal([]v1.VirtualMachineInstanceDomainPatch{
+			{Name: "gpu", Operations: patches[0].Operations},
+		}))
+		Expect(domainpatch.Select(patches, vmiLabels, map[string]string{"tenant": "other"})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,259 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainpatch
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// path is the XPath subset supported by domain patches: an absolute location
+// path of element names, each filtered by [@attr='value'], [@attr] or
+// [position] predicates, optionally ending with an @attr step.
+type path struct {
+	steps []step
+	attr  *xml.Name
+}
+
+type step struct {
+	name       xml.Name
+	predicates []predicate
+}
+
+type predicate struct {
+	attr  *xml.Name
+	value *string
+	// position is 1-based, it is set on positional predicates only
+	position int
+}
+
+// match is a selected element, along with its parent so it can be replaced or removed
+type match struct {
+	parent  *element
+	element *element
+}
+
+func parsePath(rawPath string) (*path, error) {
+	if !strings.HasPrefix(rawPath, "/") || strings.HasPrefix(rawPath, "//") {
+		return nil, errors.New("the path must be absolute")
+	}
+	rawSteps, err := splitSteps(rawPath[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	p := &path{}
+	for idx, rawStep := range rawSteps {
+		if strings.HasPrefix(rawStep, "@") {
+			if idx != len(rawSteps)-1 {
+				return nil, errors.New("an attribute can only be selected by the last step")
+			}
+			if idx == 0 {
+				return nil, errors.New("the path must select an element before an attribute")
+			}
+			attr, err := parseName(rawStep[1:])
+			if err != nil {
+				return nil, err
+			}
+			p.attr = &attr
+			continue
+		}
+		s, err := parseStep(rawStep)
+		if err != nil {
+			return nil, err
+		}
+		p.steps = append(p.steps, s)
+	}
+	return p, nil
+}
+
+func splitSteps(rawPath string) ([]string, error) {
+	var steps []string
+	var quote rune
+	depth, start := 0, 0
+	for idx, r := range rawPath {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		case r == '/' && depth == 0:
+			steps = append(steps, rawPath[start:idx])
+			start = idx + 1
+		}
+	}
+	if quote != 0 || depth != 0 {
+		return nil, errors.New("unbalanced quotes or brackets")
+	}
+	steps = append(steps, rawPath[start:])
+	for _, s := range steps {
+		if s == "" {
+			return nil, errors.New("the path has an empty step")
+		}
+	}
+	return steps, nil
+}
+
+func parseStep(rawStep string) (step, error) {
+	nameEnd := strings.IndexByte(rawStep, '[')
+	if nameEnd == -1 {
+		nameEnd = len(rawStep)
+	}
+	name, err := parseName(rawStep[:nameEnd])
+	if err != nil {
+		return step{}, err
+	}
+	s := step{name: name}
+
+	rest := rawStep[nameEnd:]
+	for rest != "" {
+		end := predicateEnd(rest)
+		if !strings.HasPrefix(rest, "[") || end == -1 {
+			return step{}, fmt.Errorf("invalid predicate in step %q", rawStep)
+		}
+		pred, err := parsePredicate(rest[1:end])
+		if err != nil {
+			return step{}, err
+		}
+		s.predicates = append(s.predicates, pred)
+		rest = rest[end+1:]
+	}
+	return s, nil
+}
+
+// predicateEnd returns the index of the bracket closing the predicate the input starts with
+func predicateEnd(input string) int {
+	var quote rune
+	for idx, r := range input {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == ']':
+			return idx
+		}
+	}
+	return -1
+}
+
+func parsePredicate(rawPredicate string) (predicate, error) {
+	if !strings.HasPrefix(rawPredicate, "@") {
+		position, err := strconv.Atoi(rawPredicate)
+		if err != nil || position < 1 {
+			return predicate{}, fmt.Errorf("unsupported predicate [%s]", rawPredicate)
+		}
+		return predicate{position: position}, nil
+	}
+
+	rawName, rawValue, hasValue := strings.Cut(rawPredicate[1:], "=")
+	attr, err := parseName(rawName)
+	if err != nil {
+		return predicate{}, err
+	}
+	if !hasValue {
+		return predicate{attr: &attr}, nil
+	}
+	if len(rawValue) < 2 || (rawValue[0] != '\'' && rawValue[0] != '"') || rawValue[len(rawValue)-1] != rawValue[0] {
+		return predicate{}, fmt.Errorf("the value of predicate [%s] must be quoted", rawPredicate)
+	}
+	value := rawValue[1 : len(rawValue)-1]
+	return predicate{attr: &attr, value: &value}, nil
+}
+
+func parseName(rawName string) (xml.Name, error) {
+	prefix, local, hasPrefix := strings.Cut(rawName, ":")
+	if !hasPrefix {
+		prefix, local = "", rawName
+	}
+	if !isNCName(local) || (hasPrefix && !isNCName(prefix)) {
+		return xml.Name{}, fmt.Errorf("invalid name %q", rawName)
+	}
+	return xml.Name{Space: prefix, Local: local}, nil
+}
+
+func isNCName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for idx, r := range name {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_'
+		if idx == 0 && !isLetter {
+			return false
+		}
+		if !isLetter && !(r >= '0' && r <= '9') && r != '-' && r != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *path) selectElements(root *element) []match {
+	if root.name != p.steps[0].name {
+		return nil
+	}
+	matches := p.steps[0].filter([]match{{element: root}})
+	for _, s := range p.steps[1:] {
+		var next []match
+		for _, m := range matches {
+			var candidates []match
+			for _, child := range m.element.childElements() {
+				if child.name == s.name {
+					candidates = append(candidates, match{parent: m.element, element: child})
+				}
+			}
+			next = append(next, s.filter(candidates)...)
+		}
+		matches = next
+	}
+	return matches
+}
+
+func (s step) filter(candidates []match) []match {
+	for _, pred := range s.predicates {
+		if pred.position > 0 {
+			if pred.position > len(candidates) {
+				return nil
+			}
+			candidates = []match{candidates[pred.position-1]}
+			continue
+		}
+		var filtered []match
+		for _, candidate := range candidates {
+			idx, exists := candidate.element.attr(*pred.attr)
+			if exists && (pred.value == nil || candidate.element.attrs[idx].Value == *pred.value) {
+				filtered = append(filtered, candidate)
+			}
+		}
+		candidates = filtered
+	}
+	return candidates
+}
@@ -73,6 +73,76 @@ func (mr *MockManagerMockRecorder) OnDefineDomain(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnDefineDomain", reflect.TypeOf((*MockManager)(nil).OnDefineDomain), arg0, arg1)
 }
 
+// OnHotplug mocks base method.
+func (m *MockManager) OnHotplug(arg0 *v1.VirtualMachineInstance, arg1 HotplugEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnHotplug", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnHotplug indicates an expected call of OnHotplug.
+func (mr *MockManagerMockRecorder) OnHotplug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnHotplug", reflect.TypeOf((*MockManager)(nil).OnHotplug), arg0, arg1)
+}
+
+// OnPause mocks base method.
+func (m *MockManager) OnPause(arg0 *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnPause", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnPause indicates an expected call of OnPause.
+func (mr *MockManagerMockRecorder) OnPause(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnPause", reflect.TypeOf((*MockManager)(nil).OnPause), arg0)
+}
+
+// OnUnpause mocks base method.
+func (m *MockManager) OnUnpause(arg0 *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnUnpause", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnUnpause indicates an expected call of OnUnpause.
+func (mr *MockManagerMockRecorder) OnUnpause(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUnpause", reflect.TypeOf((*MockManager)(nil).OnUnpause), arg0)
+}
+
+// PostMigration mocks base method.
+func (m *MockManager) PostMigration(arg0 *v1.VirtualMachineInstance, arg1 MigrationRole, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostMigration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostMigration indicates an expected call of PostMigration.
+func (mr *MockManagerMockRecorder) PostMigration(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostMigration", reflect.TypeOf((*MockManager)(nil).PostMigration), arg0, arg1, arg2)
+}
+
+// PostStart mocks base method.
+func (m *MockManager) PostStart(arg0 *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostStart", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostStart indicates an expected call of PostStart.
+func (mr *MockManagerMockRecorder) PostStart(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostStart", reflect.TypeOf((*MockManager)(nil).PostStart), arg0)
+}
+
 // PreCloudInitIso mocks base method.
 func (m *MockManager) PreCloudInitIso(arg0 *v1.VirtualMachineInstance, arg1 *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +158,20 @@ func (mr *MockManagerMockRecorder) PreCloudInitIso(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreCloudInitIso", reflect.TypeOf((*MockManager)(nil).PreCloudInitIso), arg0, arg1)
 }
 
+// PreMigration mocks base method.
+func (m *MockManager) PreMigration(arg0 *v1.VirtualMachineInstance, arg1 MigrationRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreMigration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PreMigration indicates an expected call of PreMigration.
+func (mr *MockManagerMockRecorder) PreMigration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreMigration", reflect.TypeOf((*MockManager)(nil).PreMigration), arg0, arg1)
+}
+
 // Shutdown mocks base method.
 func (m *MockManager) Shutdown() error {
 	m.ctrl.T.Helper()
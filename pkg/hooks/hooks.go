@@ -32,6 +32,36 @@ const HookSocketsSharedDirectory = "/var/run/kubevirt-hooks"
 
 const ContainerNameEnvVar = "CONTAINER_NAME"
 
+// MigrationRole is the side of a live migration a hook is called on
+type MigrationRole string
+
+const (
+	MigrationRoleSource MigrationRole = "source"
+	MigrationRoleTarget MigrationRole = "target"
+)
+
+type HotplugDeviceType string
+
+const (
+	HotplugDeviceDisk      HotplugDeviceType = "disk"
+	HotplugDeviceInterface HotplugDeviceType = "interface"
+)
+
+type HotplugAction string
+
+const (
+	HotplugActionPlug   HotplugAction = "plug"
+	HotplugActionUnplug HotplugAction = "unplug"
+)
+
+// HotplugEvent describes a device attached to or detached from a running domain
+type HotplugEvent struct {
+	DeviceType HotplugDeviceType
+	// DeviceName is the name of the volume or network backing the device
+	DeviceName string
+	Action     HotplugAction
+}
+
 type HookSidecarList []HookSidecar
 
 type ConfigMap struct {
@@ -22,3 +22,11 @@ package info
 const OnDefineDomainHookPointName = "OnDefineDomain"
 const PreCloudInitIsoHookPointName = "PreCloudInitIso"
 const ShutdownHookPointName = "Shutdown"
+
+// Hook points introduced with v1alpha4, the hook is notified and cannot alter the VMI
+const PostStartHookPointName = "PostStart"
+const OnPauseHookPointName = "OnPause"
+const OnUnpauseHookPointName = "OnUnpause"
+const PreMigrationHookPointName = "PreMigration"
+const PostMigrationHookPointName = "PostMigration"
+const OnHotplugHookPointName = "OnHotplug"
@@ -39,6 +39,7 @@ import (
 	hooksV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/v1alpha1"
 	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -47,6 +48,11 @@ import (
 
 const dialSockErr = "Failed to Dial hook socket: %s"
 
+const lifecycleHookTimeout = time.Minute
+
+// preMigrationHookTimeout bounds the call of each sidecar, as the migration waits for the PreMigration hook.
+var preMigrationHookTimeout = 10 * time.Second
+
 type callBackClient struct {
 	SocketPath           string
 	Version              string
@@ -62,6 +68,12 @@ type (
 		OnDefineDomain(*virtwrapApi.DomainSpec, *v1.VirtualMachineInstance) (string, error)
 		PreCloudInitIso(*v1.VirtualMachineInstance, *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error)
 		Shutdown() error
+		PostStart(*v1.VirtualMachineInstance) error
+		OnPause(*v1.VirtualMachineInstance) error
+		OnUnpause(*v1.VirtualMachineInstance) error
+		PreMigration(*v1.VirtualMachineInstance, MigrationRole) error
+		PostMigration(*v1.VirtualMachineInstance, MigrationRole, bool) error
+		OnHotplug(*v1.VirtualMachineInstance, HotplugEvent) error
 	}
 	hookManager struct {
 		CallbacksPerHookPoint     map[string][]*callBackClient
@@ -192,6 +204,7 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 
 	// The order matters. We should match newer versions first.
 	supportedVersions := []string{
+		hooksV1alpha4.Version,
 		hooksV1alpha3.Version,
 		hooksV1alpha2.Version,
 		hooksV1alpha1.Version,
@@ -297,6 +310,17 @@ func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpe
 			return nil, err
 		}
 		domainSpecXML = result.GetDomainXML()
+	case hooksV1alpha4.Version:
+		client := hooksV1alpha4.NewCallbacksClient(conn)
+		result, err := client.OnDefineDomain(ctx, &hooksV1alpha4.OnDefineDomainParams{
+			DomainXML: domainSpecXML,
+			Vmi:       vmiJSON,
+		})
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to call OnDefineDomain")
+			return nil, err
+		}
+		domainSpecXML = result.GetDomainXML()
 	default:
 		log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 	}
@@ -412,6 +436,28 @@ func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitD
 				return cloudInitData, err
 			}
 			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
+		case hooksV1alpha4.Version:
+			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+			if err != nil {
+				log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+				return cloudInitData, err
+			}
+			defer conn.Close()
+
+			client := hooksV1alpha4.NewCallbacksClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			result, err := client.PreCloudInitIso(ctx, &hooksV1alpha4.PreCloudInitIsoParams{
+				CloudInitData:          cloudInitDataJSON,
+				CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
+				Vmi:                    vmiJSON,
+			})
+			if err != nil {
+				log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
+				return cloudInitData, err
+			}
+			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
 		default:
 			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 		}
@@ -442,9 +488,124 @@ func (m *hookManager) Shutdown() error {
 				log.Log.Reason(err).Error("Failed to run Shutdown")
 				return err
 			}
+		case hooksV1alpha4.Version:
+			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+			if err != nil {
+				log.Log.Reason(err).Error("Failed to run Shutdown")
+				return err
+			}
+			defer conn.Close()
+
+			client := hooksV1alpha4.NewCallbacksClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			if _, err := client.Shutdown(ctx, &hooksV1alpha4.ShutdownParams{}); err != nil {
+				log.Log.Reason(err).Error("Failed to run Shutdown")
+				return err
+			}
 		default:
 			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 		}
 	}
 	return nil
 }
+
+func (m *hookManager) PostStart(vmi *v1.VirtualMachineInstance) error {
+	return m.notify(hooksInfo.PostStartHookPointName, vmi, lifecycleHookTimeout, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.PostStart(ctx, &hooksV1alpha4.LifecycleParams{Vmi: vmiJSON})
+		return err
+	})
+}
+
+func (m *hookManager) OnPause(vmi *v1.VirtualMachineInstance) error {
+	return m.notify(hooksInfo.OnPauseHookPointName, vmi, lifecycleHookTimeout, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.OnPause(ctx, &hooksV1alpha4.LifecycleParams{Vmi: vmiJSON})
+		return err
+	})
+}
+
+func (m *hookManager) OnUnpause(vmi *v1.VirtualMachineInstance) error {
+	return m.notify(hooksInfo.OnUnpauseHookPointName, vmi, lifecycleHookTimeout, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.OnUnpause(ctx, &hooksV1alpha4.LifecycleParams{Vmi: vmiJSON})
+		return err
+	})
+}
+
+func (m *hookManager) PreMigration(vmi *v1.VirtualMachineInstance, role MigrationRole) error {
+	return m.notify(hooksInfo.PreMigrationHookPointName, vmi, preMigrationHookTimeout, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.PreMigration(ctx, &hooksV1alpha4.MigrationParams{
+			Vmi:  vmiJSON,
+			Role: string(role),
+		})
+		return err
+	})
+}
+
+func (m *hookManager) PostMigration(vmi *v1.VirtualMachineInstance, role MigrationRole, succeeded bool) error {
+	return m.notify(hooksInfo.PostMigrationHookPointName, vmi, lifecycleHookTimeout, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.PostMigration(ctx, &hooksV1alpha4.MigrationParams{
+			Vmi:       vmiJSON,
+			Role:      string(role),
+			Succeeded: succeeded,
+		})
+		return err
+	})
+}
+
+func (m *hookManager) OnHotplug(vmi *v1.VirtualMachineInstance, event HotplugEvent) error {
+	return m.notify(hooksInfo.OnHotplugHookPointName, vmi, lifecycleHookTimeout, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.OnHotplug(ctx, &hooksV1alpha4.HotplugParams{
+			Vmi:        vmiJSON,
+			DeviceType: string(event.DeviceType),
+			DeviceName: event.DeviceName,
+			Action:     string(event.Action),
+		})
+		return err
+	})
+}
+
+type notifyFunc func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error
+
+// notify calls the sidecars subscribed to a lifecycle hook point, each call is bounded by the timeout.
+// These hook points exist since v1alpha4, sidecars exposing an older version are skipped.
+func (m *hookManager) notify(hookPointName string, vmi *v1.VirtualMachineInstance, timeout time.Duration, call notifyFunc) error {
+	callbacks, found := m.CallbacksPerHookPoint[hookPointName]
+	if !found {
+		return nil
+	}
+
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	for _, callback := range callbacks {
+		if callback.Version != hooksV1alpha4.Version {
+			log.Log.Errorf("Unsupported callback version for %s: %s", hookPointName, callback.Version)
+			continue
+		}
+		if err := notifyCallback(callback, hookPointName, vmiJSON, timeout, call); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func notifyCallback(callback *callBackClient, hookPointName string, vmiJSON []byte, timeout time.Duration, call notifyFunc) error {
+	conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+	if err != nil {
+		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := call(ctx, hooksV1alpha4.NewCallbacksClient(conn), vmiJSON); err != nil {
+		log.Log.Reason(err).Errorf("Failed to call %s", hookPointName)
+		return err
+	}
+	return nil
+}
@@ -42,6 +42,7 @@ import (
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
 	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -99,10 +100,64 @@ func (s *callbackServer) Shutdown(
 	return &hooksV1alpha3.ShutdownResult{}, nil
 }
 
+// lifecycleCallbackServer records the v1alpha4 lifecycle calls
+type lifecycleCallbackServer struct {
+	calls []string
+	// hangPreMigration makes PreMigration wait until the caller gives up
+	hangPreMigration bool
+}
+
+func (s *lifecycleCallbackServer) OnDefineDomain(_ context.Context, params *hooksV1alpha4.OnDefineDomainParams) (*hooksV1alpha4.OnDefineDomainResult, error) {
+	return &hooksV1alpha4.OnDefineDomainResult{DomainXML: params.GetDomainXML()}, nil
+}
+
+func (s *lifecycleCallbackServer) PreCloudInitIso(_ context.Context, params *hooksV1alpha4.PreCloudInitIsoParams) (*hooksV1alpha4.PreCloudInitIsoResult, error) {
+	return &hooksV1alpha4.PreCloudInitIsoResult{CloudInitData: params.GetCloudInitData()}, nil
+}
+
+func (s *lifecycleCallbackServer) Shutdown(_ context.Context, _ *hooksV1alpha4.ShutdownParams) (*hooksV1alpha4.ShutdownResult, error) {
+	return &hooksV1alpha4.ShutdownResult{}, nil
+}
+
+func (s *lifecycleCallbackServer) PostStart(_ context.Context, _ *hooksV1alpha4.LifecycleParams) (*hooksV1alpha4.LifecycleResult, error) {
+	s.calls = append(s.calls, "PostStart")
+	return &hooksV1alpha4.LifecycleResult{}, nil
+}
+
+func (s *lifecycleCallbackServer) OnPause(_ context.Context, _ *hooksV1alpha4.LifecycleParams) (*hooksV1alpha4.LifecycleResult, error) {
+	s.calls = append(s.calls, "OnPause")
+	return &hooksV1alpha4.LifecycleResult{}, nil
+}
+
+func (s *lifecycleCallbackServer) OnUnpause(_ context.Context, _ *hooksV1alpha4.LifecycleParams) (*hooksV1alpha4.LifecycleResult, error) {
+	s.calls = append(s.calls, "OnUnpause")
+	return &hooksV1alpha4.LifecycleResult{}, nil
+}
+
+func (s *lifecycleCallbackServer) PreMigration(ctx context.Context, params *hooksV1alpha4.MigrationParams) (*hooksV1alpha4.MigrationResult, error) {
+	s.calls = append(s.calls, fmt.Sprintf("PreMigration role=%s", params.GetRole()))
+	if s.hangPreMigration {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return &hooksV1alpha4.MigrationResult{}, nil
+}
+
+func (s *lifecycleCallbackServer) PostMigration(_ context.Context, params *hooksV1alpha4.MigrationParams) (*hooksV1alpha4.MigrationResult, error) {
+	s.calls = append(s.calls, fmt.Sprintf("PostMigration role=%s succeeded=%t", params.GetRole(), params.GetSucceeded()))
+	return &hooksV1alpha4.MigrationResult{}, nil
+}
+
+func (s *lifecycleCallbackServer) OnHotplug(_ context.Context, params *hooksV1alpha4.HotplugParams) (*hooksV1alpha4.HotplugResult, error) {
+	s.calls = append(s.calls, fmt.Sprintf("OnHotplug %s %s %s", params.GetAction(), params.GetDeviceType(), params.GetDeviceName()))
+	return &hooksV1alpha4.HotplugResult{}, nil
+}
+
 type testCase struct {
-	socketPath string
-	info       infoServer
-	callback   callbackServer
+	socketPath        string
+	info              infoServer
+	callback          callbackServer
+	lifecycleCallback lifecycleCallbackServer
 
 	// error from the Run(), will be read on Stop()
 	errch  chan error
@@ -147,6 +202,7 @@ func (t *testCase) Run() {
 
 		hooksInfo.RegisterInfoServer(server, &t.info)
 		hooksV1alpha3.RegisterCallbacksServer(server, &t.callback)
+		hooksV1alpha4.RegisterCallbacksServer(server, &t.lifecycleCallback)
 
 		GinkgoWriter.Printf("Starting hook server exposing 'info' services on socket %s\n", t.socketPath)
 		grpcDone <- server.Serve(socket)
@@ -329,6 +385,85 @@ var _ = Describe("HooksManager", func() {
 			})
 		})
 
+		Context("on calling the lifecycle methods", func() {
+			lifecycleHookPoints := []*hooksInfo.HookPoint{
+				{Name: hooksInfo.PostStartHookPointName},
+				{Name: hooksInfo.OnPauseHookPointName},
+				{Name: hooksInfo.OnUnpauseHookPointName},
+				{Name: hooksInfo.PreMigrationHookPointName},
+				{Name: hooksInfo.PostMigrationHookPointName},
+				{Name: hooksInfo.OnHotplugHookPointName},
+			}
+
+			callLifecycleMethods := func(manager Manager) {
+				vmi := &v1.VirtualMachineInstance{}
+				Expect(manager.PostStart(vmi)).To(Succeed())
+				Expect(manager.OnPause(vmi)).To(Succeed())
+				Expect(manager.OnUnpause(vmi)).To(Succeed())
+				Expect(manager.PreMigration(vmi, MigrationRoleTarget)).To(Succeed())
+				Expect(manager.PostMigration(vmi, MigrationRoleSource, false)).To(Succeed())
+				Expect(manager.OnHotplug(vmi, HotplugEvent{
+					DeviceType: HotplugDeviceDisk,
+					DeviceName: "hotplug-volume",
+					Action:     HotplugActionPlug,
+				})).To(Succeed())
+			}
+
+			It("should notify v1alpha4 sidecars", func() {
+				t := newTestCase(socketDir, "hook1")
+				t.info.Versions = []string{hooksV1alpha4.Version}
+				t.info.HookPoints = lifecycleHookPoints
+				t.Run()
+
+				manager := newManager(socketDir)
+				Expect(manager.Collect(1, collectTimeout)).To(Succeed())
+
+				callLifecycleMethods(manager)
+				Expect(t.lifecycleCallback.calls).To(Equal([]string{
+					"PostStart",
+					"OnPause",
+					"OnUnpause",
+					"PreMigration role=target",
+					"PostMigration role=source succeeded=false",
+					"OnHotplug plug disk hotplug-volume",
+				}))
+				Expect(t.Stop()).To(Succeed())
+			})
+
+			It("should bound the time PreMigration waits for a sidecar", func() {
+				preMigrationHookTimeout = 100 * time.Millisecond
+				DeferCleanup(func() {
+					preMigrationHookTimeout = 10 * time.Second
+				})
+				t := newTestCase(socketDir, "hook1")
+				t.info.Versions = []string{hooksV1alpha4.Version}
+				t.info.HookPoints = lifecycleHookPoints
+				t.lifecycleCallback.hangPreMigration = true
+				t.Run()
+
+				manager := newManager(socketDir)
+				Expect(manager.Collect(1, collectTimeout)).To(Succeed())
+
+				start := time.Now()
+				Expect(manager.PreMigration(&v1.VirtualMachineInstance{}, MigrationRoleSource)).NotTo(Succeed())
+				Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+				Expect(t.Stop()).To(Succeed())
+			})
+
+			It("should skip sidecars exposing an older version", func() {
+				t := newTestCase(socketDir, "hook1")
+				t.info.HookPoints = lifecycleHookPoints
+				t.Run()
+
+				manager := newManager(socketDir)
+				Expect(manager.Collect(1, collectTimeout)).To(Succeed())
+
+				callLifecycleMethods(manager)
+				Expect(t.lifecycleCallback.calls).To(BeEmpty())
+				Expect(t.Stop()).To(Succeed())
+			})
+		})
+
 		AfterEach(func() {
 			os.RemoveAll(socketDir)
 		})
@@ -0,0 +1,680 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api_v1alpha4.proto
+
+/*
+Package v1alpha4 is a generated protocol buffer package.
+
+It is generated from these files:
+
+	api_v1alpha4.proto
+
+It has these top-level messages:
+
+	OnDefineDomainParams
+	OnDefineDomainResult
+	PreCloudInitIsoParams
+	PreCloudInitIsoResult
+	ShutdownParams
+	ShutdownResult
+	LifecycleParams
+	LifecycleResult
+	MigrationParams
+	MigrationResult
+	HotplugParams
+	HotplugResult
+*/
+package v1alpha4
+
+import (
+	fmt "fmt"
+
+	proto "github.com/golang/protobuf/proto"
+
+	math "math"
+
+	context "golang.org/x/net/context"
+
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type OnDefineDomainParams struct {
+	// domainXML is original libvirt domain specification
+	DomainXML []byte `protobuf:"bytes,1,opt,name=domainXML,proto3" json:"domainXML,omitempty"`
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,2,opt,name=vmi,proto3" json:"vmi,omitempty"`
+}
+
+func (m *OnDefineDomainParams) Reset()                    { *m = OnDefineDomainParams{} }
+func (m *OnDefineDomainParams) String() string            { return proto.CompactTextString(m) }
+func (*OnDefineDomainParams) ProtoMessage()               {}
+func (*OnDefineDomainParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *OnDefineDomainParams) GetDomainXML() []byte {
+	if m != nil {
+		return m.DomainXML
+	}
+	return nil
+}
+
+func (m *OnDefineDomainParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+type OnDefineDomainResult struct {
+	// domainXML is processed libvirt domain specification
+	DomainXML []byte `protobuf:"bytes,1,opt,name=domainXML,proto3" json:"domainXML,omitempty"`
+}
+
+func (m *OnDefineDomainResult) Reset()                    { *m = OnDefineDomainResult{} }
+func (m *OnDefineDomainResult) String() string            { return proto.CompactTextString(m) }
+func (*OnDefineDomainResult) ProtoMessage()               {}
+func (*OnDefineDomainResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *OnDefineDomainResult) GetDomainXML() []byte {
+	if m != nil {
+		return m.DomainXML
+	}
+	return nil
+}
+
+type PreCloudInitIsoParams struct {
+	// cloudInitNoCloudSource is an object of CloudInitNoCloudSource encoded as JSON
+	// This is a legacy field to ensure backwards compatibility. New code should use cloudInitData instead.
+	CloudInitNoCloudSource []byte `protobuf:"bytes,1,opt,name=cloudInitNoCloudSource,proto3" json:"cloudInitNoCloudSource,omitempty"`
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,2,opt,name=vmi,proto3" json:"vmi,omitempty"`
+	// cloudInitData is an object of CloudInitData encoded as JSON
+	CloudInitData []byte `protobuf:"bytes,3,opt,name=cloudInitData,proto3" json:"cloudInitData,omitempty"`
+}
+
+func (m *PreCloudInitIsoParams) Reset()                    { *m = PreCloudInitIsoParams{} }
+func (m *PreCloudInitIsoParams) String() string            { return proto.CompactTextString(m) }
+func (*PreCloudInitIsoParams) ProtoMessage()               {}
+func (*PreCloudInitIsoParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *PreCloudInitIsoParams) GetCloudInitNoCloudSource() []byte {
+	if m != nil {
+		return m.CloudInitNoCloudSource
+	}
+	return nil
+}
+
+func (m *PreCloudInitIsoParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *PreCloudInitIsoParams) GetCloudInitData() []byte {
+	if m != nil {
+		return m.CloudInitData
+	}
+	return nil
+}
+
+type PreCloudInitIsoResult struct {
+	// cloudInitNoCloudSource is an object of CloudInitNoCloudSource encoded as JSON
+	// This is a legacy field to ensure backwards compatibility. New code should use cloudInitData instead.
+	CloudInitNoCloudSource []byte `protobuf:"bytes,1,opt,name=cloudInitNoCloudSource,proto3" json:"cloudInitNoCloudSource,omitempty"`
+	// cloudInitData is an object of CloudInitData encoded as JSON
+	CloudInitData []byte `protobuf:"bytes,3,opt,name=cloudInitData,proto3" json:"cloudInitData,omitempty"`
+}
+
+func (m *PreCloudInitIsoResult) Reset()                    { *m = PreCloudInitIsoResult{} }
+func (m *PreCloudInitIsoResult) String() string            { return proto.CompactTextString(m) }
+func (*PreCloudInitIsoResult) ProtoMessage()               {}
+func (*PreCloudInitIsoResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *PreCloudInitIsoResult) GetCloudInitNoCloudSource() []byte {
+	if m != nil {
+		return m.CloudInitNoCloudSource
+	}
+	return nil
+}
+
+func (m *PreCloudInitIsoResult) GetCloudInitData() []byte {
+	if m != nil {
+		return m.CloudInitData
+	}
+	return nil
+}
+
+type ShutdownParams struct {
+}
+
+func (m *ShutdownParams) Reset()                    { *m = ShutdownParams{} }
+func (m *ShutdownParams) String() string            { return proto.CompactTextString(m) }
+func (*ShutdownParams) ProtoMessage()               {}
+func (*ShutdownParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+type ShutdownResult struct {
+}
+
+func (m *ShutdownResult) Reset()                    { *m = ShutdownResult{} }
+func (m *ShutdownResult) String() string            { return proto.CompactTextString(m) }
+func (*ShutdownResult) ProtoMessage()               {}
+func (*ShutdownResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+type LifecycleParams struct {
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,1,opt,name=vmi,proto3" json:"vmi,omitempty"`
+}
+
+func (m *LifecycleParams) Reset()                    { *m = LifecycleParams{} }
+func (m *LifecycleParams) String() string            { return proto.CompactTextString(m) }
+func (*LifecycleParams) ProtoMessage()               {}
+func (*LifecycleParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *LifecycleParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+type LifecycleResult struct {
+}
+
+func (m *LifecycleResult) Reset()                    { *m = LifecycleResult{} }
+func (m *LifecycleResult) String() string            { return proto.CompactTextString(m) }
+func (*LifecycleResult) ProtoMessage()               {}
+func (*LifecycleResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+type MigrationParams struct {
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,1,opt,name=vmi,proto3" json:"vmi,omitempty"`
+	// role is the side of the migration the hook is called on, either "source" or "target"
+	Role string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+	// succeeded reports whether the migration completed, it is only set on PostMigration
+	Succeeded bool `protobuf:"varint,3,opt,name=succeeded" json:"succeeded,omitempty"`
+}
+
+func (m *MigrationParams) Reset()                    { *m = MigrationParams{} }
+func (m *MigrationParams) String() string            { return proto.CompactTextString(m) }
+func (*MigrationParams) ProtoMessage()               {}
+func (*MigrationParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *MigrationParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *MigrationParams) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *MigrationParams) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
+	}
+	return false
+}
+
+type MigrationResult struct {
+}
+
+func (m *MigrationResult) Reset()                    { *m = MigrationResult{} }
+func (m *MigrationResult) String() string            { return proto.CompactTextString(m) }
+func (*MigrationResult) ProtoMessage()               {}
+func (*MigrationResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+type HotplugParams struct {
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,1,opt,name=vmi,proto3" json:"vmi,omitempty"`
+	// deviceType is the type of the hotplugged device, either "disk" or "interface"
+	DeviceType string `protobuf:"bytes,2,opt,name=deviceType" json:"deviceType,omitempty"`
+	// deviceName is the name of the volume or network backing the device
+	DeviceName string `protobuf:"bytes,3,opt,name=deviceName" json:"deviceName,omitempty"`
+	// action is either "plug" or "unplug"
+	Action string `protobuf:"bytes,4,opt,name=action" json:"action,omitempty"`
+}
+
+func (m *HotplugParams) Reset()                    { *m = HotplugParams{} }
+func (m *HotplugParams) String() string            { return proto.CompactTextString(m) }
+func (*HotplugParams) ProtoMessage()               {}
+func (*HotplugParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *HotplugParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *HotplugParams) GetDeviceType() string {
+	if m != nil {
+		return m.DeviceType
+	}
+	return ""
+}
+
+func (m *HotplugParams) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *HotplugParams) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type HotplugResult struct {
+}
+
+func (m *HotplugResult) Reset()                    { *m = HotplugResult{} }
+func (m *HotplugResult) String() string            { return proto.CompactTextString(m) }
+func (*HotplugResult) ProtoMessage()               {}
+func (*HotplugResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func init() {
+	proto.RegisterType((*OnDefineDomainParams)(nil), "kubevirt.hooks.v1alpha4.OnDefineDomainParams")
+	proto.RegisterType((*OnDefineDomainResult)(nil), "kubevirt.hooks.v1alpha4.OnDefineDomainResult")
+	proto.RegisterType((*PreCloudInitIsoParams)(nil), "kubevirt.hooks.v1alpha4.PreCloudInitIsoParams")
+	proto.RegisterType((*PreCloudInitIsoResult)(nil), "kubevirt.hooks.v1alpha4.PreCloudInitIsoResult")
+	proto.RegisterType((*ShutdownParams)(nil), "kubevirt.hooks.v1alpha4.ShutdownParams")
+	proto.RegisterType((*ShutdownResult)(nil), "kubevirt.hooks.v1alpha4.ShutdownResult")
+	proto.RegisterType((*LifecycleParams)(nil), "kubevirt.hooks.v1alpha4.LifecycleParams")
+	proto.RegisterType((*LifecycleResult)(nil), "kubevirt.hooks.v1alpha4.LifecycleResult")
+	proto.RegisterType((*MigrationParams)(nil), "kubevirt.hooks.v1alpha4.MigrationParams")
+	proto.RegisterType((*MigrationResult)(nil), "kubevirt.hooks.v1alpha4.MigrationResult")
+	proto.RegisterType((*HotplugParams)(nil), "kubevirt.hooks.v1alpha4.HotplugParams")
+	proto.RegisterType((*HotplugResult)(nil), "kubevirt.hooks.v1alpha4.HotplugResult")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Callbacks service
+
+type CallbacksClient interface {
+	OnDefineDomain(ctx context.Context, in *OnDefineDomainParams, opts ...grpc.CallOption) (*OnDefineDomainResult, error)
+	PreCloudInitIso(ctx context.Context, in *PreCloudInitIsoParams, opts ...grpc.CallOption) (*PreCloudInitIsoResult, error)
+	Shutdown(ctx context.Context, in *ShutdownParams, opts ...grpc.CallOption) (*ShutdownResult, error)
+	PostStart(ctx context.Context, in *LifecycleParams, opts ...grpc.CallOption) (*LifecycleResult, error)
+	OnPause(ctx context.Context, in *LifecycleParams, opts ...grpc.CallOption) (*LifecycleResult, error)
+	OnUnpause(ctx context.Context, in *LifecycleParams, opts ...grpc.CallOption) (*LifecycleResult, error)
+	PreMigration(ctx context.Context, in *MigrationParams, opts ...grpc.CallOption) (*MigrationResult, error)
+	PostMigration(ctx context.Context, in *MigrationParams, opts ...grpc.CallOption) (*MigrationResult, error)
+	OnHotplug(ctx context.Context, in *HotplugParams, opts ...grpc.CallOption) (*HotplugResult, error)
+}
+
+type callbacksClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCallbacksClient(cc *grpc.ClientConn) CallbacksClient {
+	return &callbacksClient{cc}
+}
+
+func (c *callbacksClient) OnDefineDomain(ctx context.Context, in *OnDefineDomainParams, opts ...grpc.CallOption) (*OnDefineDomainResult, error) {
+	out := new(OnDefineDomainResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha4.Callbacks/OnDefineDomain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbacksClient) PreCloudInitIso(ctx context.Context, in *PreCloudInitIsoParams, opts ...grpc.CallOption) (*PreCloudInitIsoResult, error) {
+	out := new(PreCloudInitIsoResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha4.Callbacks/PreCloudInitIso", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbacksClient) Shutdown(ctx context.Context, in *ShutdownParams, opts ...grpc.CallOption) (*ShutdownResult, error) {
+	out := new(ShutdownResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha4.Callbacks/Shutdown", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbacksClient) PostStart(ctx context.Context, in *LifecycleParams, opts ...grpc.CallOption) (*LifecycleResult, error) {
+	out := new(LifecycleResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha4.Callbacks/PostStart", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbacksClient) OnPause(ctx context.Context, in *LifecycleParams, opts ...grpc.CallOption) (*LifecycleResult, error) {
+	out := new(LifecycleResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha4.Callbacks/OnPause", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbacksClient) OnUnpause(ctx context.Context, in *LifecycleParams, opts ...grpc.CallOption) (*LifecycleResult, error) {
+	out := new(LifecycleResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha4.Callbacks/OnUnpause", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbacksClient) PreMigration(ctx context.Context, in *MigrationParams, opts ...grpc.CallOption) (*MigrationResult, error) {
+	out := new(MigrationResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha4.Callbacks/PreMigration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbacksClient) PostMigration(ctx context.Context, in *MigrationParams, opts ...grpc.CallOption) (*MigrationResult, error) {
+	out := new(MigrationResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha4.Callbacks/PostMigration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbacksClient) OnHotplug(ctx context.Context, in *HotplugParams, opts ...grpc.CallOption) (*HotplugResult, error) {
+	out := new(HotplugResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.v1alpha4.Callbacks/OnHotplug", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Callbacks service
+
+type CallbacksServer interface {
+	OnDefineDomain(context.Context, *OnDefineDomainParams) (*OnDefineDomainResult, error)
+	PreCloudInitIso(context.Context, *PreCloudInitIsoParams) (*PreCloudInitIsoResult, error)
+	Shutdown(context.Context, *ShutdownParams) (*ShutdownResult, error)
+	PostStart(context.Context, *LifecycleParams) (*LifecycleResult, error)
+	OnPause(context.Context, *LifecycleParams) (*LifecycleResult, error)
+	OnUnpause(context.Context, *LifecycleParams) (*LifecycleResult, error)
+	PreMigration(context.Context, *MigrationParams) (*MigrationResult, error)
+	PostMigration(context.Context, *MigrationParams) (*MigrationResult, error)
+	OnHotplug(context.Context, *HotplugParams) (*HotplugResult, error)
+}
+
+func RegisterCallbacksServer(s *grpc.Server, srv CallbacksServer) {
+	s.RegisterService(&_Callbacks_serviceDesc, srv)
+}
+
+func _Callbacks_OnDefineDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnDefineDomainParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).OnDefineDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha4.Callbacks/OnDefineDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).OnDefineDomain(ctx, req.(*OnDefineDomainParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callbacks_PreCloudInitIso_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreCloudInitIsoParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).PreCloudInitIso(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha4.Callbacks/PreCloudInitIso",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).PreCloudInitIso(ctx, req.(*PreCloudInitIsoParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callbacks_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha4.Callbacks/Shutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).Shutdown(ctx, req.(*ShutdownParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callbacks_PostStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LifecycleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).PostStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha4.Callbacks/PostStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).PostStart(ctx, req.(*LifecycleParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callbacks_OnPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LifecycleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).OnPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha4.Callbacks/OnPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).OnPause(ctx, req.(*LifecycleParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callbacks_OnUnpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LifecycleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).OnUnpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha4.Callbacks/OnUnpause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).OnUnpause(ctx, req.(*LifecycleParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callbacks_PreMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrationParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).PreMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha4.Callbacks/PreMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).PreMigration(ctx, req.(*MigrationParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callbacks_PostMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrationParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).PostMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha4.Callbacks/PostMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).PostMigration(ctx, req.(*MigrationParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callbacks_OnHotplug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HotplugParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbacksServer).OnHotplug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.v1alpha4.Callbacks/OnHotplug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbacksServer).OnHotplug(ctx, req.(*HotplugParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Callbacks_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kubevirt.hooks.v1alpha4.Callbacks",
+	HandlerType: (*CallbacksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OnDefineDomain",
+			Handler:    _Callbacks_OnDefineDomain_Handler,
+		},
+		{
+			MethodName: "PreCloudInitIso",
+			Handler:    _Callbacks_PreCloudInitIso_Handler,
+		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _Callbacks_Shutdown_Handler,
+		},
+		{
+			MethodName: "PostStart",
+			Handler:    _Callbacks_PostStart_Handler,
+		},
+		{
+			MethodName: "OnPause",
+			Handler:    _Callbacks_OnPause_Handler,
+		},
+		{
+			MethodName: "OnUnpause",
+			Handler:    _Callbacks_OnUnpause_Handler,
+		},
+		{
+			MethodName: "PreMigration",
+			Handler:    _Callbacks_PreMigration_Handler,
+		},
+		{
+			MethodName: "PostMigration",
+			Handler:    _Callbacks_PostMigration_Handler,
+		},
+		{
+			MethodName: "OnHotplug",
+			Handler:    _Callbacks_OnHotplug_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api_v1alpha4.proto",
+}
+
+func init() { proto.RegisterFile("api_v1alpha4.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xe1, 0x6a, 0xdb, 0x30,
+	0x10, 0x26, 0x4b, 0x69, 0x9b, 0xa3, 0x69, 0x5a, 0xb1, 0x75, 0xc6, 0x8c, 0x31, 0xbc, 0xb1, 0xf5,
+	0xcf, 0x0c, 0xdb, 0xca, 0x5e, 0xa0, 0x61, 0xac, 0xd0, 0x36, 0x26, 0x59, 0x61, 0xb0, 0x8d, 0xa0,
+	0xc8, 0xd7, 0x46, 0xc4, 0x91, 0x3c, 0x59, 0xca, 0xc8, 0x13, 0xec, 0x01, 0xf6, 0xc2, 0xc3, 0x8a,
+	0x92, 0x38, 0x69, 0xdd, 0x9a, 0x42, 0xfe, 0x59, 0xdf, 0x7d, 0xf7, 0x7d, 0xa7, 0xd3, 0x1d, 0x06,
+	0x42, 0x53, 0xde, 0x9f, 0x7c, 0xa0, 0x49, 0x3a, 0xa4, 0x27, 0x61, 0xaa, 0xa4, 0x96, 0xe4, 0xf9,
+	0xc8, 0x0c, 0x70, 0xc2, 0x95, 0x0e, 0x87, 0x52, 0x8e, 0xb2, 0x70, 0x1e, 0x0e, 0xbe, 0xc0, 0xd3,
+	0x8e, 0x68, 0xe3, 0x35, 0x17, 0xd8, 0x96, 0x63, 0xca, 0x45, 0x44, 0x15, 0x1d, 0x67, 0xe4, 0x05,
+	0x34, 0x62, 0x7b, 0xfe, 0x7e, 0x71, 0xee, 0xd5, 0x5e, 0xd5, 0x8e, 0xf7, 0xba, 0x4b, 0x80, 0x1c,
+	0x40, 0x7d, 0x32, 0xe6, 0xde, 0x13, 0x8b, 0xe7, 0x9f, 0xc1, 0xc9, 0xba, 0x4e, 0x17, 0x33, 0x93,
+	0xe8, 0xfb, 0x75, 0x82, 0xbf, 0x35, 0x78, 0x16, 0x29, 0x3c, 0x4d, 0xa4, 0x89, 0xcf, 0x04, 0xd7,
+	0x67, 0x99, 0x74, 0xfe, 0x9f, 0xe1, 0x88, 0xcd, 0xd1, 0x4b, 0x69, 0x09, 0x3d, 0x69, 0x14, 0x43,
+	0x27, 0x52, 0x12, 0xbd, 0x5d, 0x19, 0x79, 0x03, 0xcd, 0x05, 0xb7, 0x4d, 0x35, 0xf5, 0xea, 0x36,
+	0xb6, 0x0a, 0x06, 0xe6, 0x56, 0x21, 0xee, 0x02, 0x8f, 0x2d, 0xa4, 0x9a, 0xed, 0x01, 0xec, 0xf7,
+	0x86, 0x46, 0xc7, 0xf2, 0x8f, 0x6b, 0x7c, 0x11, 0x99, 0x55, 0x10, 0xbc, 0x86, 0xd6, 0x39, 0xbf,
+	0x46, 0x36, 0x65, 0x09, 0xba, 0xee, 0xb8, 0x5b, 0xd6, 0x96, 0xfd, 0x3f, 0x2c, 0x90, 0x5c, 0xde,
+	0x15, 0xb4, 0x2e, 0xf8, 0x8d, 0xa2, 0x9a, 0x4b, 0x51, 0x96, 0x47, 0x08, 0x6c, 0x29, 0x99, 0xa0,
+	0x6d, 0x58, 0xa3, 0x6b, 0xbf, 0xf3, 0x37, 0xcb, 0x0c, 0x63, 0x88, 0x31, 0xc6, 0xb6, 0xec, 0xdd,
+	0xee, 0x12, 0x08, 0x0e, 0x0b, 0xb2, 0xce, 0x69, 0x0a, 0xcd, 0xaf, 0x52, 0xa7, 0x89, 0xb9, 0x29,
+	0xf5, 0x79, 0x09, 0x10, 0xe3, 0x84, 0x33, 0xfc, 0x36, 0x4d, 0xe7, 0x6e, 0x05, 0x64, 0x19, 0xbf,
+	0xa4, 0x63, 0xf4, 0xea, 0xc5, 0x78, 0x8e, 0x90, 0x23, 0xd8, 0xa6, 0x2c, 0xb7, 0xf4, 0xb6, 0x6c,
+	0xcc, 0x9d, 0x82, 0xd6, 0xc2, 0x7a, 0x56, 0xcb, 0xc7, 0x7f, 0x3b, 0xd0, 0x38, 0xa5, 0x49, 0x32,
+	0xa0, 0x6c, 0x94, 0x11, 0x01, 0xfb, 0xab, 0x63, 0x49, 0xde, 0x87, 0x25, 0xab, 0x10, 0xde, 0xb5,
+	0x07, 0x7e, 0x55, 0xba, 0x9b, 0x96, 0xdf, 0xd0, 0x5a, 0x1b, 0x23, 0x12, 0x96, 0x2a, 0xdc, 0x39,
+	0xf9, 0x7e, 0x65, 0xbe, 0xb3, 0xfc, 0x09, 0xbb, 0xf3, 0x81, 0x21, 0xef, 0x4a, 0x73, 0x57, 0xa7,
+	0xcc, 0x7f, 0x98, 0xe8, 0xd4, 0xfb, 0xd0, 0x88, 0x64, 0xa6, 0x7b, 0x9a, 0x2a, 0x4d, 0x8e, 0x4b,
+	0xb3, 0xd6, 0x06, 0xd4, 0xaf, 0xc0, 0x74, 0x06, 0xbf, 0x60, 0xa7, 0x23, 0x22, 0x6a, 0x32, 0xdc,
+	0x88, 0x7c, 0x1f, 0x1a, 0x1d, 0x71, 0x25, 0xd2, 0x8d, 0x19, 0x0c, 0x60, 0x2f, 0x52, 0xb8, 0xd8,
+	0x88, 0x7b, 0x3c, 0xd6, 0x96, 0xd1, 0xaf, 0xc0, 0x74, 0x1e, 0x0c, 0x9a, 0xf9, 0x23, 0x6c, 0xd6,
+	0xe4, 0x47, 0xde, 0x29, 0xb7, 0x4b, 0xe4, 0x6d, 0x69, 0xda, 0xca, 0xa2, 0xfb, 0x0f, 0xf2, 0x66,
+	0xe2, 0x83, 0x6d, 0xfb, 0x1b, 0xfa, 0xf4, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xca, 0x08, 0x0e, 0xcb,
+	0x9c, 0x06, 0x00, 0x00,
+}
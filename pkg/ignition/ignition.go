@@ -20,13 +20,19 @@
 package ignition
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 	"kubevirt.io/client-go/precond"
 
+	"kubevirt.io/kubevirt/pkg/config"
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/util"
 )
@@ -35,11 +41,92 @@ var ignitionLocalDir = "/var/run/libvirt/ignition-dir"
 
 const IgnitionFile = "data.ign"
 
+const (
+	// IgnitionDiskFile is the raw disk image of an Ignition volume which is attached as a disk
+	IgnitionDiskFile = "ignition.img"
+	// IgnitionDiskSerial is the serial the guest finds the Ignition disk by, i.e. /dev/disk/by-id/virtio-ignition
+	IgnitionDiskSerial = "ignition"
+	// ConfigSecretKey is the key of the Ignition config in a secret
+	ConfigSecretKey = "config"
+	// SecretVolumeSuffix is appended to the name of an Ignition volume to name the pod volume of its secret
+	SecretVolumeSuffix = "-ignition"
+
+	diskSectorSize = 512
+)
+
 func GetIgnitionSource(vmi *v1.VirtualMachineInstance) string {
 	precond.MustNotBeNil(vmi)
 	return vmi.Annotations[v1.IgnitionAnnotation]
 }
 
+// GetIgnitionVolume returns the Ignition volume of the VMI, or nil if it has none
+func GetIgnitionVolume(vmi *v1.VirtualMachineInstance) *v1.Volume {
+	for i := range vmi.Spec.Volumes {
+		if vmi.Spec.Volumes[i].Ignition != nil {
+			return &vmi.Spec.Volumes[i]
+		}
+	}
+	return nil
+}
+
+// HasIgnitionData returns true if the VMI passes an Ignition config, through a volume or the annotation
+func HasIgnitionData(vmi *v1.VirtualMachineInstance) bool {
+	return GetIgnitionVolume(vmi) != nil || GetIgnitionSource(vmi) != ""
+}
+
+// IsAttachedAsDisk returns true if the Ignition volume is attached as a disk, instead of passed through fw_cfg
+func IsAttachedAsDisk(vmi *v1.VirtualMachineInstance, volumeName string) bool {
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Name == volumeName {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSecretSourcePath returns the path the secret of an Ignition volume is mounted at
+func GetSecretSourcePath(volumeName string) string {
+	return filepath.Join(config.SecretSourceDir, volumeName)
+}
+
+// ReadVolumeConfig returns the Ignition config of a volume
+func ReadVolumeConfig(volume *v1.Volume) ([]byte, error) {
+	source := volume.Ignition
+	switch {
+	case source.ConfigSecretRef != nil:
+		data, err := os.ReadFile(filepath.Join(GetSecretSourcePath(volume.Name), ConfigSecretKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the Ignition config of volume %s: %w", volume.Name, err)
+		}
+		return data, nil
+	case source.ConfigBase64 != "":
+		data, err := base64.StdEncoding.DecodeString(source.ConfigBase64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the Ignition config of volume %s: %w", volume.Name, err)
+		}
+		return data, nil
+	case source.Config != "":
+		return []byte(source.Config), nil
+	}
+	return nil, fmt.Errorf("no Ignition config found for volume %s", volume.Name)
+}
+
+// ValidateConfig checks that data is an Ignition config, which at least declares its version
+func ValidateConfig(data []byte) error {
+	var ignitionConfig struct {
+		Ignition *struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}
+	if err := json.Unmarshal(data, &ignitionConfig); err != nil {
+		return fmt.Errorf("the Ignition config is not valid JSON: %w", err)
+	}
+	if ignitionConfig.Ignition == nil || ignitionConfig.Ignition.Version == "" {
+		return errors.New("the Ignition config does not declare ignition.version")
+	}
+	return nil
+}
+
 func SetLocalDirectory(dir string) error {
 	err := util.MkdirAllWithNosec(dir)
 	if err != nil {
@@ -61,9 +148,40 @@ func GetDomainBasePath(domain string, namespace string) string {
 	return fmt.Sprintf("%s/%s/%s", ignitionLocalDir, namespace, domain)
 }
 
+// GetIgnitionFilePath returns the path of the Ignition config passed through fw_cfg
+func GetIgnitionFilePath(domain string, namespace string) string {
+	return filepath.Join(GetDomainBasePath(domain, namespace), IgnitionFile)
+}
+
+// GetIgnitionDiskPath returns the path of the raw disk image of an Ignition volume attached as a disk
+func GetIgnitionDiskPath(domain string, namespace string) string {
+	return filepath.Join(GetDomainBasePath(domain, namespace), IgnitionDiskFile)
+}
+
+// GenerateIgnitionLocalData writes the Ignition config of the VMI, from its Ignition volume or
+// otherwise from the annotation. A volume attached as a disk is written as a raw disk image, which is
+// padded with zeros to whole sectors; Ignition strips them when it reads the disk.
 func GenerateIgnitionLocalData(vmi *v1.VirtualMachineInstance, namespace string) error {
 	precond.MustNotBeEmpty(vmi.Name)
-	precond.MustNotBeNil(vmi.Annotations[v1.IgnitionAnnotation])
+
+	ignitionFile := GetIgnitionFilePath(vmi.Name, namespace)
+	var ignitionData []byte
+	if volume := GetIgnitionVolume(vmi); volume != nil {
+		var err error
+		ignitionData, err = ReadVolumeConfig(volume)
+		if err != nil {
+			return err
+		}
+		if IsAttachedAsDisk(vmi, volume.Name) {
+			ignitionFile = GetIgnitionDiskPath(vmi.Name, namespace)
+			if padding := len(ignitionData) % diskSectorSize; padding != 0 {
+				ignitionData = append(ignitionData, bytes.Repeat([]byte{0}, diskSectorSize-padding)...)
+			}
+		}
+	} else {
+		precond.MustNotBeNil(vmi.Annotations[v1.IgnitionAnnotation])
+		ignitionData = []byte(vmi.Annotations[v1.IgnitionAnnotation])
+	}
 
 	domainBasePath := GetDomainBasePath(vmi.Name, namespace)
 	err := util.MkdirAllWithNosec(domainBasePath)
@@ -72,8 +190,6 @@ func GenerateIgnitionLocalData(vmi *v1.VirtualMachineInstance, namespace string)
 		return err
 	}
 
-	ignitionFile := fmt.Sprintf("%s/%s", domainBasePath, IgnitionFile)
-	ignitionData := []byte(vmi.Annotations[v1.IgnitionAnnotation])
 	err = util.WriteFileWithNosec(ignitionFile, ignitionData)
 	if err != nil {
 		return err
@@ -20,14 +20,19 @@
 package ignition
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	k8sv1 "k8s.io/api/core/v1"
+
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/config"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 )
 
@@ -66,5 +71,61 @@ var _ = Describe("Ignition", func() {
 				Expect(err).ToNot(HaveOccurred())
 			})
 		})
+
+		Context("with an ignition volume", func() {
+			const data = `{"ignition":{"version":"3.4.0"}}`
+
+			It("should write the config passed through fw_cfg", func() {
+				vmi = libvmi.New(
+					libvmi.WithNamespace(namespace),
+					libvmi.WithName(vmName),
+					libvmi.WithIgnition("ignition", &v1.IgnitionSource{ConfigBase64: base64.StdEncoding.EncodeToString([]byte(data))}),
+				)
+				Expect(HasIgnitionData(vmi)).To(BeTrue())
+				Expect(GenerateIgnitionLocalData(vmi, namespace)).To(Succeed())
+				Expect(os.ReadFile(GetIgnitionFilePath(vmName, namespace))).To(BeEquivalentTo(data))
+			})
+
+			It("should write a disk image padded to whole sectors when attached as a disk", func() {
+				vmi = libvmi.New(
+					libvmi.WithNamespace(namespace),
+					libvmi.WithName(vmName),
+					libvmi.WithIgnitionDisk("ignition", &v1.IgnitionSource{Config: data}),
+				)
+				Expect(GenerateIgnitionLocalData(vmi, namespace)).To(Succeed())
+				image, err := os.ReadFile(GetIgnitionDiskPath(vmName, namespace))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(image).To(HaveLen(512))
+				Expect(image).To(HavePrefix(data))
+				Expect(image[len(data):]).To(HaveEach(byte(0)))
+			})
+
+			It("should read the config from the secret", func() {
+				originalSecretSourceDir := config.SecretSourceDir
+				config.SecretSourceDir = tmpDir
+				DeferCleanup(func() { config.SecretSourceDir = originalSecretSourceDir })
+				Expect(os.MkdirAll(filepath.Join(tmpDir, "ignition"), 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(tmpDir, "ignition", ConfigSecretKey), []byte(data), 0600)).To(Succeed())
+
+				vmi = libvmi.New(
+					libvmi.WithIgnition("ignition", &v1.IgnitionSource{ConfigSecretRef: &k8sv1.LocalObjectReference{Name: "secret"}}),
+				)
+				Expect(ReadVolumeConfig(GetIgnitionVolume(vmi))).To(BeEquivalentTo(data))
+			})
+		})
 	})
+
+	DescribeTable("should validate the config", func(data string, expectedErr string) {
+		err := ValidateConfig([]byte(data))
+		if expectedErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		}
+	},
+		Entry("with a version", `{"ignition":{"version":"3.4.0"}}`, ""),
+		Entry("without a version", `{"ignition":{}}`, "does not declare ignition.version"),
+		Entry("without ignition", `{"storage":{}}`, "does not declare ignition.version"),
+		Entry("which is not JSON", "#cloud-config", "not valid JSON"),
+	)
 })
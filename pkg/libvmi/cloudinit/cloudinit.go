@@ -65,6 +65,30 @@ func WithNoCloudNetworkDataSecretName(secretName string) NoCloudOption {
 	}
 }
 
+func WithNoCloudVendorData(data string) NoCloudOption {
+	return func(source *v1.CloudInitNoCloudSource) {
+		source.VendorData = data
+	}
+}
+
+func WithNoCloudMetaData(metaData *v1.CloudInitMetaData) NoCloudOption {
+	return func(source *v1.CloudInitNoCloudSource) {
+		source.MetaData = metaData
+	}
+}
+
+func WithNoCloudMetadataService() NoCloudOption {
+	return func(source *v1.CloudInitNoCloudSource) {
+		source.MetadataService = &v1.CloudInitMetadataService{}
+	}
+}
+
+func WithNoCloudUpdatePolicy(policy v1.CloudInitUpdatePolicy) NoCloudOption {
+	return func(source *v1.CloudInitNoCloudSource) {
+		source.UpdatePolicy = &policy
+	}
+}
+
 type ConfigDriveOption func(*v1.CloudInitConfigDriveSource)
 
 func WithConfigDriveUserData(data string) ConfigDriveOption {
@@ -102,3 +126,21 @@ func WithConfigDriveNetworkDataSecretName(secretName string) ConfigDriveOption {
 		source.NetworkDataSecretRef = &k8scorev1.LocalObjectReference{Name: secretName}
 	}
 }
+
+func WithConfigDriveVendorData(data string) ConfigDriveOption {
+	return func(source *v1.CloudInitConfigDriveSource) {
+		source.VendorData = data
+	}
+}
+
+func WithConfigDriveMetaData(metaData *v1.CloudInitMetaData) ConfigDriveOption {
+	return func(source *v1.CloudInitConfigDriveSource) {
+		source.MetaData = metaData
+	}
+}
+
+func WithConfigDriveUpdatePolicy(policy v1.CloudInitUpdatePolicy) ConfigDriveOption {
+	return func(source *v1.CloudInitConfigDriveSource) {
+		source.UpdatePolicy = &policy
+	}
+}
@@ -61,6 +61,34 @@ func WithSysprepConfigMap(volumeName, configMapName string) Option {
 	}
 }
 
+func WithSysprepUnattend(volumeName string, unattend *v1.SysprepUnattend) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, newSysprepVolume(volumeName, &v1.SysprepSource{
+			Unattend: unattend,
+		}))
+	}
+}
+
+func WithIgnition(volumeName string, source *v1.IgnitionSource) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: volumeName,
+			VolumeSource: v1.VolumeSource{
+				Ignition: source,
+			},
+		})
+	}
+}
+
+func WithIgnitionDisk(volumeName string, source *v1.IgnitionSource) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		WithIgnition(volumeName, source)(vmi)
+		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+			Name: volumeName,
+		})
+	}
+}
+
 func WithLabelledConfigMapDisk(configMapName, volumeName, label string) Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		vmi.Spec.Volumes = append(vmi.Spec.Volumes, newConfigMapVolume(configMapName, volumeName, label))
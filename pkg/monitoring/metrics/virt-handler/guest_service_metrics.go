@@ -0,0 +1,51 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var (
+	guestServiceMetrics = []operatormetrics.Metric{
+		guestServiceUp,
+	}
+
+	guestServiceUp = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_service_up",
+			Help: "Whether a service watched in the guest is running (1) or not (0), as checked through the guest agent. " +
+				"Only reported for the services listed in spec.guestServiceWatch of the VMI.",
+		},
+		[]string{"node", "namespace", "name", "service"},
+	)
+)
+
+func SetGuestServiceUp(node, namespace, name, service string, up bool) {
+	value := 0.0
+	if up {
+		value = 1.0
+	}
+	guestServiceUp.WithLabelValues(node, namespace, name, service).Set(value)
+}
+
+func DeleteGuestServiceUp(node, namespace, name, service string) {
+	guestServiceUp.DeleteLabelValues(node, namespace, name, service)
+}
@@ -0,0 +1,51 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var (
+	guestTimeMetrics = []operatormetrics.Metric{
+		guestTimeDrift,
+	}
+
+	guestTimeDrift = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_time_drift_seconds",
+			Help: "Histogram of the absolute drift of the guest clock, as reported by the guest agent before synchronising it with the host clock.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: []float64{0.01, 0.1, 1, 10, 60, 600, 3600},
+		},
+		[]string{
+			// event after which the guest time was synchronised
+			"trigger",
+		},
+	)
+)
+
+func ObserveGuestTimeDrift(trigger string, drift time.Duration) {
+	guestTimeDrift.WithLabelValues(trigger).Observe(drift.Abs().Seconds())
+}
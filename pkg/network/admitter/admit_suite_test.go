@@ -22,6 +22,7 @@ package admitter_test
 import (
 	"testing"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/testutils"
 )
 
@@ -32,6 +33,7 @@ func TestAdmitter(t *testing.T) {
 type stubClusterConfigChecker struct {
 	bridgeBindingOnPodNetEnabled   bool
 	passtBindingFeatureGateEnabled bool
+	networkBindings                map[string]v1.InterfaceBindingPlugin
 }
 
 func (s stubClusterConfigChecker) PasstBindingEnabled() bool { return s.passtBindingFeatureGateEnabled }
@@ -39,3 +41,7 @@ func (s stubClusterConfigChecker) PasstBindingEnabled() bool { return s.passtBin
 func (s stubClusterConfigChecker) IsBridgeInterfaceOnPodNetworkEnabled() bool {
 	return s.bridgeBindingOnPodNetEnabled
 }
+
+func (s stubClusterConfigChecker) GetNetworkBindings() map[string]v1.InterfaceBindingPlugin {
+	return s.networkBindings
+}
@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func validateInterfacesBandwidth(
+	fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec, config clusterConfigChecker,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Bandwidth == nil {
+			continue
+		}
+		bandwidthField := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("bandwidth")
+		if !isTapBasedInterface(iface, config.GetNetworkBindings()) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("interface %s bandwidth is supported only by interfaces attached through a tap device", iface.Name),
+				Field:   bandwidthField.String(),
+			})
+			continue
+		}
+		causes = append(causes, validateBandwidthLimit(bandwidthField.Child("inbound"), iface.Bandwidth.Inbound)...)
+		causes = append(causes, validateBandwidthLimit(bandwidthField.Child("outbound"), iface.Bandwidth.Outbound)...)
+	}
+	return causes
+}
+
+func validateBandwidthLimit(fieldPath *field.Path, limit *v1.BandwidthLimit) []metav1.StatusCause {
+	if limit == nil {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	if limit.Average == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "bandwidth average rate must be greater than zero",
+			Field:   fieldPath.Child("average").String(),
+		})
+	}
+	if limit.Peak != 0 && limit.Peak < limit.Average {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("bandwidth peak rate %d must not be lower than the average rate %d", limit.Peak, limit.Average),
+			Field:   fieldPath.Child("peak").String(),
+		})
+	}
+	return causes
+}
+
+func isTapBasedInterface(iface v1.Interface, bindingPlugins map[string]v1.InterfaceBindingPlugin) bool {
+	if iface.Bridge != nil || iface.Masquerade != nil {
+		return true
+	}
+	if iface.Binding == nil {
+		return false
+	}
+	plugin, exists := bindingPlugins[iface.Binding.Name]
+	return exists && (plugin.DomainAttachmentType == v1.Tap || plugin.DomainAttachmentType == v1.ManagedTap)
+}
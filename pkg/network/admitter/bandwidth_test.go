@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating interface bandwidth", func() {
+	const (
+		tapPlugin    = "tap-plugin"
+		nonTapPlugin = "non-tap-plugin"
+	)
+
+	clusterConfig := stubClusterConfigChecker{
+		networkBindings: map[string]v1.InterfaceBindingPlugin{
+			tapPlugin:    {DomainAttachmentType: v1.Tap},
+			nonTapPlugin: {},
+		},
+	}
+
+	newVMISpec := func(iface v1.Interface, bandwidth *v1.InterfaceBandwidth) *v1.VirtualMachineInstanceSpec {
+		iface.Bandwidth = bandwidth
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+		spec.Networks = []v1.Network{*libvmi.MultusNetwork(iface.Name, "nad")}
+		return spec
+	}
+
+	DescribeTable("should accept", func(iface v1.Interface, bandwidth *v1.InterfaceBandwidth) {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newVMISpec(iface, bandwidth), clusterConfig)
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("a bridge interface with limits",
+			libvmi.InterfaceDeviceWithBridgeBinding("net1"),
+			&v1.InterfaceBandwidth{
+				Inbound:  &v1.BandwidthLimit{Average: 1000, Peak: 2000, Burst: 256},
+				Outbound: &v1.BandwidthLimit{Average: 1000},
+			},
+		),
+		Entry("a tap binding plugin interface with limits",
+			libvmi.InterfaceWithBindingPlugin("net1", v1.PluginBinding{Name: tapPlugin}),
+			&v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimit{Average: 1000, Peak: 1000}},
+		),
+	)
+
+	DescribeTable("should reject", func(iface v1.Interface, bandwidth *v1.InterfaceBandwidth, expectedCause metav1.StatusCause) {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newVMISpec(iface, bandwidth), clusterConfig)
+		Expect(validator.Validate()).To(ConsistOf(expectedCause))
+	},
+		Entry("an interface which is not tap-based",
+			libvmi.InterfaceWithBindingPlugin("net1", v1.PluginBinding{Name: nonTapPlugin}),
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "interface net1 bandwidth is supported only by interfaces attached through a tap device",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth",
+			},
+		),
+		Entry("a limit without average rate",
+			libvmi.InterfaceDeviceWithBridgeBinding("net1"),
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Burst: 256}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "bandwidth average rate must be greater than zero",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.inbound.average",
+			},
+		),
+		Entry("a limit with a peak rate lower than the average rate",
+			libvmi.InterfaceDeviceWithBridgeBinding("net1"),
+			&v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimit{Average: 1000, Peak: 500}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "bandwidth peak rate 500 must not be lower than the average rate 1000",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.outbound.peak",
+			},
+		),
+	)
+})
@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func validateInterfacesFirewall(fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Firewall == nil {
+			continue
+		}
+		firewallField := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("firewall")
+		if iface.Bridge == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("interface %s firewall is supported only by the bridge binding", iface.Name),
+				Field:   firewallField.String(),
+			})
+			continue
+		}
+		causes = append(causes, validateFirewallAction(firewallField.Child("defaultAction"), iface.Firewall.DefaultAction, true)...)
+		for ruleIdx, rule := range iface.Firewall.Rules {
+			causes = append(causes, validateFirewallRule(firewallField.Child("rules").Index(ruleIdx), rule)...)
+		}
+	}
+	return causes
+}
+
+func validateFirewallRule(fieldPath *field.Path, rule v1.FirewallRule) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	switch rule.Direction {
+	case v1.FirewallDirectionIngress, v1.FirewallDirectionEgress:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("firewall rule direction %q is not supported, only Ingress or Egress allowed", rule.Direction),
+			Field:   fieldPath.Child("direction").String(),
+		})
+	}
+	causes = append(causes, validateFirewallAction(fieldPath.Child("action"), rule.Action, false)...)
+
+	if rule.CIDR != "" {
+		if _, _, err := net.ParseCIDR(rule.CIDR); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("firewall rule CIDR %q is not valid", rule.CIDR),
+				Field:   fieldPath.Child("cidr").String(),
+			})
+		}
+	}
+
+	switch rule.Protocol {
+	case "", protocolTCP, protocolUDP, protocolSCTP, protocolICMP:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "Unknown protocol, only TCP, UDP, SCTP or ICMP allowed",
+			Field:   fieldPath.Child("protocol").String(),
+		})
+	}
+
+	return append(causes, validateFirewallRulePorts(fieldPath, rule)...)
+}
+
+func validateFirewallAction(fieldPath *field.Path, action v1.FirewallAction, allowEmpty bool) []metav1.StatusCause {
+	switch action {
+	case v1.FirewallActionAllow, v1.FirewallActionDeny:
+		return nil
+	case "":
+		if allowEmpty {
+			return nil
+		}
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("firewall action %q is not supported, only Allow or Deny allowed", action),
+		Field:   fieldPath.String(),
+	}}
+}
+
+func validateFirewallRulePorts(fieldPath *field.Path, rule v1.FirewallRule) []metav1.StatusCause {
+	if rule.Port == 0 && rule.EndPort == 0 {
+		return nil
+	}
+	switch rule.Protocol {
+	case protocolTCP, protocolUDP, protocolSCTP:
+	default:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "firewall rule ports require the TCP, UDP or SCTP protocol",
+			Field:   fieldPath.Child("protocol").String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	if rule.Port < 1 || rule.Port > 65535 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Port field must be in range 0 < x < 65536.",
+			Field:   fieldPath.Child("port").String(),
+		})
+	}
+	if rule.EndPort != 0 && (rule.EndPort < rule.Port || rule.EndPort > 65535) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "EndPort field must be in range Port <= x < 65536.",
+			Field:   fieldPath.Child("endPort").String(),
+		})
+	}
+	return causes
+}
@@ -0,0 +1,165 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating interface firewall", func() {
+	const ruleField = "fake.domain.devices.interfaces[0].firewall.rules[0]"
+
+	newVMISpec := func(iface v1.Interface, firewall *v1.InterfaceFirewall) *v1.VirtualMachineInstanceSpec {
+		iface.Firewall = firewall
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+		spec.Networks = []v1.Network{*libvmi.MultusNetwork(iface.Name, "nad")}
+		return spec
+	}
+
+	newFirewall := func(rule v1.FirewallRule) *v1.InterfaceFirewall {
+		return &v1.InterfaceFirewall{DefaultAction: v1.FirewallActionDeny, Rules: []v1.FirewallRule{rule}}
+	}
+
+	It("should accept a bridge interface with rules", func() {
+		firewall := &v1.InterfaceFirewall{
+			DefaultAction: v1.FirewallActionDeny,
+			Rules: []v1.FirewallRule{
+				{Direction: v1.FirewallDirectionIngress, Action: v1.FirewallActionAllow, CIDR: "10.0.0.0/8", Protocol: "TCP", Port: 22},
+				{Direction: v1.FirewallDirectionIngress, Action: v1.FirewallActionAllow, Protocol: "ICMP"},
+				{Direction: v1.FirewallDirectionEgress, Action: v1.FirewallActionDeny, CIDR: "fd00::/64", Protocol: "UDP", Port: 5000, EndPort: 5100},
+				{Direction: v1.FirewallDirectionEgress, Action: v1.FirewallActionAllow},
+			},
+		}
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), newVMISpec(libvmi.InterfaceDeviceWithBridgeBinding("net1"), firewall), stubClusterConfigChecker{},
+		)
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject a firewall on an interface which is not bridge bound", func() {
+		iface := v1.Interface{
+			Name:                   "net1",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+		}
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), newVMISpec(iface, &v1.InterfaceFirewall{}), stubClusterConfigChecker{},
+		)
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "interface net1 firewall is supported only by the bridge binding",
+			Field:   "fake.domain.devices.interfaces[0].firewall",
+		}))
+	})
+
+	It("should reject an unknown default action", func() {
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"),
+			newVMISpec(libvmi.InterfaceDeviceWithBridgeBinding("net1"), &v1.InterfaceFirewall{DefaultAction: "Reject"}),
+			stubClusterConfigChecker{},
+		)
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: `firewall action "Reject" is not supported, only Allow or Deny allowed`,
+			Field:   "fake.domain.devices.interfaces[0].firewall.defaultAction",
+		}))
+	})
+
+	DescribeTable("should reject a rule", func(rule v1.FirewallRule, expectedCause metav1.StatusCause) {
+		validator := admitter.NewValidator(
+			k8sfield.NewPath("fake"), newVMISpec(libvmi.InterfaceDeviceWithBridgeBinding("net1"), newFirewall(rule)), stubClusterConfigChecker{},
+		)
+		Expect(validator.Validate()).To(ConsistOf(expectedCause))
+	},
+		Entry("with an unknown direction",
+			v1.FirewallRule{Direction: "Both", Action: v1.FirewallActionAllow},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: `firewall rule direction "Both" is not supported, only Ingress or Egress allowed`,
+				Field:   ruleField + ".direction",
+			},
+		),
+		Entry("without an action",
+			v1.FirewallRule{Direction: v1.FirewallDirectionIngress},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: `firewall action "" is not supported, only Allow or Deny allowed`,
+				Field:   ruleField + ".action",
+			},
+		),
+		Entry("with an invalid CIDR",
+			v1.FirewallRule{Direction: v1.FirewallDirectionIngress, Action: v1.FirewallActionAllow, CIDR: "10.0.0.300/8"},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: `firewall rule CIDR "10.0.0.300/8" is not valid`,
+				Field:   ruleField + ".cidr",
+			},
+		),
+		Entry("with an unknown protocol",
+			v1.FirewallRule{Direction: v1.FirewallDirectionIngress, Action: v1.FirewallActionAllow, Protocol: "GRE"},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "Unknown protocol, only TCP, UDP, SCTP or ICMP allowed",
+				Field:   ruleField + ".protocol",
+			},
+		),
+		Entry("with a port and no protocol",
+			v1.FirewallRule{Direction: v1.FirewallDirectionIngress, Action: v1.FirewallActionAllow, Port: 22},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "firewall rule ports require the TCP, UDP or SCTP protocol",
+				Field:   ruleField + ".protocol",
+			},
+		),
+		Entry("with a port and the ICMP protocol",
+			v1.FirewallRule{Direction: v1.FirewallDirectionIngress, Action: v1.FirewallActionAllow, Protocol: "ICMP", Port: 22},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "firewall rule ports require the TCP, UDP or SCTP protocol",
+				Field:   ruleField + ".protocol",
+			},
+		),
+		Entry("with an out of range port",
+			v1.FirewallRule{Direction: v1.FirewallDirectionIngress, Action: v1.FirewallActionAllow, Protocol: "TCP", Port: 70000},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Port field must be in range 0 < x < 65536.",
+				Field:   ruleField + ".port",
+			},
+		),
+		Entry("with an end port lower than the port",
+			v1.FirewallRule{Direction: v1.FirewallDirectionIngress, Action: v1.FirewallActionAllow, Protocol: "TCP", Port: 100, EndPort: 99},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "EndPort field must be in range Port <= x < 65536.",
+				Field:   ruleField + ".endPort",
+			},
+		),
+	)
+})
@@ -24,6 +24,7 @@ import (
 	"net"
 	"regexp"
 
+	dhcpserver "kubevirt.io/kubevirt/pkg/network/dhcp/server"
 	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
@@ -196,9 +197,15 @@ func validatePortConfiguration(field *k8sfield.Path, idx int, iface v1.Interface
 		causes = append(causes, validateForwardPortName(field, idx, iface.Ports)...)
 
 		for portIdx, forwardPort := range iface.Ports {
-			causes = append(causes, validateForwardPortNonZero(field, idx, forwardPort, portIdx)...)
-			causes = append(causes, validateForwardPortInRange(field, idx, forwardPort, portIdx)...)
+			if forwardPort.Protocol == protocolICMP {
+				causes = append(causes, validateForwardICMPHasNoPort(field, idx, forwardPort, portIdx)...)
+			} else {
+				causes = append(causes, validateForwardPortNonZero(field, idx, forwardPort, portIdx)...)
+				causes = append(causes, validateForwardPortInRange(field, idx, forwardPort, portIdx)...)
+				causes = append(causes, validateForwardEndPort(field, idx, forwardPort, portIdx)...)
+			}
 			causes = append(causes, validateForwardPortProtocol(field, idx, forwardPort, portIdx)...)
+			causes = append(causes, validateForwardPortSupportedByBinding(field, idx, iface, forwardPort, portIdx)...)
 		}
 	}
 	return causes
@@ -230,12 +237,24 @@ func validateForwardPortName(field *k8sfield.Path, idx int, ports []v1.Port) []m
 	return causes
 }
 
+const (
+	protocolTCP  = "TCP"
+	protocolUDP  = "UDP"
+	protocolSCTP = "SCTP"
+	protocolICMP = "ICMP"
+)
+
+// maxForwardPortRangeSize bounds a port range, since every port of the range is listed in the virt-launcher pod.
+const maxForwardPortRangeSize = 1024
+
 func validateForwardPortProtocol(field *k8sfield.Path, idx int, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
 	if forwardPort.Protocol != "" {
-		if forwardPort.Protocol != "TCP" && forwardPort.Protocol != "UDP" {
+		switch forwardPort.Protocol {
+		case protocolTCP, protocolUDP, protocolSCTP, protocolICMP:
+		default:
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "Unknown protocol, only TCP or UDP allowed",
+				Message: "Unknown protocol, only TCP, UDP, SCTP or ICMP allowed",
 				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("protocol").String(),
 			})
 		}
@@ -254,6 +273,58 @@ func validateForwardPortInRange(field *k8sfield.Path, idx int, forwardPort v1.Po
 	return causes
 }
 
+func validateForwardEndPort(field *k8sfield.Path, idx int, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
+	if forwardPort.EndPort != 0 && (forwardPort.EndPort < forwardPort.Port || forwardPort.EndPort > 65535) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "EndPort field must be in range Port <= x < 65536.",
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("endPort").String(),
+		})
+	} else if forwardPort.EndPort-forwardPort.Port >= maxForwardPortRangeSize {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("A port range must not span more than %d ports.", maxForwardPortRangeSize),
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("endPort").String(),
+		})
+	}
+	return causes
+}
+
+func validateForwardICMPHasNoPort(field *k8sfield.Path, idx int, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
+	if forwardPort.Port != 0 || forwardPort.EndPort != 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Port and EndPort fields must not be set for the ICMP protocol.",
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).String(),
+		})
+	}
+	return causes
+}
+
+// validateForwardPortSupportedByBinding rejects port ranges, SCTP and ICMP on interfaces
+// that are not using the masquerade binding, since only it knows how to forward them.
+func validateForwardPortSupportedByBinding(field *k8sfield.Path, idx int, iface v1.Interface, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
+	if iface.Masquerade != nil {
+		return nil
+	}
+	portField := field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx)
+	if forwardPort.EndPort != 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "Port ranges are supported only by the masquerade binding",
+			Field:   portField.Child("endPort").String(),
+		})
+	}
+	if forwardPort.Protocol == protocolSCTP || forwardPort.Protocol == protocolICMP {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("Protocol %s is supported only by the masquerade binding", forwardPort.Protocol),
+			Field:   portField.Child("protocol").String(),
+		})
+	}
+	return causes
+}
+
 func validateForwardPortNonZero(field *k8sfield.Path, idx int, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
 	if forwardPort.Port == 0 {
 		causes = append(causes, metav1.StatusCause{
@@ -270,6 +341,102 @@ func validateDHCPOptions(field *k8sfield.Path, idx int, iface v1.Interface) []me
 	if iface.DHCPOptions != nil {
 		causes = append(causes, validateDHCPExtraOptions(field, iface)...)
 		causes = append(causes, validateDHCPNTPServersAreValidIPv4Addresses(field, iface, idx)...)
+		causes = append(causes, validateDHCPCustomOptions(field, iface, idx)...)
+		causes = append(causes, validateDHCPClasslessRoutes(field, iface, idx)...)
+		causes = append(causes, validateDHCPDomainSearch(field, iface, idx)...)
+		causes = append(causes, validateDHCPLeaseTime(field, iface, idx)...)
+		causes = append(causes, validateDHCPv6Options(field, iface, idx)...)
+	}
+	return causes
+}
+
+func validateDHCPCustomOptions(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	optionsField := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "options")
+	seenCodes := map[int]struct{}{}
+	for index, option := range iface.DHCPOptions.Options {
+		if _, exists := seenCodes[option.Code]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("DHCP option %d is specified more than once", option.Code),
+				Field:   optionsField.Index(index).Child("code").String(),
+			})
+			continue
+		}
+		seenCodes[option.Code] = struct{}{}
+
+		if err := dhcpserver.ValidateCustomOption(option); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   optionsField.Index(index).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateDHCPClasslessRoutes(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	routesField := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "classlessRoutes")
+	for index, route := range iface.DHCPOptions.ClasslessRoutes {
+		if err := dhcpserver.ValidateClasslessRoute(route); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   routesField.Index(index).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateDHCPDomainSearch(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	searchField := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "domainSearch")
+	return validateDomainSearchList(searchField, iface.DHCPOptions.DomainSearch)
+}
+
+func validateDHCPLeaseTime(field *k8sfield.Path, iface v1.Interface, idx int) []metav1.StatusCause {
+	leaseTime := iface.DHCPOptions.LeaseTimeSeconds
+	if leaseTime == nil {
+		return nil
+	}
+	if err := dhcpserver.ValidateLeaseTime(*leaseTime); err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "leaseTimeSeconds").String(),
+		}}
+	}
+	return nil
+}
+
+func validateDHCPv6Options(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	v6Options := iface.DHCPOptions.V6
+	if v6Options == nil {
+		return nil
+	}
+	v6Field := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "v6")
+	for index, ip := range v6Options.NTPServers {
+		if parsedIP := net.ParseIP(ip); parsedIP == nil || parsedIP.To4() != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DHCPv6 NTP servers must be a list of valid IPv6 addresses.",
+				Field:   v6Field.Child("ntpServers").Index(index).String(),
+			})
+		}
+	}
+	causes = append(causes, validateDomainSearchList(v6Field.Child("domainSearch"), v6Options.DomainSearch)...)
+	return causes
+}
+
+func validateDomainSearchList(field *k8sfield.Path, domains []string) (causes []metav1.StatusCause) {
+	for index, domain := range domains {
+		if errs := k8svalidation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("DHCP domain search entry %q is not a valid domain name", domain),
+				Field:   field.Index(index).String(),
+			})
+		}
 	}
 	return causes
 }
@@ -31,6 +31,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Validating VMI network spec", func() {
@@ -259,7 +260,7 @@ var _ = Describe("Validating VMI network spec", func() {
 				[]v1.Port{{Protocol: "bad", Port: 80}},
 				[]metav1.StatusCause{{
 					Type:    "FieldValueInvalid",
-					Message: "Unknown protocol, only TCP or UDP allowed",
+					Message: "Unknown protocol, only TCP, UDP, SCTP or ICMP allowed",
 					Field:   "fake.domain.devices.interfaces[0].ports[0].protocol",
 				}},
 			),
@@ -290,6 +291,33 @@ var _ = Describe("Validating VMI network spec", func() {
 					Field:   "fake.domain.devices.interfaces[0].ports[0].name",
 				}},
 			),
+			Entry(
+				"end port lower than the port",
+				[]v1.Port{{Port: 8000, EndPort: 7000}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "EndPort field must be in range Port <= x < 65536.",
+					Field:   "fake.domain.devices.interfaces[0].ports[0].endPort",
+				}},
+			),
+			Entry(
+				"port range spanning too many ports",
+				[]v1.Port{{Port: 10000, EndPort: 11024}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "A port range must not span more than 1024 ports.",
+					Field:   "fake.domain.devices.interfaces[0].ports[0].endPort",
+				}},
+			),
+			Entry(
+				"ICMP with a port",
+				[]v1.Port{{Protocol: "ICMP", Port: 8}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "Port and EndPort fields must not be set for the ICMP protocol.",
+					Field:   "fake.domain.devices.interfaces[0].ports[0]",
+				}},
+			),
 		)
 
 		DescribeTable("should accept interface with", func(ports []v1.Port) {
@@ -310,6 +338,32 @@ var _ = Describe("Validating VMI network spec", func() {
 				"multiple ports, same number, different protocols",
 				[]v1.Port{{Port: 80}, {Protocol: "UDP", Port: 80}, {Protocol: "TCP", Port: 80}},
 			),
+			Entry("a port range", []v1.Port{{Protocol: "UDP", Port: 10000, EndPort: 10100}}),
+			Entry("SCTP and ICMP", []v1.Port{{Protocol: "SCTP", Port: 3868}, {Protocol: "ICMP"}}),
+		)
+
+		DescribeTable("should reject on a non-masquerade interface", func(port v1.Port, expectedCause metav1.StatusCause) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				Ports:                  []v1.Port{port},
+			}}
+			spec.Networks = []v1.Network{{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(ContainElement(expectedCause))
+		},
+			Entry("a port range", v1.Port{Port: 10000, EndPort: 10100}, metav1.StatusCause{
+				Type:    "FieldValueNotSupported",
+				Message: "Port ranges are supported only by the masquerade binding",
+				Field:   "fake.domain.devices.interfaces[0].ports[0].endPort",
+			}),
+			Entry("SCTP", v1.Port{Protocol: "SCTP", Port: 3868}, metav1.StatusCause{
+				Type:    "FieldValueNotSupported",
+				Message: "Protocol SCTP is supported only by the masquerade binding",
+				Field:   "fake.domain.devices.interfaces[0].ports[0].protocol",
+			}),
 		)
 	})
 
@@ -362,6 +416,60 @@ var _ = Describe("Validating VMI network spec", func() {
 					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.ntpServers[1]",
 				}},
 			),
+			Entry(
+				"a reserved custom option",
+				v1.DHCPOptions{Options: []v1.DHCPOption{{Code: 51, Value: "00000e10", Type: v1.DHCPOptionTypeHex}}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCP option 51 is managed by the DHCP server and cannot be set",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.options[0]",
+				}},
+			),
+			Entry(
+				"duplicate custom options",
+				v1.DHCPOptions{Options: []v1.DHCPOption{{Code: 66, Value: "tftp.example.com"}, {Code: 66, Value: "other"}}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueDuplicate",
+					Message: "DHCP option 66 is specified more than once",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.options[1].code",
+				}},
+			),
+			Entry(
+				"a classless route with an IPv6 destination",
+				v1.DHCPOptions{ClasslessRoutes: []v1.DHCPClasslessRoute{{Destination: "fd00::/64"}}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCP classless route destination \"fd00::/64\" is not a valid IPv4 CIDR",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.classlessRoutes[0]",
+				}},
+			),
+			Entry(
+				"an invalid search domain",
+				v1.DHCPOptions{DomainSearch: []string{"Not_A_Domain"}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCP domain search entry \"Not_A_Domain\" is not a valid domain name",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.domainSearch[0]",
+				}},
+			),
+			Entry(
+				"a too short lease time",
+				v1.DHCPOptions{LeaseTimeSeconds: pointer.P(uint32(10))},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCP lease time 10 is too short, must be at least 60 seconds",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.leaseTimeSeconds",
+				}},
+			),
+			Entry(
+				"non-IPv6 DHCPv6 NTP servers",
+				v1.DHCPOptions{V6: &v1.DHCPv6Options{NTPServers: []string{"10.0.0.1"}}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCPv6 NTP servers must be a list of valid IPv6 addresses.",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.v6.ntpServers[0]",
+				}},
+			),
 		)
 
 		DescribeTable("should accept interface DHCP options with", func(dhcpOpts v1.DHCPOptions) {
@@ -389,6 +497,15 @@ var _ = Describe("Validating VMI network spec", func() {
 					},
 				},
 			),
+			Entry("custom options, classless routes and lease time", v1.DHCPOptions{
+				Options:          []v1.DHCPOption{{Code: 66, Value: "tftp.example.com"}, {Code: 42, Value: "10.0.0.1", Type: v1.DHCPOptionTypeIPv4}},
+				ClasslessRoutes:  []v1.DHCPClasslessRoute{{Destination: "192.168.10.0/24", Gateway: "10.0.2.254"}},
+				DomainSearch:     []string{"example.com"},
+				LeaseTimeSeconds: pointer.P(uint32(3600)),
+			}),
+			Entry("DHCPv6 options", v1.DHCPOptions{
+				V6: &v1.DHCPv6Options{NTPServers: []string{"fd00::123"}, DomainSearch: []string{"example.com"}},
+			}),
 		)
 	})
 })
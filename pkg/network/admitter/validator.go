@@ -28,6 +28,7 @@ import (
 type clusterConfigChecker interface {
 	IsBridgeInterfaceOnPodNetworkEnabled() bool
 	PasstBindingEnabled() bool
+	GetNetworkBindings() map[string]v1.InterfaceBindingPlugin
 }
 
 type Validator struct {
@@ -57,6 +58,8 @@ func (v Validator) Validate() []metav1.StatusCause {
 	causes = append(causes, validateInterfaceNameUnique(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfacesAssignedToNetworks(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfacesFields(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfacesBandwidth(v.field, v.vmiSpec, v.configChecker)...)
+	causes = append(causes, validateInterfacesFirewall(v.field, v.vmiSpec)...)
 
 	return causes
 }
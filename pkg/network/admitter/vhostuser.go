@@ -0,0 +1,47 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+func validateVhostUserBinding(
+	fieldPath *field.Path, idx int, iface v1.Interface, spec *v1.VirtualMachineInstanceSpec, config clusterConfigChecker,
+) []metav1.StatusCause {
+	if !vmispec.HasBindingPluginVhostUser(iface, config.GetNetworkBindings()) {
+		return nil
+	}
+
+	// The vhost-user backend accesses the guest memory, which requires it to be shared and backed by huge pages
+	if spec.Domain.Memory == nil || spec.Domain.Memory.Hugepages == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "vhost-user interface binding requires the guest memory to be backed by huge pages",
+			Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("binding").String(),
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating vhost-user binding", func() {
+	const vhostUserPlugin = "vhostuser"
+
+	clusterConfig := stubClusterConfigChecker{
+		networkBindings: map[string]v1.InterfaceBindingPlugin{
+			vhostUserPlugin: {DomainAttachmentType: v1.VhostUser},
+		},
+	}
+
+	newVMISpec := func() *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{
+			libvmi.InterfaceWithBindingPlugin("dpdk", v1.PluginBinding{Name: vhostUserPlugin}),
+		}
+		spec.Networks = []v1.Network{*libvmi.MultusNetwork("dpdk", "ovs-dpdk")}
+		return spec
+	}
+
+	It("should reject a vhost-user interface without huge pages", func() {
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), newVMISpec(), clusterConfig)
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "vhost-user interface binding requires the guest memory to be backed by huge pages",
+			Field:   "fake.domain.devices.interfaces[0].binding",
+		}))
+	})
+
+	It("should accept a vhost-user interface with huge pages", func() {
+		spec := newVMISpec()
+		spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "1Gi"}}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,205 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package announce
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/ipam"
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/netns"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+const (
+	defaultRounds   = 3
+	defaultInterval = 100 * time.Millisecond
+)
+
+type sendFunc func(launcherPID int, linkName string, frames [][]byte) error
+
+// Announcer announces the guest addresses of the bridge bound secondary interfaces of a migrated VMI,
+// from the migration target virt-launcher pod. The announcements update the forwarding tables of the
+// network, and the neighbor caches of its peers, with the new location of the guest.
+type Announcer struct {
+	send     sendFunc
+	rounds   int
+	interval time.Duration
+}
+
+func New() *Announcer {
+	return NewWithOptions(sendInNetworkNamespace, defaultRounds, defaultInterval)
+}
+
+func NewWithOptions(send sendFunc, rounds int, interval time.Duration) *Announcer {
+	return &Announcer{
+		send:     send,
+		rounds:   rounds,
+		interval: interval,
+	}
+}
+
+type announcement struct {
+	linkName string
+	frames   [][]byte
+}
+
+// Announce sends the first round of announcements right away, and the following ones in the background,
+// for announcements lost while the network converges on the handoff.
+func (a *Announcer) Announce(vmi *v1.VirtualMachineInstance, launcherPID int) error {
+	announcements, err := announcementsForVMI(vmi)
+	if err != nil {
+		return err
+	}
+	if len(announcements) == 0 {
+		return nil
+	}
+
+	if err := a.sendRound(launcherPID, announcements); err != nil {
+		return err
+	}
+
+	if a.rounds > 1 {
+		go func() {
+			for round := 1; round < a.rounds; round++ {
+				time.Sleep(a.interval)
+				if err := a.sendRound(launcherPID, announcements); err != nil {
+					log.Log.Object(vmi).Reason(err).Warning("failed to announce the guest addresses")
+					return
+				}
+			}
+		}()
+	}
+	return nil
+}
+
+func (a *Announcer) sendRound(launcherPID int, announcements []announcement) error {
+	var sendErrs []error
+	for _, announcement := range announcements {
+		if err := a.send(launcherPID, announcement.linkName, announcement.frames); err != nil {
+			sendErrs = append(sendErrs, err)
+		}
+	}
+	return errors.Join(sendErrs...)
+}
+
+func announcementsForVMI(vmi *v1.VirtualMachineInstance) ([]announcement, error) {
+	allocations, err := ipam.AllocationsFromVMI(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	networks := vmispec.FilterMultusNonDefaultNetworks(vmi.Spec.Networks)
+	networksByName := vmispec.IndexNetworkSpecByName(networks)
+	ifaceStatusesByName := vmispec.IndexInterfaceStatusByName(vmi.Status.Interfaces, nil)
+
+	var announcements []announcement
+	for _, iface := range vmispec.FilterInterfacesByNetworks(vmi.Spec.Domain.Devices.Interfaces, networks) {
+		if iface.Bridge == nil || iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		ifaceStatus := ifaceStatusesByName[iface.Name]
+
+		mac, err := guestMAC(iface, ifaceStatus)
+		if err != nil {
+			return nil, err
+		}
+		if mac == nil {
+			continue
+		}
+
+		var frames [][]byte
+		for _, addr := range guestAddresses(ifaceStatus, ipam.LookupAllocationByNetwork(allocations, iface.Name)) {
+			if addr.Is4() {
+				frames = append(frames, GratuitousARP(mac, addr))
+			} else {
+				frames = append(frames, UnsolicitedNeighborAdvertisement(mac, addr))
+			}
+		}
+		if len(frames) == 0 {
+			continue
+		}
+
+		// The pod interface name is taken over by a dummy link, the bridge uplink is the renamed pod interface.
+		podIfaceName := namescheme.HashedPodInterfaceName(networksByName[iface.Name], vmi.Status.Interfaces)
+		announcements = append(announcements, announcement{
+			linkName: link.GenerateNewBridgedVmiInterfaceName(podIfaceName),
+			frames:   frames,
+		})
+	}
+	return announcements, nil
+}
+
+func guestMAC(iface v1.Interface, ifaceStatus v1.VirtualMachineInstanceNetworkInterface) (net.HardwareAddr, error) {
+	mac := ifaceStatus.MAC
+	if mac == "" {
+		mac = iface.MacAddress
+	}
+	if mac == "" {
+		return nil, nil
+	}
+	hwAddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAC address of interface %s: %w", iface.Name, err)
+	}
+	return hwAddr, nil
+}
+
+// guestAddresses returns the addresses reported for the guest interface, and the ones allocated to it.
+func guestAddresses(ifaceStatus v1.VirtualMachineInstanceNetworkInterface, allocation *v1.VirtualMachineIPAllocation) []netip.Addr {
+	var addrs []netip.Addr
+	seen := map[netip.Addr]struct{}{}
+	appendAddr := func(addr netip.Addr) {
+		addr = addr.Unmap()
+		if _, exists := seen[addr]; exists || addr.IsUnspecified() || addr.IsLoopback() || addr.IsMulticast() {
+			return
+		}
+		seen[addr] = struct{}{}
+		addrs = append(addrs, addr)
+	}
+
+	for _, ip := range ifaceStatus.IPs {
+		if addr, err := netip.ParseAddr(ip); err == nil {
+			appendAddr(addr)
+		}
+	}
+	if allocation != nil {
+		for _, cidr := range allocation.IPAddresses {
+			if prefix, err := netip.ParsePrefix(cidr); err == nil {
+				appendAddr(prefix.Addr())
+			}
+		}
+	}
+	return addrs
+}
+
+func sendInNetworkNamespace(launcherPID int, linkName string, frames [][]byte) error {
+	return netns.New(launcherPID).Do(func() error {
+		return SendFrames(linkName, frames)
+	})
+}
@@ -0,0 +1,198 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package announce_test
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/network/announce"
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+)
+
+const (
+	launcherPID = 1234
+
+	blueNetwork = "blue"
+	blueMAC     = "02:00:00:00:00:01"
+	redNetwork  = "red"
+	redMAC      = "02:00:00:00:00:02"
+)
+
+var _ = Describe("Announcer", func() {
+	var sender *frameRecorder
+
+	BeforeEach(func() {
+		sender = &frameRecorder{}
+	})
+
+	It("should announce the addresses of the bridge bound secondary interfaces", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(blueNetwork)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(blueNetwork, "blue-nad")),
+			libvmi.WithInterface(*libvmi.InterfaceWithMacvtapBindingPlugin(redNetwork)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(redNetwork, "red-nad")),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+					Name: v1.DefaultPodNetwork().Name, MAC: "02:00:00:00:00:00", IPs: []string{"10.244.0.10"},
+				}),
+				libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+					Name: blueNetwork, MAC: blueMAC, IPs: []string{"10.200.0.10", "fd10:200::10"},
+				}),
+				libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+					Name: redNetwork, MAC: redMAC, IPs: []string{"10.201.0.10"},
+				}),
+			)),
+		)
+
+		Expect(announce.NewWithOptions(sender.send, 1, 0).Announce(vmi, launcherPID)).To(Succeed())
+
+		blueLink := link.GenerateNewBridgedVmiInterfaceName(namescheme.GenerateHashedInterfaceName(blueNetwork))
+		mac, _ := net.ParseMAC(blueMAC)
+		Expect(sender.sent()).To(Equal([]sentFrames{{
+			launcherPID: launcherPID,
+			linkName:    blueLink,
+			frames: [][]byte{
+				announce.GratuitousARP(mac, netip.MustParseAddr("10.200.0.10")),
+				announce.UnsolicitedNeighborAdvertisement(mac, netip.MustParseAddr("fd10:200::10")),
+			},
+		}}))
+	})
+
+	It("should announce the allocated addresses, the spec MAC is used until the status reports one", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(*libvmi.InterfaceWithMac(pointerTo(libvmi.InterfaceDeviceWithBridgeBinding(blueNetwork)), blueMAC)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(blueNetwork, "blue-nad")),
+			libvmi.WithAnnotation(v1.IPAllocationsAnnotation,
+				`[{"network":"blue","networkAttachmentDefinition":"default/blue-nad","ipAddresses":["10.200.0.10/24"]}]`),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+					Name: blueNetwork, IPs: []string{"10.200.0.10", "fe80::1"},
+				}),
+			)),
+		)
+
+		Expect(announce.NewWithOptions(sender.send, 1, 0).Announce(vmi, launcherPID)).To(Succeed())
+
+		mac, _ := net.ParseMAC(blueMAC)
+		Expect(sender.sent()).To(HaveLen(1))
+		Expect(sender.sent()[0].frames).To(Equal([][]byte{
+			announce.GratuitousARP(mac, netip.MustParseAddr("10.200.0.10")),
+			announce.UnsolicitedNeighborAdvertisement(mac, netip.MustParseAddr("fe80::1")),
+		}))
+	})
+
+	DescribeTable("should not announce", func(iface v1.Interface, ifaceStatus v1.VirtualMachineInstanceNetworkInterface) {
+		vmi := libvmi.New(
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(libvmi.MultusNetwork(blueNetwork, "blue-nad")),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithInterfaceStatus(ifaceStatus))),
+		)
+
+		Expect(announce.NewWithOptions(sender.send, 1, 0).Announce(vmi, launcherPID)).To(Succeed())
+		Expect(sender.sent()).To(BeEmpty())
+	},
+		Entry("interfaces without addresses",
+			libvmi.InterfaceDeviceWithBridgeBinding(blueNetwork),
+			v1.VirtualMachineInstanceNetworkInterface{Name: blueNetwork, MAC: blueMAC},
+		),
+		Entry("interfaces without a MAC address",
+			libvmi.InterfaceDeviceWithBridgeBinding(blueNetwork),
+			v1.VirtualMachineInstanceNetworkInterface{Name: blueNetwork, IPs: []string{"10.200.0.10"}},
+		),
+		Entry("unplugged interfaces",
+			v1.Interface{
+				Name:                   blueNetwork,
+				State:                  v1.InterfaceStateAbsent,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			},
+			v1.VirtualMachineInstanceNetworkInterface{Name: blueNetwork, MAC: blueMAC, IPs: []string{"10.200.0.10"}},
+		),
+	)
+
+	It("should fail when the first announcements cannot be sent", func() {
+		sender.err = errors.New("link not found")
+
+		Expect(announce.NewWithOptions(sender.send, 3, time.Millisecond).Announce(newBlueVMI(), launcherPID)).To(MatchError("link not found"))
+		Consistently(sender.sent, 20*time.Millisecond, time.Millisecond).Should(HaveLen(1))
+	})
+
+	It("should repeat the announcements in the background", func() {
+		Expect(announce.NewWithOptions(sender.send, 3, 5*time.Millisecond).Announce(newBlueVMI(), launcherPID)).To(Succeed())
+
+		Expect(sender.sent()).To(HaveLen(1), "the first round should be sent right away")
+		Eventually(sender.sent).Should(HaveLen(3))
+		Consistently(sender.sent, 30*time.Millisecond, 5*time.Millisecond).Should(HaveLen(3))
+	})
+})
+
+func newBlueVMI() *v1.VirtualMachineInstance {
+	return libvmi.New(
+		libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(blueNetwork)),
+		libvmi.WithNetwork(libvmi.MultusNetwork(blueNetwork, "blue-nad")),
+		libvmistatus.WithStatus(libvmistatus.New(
+			libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+				Name: blueNetwork, MAC: blueMAC, IPs: []string{"10.200.0.10"},
+			}),
+		)),
+	)
+}
+
+func pointerTo(iface v1.Interface) *v1.Interface {
+	return &iface
+}
+
+type sentFrames struct {
+	launcherPID int
+	linkName    string
+	frames      [][]byte
+}
+
+type frameRecorder struct {
+	mu      sync.Mutex
+	records []sentFrames
+	err     error
+}
+
+func (r *frameRecorder) send(launcherPID int, linkName string, frames [][]byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, sentFrames{launcherPID: launcherPID, linkName: linkName, frames: frames})
+	return r.err
+}
+
+func (r *frameRecorder) sent() []sentFrames {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]sentFrames(nil), r.records...)
+}
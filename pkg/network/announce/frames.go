@@ -0,0 +1,134 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package announce
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+)
+
+const (
+	etherTypeARP  = 0x0806
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86dd
+
+	ethernetHeaderLen = 14
+	arpLen            = 28
+	ipv6HeaderLen     = 40
+	// The neighbor advertisement message, followed by the target link-layer address option.
+	neighborAdvertLen = 24 + 8
+
+	arpHardwareEthernet = 1
+	arpOpRequest        = 1
+
+	protocolICMPv6              = 58
+	icmpv6NeighborAdvertisement = 136
+	ndpOptionTargetLinkAddr     = 2
+	// The override flag makes the receivers replace their cached link-layer address.
+	neighborAdvertOverrideFlag = 0x20
+
+	// Neighbor discovery messages are dropped by the receivers unless their hop limit is 255.
+	ndpHopLimit = 255
+)
+
+var (
+	broadcastMAC      = net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	allNodesMulticast = netip.MustParseAddr("ff02::1")
+	// The Ethernet address of the all-nodes multicast group.
+	allNodesMAC = net.HardwareAddr{0x33, 0x33, 0x00, 0x00, 0x00, 0x01}
+)
+
+// GratuitousARP returns the Ethernet frame of a gratuitous ARP request, announcing the IPv4 address is at the MAC.
+func GratuitousARP(mac net.HardwareAddr, ip netip.Addr) []byte {
+	frame := make([]byte, ethernetHeaderLen+arpLen)
+	putEthernetHeader(frame, broadcastMAC, mac, etherTypeARP)
+
+	arp := frame[ethernetHeaderLen:]
+	binary.BigEndian.PutUint16(arp[0:2], arpHardwareEthernet)
+	binary.BigEndian.PutUint16(arp[2:4], etherTypeIPv4)
+	arp[4] = 6
+	arp[5] = 4
+	binary.BigEndian.PutUint16(arp[6:8], arpOpRequest)
+	copy(arp[8:14], mac)
+	ip4 := ip.As4()
+	copy(arp[14:18], ip4[:])
+	// The target hardware address is left zeroed, the target protocol address is the announced one.
+	copy(arp[24:28], ip4[:])
+	return frame
+}
+
+// UnsolicitedNeighborAdvertisement returns the Ethernet frame of an unsolicited neighbor advertisement,
+// sent to all nodes, announcing the IPv6 address is at the MAC.
+func UnsolicitedNeighborAdvertisement(mac net.HardwareAddr, ip netip.Addr) []byte {
+	frame := make([]byte, ethernetHeaderLen+ipv6HeaderLen+neighborAdvertLen)
+	putEthernetHeader(frame, allNodesMAC, mac, etherTypeIPv6)
+
+	ipv6 := frame[ethernetHeaderLen:]
+	ipv6[0] = 6 << 4
+	binary.BigEndian.PutUint16(ipv6[4:6], neighborAdvertLen)
+	ipv6[6] = protocolICMPv6
+	ipv6[7] = ndpHopLimit
+	src := ip.As16()
+	dst := allNodesMulticast.As16()
+	copy(ipv6[8:24], src[:])
+	copy(ipv6[24:40], dst[:])
+
+	na := ipv6[ipv6HeaderLen:]
+	na[0] = icmpv6NeighborAdvertisement
+	na[4] = neighborAdvertOverrideFlag
+	copy(na[8:24], src[:])
+	na[24] = ndpOptionTargetLinkAddr
+	na[25] = 1 // The option length, in units of 8 bytes.
+	copy(na[26:32], mac)
+	binary.BigEndian.PutUint16(na[2:4], icmpv6Checksum(src, dst, na))
+	return frame
+}
+
+func putEthernetHeader(frame []byte, dst, src net.HardwareAddr, etherType uint16) {
+	copy(frame[0:6], dst)
+	copy(frame[6:12], src)
+	binary.BigEndian.PutUint16(frame[12:14], etherType)
+}
+
+// icmpv6Checksum returns the checksum of the ICMPv6 message, covering the IPv6 pseudo header.
+func icmpv6Checksum(src, dst [16]byte, message []byte) uint16 {
+	var sum uint32
+	add := func(b []byte) {
+		for i := 0; i+1 < len(b); i += 2 {
+			sum += uint32(binary.BigEndian.Uint16(b[i : i+2]))
+		}
+		if len(b)%2 == 1 {
+			sum += uint32(b[len(b)-1]) << 8
+		}
+	}
+	add(src[:])
+	add(dst[:])
+	var pseudoTail [8]byte
+	binary.BigEndian.PutUint32(pseudoTail[0:4], uint32(len(message)))
+	pseudoTail[7] = protocolICMPv6
+	add(pseudoTail[:])
+	add(message)
+
+	for sum>>16 != 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return ^uint16(sum)
+}
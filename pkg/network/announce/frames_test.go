@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package announce_test
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/announce"
+)
+
+var _ = Describe("Announcement frames", func() {
+	mac := net.HardwareAddr{0x02, 0x00, 0x00, 0xaa, 0xbb, 0xcc}
+
+	It("should build a gratuitous ARP request", func() {
+		frame := announce.GratuitousARP(mac, netip.MustParseAddr("10.200.0.10"))
+
+		Expect(frame).To(HaveLen(42))
+		Expect(net.HardwareAddr(frame[0:6]).String()).To(Equal("ff:ff:ff:ff:ff:ff"))
+		Expect(net.HardwareAddr(frame[6:12])).To(Equal(mac))
+		Expect(binary.BigEndian.Uint16(frame[12:14])).To(BeEquivalentTo(0x0806))
+
+		arp := frame[14:]
+		Expect(arp[0:8]).To(Equal([]byte{0, 1, 0x08, 0, 6, 4, 0, 1}), "Ethernet/IPv4 request")
+		Expect(net.HardwareAddr(arp[8:14])).To(Equal(mac), "sender hardware address")
+		Expect(net.IP(arp[14:18]).String()).To(Equal("10.200.0.10"), "sender protocol address")
+		Expect(arp[18:24]).To(Equal(make([]byte, 6)), "target hardware address")
+		Expect(net.IP(arp[24:28]).String()).To(Equal("10.200.0.10"), "target protocol address")
+	})
+
+	It("should build an unsolicited neighbor advertisement", func() {
+		frame := announce.UnsolicitedNeighborAdvertisement(mac, netip.MustParseAddr("fd10:200::10"))
+
+		Expect(frame).To(HaveLen(86))
+		Expect(net.HardwareAddr(frame[0:6]).String()).To(Equal("33:33:00:00:00:01"))
+		Expect(net.HardwareAddr(frame[6:12])).To(Equal(mac))
+		Expect(binary.BigEndian.Uint16(frame[12:14])).To(BeEquivalentTo(0x86dd))
+
+		ipv6 := frame[14:54]
+		Expect(ipv6[0] >> 4).To(BeEquivalentTo(6))
+		Expect(binary.BigEndian.Uint16(ipv6[4:6])).To(BeEquivalentTo(32), "payload length")
+		Expect(ipv6[6]).To(BeEquivalentTo(58), "next header")
+		Expect(ipv6[7]).To(BeEquivalentTo(255), "hop limit")
+		Expect(net.IP(ipv6[8:24]).String()).To(Equal("fd10:200::10"))
+		Expect(net.IP(ipv6[24:40]).String()).To(Equal("ff02::1"))
+
+		na := frame[54:]
+		Expect(na[0]).To(BeEquivalentTo(136), "neighbor advertisement type")
+		Expect(na[1]).To(BeZero(), "code")
+		Expect(na[4]).To(BeEquivalentTo(0x20), "only the override flag is set")
+		Expect(net.IP(na[8:24]).String()).To(Equal("fd10:200::10"), "target address")
+		Expect(na[24:26]).To(Equal([]byte{2, 1}), "target link-layer address option")
+		Expect(net.HardwareAddr(na[26:32])).To(Equal(mac))
+		Expect(onesComplementSum(ipv6[8:24], ipv6[24:40], []byte{0, 0, 0, 32, 0, 0, 0, 58}, na)).To(BeEquivalentTo(0xffff),
+			"the checksum should cover the pseudo header and the message")
+	})
+})
+
+func onesComplementSum(chunks ...[]byte) uint16 {
+	var sum uint32
+	for _, chunk := range chunks {
+		for i := 0; i+1 < len(chunk); i += 2 {
+			sum += uint32(binary.BigEndian.Uint16(chunk[i : i+2]))
+		}
+	}
+	for sum>>16 != 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return uint16(sum)
+}
@@ -0,0 +1,52 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package announce
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+// SendFrames sends the Ethernet frames on the link in the current network namespace.
+func SendFrames(linkName string, frames [][]byte) error {
+	link, err := net.InterfaceByName(linkName)
+	if err != nil {
+		return fmt.Errorf("failed to find link %s: %w", linkName, err)
+	}
+
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open packet socket: %w", err)
+	}
+	defer unix.Close(fd)
+
+	var sendErrs []error
+	for _, frame := range frames {
+		addr := &unix.SockaddrLinklayer{Ifindex: link.Index, Halen: 6}
+		copy(addr.Addr[:], frame[0:6])
+		if err := unix.Sendto(fd, frame, 0, addr); err != nil {
+			sendErrs = append(sendErrs, fmt.Errorf("failed to send frame on link %s: %w", linkName, err))
+		}
+	}
+	return errors.Join(sendErrs...)
+}
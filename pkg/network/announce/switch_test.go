@@ -0,0 +1,141 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package announce_test
+
+import (
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/announce"
+)
+
+// learningSwitch is a stand-in for the network the migrated VM is attached to:
+// it forwards the frames to the port the destination MAC was last seen on.
+type learningSwitch struct {
+	mu        sync.Mutex
+	fdb       map[string]string
+	learnedAt map[string]time.Time
+}
+
+func newLearningSwitch() *learningSwitch {
+	return &learningSwitch{fdb: map[string]string{}, learnedAt: map[string]time.Time{}}
+}
+
+func (s *learningSwitch) ingress(port string, frame []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	mac := net.HardwareAddr(frame[6:12]).String()
+	if s.fdb[mac] != port {
+		s.fdb[mac] = port
+		s.learnedAt[mac] = time.Now()
+	}
+}
+
+func (s *learningSwitch) portFor(mac string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fdb[mac]
+}
+
+// downtime is the time from the handoff until the traffic to the MAC is forwarded to the port.
+func (s *learningSwitch) downtime(mac, port string, handoff time.Time) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fdb[mac] != port {
+		return time.Duration(math.MaxInt64)
+	}
+	return s.learnedAt[mac].Sub(handoff)
+}
+
+var _ = Describe("Announcer on a learning switch", func() {
+	const (
+		sourcePort = "source"
+		targetPort = "target"
+
+		// Bounds the downtime of the guest traffic, from the handoff until the switch forwards it to the target.
+		// Without the announcement, it lasts until the switch entry ages out, or the guest sends traffic itself.
+		maxDowntime = 50 * time.Millisecond
+	)
+
+	var (
+		vmi    *v1.VirtualMachineInstance
+		fabric *learningSwitch
+	)
+
+	BeforeEach(func() {
+		vmi = newBlueVMI()
+		mac, _ := net.ParseMAC(blueMAC)
+		fabric = newLearningSwitch()
+
+		guestFrame := make([]byte, 60)
+		copy(guestFrame[0:6], net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+		copy(guestFrame[6:12], mac)
+		fabric.ingress(sourcePort, guestFrame)
+		Expect(fabric.portFor(blueMAC)).To(Equal(sourcePort))
+	})
+
+	// toTargetPort sends the announcements to the target port, dropping the first lostRounds rounds.
+	toTargetPort := func(lostRounds int) func(int, string, [][]byte) error {
+		var mu sync.Mutex
+		round := 0
+		return func(_ int, _ string, frames [][]byte) error {
+			mu.Lock()
+			defer mu.Unlock()
+			round++
+			if round <= lostRounds {
+				return nil
+			}
+			for _, frame := range frames {
+				fabric.ingress(targetPort, frame)
+			}
+			return nil
+		}
+	}
+
+	It("should bound the downtime of the guest traffic after the handoff", func() {
+		// The retries are far apart, only the announcement sent on the handoff can meet the bound.
+		const interval = time.Second
+
+		handoff := time.Now()
+		Expect(announce.NewWithOptions(toTargetPort(0), 3, interval).Announce(vmi, launcherPID)).To(Succeed())
+
+		Expect(fabric.portFor(blueMAC)).To(Equal(targetPort))
+		Expect(fabric.downtime(blueMAC, targetPort, handoff)).To(BeNumerically("<", maxDowntime))
+	})
+
+	It("should bound the downtime to the announcement interval when the first announcement is lost", func() {
+		const interval = 20 * time.Millisecond
+
+		handoff := time.Now()
+		Expect(announce.NewWithOptions(toTargetPort(1), 3, interval).Announce(vmi, launcherPID)).To(Succeed())
+
+		Eventually(fabric.portFor).WithArguments(blueMAC).WithTimeout(time.Second).Should(Equal(targetPort))
+		downtime := fabric.downtime(blueMAC, targetPort, handoff)
+		Expect(downtime).To(BeNumerically(">=", interval))
+		Expect(downtime).To(BeNumerically("<", interval+maxDowntime))
+	})
+})
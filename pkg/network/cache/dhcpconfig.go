@@ -99,6 +99,8 @@ type DHCPConfig struct {
 	IPAMDisabled        bool
 	Gateway             net.IP
 	Subdomain           string
+	// Nameservers are served instead of the pod nameservers when set.
+	Nameservers []net.IP
 }
 
 func (d DHCPConfig) String() string {
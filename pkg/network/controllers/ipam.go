@@ -0,0 +1,330 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sync"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/client-go/kubevirt"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/network/ipam"
+	"kubevirt.io/kubevirt/pkg/network/multus"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// IPAMController allocates addresses to the bridge bound secondary networks of a VM, from the IP pool
+// of their NetworkAttachmentDefinition. The allocations are stored in the VM status and handed to the VMI.
+// The addresses in use are collected from the status of all the VMs in the cluster, therefore an address
+// is released once the VM which holds it is deleted or the network is removed from it.
+type IPAMController struct {
+	clientset kubevirt.Interface
+	nadStore  cache.Store
+	vmStore   cache.Store
+
+	// reserved holds the addresses allocated to VMs, which are not yet reflected in the VM store.
+	// It is keyed by the NetworkAttachmentDefinition and address, and holds the VM key.
+	reserved map[reservationKey]string
+	// lock serializes the allocations from the pools and guards reserved.
+	lock sync.Mutex
+}
+
+type reservationKey struct {
+	nad  string
+	addr netip.Addr
+}
+
+const (
+	ipAllocationErrorReason = "IPAllocationError"
+)
+
+func NewIPAMController(clientset kubevirt.Interface, nadStore, vmStore cache.Store) *IPAMController {
+	return &IPAMController{
+		clientset: clientset,
+		nadStore:  nadStore,
+		vmStore:   vmStore,
+		reserved:  map[reservationKey]string{},
+	}
+}
+
+// Sync updates the VM status allocations to match its bridge bound secondary networks.
+// New addresses are reserved only once the VM status holds them, a failed status patch drops them.
+// When the VMI exists, the allocations are also handed to it, for hot plugged interfaces to get their address.
+func (c *IPAMController) Sync(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) (*v1.VirtualMachine, error) {
+	// The lock serializes the allocations from the pools, only the VMs which need a new address take it.
+	allocate, err := c.needsNewAllocations(vm)
+	if err != nil {
+		return vm, &syncError{err, ipAllocationErrorReason}
+	}
+	if allocate {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+	}
+
+	allocations, newReservations, err := c.desiredAllocations(vm, allocate)
+	if err != nil {
+		return vm, &syncError{err, ipAllocationErrorReason}
+	}
+
+	updatedVM := vm
+	if !equality.Semantic.DeepEqual(vm.Status.IPAllocations, allocations) {
+		updatedVM, err = c.vmAllocationsPatch(vm, allocations)
+		if err != nil {
+			return vm, &syncError{fmt.Errorf("error encountered when trying to patch VM IP allocations: %w", err), ipAllocationErrorReason}
+		}
+		vmKey := vm.Namespace + "/" + vm.Name
+		for _, key := range newReservations {
+			c.reserved[key] = vmKey
+		}
+	}
+
+	if vmi != nil && vmi.DeletionTimestamp == nil {
+		if err := c.vmiAllocationsPatch(vmi, allocations); err != nil {
+			return updatedVM, &syncError{fmt.Errorf("error encountered when trying to patch VMI IP allocations: %w", err), ipAllocationErrorReason}
+		}
+	}
+
+	return updatedVM, nil
+}
+
+// bridgeNetwork is a bridge bound secondary network of a VM, which may get an address from a pool.
+type bridgeNetwork struct {
+	name        string
+	networkName string
+	nadKey      string
+}
+
+func bridgeNetworks(vm *v1.VirtualMachine) []bridgeNetwork {
+	var networks []bridgeNetwork
+	networksByName := vmispec.IndexNetworkSpecByName(vm.Spec.Template.Spec.Networks)
+	for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		network, exists := networksByName[iface.Name]
+		if iface.Bridge == nil || iface.State == v1.InterfaceStateAbsent ||
+			!exists || network.Multus == nil || network.Multus.Default {
+			continue
+		}
+		networks = append(networks, bridgeNetwork{
+			name:        iface.Name,
+			networkName: network.Multus.NetworkName,
+			nadKey:      multus.NetAttachDefNamespacedName(vm.Namespace, network.Multus.NetworkName).String(),
+		})
+	}
+	return networks
+}
+
+// existingAllocation returns the allocation of the network in the VM status, when it is from its NetworkAttachmentDefinition.
+func existingAllocation(vm *v1.VirtualMachine, network bridgeNetwork) *v1.VirtualMachineIPAllocation {
+	if allocation := ipam.LookupAllocationByNetwork(vm.Status.IPAllocations, network.name); allocation != nil &&
+		allocation.NetworkAttachmentDefinition == network.nadKey {
+		return allocation
+	}
+	return nil
+}
+
+// needsNewAllocations tells whether a network of the VM has no allocation yet while its NetworkAttachmentDefinition has a pool.
+func (c *IPAMController) needsNewAllocations(vm *v1.VirtualMachine) (bool, error) {
+	for _, network := range bridgeNetworks(vm) {
+		if existingAllocation(vm, network) != nil {
+			continue
+		}
+		pool, err := c.lookupPool(vm.Namespace, network.networkName)
+		if err != nil {
+			return false, err
+		}
+		if pool != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// desiredAllocations returns the allocations of the VM networks.
+// New addresses are allocated only when allocate is set, which requires holding the lock.
+func (c *IPAMController) desiredAllocations(vm *v1.VirtualMachine, allocate bool) ([]v1.VirtualMachineIPAllocation, []reservationKey, error) {
+	var (
+		allocations     []v1.VirtualMachineIPAllocation
+		newReservations []reservationKey
+		addrsInUse      map[reservationKey]string
+	)
+	for _, network := range bridgeNetworks(vm) {
+		if allocation := existingAllocation(vm, network); allocation != nil {
+			allocations = append(allocations, *allocation)
+			continue
+		}
+		if !allocate {
+			continue
+		}
+
+		pool, err := c.lookupPool(vm.Namespace, network.networkName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if pool == nil {
+			continue
+		}
+
+		if addrsInUse == nil {
+			addrsInUse = c.addrsInUse()
+		}
+		cidr, err := pool.Allocate(func(addr netip.Addr) bool {
+			_, inUse := addrsInUse[reservationKey{nad: network.nadKey, addr: addr}]
+			return inUse
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to allocate an IP address to network %s from %s: %w", network.name, network.nadKey, err)
+		}
+		key := reservationKey{nad: network.nadKey, addr: netip.MustParsePrefix(cidr).Addr()}
+		addrsInUse[key] = vm.Namespace + "/" + vm.Name
+		newReservations = append(newReservations, key)
+		log.Log.Object(vm).Infof("allocated IP address %s to network %s from %s", cidr, network.name, network.nadKey)
+
+		allocations = append(allocations, v1.VirtualMachineIPAllocation{
+			Network:                     network.name,
+			NetworkAttachmentDefinition: network.nadKey,
+			IPAddresses:                 []string{cidr},
+			Gateway:                     pool.Gateway,
+			Nameservers:                 pool.Nameservers,
+		})
+	}
+	return allocations, newReservations, nil
+}
+
+// lookupPool returns the IP pool of the NetworkAttachmentDefinition, or nil when it has none.
+// A missing NetworkAttachmentDefinition is not an error, the VMI cannot start without it anyway.
+func (c *IPAMController) lookupPool(namespace, networkName string) (*ipam.Pool, error) {
+	nadName := multus.NetAttachDefNamespacedName(namespace, networkName)
+	obj, exists, err := c.nadStore.GetByKey(nadName.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network attachment definition %s: %w", nadName, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	nad := obj.(*networkv1.NetworkAttachmentDefinition)
+
+	poolValue, exists := nad.Annotations[ipam.PoolAnnotation]
+	if !exists {
+		return nil, nil
+	}
+	pool, err := ipam.ParsePool(poolValue)
+	if err != nil {
+		return nil, fmt.Errorf("network attachment definition %s: %w", nadName, err)
+	}
+	return pool, nil
+}
+
+// addrsInUse returns the addresses allocated to all the VMs.
+// Reservations which are reflected in the VM store, or whose VM no longer exists, are dropped.
+func (c *IPAMController) addrsInUse() map[reservationKey]string {
+	addrsInUse := map[reservationKey]string{}
+	for _, obj := range c.vmStore.List() {
+		vm := obj.(*v1.VirtualMachine)
+		for _, allocation := range vm.Status.IPAllocations {
+			for _, cidr := range allocation.IPAddresses {
+				if prefix, err := netip.ParsePrefix(cidr); err == nil {
+					addrsInUse[reservationKey{nad: allocation.NetworkAttachmentDefinition, addr: prefix.Addr()}] = vm.Namespace + "/" + vm.Name
+				}
+			}
+		}
+	}
+
+	for key, vmKey := range c.reserved {
+		_, vmExists, err := c.vmStore.GetByKey(vmKey)
+		if err == nil && (!vmExists || addrsInUse[key] == vmKey) {
+			delete(c.reserved, key)
+			continue
+		}
+		addrsInUse[key] = vmKey
+	}
+	return addrsInUse
+}
+
+func (c *IPAMController) vmAllocationsPatch(vm *v1.VirtualMachine, allocations []v1.VirtualMachineIPAllocation) (*v1.VirtualMachine, error) {
+	const allocationsPath = "/status/ipAllocations"
+	patchSet := patch.New()
+	if len(vm.Status.IPAllocations) == 0 {
+		patchSet.AddOption(
+			patch.WithTest(allocationsPath, nil),
+			patch.WithAdd(allocationsPath, allocations),
+		)
+	} else {
+		patchSet.AddOption(
+			patch.WithTest(allocationsPath, vm.Status.IPAllocations),
+			patch.WithReplace(allocationsPath, allocations),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.clientset.KubevirtV1().
+		VirtualMachines(vm.Namespace).
+		PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+}
+
+func (c *IPAMController) vmiAllocationsPatch(vmi *v1.VirtualMachineInstance, allocations []v1.VirtualMachineIPAllocation) error {
+	currentAllocations, err := ipam.AllocationsFromVMI(vmi)
+	if err == nil && equality.Semantic.DeepEqual(currentAllocations, allocations) {
+		return nil
+	}
+	// Addresses of running interfaces are kept, only allocations of new networks are handed to the VMI.
+	for _, allocation := range currentAllocations {
+		if ipam.LookupAllocationByNetwork(allocations, allocation.Network) == nil {
+			allocations = append(allocations, allocation)
+		}
+	}
+	if len(allocations) == 0 || equality.Semantic.DeepEqual(currentAllocations, allocations) {
+		return nil
+	}
+
+	value, err := ipam.AllocationsAnnotationValue(allocations)
+	if err != nil {
+		return err
+	}
+	patchSet := patch.New()
+	if vmi.Annotations == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{v1.IPAllocationsAnnotation: value}))
+	} else {
+		patchSet.AddOption(patch.WithAdd(
+			fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(v1.IPAllocationsAnnotation)), value),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.KubevirtV1().
+		VirtualMachineInstances(vmi.Namespace).
+		Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
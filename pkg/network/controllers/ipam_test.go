@@ -0,0 +1,267 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controllers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/controllers"
+	"kubevirt.io/kubevirt/pkg/network/ipam"
+)
+
+var _ = Describe("IPAM controller", func() {
+	const (
+		testNamespace = "default"
+		secondaryNet  = "secondary"
+		nadName       = "blue"
+		nadKey        = testNamespace + "/" + nadName
+	)
+
+	var (
+		clientset  *fake.Clientset
+		nadStore   cache.Store
+		vmStore    cache.Store
+		controller *controllers.IPAMController
+	)
+
+	createNAD := func(pool string) {
+		nad := &networkv1.NetworkAttachmentDefinition{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: nadName, Namespace: testNamespace},
+		}
+		if pool != "" {
+			nad.Annotations = map[string]string{ipam.PoolAnnotation: pool}
+		}
+		Expect(nadStore.Add(nad)).To(Succeed())
+	}
+
+	newVM := func(name string, iface v1.Interface) *v1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithName(name),
+			libvmi.WithNamespace(testNamespace),
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(libvmi.MultusNetwork(secondaryNet, nadName)),
+		))
+		_, err := clientset.KubevirtV1().VirtualMachines(testNamespace).Create(context.Background(), vm, k8smetav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return vm
+	}
+
+	BeforeEach(func() {
+		clientset = fake.NewSimpleClientset()
+		nadStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		vmStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		controller = controllers.NewIPAMController(clientset, nadStore, vmStore)
+	})
+
+	It("should not allocate when the network attachment definition has no pool", func() {
+		createNAD("")
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+
+		syncedVM, err := controller.Sync(vm, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syncedVM.Status.IPAllocations).To(BeEmpty())
+	})
+
+	It("should not allocate when the network attachment definition does not exist", func() {
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+
+		syncedVM, err := controller.Sync(vm, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syncedVM.Status.IPAllocations).To(BeEmpty())
+	})
+
+	It("should not allocate to non bridge bound interfaces", func() {
+		createNAD(`{"subnet": "10.10.0.0/24"}`)
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithSRIOVBinding(secondaryNet))
+
+		syncedVM, err := controller.Sync(vm, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syncedVM.Status.IPAllocations).To(BeEmpty())
+	})
+
+	It("should fail when the pool is invalid", func() {
+		createNAD(`{"subnet": "not-a-subnet"}`)
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+
+		_, err := controller.Sync(vm, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should allocate an address from the pool of the network attachment definition", func() {
+		createNAD(`{"subnet": "10.10.0.0/24", "gateway": "10.10.0.1", "nameservers": ["10.10.0.53"]}`)
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+
+		syncedVM, err := controller.Sync(vm, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syncedVM.Status.IPAllocations).To(ConsistOf(v1.VirtualMachineIPAllocation{
+			Network:                     secondaryNet,
+			NetworkAttachmentDefinition: nadKey,
+			IPAddresses:                 []string{"10.10.0.2/24"},
+			Gateway:                     "10.10.0.1",
+			Nameservers:                 []string{"10.10.0.53"},
+		}))
+	})
+
+	It("should keep an existing allocation", func() {
+		createNAD(`{"subnet": "10.10.0.0/24"}`)
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+		vm.Status.IPAllocations = []v1.VirtualMachineIPAllocation{{
+			Network:                     secondaryNet,
+			NetworkAttachmentDefinition: nadKey,
+			IPAddresses:                 []string{"10.10.0.100/24"},
+		}}
+
+		syncedVM, err := controller.Sync(vm, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syncedVM).To(BeIdenticalTo(vm))
+	})
+
+	It("should release the allocation of a removed network", func() {
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].State = v1.InterfaceStateAbsent
+		vm.Status.IPAllocations = []v1.VirtualMachineIPAllocation{{
+			Network:                     secondaryNet,
+			NetworkAttachmentDefinition: nadKey,
+			IPAddresses:                 []string{"10.10.0.100/24"},
+		}}
+		_, err := clientset.KubevirtV1().VirtualMachines(vm.Namespace).UpdateStatus(context.Background(), vm, k8smetav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		syncedVM, err := controller.Sync(vm, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syncedVM.Status.IPAllocations).To(BeEmpty())
+	})
+
+	It("should not allocate an address in use by another VM", func() {
+		createNAD(`{"subnet": "10.10.0.0/24"}`)
+		otherVM := newVM("vm0", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+		otherVM.Status.IPAllocations = []v1.VirtualMachineIPAllocation{{
+			Network:                     secondaryNet,
+			NetworkAttachmentDefinition: nadKey,
+			IPAddresses:                 []string{"10.10.0.1/24"},
+		}}
+		Expect(vmStore.Add(otherVM)).To(Succeed())
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+
+		syncedVM, err := controller.Sync(vm, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syncedVM.Status.IPAllocations[0].IPAddresses).To(ConsistOf("10.10.0.2/24"))
+	})
+
+	It("should not allocate an address reserved for a VM not yet updated in the store", func() {
+		createNAD(`{"subnet": "10.10.0.0/24"}`)
+		vm1 := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+		Expect(vmStore.Add(vm1)).To(Succeed())
+		vm2 := newVM("vm2", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+
+		syncedVM1, err := controller.Sync(vm1, nil)
+		Expect(err).NotTo(HaveOccurred())
+		syncedVM2, err := controller.Sync(vm2, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(syncedVM1.Status.IPAllocations[0].IPAddresses).To(ConsistOf("10.10.0.1/24"))
+		Expect(syncedVM2.Status.IPAllocations[0].IPAddresses).To(ConsistOf("10.10.0.2/24"))
+	})
+
+	It("should store the allocations in the VM status", func() {
+		createNAD(`{"subnet": "10.10.0.0/24"}`)
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+
+		syncedVM, err := controller.Sync(vm, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		updatedVM, err := clientset.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.Background(), vm.Name, k8smetav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updatedVM.Status.IPAllocations).To(Equal(syncedVM.Status.IPAllocations))
+	})
+
+	It("should not reserve the address when the VM status patch fails", func() {
+		createNAD(`{"subnet": "10.10.0.0/24"}`)
+		vm1 := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+		Expect(vmStore.Add(vm1)).To(Succeed())
+		vm2 := newVM("vm2", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+
+		injectedPatchError := errors.New("test patch error")
+		clientset.Fake.PrependReactor("patch", "virtualmachines",
+			func(action testing.Action) (bool, runtime.Object, error) {
+				patchAction := action.(testing.PatchAction)
+				if patchAction.GetName() == vm1.Name {
+					return true, nil, injectedPatchError
+				}
+				return false, nil, nil
+			})
+
+		_, err := controller.Sync(vm1, nil)
+		Expect(err).To(MatchError(ContainSubstring(injectedPatchError.Error())))
+
+		syncedVM2, err := controller.Sync(vm2, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syncedVM2.Status.IPAllocations[0].IPAddresses).To(ConsistOf("10.10.0.1/24"))
+	})
+
+	It("should fail when the pool is exhausted", func() {
+		createNAD(`{"subnet": "10.10.0.0/30"}`)
+		vm1 := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+		Expect(vmStore.Add(vm1)).To(Succeed())
+		vm2 := newVM("vm2", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+		Expect(vmStore.Add(vm2)).To(Succeed())
+		vm3 := newVM("vm3", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+
+		_, err := controller.Sync(vm1, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = controller.Sync(vm2, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = controller.Sync(vm3, nil)
+		Expect(err).To(MatchError(ContainSubstring(ipam.ErrPoolExhausted.Error())))
+	})
+
+	It("should hand the allocations to the running VMI", func() {
+		createNAD(`{"subnet": "10.10.0.0/24"}`)
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+		vmi := libvmi.New(libvmi.WithName(vm.Name), libvmi.WithNamespace(vm.Namespace))
+		_, err := clientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, k8smetav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		syncedVM, err := controller.Sync(vm, vmi)
+		Expect(err).NotTo(HaveOccurred())
+
+		updatedVMI, err := clientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, k8smetav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		var vmiAllocations []v1.VirtualMachineIPAllocation
+		Expect(json.Unmarshal([]byte(updatedVMI.Annotations[v1.IPAllocationsAnnotation]), &vmiAllocations)).To(Succeed())
+		Expect(vmiAllocations).To(Equal(syncedVM.Status.IPAllocations))
+	})
+})
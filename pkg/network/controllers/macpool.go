@@ -0,0 +1,190 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/client-go/kubevirt"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+type macPoolConfigurer interface {
+	GetMACPoolConfiguration() *v1.MACPoolConfiguration
+}
+
+// MACPoolController assigns MAC addresses from the configured pool to the VM interfaces which do not specify one.
+// The addresses in use are collected from all the VMs and VMIs in the cluster, therefore an address
+// is released once the VM (and its VMI) which holds it is deleted.
+type MACPoolController struct {
+	clientset         kubevirt.Interface
+	clusterConfigurer macPoolConfigurer
+	vmStore           cache.Store
+	vmiStore          cache.Store
+
+	// reserved holds the addresses allocated to VMs, which are not yet reflected in the VM store.
+	// It is keyed by the address and holds the VM key.
+	reserved map[string]string
+	lock     sync.Mutex
+}
+
+const (
+	macAllocationErrorReason = "MACAllocationError"
+)
+
+func NewMACPoolController(
+	clientset kubevirt.Interface, clusterConfigurer macPoolConfigurer, vmStore, vmiStore cache.Store,
+) *MACPoolController {
+	return &MACPoolController{
+		clientset:         clientset,
+		clusterConfigurer: clusterConfigurer,
+		vmStore:           vmStore,
+		vmiStore:          vmiStore,
+		reserved:          map[string]string{},
+	}
+}
+
+// Sync allocates addresses to the VM template interfaces without a MAC address.
+// Interfaces which already exist in the VMI are left untouched, changing them would require a restart.
+func (m *MACPoolController) Sync(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) (*v1.VirtualMachine, error) {
+	ranges := macpool.RangesForNamespace(m.clusterConfigurer.GetMACPoolConfiguration(), vm.Namespace)
+	if len(ranges) == 0 {
+		return vm, nil
+	}
+
+	var vmiIfacesByName map[string]v1.Interface
+	if vmi != nil {
+		vmiIfacesByName = vmispec.IndexInterfaceSpecByName(vmi.Spec.Domain.Devices.Interfaces)
+	}
+	var ifaceIndexesToAllocate []int
+	for idx, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		if _, existsInVMI := vmiIfacesByName[iface.Name]; iface.MacAddress == "" && !existsInVMI && iface.State != v1.InterfaceStateAbsent {
+			ifaceIndexesToAllocate = append(ifaceIndexesToAllocate, idx)
+		}
+	}
+	if len(ifaceIndexesToAllocate) == 0 {
+		return vm, nil
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	vmKey := vm.Namespace + "/" + vm.Name
+	macsInUse := m.macsInUse()
+	updatedIfaces := make([]v1.Interface, len(vm.Spec.Template.Spec.Domain.Devices.Interfaces))
+	copy(updatedIfaces, vm.Spec.Template.Spec.Domain.Devices.Interfaces)
+	var allocated []string
+	for _, idx := range ifaceIndexesToAllocate {
+		mac, err := macpool.Allocate(ranges, func(mac string) bool {
+			_, exists := macsInUse[mac]
+			return exists
+		})
+		if err != nil {
+			m.release(allocated)
+			return vm, &syncError{fmt.Errorf("failed to allocate a MAC address to interface %s: %w", updatedIfaces[idx].Name, err), macAllocationErrorReason}
+		}
+		macsInUse[mac] = vmKey
+		allocated = append(allocated, mac)
+		updatedIfaces[idx].MacAddress = mac
+	}
+
+	updatedVM, err := m.vmInterfacesPatch(vm, updatedIfaces)
+	if err != nil {
+		m.release(allocated)
+		return vm, &syncError{fmt.Errorf("error encountered when trying to patch VM interfaces MAC address: %w", err), macAllocationErrorReason}
+	}
+	for _, mac := range allocated {
+		m.reserved[mac] = vmKey
+	}
+	log.Log.Object(vm).Infof("allocated MAC addresses %v from the MAC pool", allocated)
+
+	return updatedVM, nil
+}
+
+// macsInUse returns the addresses used by all the VMs and VMIs, keyed by address.
+// Reservations which are reflected in the VM store, or whose VM no longer exists, are dropped.
+func (m *MACPoolController) macsInUse() map[string]string {
+	macsInUse := map[string]string{}
+	addMAC := func(mac, owner string) {
+		if normalizedMAC, err := macpool.Normalize(mac); err == nil {
+			macsInUse[normalizedMAC] = owner
+		}
+	}
+
+	for _, obj := range m.vmStore.List() {
+		vm := obj.(*v1.VirtualMachine)
+		for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+			addMAC(iface.MacAddress, vm.Namespace+"/"+vm.Name)
+		}
+	}
+	for _, obj := range m.vmiStore.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+			addMAC(iface.MacAddress, vmi.Namespace+"/"+vmi.Name)
+		}
+		for _, ifaceStatus := range vmi.Status.Interfaces {
+			addMAC(ifaceStatus.MAC, vmi.Namespace+"/"+vmi.Name)
+		}
+	}
+
+	for mac, vmKey := range m.reserved {
+		_, vmExists, err := m.vmStore.GetByKey(vmKey)
+		if err == nil && (!vmExists || macsInUse[mac] == vmKey) {
+			delete(m.reserved, mac)
+			continue
+		}
+		macsInUse[mac] = vmKey
+	}
+
+	return macsInUse
+}
+
+func (m *MACPoolController) release(macs []string) {
+	for _, mac := range macs {
+		delete(m.reserved, mac)
+	}
+}
+
+func (m *MACPoolController) vmInterfacesPatch(vm *v1.VirtualMachine, updatedIfaces []v1.Interface) (*v1.VirtualMachine, error) {
+	const ifacesPath = "/spec/template/spec/domain/devices/interfaces"
+	patchBytes, err := patch.New(
+		patch.WithTest(ifacesPath, vm.Spec.Template.Spec.Domain.Devices.Interfaces),
+		patch.WithReplace(ifacesPath, updatedIfaces),
+	).GeneratePayload()
+	if err != nil {
+		return nil, err
+	}
+
+	return m.clientset.KubevirtV1().
+		VirtualMachines(vm.Namespace).
+		Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+}
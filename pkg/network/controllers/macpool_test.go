@@ -0,0 +1,220 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controllers_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/client-go/kubevirt/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/controllers"
+)
+
+var _ = Describe("MAC pool controller", func() {
+	const (
+		testNamespace = "default"
+		secondaryNet  = "secondary"
+	)
+
+	var (
+		clientset *fake.Clientset
+		vmStore   cache.Store
+		vmiStore  cache.Store
+		config    stubMACPoolConfigurer
+	)
+
+	newVM := func(name string, ifaces ...v1.Interface) *v1.VirtualMachine {
+		opts := []libvmi.Option{libvmi.WithName(name), libvmi.WithNamespace(testNamespace)}
+		for i := range ifaces {
+			opts = append(opts, libvmi.WithInterface(ifaces[i]))
+		}
+		return libvmi.NewVirtualMachine(libvmi.New(opts...))
+	}
+
+	createVM := func(vm *v1.VirtualMachine) {
+		_, err := clientset.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, k8smetav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vmStore.Add(vm)).To(Succeed())
+	}
+
+	ifaceMACs := func(vm *v1.VirtualMachine) []string {
+		var macs []string
+		for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+			macs = append(macs, iface.MacAddress)
+		}
+		return macs
+	}
+
+	BeforeEach(func() {
+		clientset = fake.NewSimpleClientset()
+		vmStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		config = stubMACPoolConfigurer{config: &v1.MACPoolConfiguration{
+			Ranges: []v1.MACRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:03"}},
+		}}
+	})
+
+	It("does nothing when the MAC pool is not configured", func() {
+		c := controllers.NewMACPoolController(clientset, stubMACPoolConfigurer{}, vmStore, vmiStore)
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithMasqueradeBinding())
+		Expect(c.Sync(vm, nil)).To(Equal(vm))
+	})
+
+	It("does nothing when the namespace is opted out", func() {
+		config.config.NamespaceOverrides = []v1.NamespaceMACPool{{Namespace: testNamespace}}
+		c := controllers.NewMACPoolController(clientset, config, vmStore, vmiStore)
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithMasqueradeBinding())
+		Expect(c.Sync(vm, nil)).To(Equal(vm))
+	})
+
+	It("allocates free addresses to the interfaces without a MAC address", func() {
+		otherVM := newVM("vm0", withMAC(libvmi.InterfaceDeviceWithMasqueradeBinding(), "02:00:00:00:00:00"))
+		createVM(otherVM)
+		otherVMI := libvmi.New(libvmi.WithName("vmi0"), libvmi.WithNamespace(testNamespace))
+		otherVMI.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{MAC: "02:00:00:00:00:01"}}
+		Expect(vmiStore.Add(otherVMI)).To(Succeed())
+
+		vm := newVM("vm1",
+			libvmi.InterfaceDeviceWithMasqueradeBinding(),
+			withMAC(libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet), "02:AA:00:00:00:01"),
+			libvmi.InterfaceDeviceWithBridgeBinding("third"),
+		)
+		createVM(vm)
+
+		c := controllers.NewMACPoolController(clientset, config, vmStore, vmiStore)
+		updatedVM, err := c.Sync(vm, nil)
+		Expect(err).NotTo(HaveOccurred())
+		expectedMACs := []string{"02:00:00:00:00:02", "02:AA:00:00:00:01", "02:00:00:00:00:03"}
+		Expect(ifaceMACs(updatedVM)).To(Equal(expectedMACs))
+
+		persistedVM, err := clientset.KubevirtV1().VirtualMachines(testNamespace).Get(context.Background(), vm.Name, k8smetav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ifaceMACs(persistedVM)).To(Equal(expectedMACs))
+	})
+
+	It("does not allocate addresses to interfaces which exist in the VMI", func() {
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithMasqueradeBinding(), libvmi.InterfaceDeviceWithBridgeBinding(secondaryNet))
+		createVM(vm)
+		vmi := libvmi.New(libvmi.WithName(vm.Name), libvmi.WithNamespace(testNamespace),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+		)
+
+		c := controllers.NewMACPoolController(clientset, config, vmStore, vmiStore)
+		updatedVM, err := c.Sync(vm, vmi)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ifaceMACs(updatedVM)).To(Equal([]string{"", "02:00:00:00:00:00"}))
+	})
+
+	It("does not allocate an address reserved for another VM which is not yet updated in the store", func() {
+		vm1 := newVM("vm1", libvmi.InterfaceDeviceWithMasqueradeBinding())
+		createVM(vm1)
+		vm2 := newVM("vm2", libvmi.InterfaceDeviceWithMasqueradeBinding())
+		createVM(vm2)
+
+		c := controllers.NewMACPoolController(clientset, config, vmStore, vmiStore)
+		updatedVM1, err := c.Sync(vm1, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ifaceMACs(updatedVM1)).To(Equal([]string{"02:00:00:00:00:00"}))
+
+		updatedVM2, err := c.Sync(vm2, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ifaceMACs(updatedVM2)).To(Equal([]string{"02:00:00:00:00:01"}))
+	})
+
+	It("releases the address of a deleted VM", func() {
+		vm1 := newVM("vm1", libvmi.InterfaceDeviceWithMasqueradeBinding())
+		createVM(vm1)
+
+		c := controllers.NewMACPoolController(clientset, config, vmStore, vmiStore)
+		updatedVM1, err := c.Sync(vm1, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vmStore.Update(updatedVM1)).To(Succeed())
+		Expect(vmStore.Delete(updatedVM1)).To(Succeed())
+
+		vm2 := newVM("vm2", libvmi.InterfaceDeviceWithMasqueradeBinding())
+		createVM(vm2)
+		updatedVM2, err := c.Sync(vm2, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ifaceMACs(updatedVM2)).To(Equal([]string{"02:00:00:00:00:00"}))
+	})
+
+	It("fails when the pool is exhausted", func() {
+		config.config.Ranges = []v1.MACRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:00"}}
+		createVM(newVM("vm0", withMAC(libvmi.InterfaceDeviceWithMasqueradeBinding(), "02:00:00:00:00:00")))
+		vm := newVM("vm1", libvmi.InterfaceDeviceWithMasqueradeBinding())
+		createVM(vm)
+
+		c := controllers.NewMACPoolController(clientset, config, vmStore, vmiStore)
+		_, err := c.Sync(vm, nil)
+		Expect(err).To(MatchError(ContainSubstring("MAC pool is exhausted")))
+		var errWithReason interface{ Reason() string }
+		Expect(errors.As(err, &errWithReason)).To(BeTrue())
+		Expect(errWithReason.Reason()).To(Equal("MACAllocationError"))
+	})
+
+	It("releases the allocated addresses when the VM patch fails", func() {
+		vm1 := newVM("vm1", libvmi.InterfaceDeviceWithMasqueradeBinding())
+		createVM(vm1)
+		vm2 := newVM("vm2", libvmi.InterfaceDeviceWithMasqueradeBinding())
+		createVM(vm2)
+
+		injectedPatchError := errors.New("test patch error")
+		clientset.Fake.PrependReactor("patch", "virtualmachines",
+			func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+				if action.(testing.PatchAction).GetName() == vm1.Name {
+					return true, nil, injectedPatchError
+				}
+				return false, nil, nil
+			})
+
+		c := controllers.NewMACPoolController(clientset, config, vmStore, vmiStore)
+		_, err := c.Sync(vm1, nil)
+		Expect(err).To(MatchError(ContainSubstring(injectedPatchError.Error())))
+
+		updatedVM2, err := c.Sync(vm2, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ifaceMACs(updatedVM2)).To(Equal([]string{"02:00:00:00:00:00"}))
+	})
+})
+
+type stubMACPoolConfigurer struct {
+	config *v1.MACPoolConfiguration
+}
+
+func (s stubMACPoolConfigurer) GetMACPoolConfiguration() *v1.MACPoolConfiguration {
+	return s.config
+}
+
+func withMAC(iface v1.Interface, mac string) v1.Interface {
+	iface.MacAddress = mac
+	return iface
+}
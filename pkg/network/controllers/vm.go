@@ -174,6 +174,10 @@ func applyDynamicIfaceRequestOnVMI(
 			vmIface.State != vmiIfaceCopy.State &&
 			vmiIfaceCopy.State != v1.InterfaceStateAbsent
 
+		shouldUpdateExistingIfaceBandwidth := existsInVMISpec &&
+			!equality.Semantic.DeepEqual(vmIface.Bandwidth, vmiIfaceCopy.Bandwidth) &&
+			vmiIfaceCopy.State != v1.InterfaceStateAbsent
+
 		switch {
 		case shouldHotplugIface:
 			vmiSpecCopy.Networks = append(vmiSpecCopy.Networks, vmIndexedNetworks[vmIface.Name])
@@ -185,6 +189,11 @@ func applyDynamicIfaceRequestOnVMI(
 				vmiIface.State = vmIface.State
 			}
 		}
+
+		if shouldUpdateExistingIfaceBandwidth {
+			vmiIface := vmispec.LookupInterfaceByName(vmiSpecCopy.Domain.Devices.Interfaces, vmIface.Name)
+			vmiIface.Bandwidth = vmIface.Bandwidth.DeepCopy()
+		}
 	}
 	return vmiSpecCopy
 }
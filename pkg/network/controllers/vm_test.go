@@ -457,6 +457,53 @@ var _ = Describe("VM Network Controller", func() {
 		Entry("empty to empty", v1.InterfaceState(""), v1.InterfaceState("")),
 	)
 
+	DescribeTable("sync updates bandwidth of an existing interface", func(fromBandwidth, toBandwidth *v1.InterfaceBandwidth) {
+		clientset := fake.NewSimpleClientset()
+		c := controllers.NewVMController(clientset, stubClusterConfigurer{})
+		const defaultNetName = "default"
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:      defaultNetName,
+				Bandwidth: fromBandwidth,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{
+					Bridge: &v1.InterfaceBridge{},
+				},
+			}),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmistatus.WithStatus(
+				libvmistatus.New(libvmistatus.WithInterfaceStatus(
+					v1.VirtualMachineInstanceNetworkInterface{Name: defaultNetName},
+				)),
+			),
+		)
+
+		vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+
+		_, err := clientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, k8smetav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = toBandwidth
+
+		_, err = c.Sync(vm, vmi)
+		Expect(err).NotTo(HaveOccurred())
+
+		updatedVMI, err := clientset.KubevirtV1().
+			VirtualMachineInstances(vmi.Namespace).
+			Get(context.Background(), vmi.Name, k8smetav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(updatedVMI.Spec.Domain.Devices.Interfaces).To(
+			Equal(vm.Spec.Template.Spec.Domain.Devices.Interfaces))
+	},
+		Entry("when a limit is added", nil,
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}}),
+		Entry("when a limit is changed",
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}},
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 2000}, Outbound: &v1.BandwidthLimit{Average: 500}}),
+		Entry("when the limit is removed",
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}}, nil),
+	)
+
 	DescribeTable("sync doesn't update link state if hot-unplug is underway ", func(toState v1.InterfaceState) {
 		clientset := fake.NewSimpleClientset()
 		c := controllers.NewVMController(clientset, stubClusterConfigurer{})
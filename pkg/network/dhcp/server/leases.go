@@ -0,0 +1,52 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package server
+
+import (
+	"maps"
+	"net"
+	"sync"
+)
+
+// leases holds the addresses acknowledged by the DHCP servers running in this process,
+// indexed by the client MAC address.
+var leases = leaseStore{leases: map[string]string{}}
+
+type leaseStore struct {
+	lock   sync.Mutex
+	leases map[string]string
+}
+
+func (s *leaseStore) record(mac net.HardwareAddr, ip net.IP) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.leases[mac.String()] = ip.String()
+}
+
+func (s *leaseStore) snapshot() map[string]string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return maps.Clone(s.leases)
+}
+
+// Leases returns the IPv4 addresses acknowledged to the served clients, indexed by their MAC address.
+func Leases() map[string]string {
+	return leases.snapshot()
+}
@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package server
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	dhcp "github.com/krolaw/dhcp4"
+	"github.com/vishvananda/netlink"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// reservedOptions drive the DHCP protocol itself and are managed by the server.
+var reservedOptions = map[dhcp.OptionCode]struct{}{
+	dhcp.OptionRequestedIPAddress:     {},
+	dhcp.OptionIPAddressLeaseTime:     {},
+	dhcp.OptionOverload:               {},
+	dhcp.OptionDHCPMessageType:        {},
+	dhcp.OptionServerIdentifier:       {},
+	dhcp.OptionParameterRequestList:   {},
+	dhcp.OptionMaximumDHCPMessageSize: {},
+	dhcp.OptionRenewalTimeValue:       {},
+	dhcp.OptionRebindingTimeValue:     {},
+	dhcp.OptionClientIdentifier:       {},
+}
+
+// MinLeaseTimeSeconds is the shortest lease time accepted. Shorter leases make the guest
+// renew its address so often that the renewals flood the in-pod DHCP servers.
+const MinLeaseTimeSeconds = 60
+
+// ValidateLeaseTime verifies the lease time offered to the guest is not shorter than MinLeaseTimeSeconds.
+func ValidateLeaseTime(leaseTimeSeconds uint32) error {
+	if leaseTimeSeconds < MinLeaseTimeSeconds {
+		return fmt.Errorf("DHCP lease time %d is too short, must be at least %d seconds", leaseTimeSeconds, MinLeaseTimeSeconds)
+	}
+	return nil
+}
+
+// ValidateCustomOption verifies a custom DHCP option can be passed to the guest.
+func ValidateCustomOption(option v1.DHCPOption) error {
+	if option.Code < 1 || option.Code > 254 {
+		return fmt.Errorf("DHCP option code %d is out of range, must be in range 1 to 254", option.Code)
+	}
+	if _, isReserved := reservedOptions[dhcp.OptionCode(byte(option.Code))]; isReserved {
+		return fmt.Errorf("DHCP option %d is managed by the DHCP server and cannot be set", option.Code)
+	}
+	value, err := encodeCustomOption(option)
+	if err != nil {
+		return err
+	}
+	if len(value) > 255 {
+		return fmt.Errorf("DHCP option %d value exceeds 255 bytes", option.Code)
+	}
+	return nil
+}
+
+func encodeCustomOption(option v1.DHCPOption) ([]byte, error) {
+	switch option.Type {
+	case "", v1.DHCPOptionTypeString:
+		return []byte(option.Value), nil
+	case v1.DHCPOptionTypeHex:
+		value, err := hex.DecodeString(option.Value)
+		if err != nil {
+			return nil, fmt.Errorf("DHCP option %d value is not a valid hexadecimal string: %v", option.Code, err)
+		}
+		return value, nil
+	case v1.DHCPOptionTypeIPv4:
+		var value []byte
+		for _, address := range strings.Split(option.Value, ",") {
+			ip := net.ParseIP(strings.TrimSpace(address)).To4()
+			if ip == nil {
+				return nil, fmt.Errorf("DHCP option %d value %q is not a valid IPv4 address", option.Code, address)
+			}
+			value = append(value, ip...)
+		}
+		return value, nil
+	case v1.DHCPOptionTypeUint8, v1.DHCPOptionTypeUint16, v1.DHCPOptionTypeUint32:
+		return encodeUintOption(option)
+	default:
+		return nil, fmt.Errorf("DHCP option %d has an unsupported type %q", option.Code, option.Type)
+	}
+}
+
+func encodeUintOption(option v1.DHCPOption) ([]byte, error) {
+	bitSizeByType := map[v1.DHCPOptionType]int{
+		v1.DHCPOptionTypeUint8:  8,
+		v1.DHCPOptionTypeUint16: 16,
+		v1.DHCPOptionTypeUint32: 32,
+	}
+	bitSize := bitSizeByType[option.Type]
+	number, err := strconv.ParseUint(option.Value, 10, bitSize)
+	if err != nil {
+		return nil, fmt.Errorf("DHCP option %d value is not a valid %s: %v", option.Code, option.Type, err)
+	}
+	value := make([]byte, 4)
+	binary.BigEndian.PutUint32(value, uint32(number))
+	return value[4-bitSize/8:], nil
+}
+
+// classlessRoutes converts the custom routes to netlink routes.
+func classlessRoutes(customRoutes []v1.DHCPClasslessRoute) ([]netlink.Route, error) {
+	var routes []netlink.Route
+	for _, customRoute := range customRoutes {
+		_, dst, err := net.ParseCIDR(customRoute.Destination)
+		if err != nil || dst.IP.To4() == nil {
+			return nil, fmt.Errorf("DHCP classless route destination %q is not a valid IPv4 CIDR", customRoute.Destination)
+		}
+		route := netlink.Route{Dst: dst}
+		if customRoute.Gateway != "" {
+			route.Gw = net.ParseIP(customRoute.Gateway).To4()
+			if route.Gw == nil {
+				return nil, fmt.Errorf("DHCP classless route gateway %q is not a valid IPv4 address", customRoute.Gateway)
+			}
+		}
+		if ones, _ := dst.Mask.Size(); ones == 0 {
+			route.Dst = nil
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+// ValidateClasslessRoute verifies a custom route can be passed to the guest.
+func ValidateClasslessRoute(route v1.DHCPClasslessRoute) error {
+	_, err := classlessRoutes([]v1.DHCPClasslessRoute{route})
+	return err
+}
@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package server
+
+import (
+	"net"
+	"time"
+
+	"github.com/krolaw/dhcp4"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("DHCP custom options", func() {
+	DescribeTable("should encode", func(option v1.DHCPOption, expected []byte) {
+		Expect(ValidateCustomOption(option)).To(Succeed())
+		Expect(encodeCustomOption(option)).To(Equal(expected))
+	},
+		Entry("a string by default", v1.DHCPOption{Code: 60, Value: "PXEClient"}, []byte("PXEClient")),
+		Entry("a hexadecimal value", v1.DHCPOption{Code: 43, Value: "010203ff", Type: v1.DHCPOptionTypeHex},
+			[]byte{1, 2, 3, 255}),
+		Entry("a list of IPv4 addresses", v1.DHCPOption{Code: 44, Value: "10.0.0.1, 10.0.0.2", Type: v1.DHCPOptionTypeIPv4},
+			[]byte{10, 0, 0, 1, 10, 0, 0, 2}),
+		Entry("an uint8", v1.DHCPOption{Code: 19, Value: "1", Type: v1.DHCPOptionTypeUint8}, []byte{1}),
+		Entry("an uint16", v1.DHCPOption{Code: 22, Value: "576", Type: v1.DHCPOptionTypeUint16}, []byte{2, 64}),
+		Entry("an uint32", v1.DHCPOption{Code: 2, Value: "3600", Type: v1.DHCPOptionTypeUint32}, []byte{0, 0, 14, 16}),
+	)
+
+	DescribeTable("should reject", func(option v1.DHCPOption) {
+		Expect(ValidateCustomOption(option)).NotTo(Succeed())
+	},
+		Entry("a code out of range", v1.DHCPOption{Code: 255, Value: "x"}),
+		Entry("a reserved code", v1.DHCPOption{Code: int(dhcp4.OptionIPAddressLeaseTime), Value: "x"}),
+		Entry("an invalid hexadecimal value", v1.DHCPOption{Code: 43, Value: "zz", Type: v1.DHCPOptionTypeHex}),
+		Entry("an invalid IPv4 address", v1.DHCPOption{Code: 44, Value: "10.0.0.1,fd00::1", Type: v1.DHCPOptionTypeIPv4}),
+		Entry("an overflowing integer", v1.DHCPOption{Code: 19, Value: "256", Type: v1.DHCPOptionTypeUint8}),
+		Entry("an unknown type", v1.DHCPOption{Code: 19, Value: "1", Type: "float"}),
+	)
+
+	It("should set the custom options, overriding the computed ones", func() {
+		ip := net.ParseIP("192.168.2.1")
+		dhcpOptions := &v1.DHCPOptions{
+			Options: []v1.DHCPOption{
+				{Code: int(dhcp4.OptionInterfaceMTU), Value: "1400", Type: v1.DHCPOptionTypeUint16},
+				{Code: int(dhcp4.OptionVendorClassIdentifier), Value: "PXEClient"},
+			},
+		}
+
+		options, err := prepareDHCPOptions(ip.DefaultMask(), ip, nil, nil, nil, 1500, "myhost", dhcpOptions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(options[dhcp4.OptionInterfaceMTU]).To(Equal([]byte{5, 120}))
+		Expect(options[dhcp4.OptionVendorClassIdentifier]).To(Equal([]byte("PXEClient")))
+	})
+
+	It("should override the domain search list", func() {
+		ip := net.ParseIP("192.168.2.1")
+		dhcpOptions := &v1.DHCPOptions{DomainSearch: []string{"example.com"}}
+
+		options, err := prepareDHCPOptions(ip.DefaultMask(), ip, nil, nil, []string{"svc.cluster.local"}, 1500, "myhost", dhcpOptions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(options[dhcp4.OptionDomainSearch]).To(Equal([]byte{7, 'e', 'x', 'a', 'm', 'p', 'l', 'e', 3, 'c', 'o', 'm', 0}))
+	})
+
+	It("should pass the classless routes with a default route through the router", func() {
+		router := net.ParseIP("10.0.2.1")
+		dhcpOptions := &v1.DHCPOptions{
+			ClasslessRoutes: []v1.DHCPClasslessRoute{{Destination: "192.168.1.0/24", Gateway: "10.0.2.254"}},
+		}
+
+		options, err := prepareDHCPOptions(net.CIDRMask(24, 32), router, nil, nil, nil, 1500, "myhost", dhcpOptions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(options[dhcp4.OptionClasslessRouteFormat]).To(Equal([]byte{
+			24, 192, 168, 1, 10, 0, 2, 254,
+			0, 10, 0, 2, 1,
+		}))
+	})
+
+	It("should fail on an invalid classless route", func() {
+		router := net.ParseIP("10.0.2.1")
+		dhcpOptions := &v1.DHCPOptions{
+			ClasslessRoutes: []v1.DHCPClasslessRoute{{Destination: "fd00::/64"}},
+		}
+
+		_, err := prepareDHCPOptions(net.CIDRMask(24, 32), router, nil, nil, nil, 1500, "myhost", dhcpOptions)
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("should lease", func(dhcpOptions *v1.DHCPOptions, expected time.Duration) {
+		Expect(leaseDuration(dhcpOptions)).To(Equal(expected))
+	},
+		Entry("forever by default", nil, infiniteLease),
+		Entry("for the requested time", &v1.DHCPOptions{LeaseTimeSeconds: pointer.P(uint32(3600))}, time.Hour),
+	)
+})
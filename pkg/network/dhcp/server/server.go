@@ -78,7 +78,7 @@ func SingleClientDHCPServer(
 		clientIP:      clientIP,
 		clientMAC:     clientMAC,
 		serverIP:      serverIP.To4(),
-		leaseDuration: infiniteLease,
+		leaseDuration: leaseDuration(customDHCPOptions),
 		options:       options,
 	}
 
@@ -125,13 +125,24 @@ func prepareDHCPOptions(
 		dhcpOptions[dhcp.OptionRouter] = routerIP.To4()
 	}
 
+	if customDHCPOptions != nil && len(customDHCPOptions.ClasslessRoutes) > 0 {
+		var err error
+		routes, err = withCustomRoutes(routes, routerIP, customDHCPOptions.ClasslessRoutes)
+		if err != nil {
+			return nil, err
+		}
+	}
 	netRoutes := formClasslessRoutes(routes)
 
 	if len(netRoutes) != 0 {
 		dhcpOptions[dhcp.OptionClasslessRouteFormat] = netRoutes
 	}
 
-	searchDomainBytes, err := convertSearchDomainsToBytes(searchDomains)
+	searchDomainOption := searchDomains
+	if customDHCPOptions != nil && len(customDHCPOptions.DomainSearch) > 0 {
+		searchDomainOption = customDHCPOptions.DomainSearch
+	}
+	searchDomainBytes, err := convertSearchDomainsToBytes(searchDomainOption)
 	if err != nil {
 		return nil, err
 	}
@@ -181,11 +192,55 @@ func prepareDHCPOptions(
 				}
 			}
 		}
+
+		for _, customOption := range customDHCPOptions.Options {
+			if err := ValidateCustomOption(customOption); err != nil {
+				return nil, err
+			}
+			value, _ := encodeCustomOption(customOption)
+			log.Log.Infof("Setting dhcp option %d", customOption.Code)
+			dhcpOptions[dhcp.OptionCode(byte(customOption.Code))] = value
+		}
 	}
 
 	return dhcpOptions, nil
 }
 
+func leaseDuration(customDHCPOptions *v1.DHCPOptions) time.Duration {
+	if customDHCPOptions == nil || customDHCPOptions.LeaseTimeSeconds == nil {
+		return infiniteLease
+	}
+	return time.Duration(*customDHCPOptions.LeaseTimeSeconds) * time.Second
+}
+
+// withCustomRoutes appends the custom routes to the pod routes.
+// A client receiving classless routes ignores the router option (RFC3442), hence a default
+// route through the router is added when none is provided.
+func withCustomRoutes(routes *[]netlink.Route, routerIP net.IP, customRoutes []v1.DHCPClasslessRoute) (*[]netlink.Route, error) {
+	extraRoutes, err := classlessRoutes(customRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	var allRoutes []netlink.Route
+	if routes != nil {
+		allRoutes = append(allRoutes, *routes...)
+	}
+	allRoutes = append(allRoutes, extraRoutes...)
+
+	hasDefaultRoute := false
+	for _, route := range allRoutes {
+		if route.Dst == nil {
+			hasDefaultRoute = true
+			break
+		}
+	}
+	if !hasDefaultRoute && routerIP.To4() != nil {
+		allRoutes = append(allRoutes, netlink.Route{Gw: routerIP.To4()})
+	}
+	return &allRoutes, nil
+}
+
 type DHCPHandler struct {
 	serverIP      net.IP
 	clientIP      net.IP
@@ -212,6 +267,7 @@ func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, _ dhcp.
 
 	case dhcp.Request:
 		log.Log.V(4).Info("The request has message type REQUEST")
+		leases.record(p.CHAddr(), h.clientIP)
 		return dhcp.ReplyPacket(p, dhcp.ACK, h.serverIP, h.clientIP, h.leaseDuration,
 			h.options.SelectOrderOrAll(nil))
 
@@ -249,4 +249,35 @@ var _ = Describe("DHCP Server", func() {
 			})
 		})
 	})
+
+	Context("ServeDHCP", func() {
+		It("should record the acknowledged lease of the client", func() {
+			clientMAC, _ := net.ParseMAC("12:34:56:78:9a:bc")
+			clientIP := net.ParseIP("10.0.0.10")
+			handler := &DHCPHandler{
+				clientIP:      clientIP,
+				clientMAC:     clientMAC,
+				serverIP:      net.ParseIP("10.0.0.1"),
+				leaseDuration: infiniteLease,
+			}
+
+			request := dhcp4.RequestPacket(dhcp4.Request, clientMAC, nil, []byte{0, 0, 0, 1}, false, nil)
+			Expect(handler.ServeDHCP(request, dhcp4.Request, nil)).NotTo(BeNil())
+			Expect(Leases()).To(HaveKeyWithValue("12:34:56:78:9a:bc", "10.0.0.10"))
+		})
+
+		It("should not record a lease when only offering", func() {
+			clientMAC, _ := net.ParseMAC("12:34:56:78:9a:bd")
+			handler := &DHCPHandler{
+				clientIP:      net.ParseIP("10.0.0.11"),
+				clientMAC:     clientMAC,
+				serverIP:      net.ParseIP("10.0.0.1"),
+				leaseDuration: infiniteLease,
+			}
+
+			discover := dhcp4.RequestPacket(dhcp4.Discover, clientMAC, nil, []byte{0, 0, 0, 2}, false, nil)
+			Expect(handler.ServeDHCP(discover, dhcp4.Discover, nil)).NotTo(BeNil())
+			Expect(Leases()).NotTo(HaveKey("12:34:56:78:9a:bd"))
+		})
+	})
 })
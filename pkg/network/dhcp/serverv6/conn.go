@@ -19,41 +19,109 @@
 package serverv6
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"net"
+	"os"
 	"time"
 
 	"github.com/insomniacslk/dhcp/dhcpv6"
 	"github.com/insomniacslk/dhcp/dhcpv6/server6"
-
+	"golang.org/x/net/bpf"
 	"golang.org/x/net/ipv6"
+	"golang.org/x/sys/unix"
 )
 
 const errFmt = "%s: %v"
 
+const udpHeaderLen = 8
+
+// ClientAddr is the address of a DHCPv6 client, with the link-layer source address of the frame
+// which carried its request.
+type ClientAddr struct {
+	net.UDPAddr
+	HardwareAddr net.HardwareAddr
+}
+
+// FilteredConn receives the DHCPv6 requests sent on the server interface through a packet socket,
+// which exposes the link-layer source address of each request, and sends the responses through
+// a UDP socket.
 type FilteredConn struct {
-	ifIndex    int
+	iface      *net.Interface
 	packetConn *ipv6.PacketConn
-	cm         *ipv6.ControlMessage
+	linkConn   *os.File
 }
 
 func (fc *FilteredConn) ReadFrom(b []byte) (n int, addr net.Addr, err error) {
-	for { // Filter all other interfaces
-		n, fc.cm, addr, err = fc.packetConn.ReadFrom(b)
-		if err != nil || fc.cm == nil || fc.cm.IfIndex == fc.ifIndex {
-			break
+	rawConn, err := fc.linkConn.SyscallConn()
+	if err != nil {
+		return 0, nil, err
+	}
+	frame := make([]byte, len(b)+ipv6.HeaderLen+udpHeaderLen)
+	for {
+		var frameLen int
+		var from unix.Sockaddr
+		var recvErr error
+		err = rawConn.Read(func(fd uintptr) bool {
+			frameLen, from, recvErr = unix.Recvfrom(int(fd), frame, 0)
+			return !errors.Is(recvErr, unix.EAGAIN)
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		if errors.Is(recvErr, unix.EINTR) {
+			continue
+		}
+		if recvErr != nil {
+			return 0, nil, recvErr
 		}
+		linkAddr, ok := from.(*unix.SockaddrLinklayer)
+		if !ok || linkAddr.Pkttype == unix.PACKET_OUTGOING {
+			continue
+		}
+		clientAddr, payload, ok := parseDHCPv6Request(frame[:frameLen], linkAddr, fc.iface.Name)
+		if !ok {
+			continue
+		}
+		return copy(b, payload), clientAddr, nil
+	}
+}
+
+// parseDHCPv6Request returns the client address and the DHCPv6 message of an IPv6 packet sent to the server port.
+func parseDHCPv6Request(packet []byte, linkAddr *unix.SockaddrLinklayer, zone string) (*ClientAddr, []byte, bool) {
+	header, err := ipv6.ParseHeader(packet)
+	if err != nil || header.NextHeader != unix.IPPROTO_UDP || len(packet) < ipv6.HeaderLen+udpHeaderLen {
+		return nil, nil, false
+	}
+	udp := packet[ipv6.HeaderLen:]
+	if binary.BigEndian.Uint16(udp[2:4]) != dhcpv6.DefaultServerPort {
+		return nil, nil, false
+	}
+	udpLen := int(binary.BigEndian.Uint16(udp[4:6]))
+	if udpLen < udpHeaderLen || udpLen > len(udp) {
+		return nil, nil, false
+	}
+	clientAddr := &ClientAddr{
+		UDPAddr: net.UDPAddr{
+			IP:   header.Src,
+			Port: int(binary.BigEndian.Uint16(udp[0:2])),
+			Zone: zone,
+		},
+		HardwareAddr: net.HardwareAddr(linkAddr.Addr[:min(int(linkAddr.Halen), len(linkAddr.Addr))]),
 	}
-	return
+	return clientAddr, udp[udpHeaderLen:udpLen], true
 }
 
 func (fc *FilteredConn) WriteTo(b []byte, addr net.Addr) (n int, err error) {
-	fc.cm.Src = nil
-	return fc.packetConn.WriteTo(b, fc.cm, addr)
+	if clientAddr, ok := addr.(*ClientAddr); ok {
+		addr = &clientAddr.UDPAddr
+	}
+	return fc.packetConn.WriteTo(b, &ipv6.ControlMessage{IfIndex: fc.iface.Index}, addr)
 }
 
 func (fc *FilteredConn) Close() error {
-	return fc.packetConn.Close()
+	return errors.Join(fc.linkConn.Close(), fc.packetConn.Close())
 }
 
 func (fc *FilteredConn) LocalAddr() net.Addr {
@@ -61,11 +129,11 @@ func (fc *FilteredConn) LocalAddr() net.Addr {
 }
 
 func (fc *FilteredConn) SetDeadline(t time.Time) error {
-	return fc.packetConn.SetDeadline(t)
+	return errors.Join(fc.linkConn.SetDeadline(t), fc.packetConn.SetDeadline(t))
 }
 
 func (fc *FilteredConn) SetReadDeadline(t time.Time) error {
-	return fc.packetConn.SetReadDeadline(t)
+	return fc.linkConn.SetReadDeadline(t)
 }
 
 func (fc *FilteredConn) SetWriteDeadline(t time.Time) error {
@@ -83,8 +151,16 @@ func NewConnection(serverIface *net.Interface) (*FilteredConn, error) {
 		return nil, fmt.Errorf(errFmt, errorString, err)
 	}
 
+	// The UDP socket only sends the responses and keeps the interface in the multicast group,
+	// the requests are received through the packet socket.
 	packetConn := ipv6.NewPacketConn(udpConn)
-	if err := packetConn.SetControlMessage(ipv6.FlagInterface, true); err != nil {
+	dropAll, err := bpf.Assemble([]bpf.Instruction{bpf.RetConstant{Val: 0}})
+	if err != nil {
+		packetConn.Close()
+		return nil, fmt.Errorf(errFmt, errorString, err)
+	}
+	if err := packetConn.SetBPF(dropAll); err != nil {
+		packetConn.Close()
 		return nil, fmt.Errorf(errFmt, errorString, err)
 	}
 
@@ -92,8 +168,34 @@ func NewConnection(serverIface *net.Interface) (*FilteredConn, error) {
 		IP:   dhcpv6.AllDHCPRelayAgentsAndServers,
 		Port: dhcpv6.DefaultServerPort}
 	if err := packetConn.JoinGroup(serverIface, &group); err != nil {
+		packetConn.Close()
+		return nil, fmt.Errorf(errFmt, errorString, err)
+	}
+
+	linkConn, err := newLinkConn(serverIface)
+	if err != nil {
+		packetConn.Close()
 		return nil, fmt.Errorf(errFmt, errorString, err)
 	}
 
-	return &FilteredConn{packetConn: packetConn, ifIndex: serverIface.Index}, nil
+	return &FilteredConn{iface: serverIface, packetConn: packetConn, linkConn: linkConn}, nil
+}
+
+// newLinkConn opens a non-blocking packet socket receiving the IPv6 packets of the interface,
+// without their link-layer header.
+func newLinkConn(iface *net.Interface) (*os.File, error) {
+	protocol := htons(unix.ETH_P_IPV6)
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC|unix.SOCK_NONBLOCK, int(protocol))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open packet socket: %w", err)
+	}
+	if err := unix.Bind(fd, &unix.SockaddrLinklayer{Protocol: protocol, Ifindex: iface.Index}); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to bind packet socket to %s: %w", iface.Name, err)
+	}
+	return os.NewFile(uintptr(fd), "dhcpv6-"+iface.Name), nil
+}
+
+func htons(value uint16) uint16 {
+	return value<<8 | value>>8
 }
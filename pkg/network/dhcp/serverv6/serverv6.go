@@ -20,6 +20,7 @@
 package serverv6
 
 import (
+	"bytes"
 	"fmt"
 	"net"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/insomniacslk/dhcp/dhcpv6/server6"
 	"github.com/insomniacslk/dhcp/iana"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 )
 
@@ -37,10 +39,13 @@ const (
 
 type DHCPv6Handler struct {
 	clientIP  net.IP
+	clientMAC net.HardwareAddr
 	modifiers []dhcpv6.Modifier
 }
 
-func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string, ipv6Nameservers [][]byte) error {
+// SingleClientDHCPv6Server serves clientIP as a static lease of the client owning clientMAC.
+// When clientMAC is empty the lease is offered to any client on the interface.
+func SingleClientDHCPv6Server(clientIP net.IP, clientMAC net.HardwareAddr, serverIfaceName string, ipv6Nameservers [][]byte, dhcpOptions *v1.DHCPOptions) error {
 	log.Log.Info("Starting SingleClientDHCPv6Server")
 
 	iface, err := net.InterfaceByName(serverIfaceName)
@@ -48,10 +53,11 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string, ipv6Names
 		return fmt.Errorf("couldn't create DHCPv6 server, couldn't get the dhcp6 server interface: %v", err)
 	}
 
-	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr, ipv6Nameservers)
+	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr, ipv6Nameservers, dhcpOptions)
 
 	handler := &DHCPv6Handler{
 		clientIP:  clientIP,
+		clientMAC: clientMAC,
 		modifiers: modifiers,
 	}
 
@@ -76,7 +82,10 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string, ipv6Names
 func (h *DHCPv6Handler) ServeDHCPv6(conn net.PacketConn, peer net.Addr, m dhcpv6.DHCPv6) {
 	log.Log.V(4).Info("DHCPv6 serving a new request")
 
-	// TODO if we extend the server to support bridge binding, we need to filter out non-vm requests
+	if !h.isOwnClient(peer) {
+		log.Log.V(4).Info("DHCPv6 - the request is not from our client")
+		return
+	}
 
 	response, err := h.buildResponse(m)
 	if err != nil {
@@ -89,6 +98,20 @@ func (h *DHCPv6Handler) ServeDHCPv6(conn net.PacketConn, peer net.Addr, m dhcpv6
 	}
 }
 
+// isOwnClient reports whether the request comes from the client the static lease belongs to, by the
+// link-layer source address of the frame which carried it. The DUID of the client is not used: it is
+// often generated from another interface, or copied along with the guest image.
+func (h *DHCPv6Handler) isOwnClient(peer net.Addr) bool {
+	if len(h.clientMAC) == 0 {
+		return true
+	}
+	clientAddr, ok := peer.(*ClientAddr)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(clientAddr.HardwareAddr, h.clientMAC)
+}
+
 func (h *DHCPv6Handler) buildResponse(msg dhcpv6.DHCPv6) (*dhcpv6.Message, error) {
 	var response *dhcpv6.Message
 	var err error
@@ -121,8 +144,14 @@ func (h *DHCPv6Handler) buildResponse(msg dhcpv6.DHCPv6) (*dhcpv6.Message, error
 	return response, nil
 }
 
-func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr, ipv6Nameservers [][]byte) []dhcpv6.Modifier {
-	optIAAddress := dhcpv6.OptIAAddress{IPv6Addr: clientIP, PreferredLifetime: infiniteLease, ValidLifetime: infiniteLease}
+func prepareDHCPv6Modifiers(
+	clientIP net.IP,
+	serverInterfaceMac net.HardwareAddr,
+	ipv6Nameservers [][]byte,
+	dhcpOptions *v1.DHCPOptions,
+) []dhcpv6.Modifier {
+	lifetime := leaseLifetime(dhcpOptions)
+	optIAAddress := dhcpv6.OptIAAddress{IPv6Addr: clientIP, PreferredLifetime: lifetime, ValidLifetime: lifetime}
 	duid := &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: serverInterfaceMac}
 
 	modifiers := []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
@@ -135,5 +164,42 @@ func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr
 		modifiers = append(modifiers, dhcpv6.WithDNS(dnsServers...))
 	}
 
+	if dhcpOptions != nil && dhcpOptions.V6 != nil {
+		modifiers = append(modifiers, customOptionsModifiers(dhcpOptions.V6)...)
+	}
+
+	return modifiers
+}
+
+func leaseLifetime(dhcpOptions *v1.DHCPOptions) time.Duration {
+	if dhcpOptions == nil || dhcpOptions.LeaseTimeSeconds == nil {
+		return infiniteLease
+	}
+	return time.Duration(*dhcpOptions.LeaseTimeSeconds) * time.Second
+}
+
+func customOptionsModifiers(options *v1.DHCPv6Options) []dhcpv6.Modifier {
+	var modifiers []dhcpv6.Modifier
+
+	if len(options.NTPServers) > 0 {
+		ntpOption := &dhcpv6.OptNTPServer{}
+		for _, server := range options.NTPServers {
+			ip := net.ParseIP(server)
+			if ip == nil || ip.To4() != nil {
+				log.Log.Warningf("DHCPv6 - ignoring NTP server %q, not an IPv6 address", server)
+				continue
+			}
+			ntpServer := dhcpv6.NTPSuboptionSrvAddr(ip)
+			ntpOption.Suboptions.Add(&ntpServer)
+		}
+		if len(ntpOption.Suboptions) > 0 {
+			modifiers = append(modifiers, dhcpv6.WithOption(ntpOption))
+		}
+	}
+
+	if len(options.DomainSearch) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDomainSearchList(options.DomainSearch...))
+	}
+
 	return modifiers
 }
@@ -20,13 +20,19 @@
 package serverv6
 
 import (
+	"encoding/binary"
 	"net"
+	"time"
 
 	"github.com/insomniacslk/dhcp/dhcpv6"
 	"github.com/insomniacslk/dhcp/iana"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"golang.org/x/net/ipv6"
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
 )
 
 var _ = Describe("DHCPv6", func() {
@@ -34,7 +40,7 @@ var _ = Describe("DHCPv6", func() {
 		It("should contain ianaAdrress and duid", func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil)
 			Expect(modifiers).To(HaveLen(2))
 
 			msg := &dhcpv6.Message{
@@ -60,7 +66,7 @@ var _ = Describe("DHCPv6", func() {
 				net.ParseIP("2001:4860:4860::8888").To16(),
 				net.ParseIP("2001:4860:4860::8844").To16(),
 			}
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, ipv6Nameservers)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, ipv6Nameservers, nil)
 			Expect(modifiers).To(HaveLen(3))
 
 			msg := &dhcpv6.Message{
@@ -78,6 +84,42 @@ var _ = Describe("DHCPv6", func() {
 			Expect(dnsString).To(ContainSubstring("2001:4860:4860::8888"))
 			Expect(dnsString).To(ContainSubstring("2001:4860:4860::8844"))
 		})
+
+		It("should use the requested lease time as the address lifetimes", func() {
+			clientIP := net.ParseIP("fd10:0:2::2")
+			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
+			leaseTime := uint32(3600)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, &v1.DHCPOptions{LeaseTimeSeconds: &leaseTime})
+
+			msg := &dhcpv6.Message{MessageType: dhcpv6.MessageTypeAdvertise}
+			modifiers[0](msg)
+			address := msg.Options.OneIANA().Options.OneAddress()
+			Expect(address.PreferredLifetime).To(Equal(time.Hour))
+			Expect(address.ValidLifetime).To(Equal(time.Hour))
+		})
+
+		It("should contain the custom NTP servers and domain search list", func() {
+			clientIP := net.ParseIP("fd10:0:2::2")
+			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
+			dhcpOptions := &v1.DHCPOptions{
+				V6: &v1.DHCPv6Options{
+					NTPServers:   []string{"fd00::123", "10.0.0.1"},
+					DomainSearch: []string{"example.com"},
+				},
+			}
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, dhcpOptions)
+			Expect(modifiers).To(HaveLen(4))
+
+			msg := &dhcpv6.Message{MessageType: dhcpv6.MessageTypeAdvertise}
+			for _, modifier := range modifiers {
+				modifier(msg)
+			}
+
+			ntpServers := msg.Options.NTPServers()
+			Expect(ntpServers).To(HaveLen(1))
+			Expect(ntpServers[0].Equal(net.ParseIP("fd00::123"))).To(BeTrue())
+			Expect(msg.Options.DomainSearchList().Labels).To(ConsistOf("example.com"))
+		})
 	})
 	Context("buildResponse should build a response with", func() {
 		var handler *DHCPv6Handler
@@ -85,7 +127,7 @@ var _ = Describe("DHCPv6", func() {
 		BeforeEach(func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil)
 
 			handler = &DHCPv6Handler{
 				clientIP:  clientIP,
@@ -148,6 +190,68 @@ var _ = Describe("DHCPv6", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+	Context("the static lease", func() {
+		var handler *DHCPv6Handler
+
+		BeforeEach(func() {
+			clientMac, _ := net.ParseMAC("34:56:78:9A:BC:DE")
+			handler = &DHCPv6Handler{
+				clientIP:  net.ParseIP("fd10:0:2::2"),
+				clientMAC: clientMac,
+			}
+		})
+
+		It("should be served to the client owning the MAC address", func() {
+			clientMac, _ := net.ParseMAC("34:56:78:9A:BC:DE")
+			Expect(handler.isOwnClient(&ClientAddr{HardwareAddr: clientMac})).To(BeTrue())
+		})
+
+		It("should not be served to another client", func() {
+			otherMac, _ := net.ParseMAC("34:56:78:9A:BC:FF")
+			Expect(handler.isOwnClient(&ClientAddr{HardwareAddr: otherMac})).To(BeFalse())
+		})
+
+		It("should not be served to a client of unknown link-layer address", func() {
+			Expect(handler.isOwnClient(&net.UDPAddr{IP: net.ParseIP("fe80::1")})).To(BeFalse())
+		})
+	})
+
+	Context("the connection", func() {
+		newRequest := func(nextHeader, dstPort int, payload []byte) []byte {
+			packet := make([]byte, ipv6.HeaderLen+udpHeaderLen+len(payload))
+			packet[0] = ipv6.Version << 4
+			binary.BigEndian.PutUint16(packet[4:6], uint16(udpHeaderLen+len(payload)))
+			packet[6] = byte(nextHeader)
+			copy(packet[8:24], net.ParseIP("fe80::1"))
+			copy(packet[24:40], dhcpv6.AllDHCPRelayAgentsAndServers)
+			udp := packet[ipv6.HeaderLen:]
+			binary.BigEndian.PutUint16(udp[0:2], dhcpv6.DefaultClientPort)
+			binary.BigEndian.PutUint16(udp[2:4], uint16(dstPort))
+			binary.BigEndian.PutUint16(udp[4:6], uint16(udpHeaderLen+len(payload)))
+			copy(udp[udpHeaderLen:], payload)
+			return packet
+		}
+		linkAddr := &unix.SockaddrLinklayer{Halen: 6, Addr: [8]byte{0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde}}
+
+		It("should return the request with the link-layer source address of the client", func() {
+			clientAddr, payload, ok := parseDHCPv6Request(newRequest(unix.IPPROTO_UDP, dhcpv6.DefaultServerPort, []byte{1, 2, 3}), linkAddr, "k6t-eth0")
+			Expect(ok).To(BeTrue())
+			Expect(payload).To(Equal([]byte{1, 2, 3}))
+			Expect(clientAddr.IP.String()).To(Equal("fe80::1"))
+			Expect(clientAddr.Port).To(Equal(dhcpv6.DefaultClientPort))
+			Expect(clientAddr.Zone).To(Equal("k6t-eth0"))
+			Expect(clientAddr.HardwareAddr.String()).To(Equal("34:56:78:9a:bc:de"))
+		})
+
+		DescribeTable("should ignore packets which are not DHCPv6 requests", func(packet []byte) {
+			_, _, ok := parseDHCPv6Request(packet, linkAddr, "k6t-eth0")
+			Expect(ok).To(BeFalse())
+		},
+			Entry("not UDP", newRequest(unix.IPPROTO_TCP, dhcpv6.DefaultServerPort, nil)),
+			Entry("to another port", newRequest(unix.IPPROTO_UDP, dhcpv6.DefaultClientPort, nil)),
+			Entry("truncated", newRequest(unix.IPPROTO_UDP, dhcpv6.DefaultServerPort, []byte{1, 2, 3})[:ipv6.HeaderLen+4]),
+		)
+	})
 })
 
 func newMessage(messageType dhcpv6.MessageType) (*dhcpv6.Message, error) {
@@ -0,0 +1,49 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainspec
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// NewBandWidth converts the bandwidth limits of a VMI interface to the libvirt domain representation.
+// https://libvirt.org/formatnetwork.html#quality-of-service
+func NewBandWidth(iface *v1.Interface) *api.BandWidth {
+	if iface.Bandwidth == nil || (iface.Bandwidth.Inbound == nil && iface.Bandwidth.Outbound == nil) {
+		return nil
+	}
+	return &api.BandWidth{
+		Inbound:  newBandWidthRate(iface.Bandwidth.Inbound),
+		Outbound: newBandWidthRate(iface.Bandwidth.Outbound),
+	}
+}
+
+func newBandWidthRate(limit *v1.BandwidthLimit) *api.BandWidthRate {
+	if limit == nil {
+		return nil
+	}
+	return &api.BandWidthRate{
+		Average: limit.Average,
+		Peak:    limit.Peak,
+		Burst:   limit.Burst,
+	}
+}
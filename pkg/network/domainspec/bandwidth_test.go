@@ -0,0 +1,54 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainspec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Interface bandwidth", func() {
+	DescribeTable("should be converted", func(bandwidth *v1.InterfaceBandwidth, expected *api.BandWidth) {
+		iface := &v1.Interface{Name: "iface1", Bandwidth: bandwidth}
+		Expect(domainspec.NewBandWidth(iface)).To(Equal(expected))
+	},
+		Entry("to nothing when unset", nil, nil),
+		Entry("to nothing when no direction is limited", &v1.InterfaceBandwidth{}, nil),
+		Entry("with the inbound limit only",
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}},
+			&api.BandWidth{Inbound: &api.BandWidthRate{Average: 1000}},
+		),
+		Entry("with both directions limited",
+			&v1.InterfaceBandwidth{
+				Inbound:  &v1.BandwidthLimit{Average: 1000, Peak: 2000, Burst: 256},
+				Outbound: &v1.BandwidthLimit{Average: 500, Burst: 128},
+			},
+			&api.BandWidth{
+				Inbound:  &api.BandWidthRate{Average: 1000, Peak: 2000, Burst: 256},
+				Outbound: &api.BandWidthRate{Average: 500, Burst: 128},
+			},
+		),
+	)
+})
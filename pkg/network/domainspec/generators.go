@@ -74,6 +74,7 @@ func (b *TapLibvirtSpecGenerator) Generate() error {
 			ifaces[i].MTU = domainIface.MTU
 			ifaces[i].MAC = domainIface.MAC
 			ifaces[i].Target = domainIface.Target
+			ifaces[i].BandWidth = NewBandWidth(b.vmiSpecIface)
 			break
 		}
 	}
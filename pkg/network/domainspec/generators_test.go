@@ -113,6 +113,20 @@ var _ = Describe("Pod Network", func() {
 
 				verifyTapDomain(domain.Spec.Devices.Interfaces, tapName, mtu, fakeMac.String())
 			})
+
+			It("Should set the interface bandwidth", func() {
+				mockNetwork.EXPECT().LinkByName(tapName).Return(tapInterface, nil)
+				vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+					Inbound: &v1.BandwidthLimit{Average: 1000, Peak: 2000, Burst: 512},
+				}
+
+				Expect(specGenerator.Generate()).To(Succeed())
+
+				verifyTapDomain(domain.Spec.Devices.Interfaces, tapName, mtu, specMAC)
+				Expect(domain.Spec.Devices.Interfaces[0].BandWidth).To(Equal(&api.BandWidth{
+					Inbound: &api.BandWidthRate{Average: 1000, Peak: 2000, Burst: 512},
+				}))
+			})
 		})
 	})
 })
@@ -165,6 +165,16 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 		return fmt.Errorf("Failed to get DNS servers from resolv.conf: %v", err)
 	}
 
+	ipv4Nameservers := nameservers.IPv4
+	if len(nic.Nameservers) > 0 {
+		ipv4Nameservers = nil
+		for _, nameserver := range nic.Nameservers {
+			if ipv4 := nameserver.To4(); ipv4 != nil {
+				ipv4Nameservers = append(ipv4Nameservers, ipv4)
+			}
+		}
+	}
+
 	domain := dns.DomainNameWithSubdomain(searchDomains, nic.Subdomain)
 	if domain != "" {
 		searchDomains = append([]string{domain}, searchDomains...)
@@ -181,7 +191,7 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 				bridgeInterfaceName,
 				nic.AdvertisingIPAddr,
 				nic.Gateway,
-				nameservers.IPv4,
+				ipv4Nameservers,
 				nic.Routes,
 				searchDomains,
 				nic.Mtu,
@@ -197,8 +207,10 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 		go func() {
 			if err = DHCPv6Server(
 				nic.IPv6.IP,
+				nic.MAC,
 				bridgeInterfaceName,
 				nameservers.IPv6,
+				dhcpOptions,
 			); err != nil {
 				log.Log.Reason(err).Error("failed to run DHCPv6 Server")
 				panic(err)
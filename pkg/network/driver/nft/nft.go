@@ -29,8 +29,9 @@ type NFTBin struct{}
 type IPFamily string
 
 const (
-	IPv4 IPFamily = "ip"
-	IPv6 IPFamily = "ip6"
+	IPv4   IPFamily = "ip"
+	IPv6   IPFamily = "ip6"
+	Bridge IPFamily = "bridge"
 )
 
 const (
@@ -0,0 +1,183 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package guestaddr learns the guest interfaces addresses without the guest agent.
+// It runs in virt-launcher and observes the pod network namespace:
+//   - The leases acknowledged by the embedded DHCP server.
+//   - The addresses the guest announces through ARP/NDP on its tap devices.
+//   - The pod interface addresses a passt backend copies into the guest.
+package guestaddr
+
+import (
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+
+	dhcpserver "kubevirt.io/kubevirt/pkg/network/dhcp/server"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const passtBackend = "passt"
+
+type Learner struct {
+	leases        func() map[string]string
+	neighbors     func(tapNames []string) ([]Neighbor, error)
+	linkAddresses func(linkName string) ([]netlink.Addr, error)
+}
+
+type option func(*Learner)
+
+func NewLearner(opts ...option) Learner {
+	l := Learner{
+		leases:        dhcpserver.Leases,
+		neighbors:     NewSnooper().Neighbors,
+		linkAddresses: listLinkAddresses,
+	}
+	for _, opt := range opts {
+		opt(&l)
+	}
+	return l
+}
+
+func WithLeases(leases func() map[string]string) option {
+	return func(l *Learner) {
+		l.leases = leases
+	}
+}
+
+func WithNeighbors(neighbors func(tapNames []string) ([]Neighbor, error)) option {
+	return func(l *Learner) {
+		l.neighbors = neighbors
+	}
+}
+
+func WithLinkAddresses(linkAddresses func(linkName string) ([]netlink.Addr, error)) option {
+	return func(l *Learner) {
+		l.linkAddresses = linkAddresses
+	}
+}
+
+// Learn returns the addresses learned for the given domain interfaces.
+// Each returned status carries the addresses of a single interface from a single source.
+func (l Learner) Learn(domainIfaces []api.Interface) ([]api.InterfaceStatus, error) {
+	neighbors, err := l.neighbors(tapNames(domainIfaces))
+	if err != nil {
+		return nil, err
+	}
+	neighborIPsByMAC := indexNeighborIPsByMAC(neighbors)
+	leases := l.leases()
+
+	var statuses []api.InterfaceStatus
+	for _, domainIface := range domainIfaces {
+		if domainIface.MAC == nil || domainIface.MAC.MAC == "" {
+			continue
+		}
+		mac := strings.ToLower(domainIface.MAC.MAC)
+
+		if leasedIP, exists := leases[mac]; exists {
+			statuses = append(statuses, newStatus(mac, []string{leasedIP}, netvmispec.InfoSourceDHCPLease))
+		}
+
+		if neighborIPs := neighborIPsByMAC[mac]; len(neighborIPs) > 0 {
+			statuses = append(statuses, newStatus(mac, neighborIPs, netvmispec.InfoSourceNeighbor))
+		}
+
+		if isPasstInterface(domainIface) {
+			passtIPs, err := l.passtGuestIPs(domainIface.Source.Device)
+			if err != nil {
+				return nil, err
+			}
+			if len(passtIPs) > 0 {
+				statuses = append(statuses, newStatus(mac, passtIPs, netvmispec.InfoSourcePasst))
+			}
+		}
+	}
+	return statuses, nil
+}
+
+// passtGuestIPs returns the addresses of the pod interface, which passt assigns to the guest.
+func (l Learner) passtGuestIPs(podIfaceName string) ([]string, error) {
+	addresses, err := l.linkAddresses(podIfaceName)
+	if err != nil {
+		return nil, err
+	}
+	var ips []string
+	for _, address := range addresses {
+		if isGuestUnicastIP(address.IP) {
+			ips = append(ips, address.IP.String())
+		}
+	}
+	return ips, nil
+}
+
+// tapNames returns the tap devices connecting the guest interfaces to the pod network.
+func tapNames(domainIfaces []api.Interface) []string {
+	var names []string
+	for _, domainIface := range domainIfaces {
+		if domainIface.Type == "ethernet" && domainIface.Target != nil && domainIface.Target.Device != "" {
+			names = append(names, domainIface.Target.Device)
+		}
+	}
+	return names
+}
+
+func indexNeighborIPsByMAC(neighbors []Neighbor) map[string][]string {
+	ipsByMAC := map[string][]string{}
+	for _, neighbor := range neighbors {
+		if len(neighbor.MAC) == 0 || !isGuestUnicastIP(neighbor.IP) {
+			continue
+		}
+		mac := neighbor.MAC.String()
+		if ip := neighbor.IP.String(); !slices.Contains(ipsByMAC[mac], ip) {
+			ipsByMAC[mac] = append(ipsByMAC[mac], ip)
+		}
+	}
+	for mac := range ipsByMAC {
+		slices.Sort(ipsByMAC[mac])
+	}
+	return ipsByMAC
+}
+
+func isGuestUnicastIP(ip net.IP) bool {
+	return ip != nil && ip.IsGlobalUnicast()
+}
+
+func isPasstInterface(domainIface api.Interface) bool {
+	return domainIface.Backend != nil && domainIface.Backend.Type == passtBackend && domainIface.Source.Device != ""
+}
+
+func newStatus(mac string, ips []string, infoSource string) api.InterfaceStatus {
+	return api.InterfaceStatus{
+		Mac:        mac,
+		Ip:         ips[0],
+		IPs:        ips,
+		InfoSource: infoSource,
+	}
+}
+
+func listLinkAddresses(linkName string) ([]netlink.Addr, error) {
+	link, err := netlink.LinkByName(linkName)
+	if err != nil {
+		return nil, err
+	}
+	return netlink.AddrList(link, netlink.FAMILY_ALL)
+}
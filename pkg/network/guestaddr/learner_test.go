@@ -0,0 +1,175 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestaddr_test
+
+import (
+	"errors"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+
+	"kubevirt.io/kubevirt/pkg/network/guestaddr"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Guest address learner", func() {
+	const (
+		bridgeIfaceMAC = "02:00:00:00:00:01"
+		passtIfaceMAC  = "02:00:00:00:00:02"
+	)
+
+	var bridgeDomainIface, passtDomainIface api.Interface
+
+	BeforeEach(func() {
+		bridgeDomainIface = api.Interface{
+			Type:   "ethernet",
+			MAC:    &api.MAC{MAC: bridgeIfaceMAC},
+			Target: &api.InterfaceTarget{Device: "tap0"},
+		}
+		passtDomainIface = api.Interface{
+			Type:    "vhostuser",
+			MAC:     &api.MAC{MAC: passtIfaceMAC},
+			Source:  api.InterfaceSource{Device: "eth0"},
+			Backend: &api.InterfaceBackend{Type: "passt"},
+		}
+	})
+
+	It("learns nothing when no source knows the guest", func() {
+		learner := guestaddr.NewLearner(
+			guestaddr.WithLeases(noLeases),
+			guestaddr.WithNeighbors(neighborsStub()),
+		)
+
+		Expect(learner.Learn([]api.Interface{bridgeDomainIface})).To(BeEmpty())
+	})
+
+	It("learns the DHCP lease of an interface", func() {
+		learner := guestaddr.NewLearner(
+			guestaddr.WithLeases(func() map[string]string { return map[string]string{bridgeIfaceMAC: "10.0.0.10"} }),
+			guestaddr.WithNeighbors(neighborsStub()),
+		)
+
+		Expect(learner.Learn([]api.Interface{bridgeDomainIface})).To(ConsistOf(api.InterfaceStatus{
+			Mac:        bridgeIfaceMAC,
+			Ip:         "10.0.0.10",
+			IPs:        []string{"10.0.0.10"},
+			InfoSource: "dhcp-lease",
+		}))
+	})
+
+	It("learns the neighbor addresses of an interface", func() {
+		learner := guestaddr.NewLearner(
+			guestaddr.WithLeases(noLeases),
+			guestaddr.WithNeighbors(neighborsStub(
+				neighbor(bridgeIfaceMAC, "fd00::10"),
+				neighbor(bridgeIfaceMAC, "10.0.0.10"),
+				neighbor(bridgeIfaceMAC, "fe80::1"),
+				neighbor("02:00:00:00:00:99", "10.0.0.99"),
+			)),
+		)
+
+		Expect(learner.Learn([]api.Interface{bridgeDomainIface})).To(ConsistOf(api.InterfaceStatus{
+			Mac:        bridgeIfaceMAC,
+			Ip:         "10.0.0.10",
+			IPs:        []string{"10.0.0.10", "fd00::10"},
+			InfoSource: "arp-ndp",
+		}))
+	})
+
+	It("looks for the neighbors on the tap devices of the interfaces", func() {
+		var snoopedTaps []string
+		learner := guestaddr.NewLearner(
+			guestaddr.WithLeases(noLeases),
+			guestaddr.WithNeighbors(func(tapNames []string) ([]guestaddr.Neighbor, error) {
+				snoopedTaps = tapNames
+				return nil, nil
+			}),
+			guestaddr.WithLinkAddresses(func(string) ([]netlink.Addr, error) { return nil, nil }),
+		)
+
+		_, err := learner.Learn([]api.Interface{bridgeDomainIface, passtDomainIface})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(snoopedTaps).To(Equal([]string{"tap0"}))
+	})
+
+	It("learns the pod addresses a passt interface copies into the guest", func() {
+		learner := guestaddr.NewLearner(
+			guestaddr.WithLeases(noLeases),
+			guestaddr.WithNeighbors(neighborsStub()),
+			guestaddr.WithLinkAddresses(func(linkName string) ([]netlink.Addr, error) {
+				Expect(linkName).To(Equal("eth0"))
+				return []netlink.Addr{address("10.244.0.5"), address("fe80::5"), address("fd10:244::5")}, nil
+			}),
+		)
+
+		Expect(learner.Learn([]api.Interface{passtDomainIface})).To(ConsistOf(api.InterfaceStatus{
+			Mac:        passtIfaceMAC,
+			Ip:         "10.244.0.5",
+			IPs:        []string{"10.244.0.5", "fd10:244::5"},
+			InfoSource: "passt",
+		}))
+	})
+
+	It("reports each source separately", func() {
+		learner := guestaddr.NewLearner(
+			guestaddr.WithLeases(func() map[string]string { return map[string]string{bridgeIfaceMAC: "10.0.0.10"} }),
+			guestaddr.WithNeighbors(neighborsStub(neighbor(bridgeIfaceMAC, "10.0.0.10"))),
+		)
+
+		statuses, err := learner.Learn([]api.Interface{bridgeDomainIface})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(statuses).To(HaveLen(2))
+		Expect(statuses[0].InfoSource).To(Equal("dhcp-lease"))
+		Expect(statuses[1].InfoSource).To(Equal("arp-ndp"))
+	})
+
+	It("fails when the neighbors cannot be listed", func() {
+		testErr := errors.New("test error")
+		learner := guestaddr.NewLearner(
+			guestaddr.WithLeases(noLeases),
+			guestaddr.WithNeighbors(func([]string) ([]guestaddr.Neighbor, error) { return nil, testErr }),
+		)
+
+		_, err := learner.Learn([]api.Interface{bridgeDomainIface})
+		Expect(err).To(MatchError(testErr))
+	})
+})
+
+func noLeases() map[string]string {
+	return nil
+}
+
+func neighborsStub(neighbors ...guestaddr.Neighbor) func([]string) ([]guestaddr.Neighbor, error) {
+	return func([]string) ([]guestaddr.Neighbor, error) {
+		return neighbors, nil
+	}
+}
+
+func neighbor(mac, ip string) guestaddr.Neighbor {
+	hwAddr, err := net.ParseMAC(mac)
+	Expect(err).NotTo(HaveOccurred())
+	return guestaddr.Neighbor{MAC: hwAddr, IP: net.ParseIP(ip)}
+}
+
+func address(ip string) netlink.Addr {
+	return netlink.Addr{IPNet: &net.IPNet{IP: net.ParseIP(ip)}}
+}
@@ -0,0 +1,77 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestaddr
+
+import (
+	"time"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const repeatingLogLevel = 4
+
+// Poller periodically learns the guest addresses of the domain interfaces and stores them.
+type Poller struct {
+	learner          Learner
+	domainInterfaces func() ([]api.Interface, error)
+	store            func([]api.InterfaceStatus)
+	interval         time.Duration
+}
+
+func NewPoller(
+	learner Learner,
+	domainInterfaces func() ([]api.Interface, error),
+	store func([]api.InterfaceStatus),
+	interval time.Duration,
+) *Poller {
+	return &Poller{
+		learner:          learner,
+		domainInterfaces: domainInterfaces,
+		store:            store,
+		interval:         interval,
+	}
+}
+
+// Run polls for the lifetime of the process.
+func (p *Poller) Run() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		p.poll()
+	}
+}
+
+func (p *Poller) poll() {
+	domainIfaces, err := p.domainInterfaces()
+	if err != nil {
+		log.Log.V(repeatingLogLevel).Reason(err).Info("failed to read the domain interfaces, skipping guest address learning")
+		return
+	}
+
+	statuses, err := p.learner.Learn(domainIfaces)
+	if err != nil {
+		log.Log.V(repeatingLogLevel).Reason(err).Info("failed to learn the guest addresses")
+		return
+	}
+	p.store(statuses)
+}
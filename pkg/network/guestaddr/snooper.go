@@ -0,0 +1,221 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestaddr
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/net/bpf"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/pcap"
+)
+
+const (
+	// neighborTTL is the time an address is reported after the guest last announced it.
+	// Guests refresh their ARP/NDP caches every few minutes, which renews the address.
+	neighborTTL = 10 * time.Minute
+
+	etherTypeARP  = 0x0806
+	etherTypeIPv6 = 0x86dd
+
+	ethernetHeaderLen = 14
+	ipv6HeaderLen     = 40
+	protocolICMPv6    = 58
+
+	icmpv6NeighborSolicitation  = 135
+	icmpv6NeighborAdvertisement = 136
+
+	arpHardwareEthernet = 1
+	arpProtocolIPv4     = 0x0800
+)
+
+// neighborFilter accepts the ARP frames and the IPv6 neighbor solicitations and advertisements.
+var neighborFilter = mustAssemble([]bpf.Instruction{
+	bpf.LoadAbsolute{Off: 12, Size: 2},
+	bpf.JumpIf{Cond: bpf.JumpEqual, Val: etherTypeARP, SkipTrue: 6},
+	bpf.JumpIf{Cond: bpf.JumpEqual, Val: etherTypeIPv6, SkipFalse: 6},
+	bpf.LoadAbsolute{Off: ethernetHeaderLen + 6, Size: 1},
+	bpf.JumpIf{Cond: bpf.JumpEqual, Val: protocolICMPv6, SkipFalse: 4},
+	bpf.LoadAbsolute{Off: ethernetHeaderLen + ipv6HeaderLen, Size: 1},
+	bpf.JumpIf{Cond: bpf.JumpEqual, Val: icmpv6NeighborSolicitation, SkipTrue: 1},
+	bpf.JumpIf{Cond: bpf.JumpEqual, Val: icmpv6NeighborAdvertisement, SkipFalse: 1},
+	bpf.RetConstant{Val: 256},
+	bpf.RetConstant{Val: 0},
+})
+
+// Neighbor is an address the guest announced on a tap device.
+type Neighbor struct {
+	MAC net.HardwareAddr
+	IP  net.IP
+}
+
+type neighborKey struct {
+	mac string
+	ip  string
+}
+
+// Snooper learns the guest addresses from the ARP and NDP traffic the guest sends on its tap devices.
+// It observes the taps directly, as the pod kernel does not learn the neighbors of bridged guests.
+type Snooper struct {
+	lock      sync.Mutex
+	snooped   map[string]struct{}
+	neighbors map[neighborKey]time.Time
+	open      func(tapName string) (*pcap.Socket, error)
+	now       func() time.Time
+}
+
+func NewSnooper() *Snooper {
+	return &Snooper{
+		snooped:   map[string]struct{}{},
+		neighbors: map[neighborKey]time.Time{},
+		open:      func(tapName string) (*pcap.Socket, error) { return pcap.OpenSocket(tapName, neighborFilter) },
+		now:       time.Now,
+	}
+}
+
+// Neighbors starts snooping the taps not snooped yet and returns the addresses announced on all taps
+// within the neighbor TTL.
+func (s *Snooper) Neighbors(tapNames []string) ([]Neighbor, error) {
+	for _, tapName := range tapNames {
+		if err := s.snoop(tapName); err != nil {
+			return nil, err
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var neighbors []Neighbor
+	for key, lastSeen := range s.neighbors {
+		if s.now().Sub(lastSeen) > neighborTTL {
+			delete(s.neighbors, key)
+			continue
+		}
+		mac, _ := net.ParseMAC(key.mac)
+		neighbors = append(neighbors, Neighbor{MAC: mac, IP: net.ParseIP(key.ip)})
+	}
+	return neighbors, nil
+}
+
+func (s *Snooper) snoop(tapName string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, exists := s.snooped[tapName]; exists {
+		return nil
+	}
+
+	socket, err := s.open(tapName)
+	if err != nil {
+		return err
+	}
+	s.snooped[tapName] = struct{}{}
+
+	go func() {
+		defer socket.Close()
+		err := socket.Receive(context.Background(), s.observe)
+		log.Log.V(repeatingLogLevel).Reason(err).Infof("stopped snooping the guest neighbors on %s", tapName)
+
+		// The tap is snooped again once it reappears, e.g. after the interface was hotplugged back.
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		delete(s.snooped, tapName)
+	}()
+	return nil
+}
+
+func (s *Snooper) observe(frame []byte) {
+	neighbor, ok := parseNeighbor(frame)
+	if !ok || !isGuestUnicastIP(neighbor.IP) {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.neighbors[neighborKey{mac: neighbor.MAC.String(), ip: neighbor.IP.String()}] = s.now()
+}
+
+// parseNeighbor returns the address the sender of the frame announced, from an ARP packet or
+// an IPv6 neighbor solicitation or advertisement.
+// Only the addresses claimed by the frame source MAC are returned, e.g. proxy ARP replies are ignored.
+func parseNeighbor(frame []byte) (Neighbor, bool) {
+	if len(frame) < ethernetHeaderLen {
+		return Neighbor{}, false
+	}
+	sourceMAC := net.HardwareAddr(frame[6:12])
+	payload := frame[ethernetHeaderLen:]
+
+	switch binary.BigEndian.Uint16(frame[12:14]) {
+	case etherTypeARP:
+		return parseARP(sourceMAC, payload)
+	case etherTypeIPv6:
+		return parseNDP(sourceMAC, payload)
+	}
+	return Neighbor{}, false
+}
+
+func parseARP(sourceMAC net.HardwareAddr, packet []byte) (Neighbor, bool) {
+	const arpIPv4EthernetLen = 28
+	if len(packet) < arpIPv4EthernetLen ||
+		binary.BigEndian.Uint16(packet[0:2]) != arpHardwareEthernet ||
+		binary.BigEndian.Uint16(packet[2:4]) != arpProtocolIPv4 {
+		return Neighbor{}, false
+	}
+	senderMAC := net.HardwareAddr(packet[8:14])
+	if senderMAC.String() != sourceMAC.String() {
+		return Neighbor{}, false
+	}
+	return Neighbor{MAC: cloneMAC(senderMAC), IP: net.IP(packet[14:18]).To16()}, true
+}
+
+func parseNDP(sourceMAC net.HardwareAddr, packet []byte) (Neighbor, bool) {
+	const targetAddressOffset = ipv6HeaderLen + 8
+	if len(packet) < targetAddressOffset+net.IPv6len || packet[6] != protocolICMPv6 {
+		return Neighbor{}, false
+	}
+
+	var ip net.IP
+	switch packet[ipv6HeaderLen] {
+	case icmpv6NeighborAdvertisement:
+		ip = net.IP(packet[targetAddressOffset : targetAddressOffset+net.IPv6len])
+	case icmpv6NeighborSolicitation:
+		// The source of a solicitation is unspecified during duplicate address detection,
+		// when the guest does not own the address yet.
+		ip = net.IP(packet[8 : 8+net.IPv6len])
+	default:
+		return Neighbor{}, false
+	}
+	return Neighbor{MAC: cloneMAC(sourceMAC), IP: append(net.IP(nil), ip...)}, true
+}
+
+func cloneMAC(mac net.HardwareAddr) net.HardwareAddr {
+	return append(net.HardwareAddr(nil), mac...)
+}
+
+func mustAssemble(instructions []bpf.Instruction) []bpf.RawInstruction {
+	raw, err := bpf.Assemble(instructions)
+	if err != nil {
+		panic(err)
+	}
+	return raw
+}
@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestaddr
+
+import (
+	"encoding/binary"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/bpf"
+)
+
+var _ = Describe("Guest neighbor snooper", func() {
+	const guestMAC = "02:00:00:00:00:01"
+
+	DescribeTable("learns the address announced by", func(frame []byte, expectedIP string) {
+		neighbor, ok := parseNeighbor(frame)
+		Expect(ok).To(BeTrue())
+		Expect(neighbor.MAC.String()).To(Equal(guestMAC))
+		Expect(neighbor.IP.String()).To(Equal(expectedIP))
+
+		Expect(runFilter(frame)).To(BeNumerically(">", 0))
+	},
+		Entry("an ARP request", arpFrame(guestMAC, guestMAC, "10.0.0.10"), "10.0.0.10"),
+		Entry("a neighbor advertisement", ndpFrame(guestMAC, icmpv6NeighborAdvertisement, "fd00::1", "fd00::10"), "fd00::10"),
+		Entry("a neighbor solicitation", ndpFrame(guestMAC, icmpv6NeighborSolicitation, "fd00::10", "fd00::1"), "fd00::10"),
+	)
+
+	It("ignores an ARP packet sent on behalf of another MAC address", func() {
+		_, ok := parseNeighbor(arpFrame(guestMAC, "02:00:00:00:00:99", "10.0.0.99"))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("filters out the guest traffic other than ARP and NDP", func() {
+		frame := ndpFrame(guestMAC, 128, "fd00::10", "fd00::1")
+		Expect(runFilter(frame)).To(BeZero())
+	})
+
+	It("forgets the addresses which were not announced within the TTL", func() {
+		now := time.Now()
+		snooper := NewSnooper()
+		snooper.now = func() time.Time { return now }
+
+		snooper.observe(arpFrame(guestMAC, guestMAC, "10.0.0.10"))
+		Expect(snooper.Neighbors(nil)).To(HaveLen(1))
+
+		now = now.Add(neighborTTL + time.Second)
+		Expect(snooper.Neighbors(nil)).To(BeEmpty())
+	})
+})
+
+func runFilter(frame []byte) int {
+	vm, err := bpf.NewVM(mustDisassemble(neighborFilter))
+	Expect(err).NotTo(HaveOccurred())
+	accepted, err := vm.Run(frame)
+	Expect(err).NotTo(HaveOccurred())
+	return accepted
+}
+
+func mustDisassemble(raw []bpf.RawInstruction) []bpf.Instruction {
+	instructions, allDecoded := bpf.Disassemble(raw)
+	Expect(allDecoded).To(BeTrue())
+	return instructions
+}
+
+func ethernetFrame(sourceMAC string, etherType uint16, payload []byte) []byte {
+	frame := make([]byte, ethernetHeaderLen, ethernetHeaderLen+len(payload))
+	copy(frame[0:6], net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	copy(frame[6:12], parseMAC(sourceMAC))
+	binary.BigEndian.PutUint16(frame[12:14], etherType)
+	return append(frame, payload...)
+}
+
+func arpFrame(sourceMAC, senderMAC, senderIP string) []byte {
+	packet := make([]byte, 28)
+	binary.BigEndian.PutUint16(packet[0:2], arpHardwareEthernet)
+	binary.BigEndian.PutUint16(packet[2:4], arpProtocolIPv4)
+	packet[4], packet[5] = 6, 4
+	binary.BigEndian.PutUint16(packet[6:8], 1)
+	copy(packet[8:14], parseMAC(senderMAC))
+	copy(packet[14:18], net.ParseIP(senderIP).To4())
+	copy(packet[24:28], net.ParseIP("10.0.0.1").To4())
+	return ethernetFrame(sourceMAC, etherTypeARP, packet)
+}
+
+func ndpFrame(sourceMAC string, icmpType byte, sourceIP, targetIP string) []byte {
+	packet := make([]byte, ipv6HeaderLen+8+net.IPv6len)
+	packet[0] = 0x60
+	binary.BigEndian.PutUint16(packet[4:6], uint16(8+net.IPv6len))
+	packet[6] = protocolICMPv6
+	packet[7] = 255
+	copy(packet[8:24], net.ParseIP(sourceIP))
+	copy(packet[24:40], net.ParseIP("ff02::1"))
+	packet[ipv6HeaderLen] = icmpType
+	copy(packet[ipv6HeaderLen+8:], net.ParseIP(targetIP))
+	return ethernetFrame(sourceMAC, etherTypeIPv6, packet)
+}
+
+func parseMAC(mac string) net.HardwareAddr {
+	hwAddr, err := net.ParseMAC(mac)
+	Expect(err).NotTo(HaveOccurred())
+	return hwAddr
+}
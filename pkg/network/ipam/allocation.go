@@ -0,0 +1,58 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ipam
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// AllocationsFromVMI returns the IP allocations the VM controller has handed to the VMI.
+func AllocationsFromVMI(vmi *v1.VirtualMachineInstance) ([]v1.VirtualMachineIPAllocation, error) {
+	value, exists := vmi.Annotations[v1.IPAllocationsAnnotation]
+	if !exists {
+		return nil, nil
+	}
+	var allocations []v1.VirtualMachineIPAllocation
+	if err := json.Unmarshal([]byte(value), &allocations); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal IP allocations: %w", err)
+	}
+	return allocations, nil
+}
+
+// AllocationsAnnotationValue returns the VMI annotation value carrying the given allocations.
+func AllocationsAnnotationValue(allocations []v1.VirtualMachineIPAllocation) (string, error) {
+	value, err := json.Marshal(allocations)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func LookupAllocationByNetwork(allocations []v1.VirtualMachineIPAllocation, networkName string) *v1.VirtualMachineIPAllocation {
+	for i := range allocations {
+		if allocations[i].Network == networkName {
+			return &allocations[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ipam_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/ipam"
+)
+
+var _ = Describe("IP allocations", func() {
+	allocations := []v1.VirtualMachineIPAllocation{
+		{Network: "blue", NetworkAttachmentDefinition: "default/blue", IPAddresses: []string{"10.0.0.2/24"}, Gateway: "10.0.0.1"},
+		{Network: "red", NetworkAttachmentDefinition: "default/red", IPAddresses: []string{"fd00::2/64"}},
+	}
+
+	It("are carried to the VMI by an annotation", func() {
+		value, err := ipam.AllocationsAnnotationValue(allocations)
+		Expect(err).NotTo(HaveOccurred())
+		vmi := libvmi.New(libvmi.WithAnnotation(v1.IPAllocationsAnnotation, value))
+
+		Expect(ipam.AllocationsFromVMI(vmi)).To(Equal(allocations))
+	})
+
+	It("are empty when the VMI has no annotation", func() {
+		Expect(ipam.AllocationsFromVMI(libvmi.New())).To(BeEmpty())
+	})
+
+	It("fail when the VMI annotation is malformed", func() {
+		_, err := ipam.AllocationsFromVMI(libvmi.New(libvmi.WithAnnotation(v1.IPAllocationsAnnotation, "[")))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("are looked up by network", func() {
+		Expect(ipam.LookupAllocationByNetwork(allocations, "red")).To(Equal(&allocations[1]))
+		Expect(ipam.LookupAllocationByNetwork(allocations, "green")).To(BeNil())
+	})
+})
@@ -0,0 +1,155 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ipam
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+// PoolAnnotation is set on a NetworkAttachmentDefinition to have KubeVirt allocate
+// the addresses of the bridge bound interfaces attached to it.
+// The value is a JSON encoded Pool.
+const PoolAnnotation = "kubevirt.io/ipam-pool"
+
+var ErrPoolExhausted = errors.New("IP pool is exhausted")
+
+type Pool struct {
+	// Subnet the addresses are allocated from, e.g. 10.200.0.0/24
+	Subnet string `json:"subnet"`
+	// RangeStart is the first address to allocate, defaults to the first address of the subnet.
+	RangeStart string `json:"rangeStart,omitempty"`
+	// RangeEnd is the last address to allocate, defaults to the last address of the subnet.
+	RangeEnd string `json:"rangeEnd,omitempty"`
+	// Gateway of the subnet, it is never allocated.
+	Gateway string `json:"gateway,omitempty"`
+	// Nameservers advertised to the guest.
+	Nameservers []string `json:"nameservers,omitempty"`
+}
+
+type pool struct {
+	subnet      netip.Prefix
+	start       netip.Addr
+	end         netip.Addr
+	gateway     netip.Addr
+	nameservers []string
+}
+
+// ParsePool parses and validates the pool annotation value.
+func ParsePool(value string) (*Pool, error) {
+	p := &Pool{}
+	if err := json.Unmarshal([]byte(value), p); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal IP pool: %w", err)
+	}
+	if _, err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Allocate returns the first address of the pool range which is not in use,
+// in CIDR notation with the subnet prefix length.
+func (p Pool) Allocate(inUse func(addr netip.Addr) bool) (string, error) {
+	parsedPool, err := p.parse()
+	if err != nil {
+		return "", err
+	}
+	for addr := parsedPool.start; addr.IsValid() && addr.Compare(parsedPool.end) <= 0; addr = addr.Next() {
+		if addr == parsedPool.gateway || inUse(addr) {
+			continue
+		}
+		return netip.PrefixFrom(addr, parsedPool.subnet.Bits()).String(), nil
+	}
+	return "", ErrPoolExhausted
+}
+
+func (p Pool) parse() (pool, error) {
+	subnet, err := netip.ParsePrefix(p.Subnet)
+	if err != nil {
+		return pool{}, fmt.Errorf("invalid IP pool subnet: %w", err)
+	}
+	subnet = subnet.Masked()
+	parsedPool := pool{subnet: subnet, nameservers: p.Nameservers}
+
+	parsedPool.start, parsedPool.end = usableRange(subnet)
+	if p.RangeStart != "" {
+		if parsedPool.start, err = parseAddrInSubnet(p.RangeStart, subnet); err != nil {
+			return pool{}, fmt.Errorf("invalid IP pool range start: %w", err)
+		}
+	}
+	if p.RangeEnd != "" {
+		if parsedPool.end, err = parseAddrInSubnet(p.RangeEnd, subnet); err != nil {
+			return pool{}, fmt.Errorf("invalid IP pool range end: %w", err)
+		}
+	}
+	if parsedPool.start.Compare(parsedPool.end) > 0 {
+		return pool{}, fmt.Errorf("IP pool range start %s is greater than range end %s", parsedPool.start, parsedPool.end)
+	}
+	if p.Gateway != "" {
+		if parsedPool.gateway, err = parseAddrInSubnet(p.Gateway, subnet); err != nil {
+			return pool{}, fmt.Errorf("invalid IP pool gateway: %w", err)
+		}
+	}
+	for _, nameserver := range p.Nameservers {
+		if _, err := netip.ParseAddr(nameserver); err != nil {
+			return pool{}, fmt.Errorf("invalid IP pool nameserver: %w", err)
+		}
+	}
+	return parsedPool, nil
+}
+
+// usableRange returns the subnet addresses which can be assigned to hosts.
+// The IPv4 network and broadcast addresses, and the IPv6 subnet-router anycast address are excluded.
+func usableRange(subnet netip.Prefix) (netip.Addr, netip.Addr) {
+	first := subnet.Addr()
+	last := lastAddr(subnet)
+	if first == last {
+		return first, last
+	}
+	if subnet.Addr().Is4() && subnet.Bits() < 31 {
+		return first.Next(), last.Prev()
+	}
+	if subnet.Addr().Is6() && subnet.Bits() < 127 {
+		return first.Next(), last
+	}
+	return first, last
+}
+
+func lastAddr(subnet netip.Prefix) netip.Addr {
+	bytes := subnet.Addr().AsSlice()
+	for bit := subnet.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 0x80 >> (bit % 8)
+	}
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
+
+func parseAddrInSubnet(value string, subnet netip.Prefix) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	if !subnet.Contains(addr) {
+		return netip.Addr{}, fmt.Errorf("%s is not in subnet %s", addr, subnet)
+	}
+	return addr, nil
+}
@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ipam_test
+
+import (
+	"net/netip"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/ipam"
+)
+
+var _ = Describe("IP pool", func() {
+	inUseOf := func(addrs ...string) func(netip.Addr) bool {
+		return func(addr netip.Addr) bool {
+			for _, a := range addrs {
+				if netip.MustParseAddr(a) == addr {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	It("parses a pool", func() {
+		pool, err := ipam.ParsePool(`{"subnet":"10.200.0.0/24","gateway":"10.200.0.1","nameservers":["10.200.0.2"]}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pool).To(Equal(&ipam.Pool{Subnet: "10.200.0.0/24", Gateway: "10.200.0.1", Nameservers: []string{"10.200.0.2"}}))
+	})
+
+	DescribeTable("rejects the pool", func(value, expectedErr string) {
+		_, err := ipam.ParsePool(value)
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("which is not JSON", `subnet: 10.0.0.0/24`, "failed to unmarshal IP pool"),
+		Entry("with an invalid subnet", `{"subnet":"10.0.0.0"}`, "invalid IP pool subnet"),
+		Entry("with a range start outside the subnet",
+			`{"subnet":"10.0.0.0/24","rangeStart":"10.0.1.1"}`, "invalid IP pool range start: 10.0.1.1 is not in subnet 10.0.0.0/24"),
+		Entry("with an invalid range end", `{"subnet":"10.0.0.0/24","rangeEnd":"x"}`, "invalid IP pool range end"),
+		Entry("with a range start greater than the range end",
+			`{"subnet":"10.0.0.0/24","rangeStart":"10.0.0.20","rangeEnd":"10.0.0.10"}`, "is greater than range end"),
+		Entry("with a gateway outside the subnet", `{"subnet":"10.0.0.0/24","gateway":"10.0.1.1"}`, "invalid IP pool gateway"),
+		Entry("with an invalid nameserver", `{"subnet":"10.0.0.0/24","nameservers":["dns"]}`, "invalid IP pool nameserver"),
+	)
+
+	DescribeTable("allocates", func(pool ipam.Pool, inUse func(netip.Addr) bool, expectedAddr string) {
+		Expect(pool.Allocate(inUse)).To(Equal(expectedAddr))
+	},
+		Entry("the first host address of an IPv4 subnet", ipam.Pool{Subnet: "10.0.0.0/24"}, inUseOf(), "10.0.0.1/24"),
+		Entry("skipping the gateway and addresses in use",
+			ipam.Pool{Subnet: "10.0.0.0/24", Gateway: "10.0.0.1"}, inUseOf("10.0.0.2"), "10.0.0.3/24"),
+		Entry("from the range start", ipam.Pool{Subnet: "10.0.0.0/24", RangeStart: "10.0.0.100"}, inUseOf(), "10.0.0.100/24"),
+		Entry("from a non masked subnet", ipam.Pool{Subnet: "10.0.0.7/24"}, inUseOf(), "10.0.0.1/24"),
+		Entry("the first host address of an IPv6 subnet", ipam.Pool{Subnet: "fd00::/64"}, inUseOf("fd00::1"), "fd00::2/64"),
+	)
+
+	DescribeTable("fails to allocate when the pool is exhausted", func(pool ipam.Pool, inUse func(netip.Addr) bool) {
+		_, err := pool.Allocate(inUse)
+		Expect(err).To(MatchError(ipam.ErrPoolExhausted))
+	},
+		Entry("excluding the IPv4 broadcast address", ipam.Pool{Subnet: "10.0.0.0/30"}, inUseOf("10.0.0.1", "10.0.0.2")),
+		Entry("with a range end", ipam.Pool{Subnet: "10.0.0.0/24", RangeEnd: "10.0.0.2", Gateway: "10.0.0.1"}, inUseOf("10.0.0.2")),
+	)
+})
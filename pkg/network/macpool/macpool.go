@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package macpool
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var ErrPoolExhausted = errors.New("MAC pool is exhausted")
+
+type macRange struct {
+	start uint64
+	end   uint64
+}
+
+// RangesForNamespace returns the ranges to allocate from for the given namespace.
+// A namespace override takes precedence over the cluster wide ranges.
+func RangesForNamespace(config *v1.MACPoolConfiguration, namespace string) []v1.MACRange {
+	if config == nil {
+		return nil
+	}
+	for _, override := range config.NamespaceOverrides {
+		if override.Namespace == namespace {
+			return override.Ranges
+		}
+	}
+	return config.Ranges
+}
+
+// ValidateRange checks that the range boundaries are unicast MAC addresses in ascending order.
+func ValidateRange(r v1.MACRange) error {
+	_, err := parseRange(r)
+	return err
+}
+
+// Allocate returns the first address of the ranges which is not in use.
+func Allocate(ranges []v1.MACRange, inUse func(mac string) bool) (string, error) {
+	for _, r := range ranges {
+		parsedRange, err := parseRange(r)
+		if err != nil {
+			return "", err
+		}
+		for addr := parsedRange.start; addr <= parsedRange.end; addr++ {
+			mac := toMAC(addr).String()
+			if !inUse(mac) {
+				return mac, nil
+			}
+		}
+	}
+	return "", ErrPoolExhausted
+}
+
+// Normalize returns the canonical form of a MAC address, the form Allocate returns.
+func Normalize(mac string) (string, error) {
+	hwAddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return "", err
+	}
+	return hwAddr.String(), nil
+}
+
+func parseRange(r v1.MACRange) (macRange, error) {
+	start, err := parseUnicastMAC(r.Start)
+	if err != nil {
+		return macRange{}, fmt.Errorf("invalid range start: %w", err)
+	}
+	end, err := parseUnicastMAC(r.End)
+	if err != nil {
+		return macRange{}, fmt.Errorf("invalid range end: %w", err)
+	}
+	if start > end {
+		return macRange{}, fmt.Errorf("range start %s is greater than range end %s", r.Start, r.End)
+	}
+	// The multicast bit is part of the first octet, a range spanning it includes multicast addresses.
+	if start>>40 != end>>40 {
+		return macRange{}, fmt.Errorf("range %s-%s must not span more than one first octet", r.Start, r.End)
+	}
+	return macRange{start: start, end: end}, nil
+}
+
+func parseUnicastMAC(mac string) (uint64, error) {
+	hwAddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return 0, err
+	}
+	if len(hwAddr) != 6 {
+		return 0, fmt.Errorf("%s is not a 48-bit MAC address", mac)
+	}
+	if hwAddr[0]&0x01 != 0 {
+		return 0, fmt.Errorf("%s is a multicast MAC address", mac)
+	}
+	var buf [8]byte
+	copy(buf[2:], hwAddr)
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
+
+func toMAC(addr uint64) net.HardwareAddr {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], addr)
+	return net.HardwareAddr(buf[2:])
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package macpool_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/macpool"
+)
+
+var _ = Describe("MAC pool", func() {
+	const testNamespace = "ns1"
+
+	config := &v1.MACPoolConfiguration{
+		Ranges: []v1.MACRange{{Start: "02:00:00:00:00:00", End: "02:00:00:ff:ff:ff"}},
+		NamespaceOverrides: []v1.NamespaceMACPool{
+			{Namespace: testNamespace, Ranges: []v1.MACRange{{Start: "02:10:00:00:00:00", End: "02:10:00:00:00:ff"}}},
+			{Namespace: "opted-out"},
+		},
+	}
+
+	DescribeTable("ranges for namespace", func(config *v1.MACPoolConfiguration, namespace string, expectedRanges []v1.MACRange) {
+		Expect(macpool.RangesForNamespace(config, namespace)).To(Equal(expectedRanges))
+	},
+		Entry("without a configuration", nil, "default", nil),
+		Entry("without an override", config, "default", config.Ranges),
+		Entry("with an override", config, testNamespace, config.NamespaceOverrides[0].Ranges),
+		Entry("with an override without ranges", config, "opted-out", nil),
+	)
+
+	It("allocates the first free address", func() {
+		inUse := map[string]bool{"02:00:00:00:00:00": true, "02:00:00:00:00:01": true}
+		Expect(macpool.Allocate(config.Ranges, func(mac string) bool { return inUse[mac] })).To(Equal("02:00:00:00:00:02"))
+	})
+
+	It("allocates across octet boundaries", func() {
+		ranges := []v1.MACRange{{Start: "02:00:00:00:00:ff", End: "02:00:00:00:01:ff"}}
+		inUse := map[string]bool{"02:00:00:00:00:ff": true}
+		Expect(macpool.Allocate(ranges, func(mac string) bool { return inUse[mac] })).To(Equal("02:00:00:00:01:00"))
+	})
+
+	It("allocates from the next range when a range is exhausted", func() {
+		ranges := []v1.MACRange{
+			{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:00"},
+			{Start: "02:00:00:00:01:00", End: "02:00:00:00:01:01"},
+		}
+		inUse := map[string]bool{"02:00:00:00:00:00": true}
+		Expect(macpool.Allocate(ranges, func(mac string) bool { return inUse[mac] })).To(Equal("02:00:00:00:01:00"))
+	})
+
+	It("fails to allocate when all the ranges are exhausted", func() {
+		ranges := []v1.MACRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:01"}}
+		_, err := macpool.Allocate(ranges, func(string) bool { return true })
+		Expect(err).To(MatchError(macpool.ErrPoolExhausted))
+	})
+
+	It("normalizes addresses", func() {
+		Expect(macpool.Normalize("02-00-00-AB-CD-EF")).To(Equal("02:00:00:ab:cd:ef"))
+	})
+
+	DescribeTable("accepts the range", func(r v1.MACRange) {
+		Expect(macpool.ValidateRange(r)).To(Succeed())
+	},
+		Entry("of a single address", v1.MACRange{Start: "02:00:00:00:00:01", End: "02:00:00:00:00:01"}),
+		Entry("with upper case addresses", v1.MACRange{Start: "0A:00:00:00:00:00", End: "0A:FF:FF:FF:FF:FF"}),
+	)
+
+	DescribeTable("rejects the range", func(r v1.MACRange, expectedErr string) {
+		Expect(macpool.ValidateRange(r)).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("with an invalid start", v1.MACRange{Start: "02:00:00", End: "02:00:00:00:00:01"}, "invalid range start"),
+		Entry("with an invalid end", v1.MACRange{Start: "02:00:00:00:00:01", End: "zz"}, "invalid range end"),
+		Entry("with a multicast address", v1.MACRange{Start: "01:00:00:00:00:00", End: "01:00:00:00:00:ff"}, "multicast"),
+		Entry("with a start greater than the end",
+			v1.MACRange{Start: "02:00:00:00:00:02", End: "02:00:00:00:00:01"}, "greater than range end"),
+		Entry("spanning more than one first octet",
+			v1.MACRange{Start: "02:00:00:00:00:00", End: "04:00:00:00:00:00"}, "must not span more than one first octet"),
+		Entry("of EUI-64 addresses",
+			v1.MACRange{Start: "02:00:00:00:00:00:00:00", End: "02:00:00:00:00:00:00:01"}, "is not a 48-bit MAC address"),
+	)
+})
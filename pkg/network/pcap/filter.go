@@ -0,0 +1,513 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pcap
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/bpf"
+)
+
+const (
+	maxFilterLength       = 1024
+	maxFilterInstructions = 4096
+)
+
+// Offsets in the Ethernet frames captured on the tap device.
+const (
+	etherDstOffset  = 0
+	etherSrcOffset  = 6
+	etherTypeOffset = 12
+	ipOffset        = 14
+
+	ipProtoOffset    = ipOffset + 9
+	ipFragOffset     = ipOffset + 6
+	ipSrcOffset      = ipOffset + 12
+	ipDstOffset      = ipOffset + 16
+	ip6NextHdrOffset = ipOffset + 6
+	ip6SrcOffset     = ipOffset + 8
+	ip6DstOffset     = ipOffset + 24
+	ip6PayloadOffset = ipOffset + 40
+	arpSenderOffset  = ipOffset + 14
+	arpTargetOffset  = ipOffset + 24
+
+	etherTypeIPv4 = 0x0800
+	etherTypeARP  = 0x0806
+	etherTypeIPv6 = 0x86dd
+
+	ipProtoICMP   = 1
+	ipProtoTCP    = 6
+	ipProtoUDP    = 17
+	ipProtoICMPv6 = 58
+	ipProtoSCTP   = 132
+)
+
+// CompileFilter compiles a pcap-filter expression into a classic BPF program matching Ethernet frames.
+// The following subset of the pcap-filter syntax is supported:
+//   - the protocols ip, ip6, arp, tcp, udp, sctp, icmp and icmp6
+//   - [ip|ip6|arp] [src|dst] host <address>
+//   - [src|dst] net <address>/<prefix length>
+//   - [tcp|udp|sctp] [src|dst] port <port>, and portrange <port>-<port>
+//   - ether [src|dst] host <MAC address>
+//
+// Primitives are combined with and, or, not, &&, || and !, and grouped with parentheses.
+// Host names and service names are not resolved.
+func CompileFilter(expression string) ([]bpf.RawInstruction, error) {
+	if len(expression) > maxFilterLength {
+		return nil, fmt.Errorf("filter expression is longer than %d characters", maxFilterLength)
+	}
+	p := &filterParser{tokens: tokenizeFilter(expression)}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("filter expression is empty")
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter expression %q: %v", expression, err)
+	}
+	if token := p.peek(); token != "" {
+		return nil, fmt.Errorf("invalid filter expression %q: unexpected %q", expression, token)
+	}
+
+	program, err := newFilterCompiler().compile(root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter expression %q: %v", expression, err)
+	}
+	return program, nil
+}
+
+func tokenizeFilter(expression string) []string {
+	for _, operator := range []string{"(", ")", "&&", "||", "!"} {
+		expression = strings.ReplaceAll(expression, operator, " "+operator+" ")
+	}
+	return strings.Fields(expression)
+}
+
+// filterNode is a node of a parsed filter expression.
+type filterNode interface{}
+
+type andNode struct{ left, right filterNode }
+
+type orNode struct{ left, right filterNode }
+
+type notNode struct{ node filterNode }
+
+// testNode loads a field of the packet and compares it with a value.
+// Fields of the IPv4 payload are loaded relative to the IPv4 header length.
+type testNode struct {
+	offset    uint32
+	size      int
+	ipPayload bool
+	mask      uint32
+	cond      bpf.JumpTest
+	value     uint32
+}
+
+func allOf(nodes ...filterNode) filterNode {
+	node := nodes[0]
+	for _, next := range nodes[1:] {
+		node = andNode{node, next}
+	}
+	return node
+}
+
+func anyOf(nodes ...filterNode) filterNode {
+	node := nodes[0]
+	for _, next := range nodes[1:] {
+		node = orNode{node, next}
+	}
+	return node
+}
+
+func fieldEquals(offset uint32, size int, value uint32) filterNode {
+	return testNode{offset: offset, size: size, cond: bpf.JumpEqual, value: value}
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *filterParser) next() string {
+	token := p.peek()
+	if token != "" {
+		p.pos++
+	}
+	return token
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	node, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "or" || p.peek() == "||" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		node = orNode{node, right}
+	}
+	return node, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	node, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "and" || p.peek() == "&&" {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		node = andNode{node, right}
+	}
+	return node, nil
+}
+
+func (p *filterParser) parseNot() (filterNode, error) {
+	switch p.peek() {
+	case "not", "!":
+		p.next()
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{node}, nil
+	case "(":
+		p.next()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if token := p.next(); token != ")" {
+			return nil, fmt.Errorf("expected \")\" instead of %q", token)
+		}
+		return node, nil
+	}
+	return p.parsePrimitive()
+}
+
+// parsePrimitive parses a primitive, made of an optional protocol, an optional direction and a type with its value.
+func (p *filterParser) parsePrimitive() (filterNode, error) {
+	proto := ""
+	switch p.peek() {
+	case "ether", "ip", "ip6", "arp", "tcp", "udp", "sctp", "icmp", "icmp6":
+		proto = p.next()
+	}
+	direction := ""
+	switch p.peek() {
+	case "src", "dst":
+		direction = p.next()
+	}
+
+	kind := p.next()
+	switch kind {
+	case "host":
+		return p.parseHost(proto, direction)
+	case "net":
+		if proto != "" {
+			return nil, fmt.Errorf("net does not support the %s qualifier", proto)
+		}
+		return p.parseNet(direction)
+	case "port", "portrange":
+		return p.parsePort(proto, direction, kind == "portrange")
+	}
+
+	if kind == "" {
+		if proto != "" && proto != "ether" && direction == "" {
+			return protocolNode(proto), nil
+		}
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	// The kind is not part of the primitive, it is either the address of "ether src <MAC address>",
+	// or follows a protocol primitive.
+	p.pos--
+	if proto == "ether" && direction != "" {
+		return p.parseHost(proto, direction)
+	}
+	if proto != "" && proto != "ether" && direction == "" {
+		return protocolNode(proto), nil
+	}
+	return nil, fmt.Errorf("unexpected %q", kind)
+}
+
+func protocolNode(proto string) filterNode {
+	switch proto {
+	case "ip":
+		return fieldEquals(etherTypeOffset, 2, etherTypeIPv4)
+	case "ip6":
+		return fieldEquals(etherTypeOffset, 2, etherTypeIPv6)
+	case "arp":
+		return fieldEquals(etherTypeOffset, 2, etherTypeARP)
+	case "icmp":
+		return allOf(protocolNode("ip"), fieldEquals(ipProtoOffset, 1, ipProtoICMP))
+	case "icmp6":
+		return allOf(protocolNode("ip6"), fieldEquals(ip6NextHdrOffset, 1, ipProtoICMPv6))
+	}
+	return anyOf(ipTransportNode(transportProtocols[proto]...), ip6TransportNode(transportProtocols[proto]...))
+}
+
+var transportProtocols = map[string][]uint32{
+	"tcp":  {ipProtoTCP},
+	"udp":  {ipProtoUDP},
+	"sctp": {ipProtoSCTP},
+	"":     {ipProtoTCP, ipProtoUDP, ipProtoSCTP},
+}
+
+func ipTransportNode(protocols ...uint32) filterNode {
+	var protoNodes []filterNode
+	for _, proto := range protocols {
+		protoNodes = append(protoNodes, fieldEquals(ipProtoOffset, 1, proto))
+	}
+	return allOf(protocolNode("ip"), anyOf(protoNodes...))
+}
+
+func ip6TransportNode(protocols ...uint32) filterNode {
+	var protoNodes []filterNode
+	for _, proto := range protocols {
+		protoNodes = append(protoNodes, fieldEquals(ip6NextHdrOffset, 1, proto))
+	}
+	return allOf(protocolNode("ip6"), anyOf(protoNodes...))
+}
+
+// directionNode matches the packets whose source or destination matches, as selected by the direction.
+func directionNode(direction string, src, dst filterNode) filterNode {
+	switch direction {
+	case "src":
+		return src
+	case "dst":
+		return dst
+	}
+	return anyOf(src, dst)
+}
+
+func (p *filterParser) parseHost(proto, direction string) (filterNode, error) {
+	value := p.next()
+	if proto == "ether" {
+		mac, err := net.ParseMAC(value)
+		if err != nil || len(mac) != 6 {
+			return nil, fmt.Errorf("invalid MAC address %q", value)
+		}
+		return directionNode(direction, macNode(etherSrcOffset, mac), macNode(etherDstOffset, mac)), nil
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid host address %q", value)
+	}
+	prefix := netip.PrefixFrom(addr, addr.BitLen())
+	switch {
+	case addr.Is4() && (proto == "" || proto == "ip" || proto == "arp"):
+		var nodes []filterNode
+		if proto != "arp" {
+			nodes = append(nodes, ipNetNode(direction, prefix))
+		}
+		if proto != "ip" {
+			nodes = append(nodes, allOf(protocolNode("arp"),
+				directionNode(direction, prefixNode(arpSenderOffset, prefix), prefixNode(arpTargetOffset, prefix))))
+		}
+		return anyOf(nodes...), nil
+	case addr.Is6() && (proto == "" || proto == "ip6"):
+		return ipNetNode(direction, prefix), nil
+	}
+	return nil, fmt.Errorf("host %s does not match the %s qualifier", value, proto)
+}
+
+func (p *filterParser) parseNet(direction string) (filterNode, error) {
+	value := p.next()
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid network %q", value)
+	}
+	return ipNetNode(direction, prefix.Masked()), nil
+}
+
+// ipNetNode matches the IPv4 or IPv6 packets whose source or destination address is in the network.
+func ipNetNode(direction string, prefix netip.Prefix) filterNode {
+	if prefix.Addr().Is4() {
+		return allOf(protocolNode("ip"), directionNode(direction, prefixNode(ipSrcOffset, prefix), prefixNode(ipDstOffset, prefix)))
+	}
+	return allOf(protocolNode("ip6"), directionNode(direction, prefixNode(ip6SrcOffset, prefix), prefixNode(ip6DstOffset, prefix)))
+}
+
+// prefixNode matches the address at the offset against the network, word by word.
+func prefixNode(offset uint32, prefix netip.Prefix) filterNode {
+	addr := prefix.Addr().AsSlice()
+	var nodes []filterNode
+	for word, bits := 0, prefix.Bits(); bits > 0; word, bits = word+1, bits-32 {
+		mask := uint32(0xffffffff)
+		if bits < 32 {
+			mask = ^(mask >> bits)
+		}
+		node := testNode{
+			offset: offset + uint32(word*4),
+			size:   4,
+			cond:   bpf.JumpEqual,
+			value:  binary.BigEndian.Uint32(addr[word*4:]),
+		}
+		if mask != 0xffffffff {
+			node.mask = mask
+		}
+		nodes = append(nodes, node)
+	}
+	if len(nodes) == 0 {
+		// A zero length prefix matches any address.
+		return testNode{offset: offset, size: 1, cond: bpf.JumpGreaterOrEqual, value: 0}
+	}
+	return allOf(nodes...)
+}
+
+func macNode(offset uint32, mac net.HardwareAddr) filterNode {
+	return allOf(
+		fieldEquals(offset, 4, binary.BigEndian.Uint32(mac[:4])),
+		fieldEquals(offset+4, 2, uint32(binary.BigEndian.Uint16(mac[4:]))),
+	)
+}
+
+func (p *filterParser) parsePort(proto, direction string, isRange bool) (filterNode, error) {
+	protocols, supported := transportProtocols[proto]
+	if !supported {
+		return nil, fmt.Errorf("port does not support the %s qualifier", proto)
+	}
+
+	value := p.next()
+	first, last := value, value
+	if isRange {
+		var found bool
+		if first, last, found = strings.Cut(value, "-"); !found {
+			return nil, fmt.Errorf("invalid port range %q", value)
+		}
+	}
+	firstPort, err := strconv.ParseUint(first, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port %q", first)
+	}
+	lastPort, err := strconv.ParseUint(last, 10, 16)
+	if err != nil || lastPort < firstPort {
+		return nil, fmt.Errorf("invalid port %q", last)
+	}
+
+	portNode := func(offset uint32, ipPayload bool) filterNode {
+		if firstPort == lastPort {
+			return testNode{offset: offset, size: 2, ipPayload: ipPayload, cond: bpf.JumpEqual, value: uint32(firstPort)}
+		}
+		return allOf(
+			testNode{offset: offset, size: 2, ipPayload: ipPayload, cond: bpf.JumpGreaterOrEqual, value: uint32(firstPort)},
+			testNode{offset: offset, size: 2, ipPayload: ipPayload, cond: bpf.JumpLessOrEqual, value: uint32(lastPort)},
+		)
+	}
+	// Only the first IPv4 fragment holds the transport header.
+	notFragment := notNode{testNode{offset: ipFragOffset, size: 2, cond: bpf.JumpBitsSet, value: 0x1fff}}
+	return anyOf(
+		allOf(ipTransportNode(protocols...), notFragment, directionNode(direction, portNode(0, true), portNode(2, true))),
+		allOf(ip6TransportNode(protocols...), directionNode(direction, portNode(ip6PayloadOffset, false), portNode(ip6PayloadOffset+2, false))),
+	), nil
+}
+
+// filterCompiler generates the program of a filter expression, with short-circuit evaluation.
+// Every node jumps forward to the labels of its true and false outcomes.
+type filterCompiler struct {
+	instructions []bpf.Instruction
+	// jumps holds the true and false labels of the conditional jumps, by instruction index.
+	jumps  map[int][2]int
+	labels []int
+}
+
+func newFilterCompiler() *filterCompiler {
+	return &filterCompiler{jumps: map[int][2]int{}}
+}
+
+func (c *filterCompiler) newLabel() int {
+	c.labels = append(c.labels, -1)
+	return len(c.labels) - 1
+}
+
+func (c *filterCompiler) bind(label int) {
+	c.labels[label] = len(c.instructions)
+}
+
+func (c *filterCompiler) compile(root filterNode) ([]bpf.RawInstruction, error) {
+	accept, reject := c.newLabel(), c.newLabel()
+	c.generate(root, accept, reject)
+	c.bind(accept)
+	c.instructions = append(c.instructions, bpf.RetConstant{Val: SnapLen})
+	c.bind(reject)
+	c.instructions = append(c.instructions, bpf.RetConstant{Val: 0})
+
+	if len(c.instructions) > maxFilterInstructions {
+		return nil, fmt.Errorf("the program exceeds %d instructions", maxFilterInstructions)
+	}
+	for index, labels := range c.jumps {
+		jump := c.instructions[index].(bpf.JumpIf)
+		skipTrue, skipFalse := c.labels[labels[0]]-index-1, c.labels[labels[1]]-index-1
+		if skipTrue > 0xff || skipFalse > 0xff {
+			return nil, fmt.Errorf("the expression is too long")
+		}
+		jump.SkipTrue, jump.SkipFalse = uint8(skipTrue), uint8(skipFalse)
+		c.instructions[index] = jump
+	}
+	return bpf.Assemble(c.instructions)
+}
+
+func (c *filterCompiler) generate(node filterNode, onTrue, onFalse int) {
+	switch n := node.(type) {
+	case andNode:
+		right := c.newLabel()
+		c.generate(n.left, right, onFalse)
+		c.bind(right)
+		c.generate(n.right, onTrue, onFalse)
+	case orNode:
+		right := c.newLabel()
+		c.generate(n.left, onTrue, right)
+		c.bind(right)
+		c.generate(n.right, onTrue, onFalse)
+	case notNode:
+		c.generate(n.node, onFalse, onTrue)
+	case testNode:
+		if n.ipPayload {
+			c.instructions = append(c.instructions,
+				bpf.LoadMemShift{Off: ipOffset},
+				bpf.LoadIndirect{Off: ipOffset + n.offset, Size: n.size},
+			)
+		} else {
+			c.instructions = append(c.instructions, bpf.LoadAbsolute{Off: n.offset, Size: n.size})
+		}
+		if n.mask != 0 {
+			c.instructions = append(c.instructions, bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: n.mask})
+		}
+		c.jumps[len(c.instructions)] = [2]int{onTrue, onFalse}
+		c.instructions = append(c.instructions, bpf.JumpIf{Cond: n.cond, Val: n.value})
+	}
+}
@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pcap_test
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"golang.org/x/net/bpf"
+
+	"kubevirt.io/kubevirt/pkg/network/pcap"
+)
+
+var _ = Describe("BPF filter", func() {
+	const (
+		guestMAC = "02:00:00:00:00:01"
+		peerMAC  = "02:00:00:00:00:02"
+		guestIP  = "10.0.2.2"
+		peerIP   = "192.168.1.5"
+		guestIP6 = "fd10:0:2::2"
+		peerIP6  = "fd00::5"
+	)
+
+	var (
+		tcpToPeer         = ipv4Packet(guestMAC, peerMAC, guestIP, peerIP, 6, 5, transportHeader(40000, 22))
+		tcpWithIPOptions  = ipv4Packet(guestMAC, peerMAC, guestIP, peerIP, 6, 6, transportHeader(40000, 22))
+		tcpFragment       = fragment(ipv4Packet(guestMAC, peerMAC, guestIP, peerIP, 6, 5, transportHeader(40000, 22)))
+		udpFromPeer       = ipv4Packet(peerMAC, guestMAC, peerIP, guestIP, 17, 5, transportHeader(53, 35000))
+		icmpToPeer        = ipv4Packet(guestMAC, peerMAC, guestIP, peerIP, 1, 5, []byte{8, 0, 0, 0})
+		udp6ToPeer        = ipv6Packet(guestMAC, peerMAC, guestIP6, peerIP6, 17, transportHeader(35000, 5353))
+		icmp6FromPeer     = ipv6Packet(peerMAC, guestMAC, peerIP6, guestIP6, 58, []byte{128, 0, 0, 0})
+		arpRequestToGuest = arpPacket(peerMAC, peerIP, guestIP)
+	)
+
+	DescribeTable("should match the packets of the expression", func(expression string, packet []byte, expectedMatch bool) {
+		program, err := pcap.CompileFilter(expression)
+		Expect(err).NotTo(HaveOccurred())
+		instructions, allDecoded := bpf.Disassemble(program)
+		Expect(allDecoded).To(BeTrue())
+		vm, err := bpf.NewVM(instructions)
+		Expect(err).NotTo(HaveOccurred())
+
+		accepted, err := vm.Run(packet)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(accepted > 0).To(Equal(expectedMatch))
+	},
+		Entry("ip on IPv4", "ip", tcpToPeer, true),
+		Entry("ip on IPv6", "ip", udp6ToPeer, false),
+		Entry("ip6 on IPv6", "ip6", udp6ToPeer, true),
+		Entry("arp on ARP", "arp", arpRequestToGuest, true),
+		Entry("tcp on TCP", "tcp", tcpToPeer, true),
+		Entry("tcp on UDP", "tcp", udpFromPeer, false),
+		Entry("udp on UDP over IPv6", "udp", udp6ToPeer, true),
+		Entry("icmp on ICMP", "icmp", icmpToPeer, true),
+		Entry("icmp on ICMPv6", "icmp", icmp6FromPeer, false),
+		Entry("icmp6 on ICMPv6", "icmp6", icmp6FromPeer, true),
+		Entry("host on the source", "host "+guestIP, tcpToPeer, true),
+		Entry("host on the destination", "host "+guestIP, udpFromPeer, true),
+		Entry("host on an ARP request", "host "+guestIP, arpRequestToGuest, true),
+		Entry("ip host on an ARP request", "ip host "+guestIP, arpRequestToGuest, false),
+		Entry("src host on the destination", "src host "+guestIP, udpFromPeer, false),
+		Entry("dst host on the destination", "dst host "+guestIP, udpFromPeer, true),
+		Entry("IPv6 host", "host "+peerIP6, udp6ToPeer, true),
+		Entry("IPv6 host on another address", "host fd00::6", udp6ToPeer, false),
+		Entry("net containing the address", "net 192.168.0.0/16", tcpToPeer, true),
+		Entry("net not containing the address", "net 192.168.2.0/24", tcpToPeer, false),
+		Entry("IPv6 net containing the address", "dst net fd00::/64", udp6ToPeer, true),
+		Entry("port", "port 22", tcpToPeer, true),
+		Entry("port behind IPv4 options", "port 22", tcpWithIPOptions, true),
+		Entry("port on a non first fragment", "port 22", tcpFragment, false),
+		Entry("src port on the destination port", "src port 22", tcpToPeer, false),
+		Entry("udp port on a TCP port", "udp port 22", tcpToPeer, false),
+		Entry("IPv6 port", "udp dst port 5353", udp6ToPeer, true),
+		Entry("portrange containing the port", "portrange 20-30", tcpToPeer, true),
+		Entry("portrange not containing the port", "portrange 23-30", tcpToPeer, false),
+		Entry("ether host", "ether host "+peerMAC, tcpToPeer, true),
+		Entry("ether src", "ether src "+peerMAC, tcpToPeer, false),
+		Entry("and", "tcp and dst port 22", tcpToPeer, true),
+		Entry("or", "udp || icmp", icmpToPeer, true),
+		Entry("not", "not icmp", icmpToPeer, false),
+		Entry("grouping", "host "+guestIP+" and (tcp port 80 or icmp)", tcpToPeer, false),
+		Entry("negated grouping", "!(udp or icmp)", tcpToPeer, true),
+	)
+
+	DescribeTable("should reject", func(expression string) {
+		_, err := pcap.CompileFilter(expression)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("an empty expression", ""),
+		Entry("a BPF program", "4,40 0 0 12,21 0 1 2048,6 0 0 262144,6 0 0 0"),
+		Entry("a host name", "host example.com"),
+		Entry("a service name", "port ssh"),
+		Entry("an out of range port", "port 65536"),
+		Entry("an inverted port range", "portrange 30-20"),
+		Entry("a mismatching host qualifier", "ip6 host "+guestIP),
+		Entry("a port on ICMP", "icmp port 1"),
+		Entry("a missing value", "tcp port"),
+		Entry("an unbalanced parenthesis", "(tcp or udp"),
+		Entry("a dangling operator", "tcp and"),
+		Entry("an unknown primitive", "vlan 100"),
+		Entry("a too long expression", strings.Repeat("tcp or ", 200)+"udp"),
+	)
+})
+
+func ethernetHeader(srcMAC, dstMAC string, etherType uint16) []byte {
+	header := append(mustParseMAC(dstMAC), mustParseMAC(srcMAC)...)
+	return binary.BigEndian.AppendUint16(header, etherType)
+}
+
+func ipv4Packet(srcMAC, dstMAC, srcIP, dstIP string, protocol byte, headerWords int, payload []byte) []byte {
+	header := make([]byte, headerWords*4)
+	header[0] = 0x40 | byte(headerWords)
+	binary.BigEndian.PutUint16(header[2:], uint16(len(header)+len(payload)))
+	header[8] = 64
+	header[9] = protocol
+	copy(header[12:], netip.MustParseAddr(srcIP).AsSlice())
+	copy(header[16:], netip.MustParseAddr(dstIP).AsSlice())
+	packet := append(ethernetHeader(srcMAC, dstMAC, 0x0800), header...)
+	return append(packet, payload...)
+}
+
+// fragment turns the IPv4 packet into a non first fragment.
+func fragment(packet []byte) []byte {
+	binary.BigEndian.PutUint16(packet[14+6:], 185)
+	return packet
+}
+
+func ipv6Packet(srcMAC, dstMAC, srcIP, dstIP string, nextHeader byte, payload []byte) []byte {
+	header := make([]byte, 40)
+	header[0] = 0x60
+	binary.BigEndian.PutUint16(header[4:], uint16(len(payload)))
+	header[6] = nextHeader
+	header[7] = 64
+	copy(header[8:], netip.MustParseAddr(srcIP).AsSlice())
+	copy(header[24:], netip.MustParseAddr(dstIP).AsSlice())
+	packet := append(ethernetHeader(srcMAC, dstMAC, 0x86dd), header...)
+	return append(packet, payload...)
+}
+
+func arpPacket(senderMAC, senderIP, targetIP string) []byte {
+	const broadcastMAC = "ff:ff:ff:ff:ff:ff"
+	arp := []byte{0, 1, 8, 0, 6, 4, 0, 1}
+	arp = append(arp, mustParseMAC(senderMAC)...)
+	arp = append(arp, netip.MustParseAddr(senderIP).AsSlice()...)
+	arp = append(arp, make([]byte, 6)...)
+	arp = append(arp, netip.MustParseAddr(targetIP).AsSlice()...)
+	return append(ethernetHeader(senderMAC, broadcastMAC, 0x0806), arp...)
+}
+
+func transportHeader(srcPort, dstPort uint16) []byte {
+	header := binary.BigEndian.AppendUint16(nil, srcPort)
+	header = binary.BigEndian.AppendUint16(header, dstPort)
+	return append(header, make([]byte, 16)...)
+}
+
+func mustParseMAC(mac string) []byte {
+	hwAddr, err := net.ParseMAC(mac)
+	Expect(err).NotTo(HaveOccurred())
+	return hwAddr
+}
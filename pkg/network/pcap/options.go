@@ -0,0 +1,96 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pcap
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/bpf"
+)
+
+const (
+	InterfaceParam   = "interface"
+	FilterParam      = "filter"
+	DurationParam    = "duration"
+	PacketCountParam = "packetCount"
+)
+
+// The capture runs in a privileged context on the node, therefore it is always bounded.
+const (
+	DefaultDuration    = time.Minute
+	MaxDuration        = 30 * time.Minute
+	DefaultPacketCount = 10000
+	MaxPacketCount     = 1000000
+)
+
+// Options of a capture, as parsed from the subresource request query.
+type Options struct {
+	Interface   string
+	Filter      []bpf.RawInstruction
+	Duration    time.Duration
+	PacketCount uint32
+}
+
+// ParseQuery parses and validates the capture options of the subresource request query.
+// The duration and packet count default to DefaultDuration and DefaultPacketCount when not set.
+func ParseQuery(query url.Values) (Options, error) {
+	opts := Options{
+		Interface:   query.Get(InterfaceParam),
+		Duration:    DefaultDuration,
+		PacketCount: DefaultPacketCount,
+	}
+	if opts.Interface == "" {
+		return Options{}, fmt.Errorf("%s must not be empty", InterfaceParam)
+	}
+
+	if filter := query.Get(FilterParam); filter != "" {
+		instructions, err := CompileFilter(filter)
+		if err != nil {
+			return Options{}, err
+		}
+		opts.Filter = instructions
+	}
+
+	if duration := query.Get(DurationParam); duration != "" {
+		d, err := time.ParseDuration(duration)
+		if err != nil {
+			return Options{}, fmt.Errorf("invalid %s %q: %v", DurationParam, duration, err)
+		}
+		if d <= 0 || d > MaxDuration {
+			return Options{}, fmt.Errorf("%s must be positive and at most %s", DurationParam, MaxDuration)
+		}
+		opts.Duration = d
+	}
+
+	if packetCount := query.Get(PacketCountParam); packetCount != "" {
+		count, err := strconv.ParseUint(packetCount, 10, 32)
+		if err != nil {
+			return Options{}, fmt.Errorf("invalid %s %q: %v", PacketCountParam, packetCount, err)
+		}
+		if count == 0 || count > MaxPacketCount {
+			return Options{}, fmt.Errorf("%s must be between 1 and %d", PacketCountParam, MaxPacketCount)
+		}
+		opts.PacketCount = uint32(count)
+	}
+	return opts, nil
+}
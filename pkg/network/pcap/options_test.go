@@ -0,0 +1,70 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pcap_test
+
+import (
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/pcap"
+)
+
+var _ = Describe("capture options", func() {
+	It("should parse the query", func() {
+		opts, err := pcap.ParseQuery(url.Values{
+			pcap.InterfaceParam:   []string{"default"},
+			pcap.FilterParam:      []string{"icmp"},
+			pcap.DurationParam:    []string{"30s"},
+			pcap.PacketCountParam: []string{"100"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opts.Interface).To(Equal("default"))
+		Expect(opts.Filter).NotTo(BeEmpty())
+		Expect(opts.Duration).To(Equal(30 * time.Second))
+		Expect(opts.PacketCount).To(Equal(uint32(100)))
+	})
+
+	It("should bound the capture by default", func() {
+		opts, err := pcap.ParseQuery(url.Values{pcap.InterfaceParam: []string{"default"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opts).To(Equal(pcap.Options{
+			Interface:   "default",
+			Duration:    pcap.DefaultDuration,
+			PacketCount: pcap.DefaultPacketCount,
+		}))
+	})
+
+	DescribeTable("should reject", func(query url.Values) {
+		_, err := pcap.ParseQuery(query)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("a missing interface", url.Values{}),
+		Entry("an invalid filter", url.Values{pcap.InterfaceParam: []string{"default"}, pcap.FilterParam: []string{"port ssh"}}),
+		Entry("an invalid duration", url.Values{pcap.InterfaceParam: []string{"default"}, pcap.DurationParam: []string{"soon"}}),
+		Entry("a negative duration", url.Values{pcap.InterfaceParam: []string{"default"}, pcap.DurationParam: []string{"-1s"}}),
+		Entry("a too long duration", url.Values{pcap.InterfaceParam: []string{"default"}, pcap.DurationParam: []string{"31m"}}),
+		Entry("an invalid packet count", url.Values{pcap.InterfaceParam: []string{"default"}, pcap.PacketCountParam: []string{"-1"}}),
+		Entry("a zero packet count", url.Values{pcap.InterfaceParam: []string{"default"}, pcap.PacketCountParam: []string{"0"}}),
+		Entry("a too large packet count", url.Values{pcap.InterfaceParam: []string{"default"}, pcap.PacketCountParam: []string{"1000001"}}),
+	)
+})
@@ -0,0 +1,154 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pcap
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// SnapLen is the maximum number of bytes captured per packet.
+	SnapLen = 262144
+
+	// pollInterval bounds the time a blocked read delays noticing the capture end.
+	pollInterval = time.Second
+)
+
+// Socket is a packet socket receiving the traffic of a single link.
+type Socket struct {
+	fd int
+}
+
+// OpenSocket opens a packet socket receiving the traffic of the link in the current network namespace,
+// which passes the filter. The socket keeps receiving the link traffic after leaving the namespace.
+func OpenSocket(linkName string, filter []bpf.RawInstruction) (*Socket, error) {
+	link, err := net.InterfaceByName(linkName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find link %s: %w", linkName, err)
+	}
+
+	// The socket is created without a protocol, for it not to receive packets before the filter is attached.
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open packet socket: %w", err)
+	}
+	s := &Socket{fd: fd}
+
+	if len(filter) > 0 {
+		if err := s.attachFilter(filter); err != nil {
+			s.Close()
+			return nil, err
+		}
+	}
+
+	readTimeout := unix.NsecToTimeval(pollInterval.Nanoseconds())
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &readTimeout); err != nil {
+		s.Close()
+		return nil, fmt.Errorf("failed to set packet socket read timeout: %w", err)
+	}
+
+	if err := unix.Bind(fd, &unix.SockaddrLinklayer{Protocol: htons(unix.ETH_P_ALL), Ifindex: link.Index}); err != nil {
+		s.Close()
+		return nil, fmt.Errorf("failed to bind packet socket to link %s: %w", linkName, err)
+	}
+	return s, nil
+}
+
+func (s *Socket) attachFilter(filter []bpf.RawInstruction) error {
+	sockFilter := make([]unix.SockFilter, 0, len(filter))
+	for _, instruction := range filter {
+		sockFilter = append(sockFilter, unix.SockFilter{
+			Code: instruction.Op,
+			Jt:   instruction.Jt,
+			Jf:   instruction.Jf,
+			K:    instruction.K,
+		})
+	}
+	program := unix.SockFprog{Len: uint16(len(sockFilter)), Filter: &sockFilter[0]}
+	if err := unix.SetsockoptSockFprog(s.fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &program); err != nil {
+		return fmt.Errorf("failed to attach BPF filter: %w", err)
+	}
+	return nil
+}
+
+func (s *Socket) Close() error {
+	return unix.Close(s.fd)
+}
+
+// Capture writes the received packets to w in the pcap format, until the context is done,
+// packetCount packets are written or writing fails. A zero packetCount means no limit.
+func (s *Socket) Capture(ctx context.Context, w io.Writer, packetCount uint32) error {
+	writer, err := NewWriter(w, SnapLen)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, SnapLen)
+	for captured := uint32(0); packetCount == 0 || captured < packetCount; {
+		if ctx.Err() != nil {
+			return nil
+		}
+		// With MSG_TRUNC the original length of the packet is returned, even when it exceeds the buffer.
+		n, _, err := unix.Recvfrom(s.fd, buf, unix.MSG_TRUNC)
+		if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to receive packet: %w", err)
+		}
+		if err := writer.WritePacket(time.Now(), buf[:min(n, len(buf))], n); err != nil {
+			return err
+		}
+		captured++
+	}
+	return nil
+}
+
+// Receive calls handle with every received packet until the context is done or receiving fails.
+// The packet is only valid for the duration of the call.
+func (s *Socket) Receive(ctx context.Context, handle func(packet []byte)) error {
+	buf := make([]byte, SnapLen)
+	for ctx.Err() == nil {
+		n, _, err := unix.Recvfrom(s.fd, buf, 0)
+		if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to receive packet: %w", err)
+		}
+		handle(buf[:n])
+	}
+	return nil
+}
+
+func htons(v uint16) uint16 {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	return binary.NativeEndian.Uint16(b[:])
+}
@@ -0,0 +1,69 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pcap
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+const (
+	magicMicroseconds  = 0xa1b2c3d4
+	versionMajor       = 2
+	versionMinor       = 4
+	linkTypeEthernet   = 1
+	fileHeaderLength   = 24
+	recordHeaderLength = 16
+)
+
+// Writer writes packets in the pcap file format, readable by tcpdump and Wireshark.
+type Writer struct {
+	w       io.Writer
+	snapLen uint32
+}
+
+// NewWriter writes the pcap file header, for Ethernet packets truncated to snapLen.
+func NewWriter(w io.Writer, snapLen uint32) (*Writer, error) {
+	header := make([]byte, fileHeaderLength)
+	binary.LittleEndian.PutUint32(header[0:4], magicMicroseconds)
+	binary.LittleEndian.PutUint16(header[4:6], versionMajor)
+	binary.LittleEndian.PutUint16(header[6:8], versionMinor)
+	binary.LittleEndian.PutUint32(header[16:20], snapLen)
+	binary.LittleEndian.PutUint32(header[20:24], linkTypeEthernet)
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	return &Writer{w: w, snapLen: snapLen}, nil
+}
+
+// WritePacket writes a packet record, data holds the captured bytes of a packet of origLen bytes.
+func (w *Writer) WritePacket(timestamp time.Time, data []byte, origLen int) error {
+	if uint32(len(data)) > w.snapLen {
+		data = data[:w.snapLen]
+	}
+	record := make([]byte, recordHeaderLength, recordHeaderLength+len(data))
+	binary.LittleEndian.PutUint32(record[0:4], uint32(timestamp.Unix()))
+	binary.LittleEndian.PutUint32(record[4:8], uint32(timestamp.Nanosecond()/int(time.Microsecond)))
+	binary.LittleEndian.PutUint32(record[8:12], uint32(len(data)))
+	binary.LittleEndian.PutUint32(record[12:16], uint32(origLen))
+	_, err := w.w.Write(append(record, data...))
+	return err
+}
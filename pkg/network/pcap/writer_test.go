@@ -0,0 +1,64 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pcap_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/pcap"
+)
+
+var _ = Describe("pcap writer", func() {
+	It("should write the file header", func() {
+		var buf bytes.Buffer
+		_, err := pcap.NewWriter(&buf, 65535)
+		Expect(err).NotTo(HaveOccurred())
+
+		header := buf.Bytes()
+		Expect(header).To(HaveLen(24))
+		Expect(binary.LittleEndian.Uint32(header[0:4])).To(Equal(uint32(0xa1b2c3d4)))
+		Expect(binary.LittleEndian.Uint16(header[4:6])).To(Equal(uint16(2)))
+		Expect(binary.LittleEndian.Uint16(header[6:8])).To(Equal(uint16(4)))
+		Expect(binary.LittleEndian.Uint32(header[16:20])).To(Equal(uint32(65535)))
+		Expect(binary.LittleEndian.Uint32(header[20:24])).To(Equal(uint32(1)))
+	})
+
+	It("should write packet records truncated to the snap length", func() {
+		var buf bytes.Buffer
+		writer, err := pcap.NewWriter(&buf, 4)
+		Expect(err).NotTo(HaveOccurred())
+		timestamp := time.Unix(1700000000, 123456000)
+
+		Expect(writer.WritePacket(timestamp, []byte{1, 2, 3, 4, 5, 6}, 100)).To(Succeed())
+
+		record := buf.Bytes()[24:]
+		Expect(record).To(HaveLen(16 + 4))
+		Expect(binary.LittleEndian.Uint32(record[0:4])).To(Equal(uint32(1700000000)))
+		Expect(binary.LittleEndian.Uint32(record[4:8])).To(Equal(uint32(123456)))
+		Expect(binary.LittleEndian.Uint32(record[8:12])).To(Equal(uint32(4)))
+		Expect(binary.LittleEndian.Uint32(record[12:16])).To(Equal(uint32(100)))
+		Expect(record[16:]).To(Equal([]byte{1, 2, 3, 4}))
+	})
+})
@@ -28,8 +28,10 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/network/ipam"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/netns"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
@@ -97,6 +99,10 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 	if util.IsNonRootVMI(vmi) {
 		ownerID = util.NonRootUID
 	}
+	ipAllocations, err := ipam.AllocationsFromVMI(vmi)
+	if err != nil {
+		return fmt.Errorf("setup failed, err: %w", err)
+	}
 	queuesCapacity := int(converternet.NetworkQueuesCapacity(vmi))
 	netpod := netpod.NewNetPod(
 		networks,
@@ -111,6 +117,7 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 		netpod.WithBindingPlugins(c.clusterConfigurer.GetNetworkBindings()),
 		netpod.WithLogger(log.Log.Object(vmi)),
 		netpod.WithVMIIfaceStatuses(vmi.Status.Interfaces),
+		netpod.WithIPAllocations(ipAllocations),
 	)
 
 	if err := netpod.Setup(); err != nil {
@@ -132,12 +139,21 @@ func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
 }
 
 func newMasqueradeAdapter(vmi *v1.VirtualMachineInstance) masquerade.MasqPod {
+	var metadataServiceAddresses []string
+	if cloudinit.HasMetadataService(vmi) {
+		metadataServiceAddresses = []string{cloudinit.MetadataServiceAddress, cloudinit.MetadataServiceIPv6Address}
+	}
+	metadataServiceRedirect := masquerade.WithMetadataServiceRedirect(
+		metadataServiceAddresses, cloudinit.MetadataServiceGuestPort, cloudinit.MetadataServicePort,
+	)
+
 	if vmi.Status.MigrationTransport == v1.MigrationTransportUnix {
-		return masquerade.New(masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)))
+		return masquerade.New(masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)), metadataServiceRedirect)
 	} else {
 		return masquerade.New(
 			masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)),
 			masquerade.WithLegacyMigrationPorts(),
+			metadataServiceRedirect,
 		)
 	}
 }
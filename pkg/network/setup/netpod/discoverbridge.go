@@ -31,6 +31,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	"kubevirt.io/kubevirt/pkg/network/driver/nmstate"
+	"kubevirt.io/kubevirt/pkg/network/ipam"
 )
 
 func (n NetPod) storeBridgeBindingDHCPInterfaceData(currentStatus *nmstate.Status, podIfaceStatus nmstate.Interface, vmiSpecIface v1.Interface, podIfaceName string) error {
@@ -69,6 +70,10 @@ func (n NetPod) storeBridgeBindingDHCPInterfaceData(currentStatus *nmstate.Statu
 		if len(dhcpRoutes) > 0 {
 			dhcpConfig.Routes = &dhcpRoutes
 		}
+	} else if allocation := ipam.LookupAllocationByNetwork(n.ipAllocations, vmiSpecIface.Name); allocation != nil {
+		if err := setDHCPConfigFromIPAllocation(&dhcpConfig, allocation, podIfaceStatus, vmiSpecIface); err != nil {
+			return err
+		}
 	}
 
 	log.Log.V(4).Infof("The generated dhcpConfig: %s\nRoutes: %+v", dhcpConfig.String(), dhcpConfig.Routes)
@@ -79,6 +84,35 @@ func (n NetPod) storeBridgeBindingDHCPInterfaceData(currentStatus *nmstate.Statu
 	return nil
 }
 
+// setDHCPConfigFromIPAllocation serves the IPv4 address KubeVirt IPAM allocated to the network,
+// when the pod interface has none of its own.
+func setDHCPConfigFromIPAllocation(dhcpConfig *cache.DHCPConfig, allocation *v1.VirtualMachineIPAllocation, podIfaceStatus nmstate.Interface, vmiSpecIface v1.Interface) error {
+	for _, cidr := range allocation.IPAddresses {
+		addr, err := vishnetlink.ParseAddr(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid IP allocation for network %s: %w", allocation.Network, err)
+		}
+		if addr.IP.To4() == nil {
+			continue
+		}
+		mac, err := resolveMacAddress(podIfaceStatus.MacAddress, vmiSpecIface.MacAddress)
+		if err != nil {
+			return err
+		}
+		dhcpConfig.IPAMDisabled = false
+		dhcpConfig.IP = *addr
+		dhcpConfig.MAC = mac
+		dhcpConfig.Gateway = net.ParseIP(allocation.Gateway)
+		for _, nameserver := range allocation.Nameservers {
+			if ip := net.ParseIP(nameserver); ip != nil {
+				dhcpConfig.Nameservers = append(dhcpConfig.Nameservers, ip)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
 func translateNmstateToNetlinkRoutes(otherRoutes []nmstate.Route) ([]vishnetlink.Route, error) {
 	var dhcpRoutes []vishnetlink.Route
 	for _, nmstateRoute := range otherRoutes {
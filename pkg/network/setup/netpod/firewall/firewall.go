@@ -0,0 +1,204 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+)
+
+type nftable interface {
+	AddTable(family nft.IPFamily, name string) error
+	AddChain(family nft.IPFamily, table, name string, chainspec ...string) error
+	AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error
+}
+
+// Firewall filters the traffic bridged in the pod between the guest tap device and the network.
+type Firewall struct {
+	nftable      nftable
+	moduleLoaded func(name string) bool
+}
+
+const (
+	filterTable  = "filter"
+	forwardChain = "forward"
+
+	protocolICMP = "icmp"
+
+	// conntrackBridgeModule provides the connection tracking of bridged traffic, used by the
+	// stateful rules. Without it, the replies to allowed connections would hit the default action.
+	conntrackBridgeModule = "nf_conntrack_bridge"
+
+	sysModulePath = "/sys/module"
+)
+
+type option func(*Firewall)
+
+func New(opts ...option) Firewall {
+	f := Firewall{nftable: nft.NFTBin{}, moduleLoaded: isModuleLoaded}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
+}
+
+func WithNftableAdapter(h nftable) option {
+	return func(f *Firewall) {
+		f.nftable = h
+	}
+}
+
+func WithModuleChecker(moduleLoaded func(name string) bool) option {
+	return func(f *Firewall) {
+		f.moduleLoaded = moduleLoaded
+	}
+}
+
+// Setup renders the interface firewall as nftables rules of the bridge family,
+// matching the traffic which leaves or enters the guest through the given tap device.
+// The node must have the nf_conntrack_bridge module loaded, as the rules are stateful.
+func (f Firewall) Setup(tapIfaceName string, firewall v1.InterfaceFirewall) error {
+	if !f.moduleLoaded(conntrackBridgeModule) {
+		return fmt.Errorf("the %s kernel module is not loaded on the node, it is required by the interface firewall", conntrackBridgeModule)
+	}
+	if err := f.nftable.AddTable(nft.Bridge, filterTable); err != nil {
+		return err
+	}
+	if err := f.nftable.AddChain(nft.Bridge, filterTable, forwardChain, "{ type filter hook forward priority 0; }"); err != nil {
+		return err
+	}
+
+	ingressChain := tapIfaceName + "-ingress"
+	egressChain := tapIfaceName + "-egress"
+	if err := f.setupDirectionChain(ingressChain, v1.FirewallDirectionIngress, firewall); err != nil {
+		return err
+	}
+	if err := f.setupDirectionChain(egressChain, v1.FirewallDirectionEgress, firewall); err != nil {
+		return err
+	}
+
+	if err := f.nftable.AddRule(nft.Bridge, filterTable, forwardChain, "oifname", tapIfaceName, "counter", "jump", ingressChain); err != nil {
+		return err
+	}
+	return f.nftable.AddRule(nft.Bridge, filterTable, forwardChain, "iifname", tapIfaceName, "counter", "jump", egressChain)
+}
+
+func (f Firewall) setupDirectionChain(chain string, direction v1.FirewallDirection, firewall v1.InterfaceFirewall) error {
+	if err := f.nftable.AddChain(nft.Bridge, filterTable, chain); err != nil {
+		return err
+	}
+
+	// Replies to allowed connections and the address resolution are never filtered.
+	baseRules := [][]string{
+		{"ct", "state", "established,related", "counter", "accept"},
+		{"ether", "type", "arp", "counter", "accept"},
+		{"icmpv6", "type", "{ nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert }", "counter", "accept"},
+	}
+	for _, rule := range baseRules {
+		if err := f.nftable.AddRule(nft.Bridge, filterTable, chain, rule...); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range firewall.Rules {
+		if rule.Direction != direction {
+			continue
+		}
+		rulespec, err := ruleSpec(rule)
+		if err != nil {
+			return err
+		}
+		if err := f.nftable.AddRule(nft.Bridge, filterTable, chain, rulespec...); err != nil {
+			return err
+		}
+	}
+
+	return f.nftable.AddRule(nft.Bridge, filterTable, chain, "counter", verdict(firewall.DefaultAction))
+}
+
+func ruleSpec(rule v1.FirewallRule) ([]string, error) {
+	var rulespec []string
+
+	var family nft.IPFamily
+	if rule.CIDR != "" {
+		_, cidr, err := net.ParseCIDR(rule.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("invalid firewall rule CIDR %q: %v", rule.CIDR, err)
+		}
+		family = nft.IPv4
+		if cidr.IP.To4() == nil {
+			family = nft.IPv6
+		}
+		peerAddress := "saddr"
+		if rule.Direction == v1.FirewallDirectionEgress {
+			peerAddress = "daddr"
+		}
+		rulespec = append(rulespec, string(family), peerAddress, cidr.String())
+	}
+
+	if rule.Protocol != "" {
+		rulespec = append(rulespec, "meta", "l4proto", l4Protocol(strings.ToLower(rule.Protocol), family))
+	}
+
+	if rule.Port != 0 {
+		ports := strconv.Itoa(int(rule.Port))
+		if rule.EndPort > rule.Port {
+			ports = fmt.Sprintf("%d-%d", rule.Port, rule.EndPort)
+		}
+		rulespec = append(rulespec, "th", "dport", ports)
+	}
+
+	return append(rulespec, "counter", verdict(rule.Action)), nil
+}
+
+func l4Protocol(protocol string, family nft.IPFamily) string {
+	if protocol != protocolICMP {
+		return protocol
+	}
+	switch family {
+	case nft.IPv4:
+		return "icmp"
+	case nft.IPv6:
+		return "ipv6-icmp"
+	default:
+		return "{ icmp, ipv6-icmp }"
+	}
+}
+
+func verdict(action v1.FirewallAction) string {
+	if action == v1.FirewallActionDeny {
+		return "drop"
+	}
+	return "accept"
+}
+
+// isModuleLoaded checks the sysfs entry of the module, which is shared with the node.
+func isModuleLoaded(name string) bool {
+	_, err := os.Stat(filepath.Join(sysModulePath, name))
+	return err == nil
+}
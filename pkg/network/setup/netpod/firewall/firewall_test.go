@@ -0,0 +1,149 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
+)
+
+var _ = Describe("firewall", func() {
+	It("setup fails", func() {
+		testErr := errors.New("test error")
+		fw := newFirewall(&nftableStub{addTableErr: testErr})
+
+		Expect(fw.Setup("tap1", v1.InterfaceFirewall{})).To(MatchError(testErr))
+	})
+
+	It("setup fails when connection tracking of bridged traffic is not available", func() {
+		nftStub := &nftableStub{}
+		fw := firewall.New(
+			firewall.WithNftableAdapter(nftStub),
+			firewall.WithModuleChecker(func(string) bool { return false }),
+		)
+
+		Expect(fw.Setup("tap1", v1.InterfaceFirewall{})).To(MatchError(ContainSubstring("nf_conntrack_bridge")))
+		Expect(nftStub.entries).To(BeEmpty())
+	})
+
+	It("setup with the default action only", func() {
+		nftStub := &nftableStub{}
+		fw := newFirewall(nftStub)
+
+		Expect(fw.Setup("tap1", v1.InterfaceFirewall{DefaultAction: v1.FirewallActionDeny})).To(Succeed())
+
+		Expect(nftStub.String()).To(Equal(`table bridge filter
+chain bridge filter forward [{ type filter hook forward priority 0; }]
+chain bridge filter tap1-ingress []
+rule bridge filter tap1-ingress [ct state established,related counter accept]
+rule bridge filter tap1-ingress [ether type arp counter accept]
+rule bridge filter tap1-ingress [icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } counter accept]
+rule bridge filter tap1-ingress [counter drop]
+chain bridge filter tap1-egress []
+rule bridge filter tap1-egress [ct state established,related counter accept]
+rule bridge filter tap1-egress [ether type arp counter accept]
+rule bridge filter tap1-egress [icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } counter accept]
+rule bridge filter tap1-egress [counter drop]
+rule bridge filter forward [oifname tap1 counter jump tap1-ingress]
+rule bridge filter forward [iifname tap1 counter jump tap1-egress]
+`))
+	})
+
+	It("setup with rules in both directions", func() {
+		nftStub := &nftableStub{}
+		fw := newFirewall(nftStub)
+
+		Expect(fw.Setup("tap1", v1.InterfaceFirewall{
+			Rules: []v1.FirewallRule{
+				{Direction: v1.FirewallDirectionIngress, Action: v1.FirewallActionAllow, CIDR: "10.10.0.0/16", Protocol: "TCP", Port: 22},
+				{Direction: v1.FirewallDirectionIngress, Action: v1.FirewallActionAllow, Protocol: "ICMP"},
+				{Direction: v1.FirewallDirectionIngress, Action: v1.FirewallActionDeny, CIDR: "fd00::/64", Protocol: "ICMP"},
+				{Direction: v1.FirewallDirectionEgress, Action: v1.FirewallActionDeny, CIDR: "192.168.0.0/24", Protocol: "UDP", Port: 5000, EndPort: 5100},
+			},
+		})).To(Succeed())
+
+		Expect(nftStub.rulesOfChain("tap1-ingress")).To(ContainElements(
+			"[ip saddr 10.10.0.0/16 meta l4proto tcp th dport 22 counter accept]",
+			"[meta l4proto { icmp, ipv6-icmp } counter accept]",
+			"[ip6 saddr fd00::/64 meta l4proto ipv6-icmp counter drop]",
+		))
+		Expect(nftStub.rulesOfChain("tap1-ingress")).To(HaveLen(7))
+		Expect(nftStub.rulesOfChain("tap1-ingress")[6]).To(Equal("[counter accept]"))
+
+		Expect(nftStub.rulesOfChain("tap1-egress")).To(ContainElement(
+			"[ip daddr 192.168.0.0/24 meta l4proto udp th dport 5000-5100 counter drop]",
+		))
+		Expect(nftStub.rulesOfChain("tap1-egress")).To(HaveLen(5))
+	})
+})
+
+func newFirewall(nftStub *nftableStub) firewall.Firewall {
+	return firewall.New(
+		firewall.WithNftableAdapter(nftStub),
+		firewall.WithModuleChecker(func(name string) bool { return name == "nf_conntrack_bridge" }),
+	)
+}
+
+type nftableStub struct {
+	addTableErr error
+	entries     []string
+}
+
+func (n *nftableStub) AddTable(family nft.IPFamily, name string) error {
+	if n.addTableErr != nil {
+		return n.addTableErr
+	}
+	n.entries = append(n.entries, fmt.Sprintf("table %s %s", family, name))
+	return nil
+}
+
+func (n *nftableStub) AddChain(family nft.IPFamily, table, name string, chainspec ...string) error {
+	n.entries = append(n.entries, fmt.Sprintf("chain %s %s %s %s", family, table, name, chainspec))
+	return nil
+}
+
+func (n *nftableStub) AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error {
+	n.entries = append(n.entries, fmt.Sprintf("rule %s %s %s %s", family, table, chain, rulespec))
+	return nil
+}
+
+func (n *nftableStub) rulesOfChain(chain string) []string {
+	prefix := fmt.Sprintf("rule %s %s %s ", nft.Bridge, "filter", chain)
+	var rules []string
+	for _, entry := range n.entries {
+		if strings.HasPrefix(entry, prefix) {
+			rules = append(rules, strings.TrimPrefix(entry, prefix))
+		}
+	}
+	return rules
+}
+
+func (n *nftableStub) String() string {
+	return strings.Join(n.entries, "\n") + "\n"
+}
@@ -44,11 +44,17 @@ type MasqPod struct {
 	nftable        nftable
 	istioEnabled   bool
 	migrationPorts []uint
+
+	metadataServiceAddresses []string
+	metadataServicePort      uint
+	metadataServiceTarget    uint
 }
 
 const (
 	natTable = "nat"
 
+	protocolICMP = "icmp"
+
 	preroutingChain          = "prerouting"
 	postroutingChain         = "postrouting"
 	inputChain               = "input"
@@ -89,6 +95,17 @@ func WithLegacyMigrationPorts() option {
 	}
 }
 
+// WithMetadataServiceRedirect redirects the guest traffic to the given addresses and port,
+// to the target port on the guest gateway, where virt-launcher serves the metadata service.
+// Each address is redirected for the IP family it belongs to. No addresses disable the redirect.
+func WithMetadataServiceRedirect(addresses []string, port, targetPort uint) option {
+	return func(m *MasqPod) {
+		m.metadataServiceAddresses = addresses
+		m.metadataServicePort = port
+		m.metadataServiceTarget = targetPort
+	}
+}
+
 func (m MasqPod) Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error {
 	if bridgeIfaceSpec.IPv4.Enabled != nil && *bridgeIfaceSpec.IPv4.Enabled {
 		if err := m.setupNATByFamily(nft.IPv4, podIfaceSpec, bridgeIfaceSpec, vmiIface); err != nil {
@@ -138,6 +155,20 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 		return err
 	}
 
+	for _, address := range m.metadataServiceAddresses {
+		if isIPv4 := net.ParseIP(address).To4() != nil; isIPv4 != (family == nft.IPv4) {
+			continue
+		}
+		if err := m.nftable.AddRule(family, natTable, preroutingChain,
+			"iifname", bridgeIfaceSpec.Name,
+			string(family), "daddr", address,
+			"tcp", "dport", strconv.Itoa(int(m.metadataServicePort)),
+			"counter", "redirect", "to", fmt.Sprintf(":%d", m.metadataServiceTarget),
+		); err != nil {
+			return fmt.Errorf("failed to redirect the metadata service address %s: %v", address, err)
+		}
+	}
+
 	if len(m.migrationPorts) > 0 {
 		if err := m.skipForwardPorts(family, m.migrationPorts...); err != nil {
 			return err
@@ -158,32 +189,41 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 		addressesToSnat := []string{ipLoopback(family)}
 
 		if m.istioEnabled {
-			var portsToForward []int
-			for _, nonProxiedPort := range istio.NonProxiedPorts() {
-				if int(port.Port) == nonProxiedPort {
-					portsToForward = append(portsToForward, nonProxiedPort)
+			if protocol == protocolICMP {
+				if err := m.forward(family, guestIP, icmpEchoRequestMatch(family)...); err != nil {
+					return err
+				}
+			} else {
+				var portsToForward []int
+				for _, nonProxiedPort := range istio.NonProxiedPorts() {
+					if portInRange(port, nonProxiedPort) {
+						portsToForward = append(portsToForward, nonProxiedPort)
+					}
+				}
+				if err := m.forwardPorts(family, guestIP, "tcp", portsToForward...); err != nil {
+					return err
 				}
-			}
-			if err := m.forwardPorts(family, guestIP, "tcp", portsToForward...); err != nil {
-				return err
 			}
 
 			if family == nft.IPv4 {
 				addressesToSnat = append(addressesToSnat, istio.GetLoopbackAddress())
 			}
 		} else {
-			if err := m.forwardPorts(family, guestIP, protocol, int(port.Port)); err != nil {
+			if err := m.forward(family, guestIP, portMatch(family, protocol, port, true)...); err != nil {
 				return err
 			}
 		}
 
+		match := portMatch(family, protocol, port, false)
 		addressesToSnatSpec := fmt.Sprintf("{ %s }", strings.Join(addressesToSnat, ", "))
 		gw := guestIPGateway(family, *bridgeIfaceSpec).String()
-		if err := m.nftable.AddRule(family, natTable, kubevirtPostInboundChain, protocol, "dport", strconv.Itoa(int(port.Port)), string(family), "saddr", addressesToSnatSpec, "counter", "snat", "to", gw); err != nil {
+		snatRule := append(append([]string{}, match...), string(family), "saddr", addressesToSnatSpec, "counter", "snat", "to", gw)
+		if err := m.nftable.AddRule(family, natTable, kubevirtPostInboundChain, snatRule...); err != nil {
 			return err
 		}
 
-		if err := m.nftable.AddRule(family, natTable, outputChain, string(family), "daddr", addressesToDnatSpec, protocol, "dport", strconv.Itoa(int(port.Port)), "counter", "dnat", "to", guestIP); err != nil {
+		dnatRule := append(append([]string{string(family), "daddr", addressesToDnatSpec}, match...), "counter", "dnat", "to", guestIP)
+		if err := m.nftable.AddRule(family, natTable, outputChain, dnatRule...); err != nil {
 			return err
 		}
 	}
@@ -246,7 +286,45 @@ func (m MasqPod) forwardPorts(family nft.IPFamily, toIP string, protocol string,
 	}
 	p := strings.Trim(strings.Replace(fmt.Sprint(ports), " ", ", ", -1), "[]")
 	portsSpec := fmt.Sprintf("{ %s }", p)
-	return m.nftable.AddRule(family, natTable, kubevirtPreInboundChain, protocol, "dport", portsSpec, "counter", "dnat", "to", toIP)
+	return m.forward(family, toIP, protocol, "dport", portsSpec)
+}
+
+// forward redirects the inbound traffic that matches the given expression to the guest.
+func (m MasqPod) forward(family nft.IPFamily, toIP string, match ...string) error {
+	rule := append(append([]string{}, match...), "counter", "dnat", "to", toIP)
+	return m.nftable.AddRule(family, natTable, kubevirtPreInboundChain, rule...)
+}
+
+// portMatch returns the nft match expression of the given port.
+// A port range is formatted as "start-end", and the set form wraps the ports in braces.
+// ICMP ports match echo requests only.
+func portMatch(family nft.IPFamily, protocol string, port v1.Port, asSet bool) []string {
+	if protocol == protocolICMP {
+		return icmpEchoRequestMatch(family)
+	}
+	portsSpec := strconv.Itoa(int(port.Port))
+	if port.EndPort > port.Port {
+		portsSpec = fmt.Sprintf("%d-%d", port.Port, port.EndPort)
+	}
+	if asSet {
+		portsSpec = fmt.Sprintf("{ %s }", portsSpec)
+	}
+	return []string{protocol, "dport", portsSpec}
+}
+
+func icmpEchoRequestMatch(family nft.IPFamily) []string {
+	if family == nft.IPv6 {
+		return []string{"icmpv6", "type", "echo-request"}
+	}
+	return []string{"icmp", "type", "echo-request"}
+}
+
+func portInRange(port v1.Port, candidate int) bool {
+	endPort := port.EndPort
+	if endPort < port.Port {
+		endPort = port.Port
+	}
+	return candidate >= int(port.Port) && candidate <= int(endPort)
 }
 
 func ipLoopback(family nft.IPFamily) string {
@@ -104,6 +104,59 @@ family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } counter dnat t
 		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 	})
 
+	It("setup with IPv4, no ports and the metadata service redirect", func() {
+		nftStub := &nftableStub{}
+		masqPod := masquerade.New(
+			masquerade.WithNftableAdapter(nftStub),
+			masquerade.WithMetadataServiceRedirect([]string{"169.254.169.254", "fd00:ec2::254"}, 80, 8775),
+		)
+
+		err := masqPod.Setup(
+			&nmstate.Interface{
+				Name:     "k6t-eth0",
+				TypeName: nmstate.TypeBridge,
+				State:    nmstate.IfaceStateUp,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.0.2.1", PrefixLen: 24}},
+				},
+			},
+			&nmstate.Interface{
+				Name:     "eth0",
+				TypeName: nmstate.TypeVETH,
+				State:    nmstate.IfaceStateUp,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.222.222.1", PrefixLen: 30}},
+				},
+			},
+			v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		expectedConfig := `tables:
+family ip name nat
+chains:
+family ip table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip table nat name KUBEVIRT_POSTINBOUND chainspec []
+rules:
+family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
+family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain prerouting rulespec [iifname k6t-eth0 ip daddr 169.254.169.254 tcp dport 80 counter redirect to :8775]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } counter dnat to 10.0.2.2]
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+	})
+
 	It("setup with IPv6, no ports", func() {
 		nftStub := &nftableStub{}
 		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
@@ -163,6 +216,69 @@ family ip6 table nat chain output rulespec [ip6 daddr { ::1 } counter dnat to fd
 		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 	})
 
+	It("setup with IPv6, no ports and the metadata service redirect", func() {
+		nftStub := &nftableStub{}
+		masqPod := masquerade.New(
+			masquerade.WithNftableAdapter(nftStub),
+			masquerade.WithMetadataServiceRedirect([]string{"169.254.169.254", "fd00:ec2::254"}, 80, 8775),
+		)
+
+		err := masqPod.Setup(
+			&nmstate.Interface{
+				Name:       "k6t-eth0",
+				Index:      1,
+				TypeName:   nmstate.TypeBridge,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "bb:bb:bb:bb:bb:bb",
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "fd10:0:2::1", PrefixLen: 120}},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			&nmstate.Interface{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "aa:aa:aa:aa:aa:aa",
+				MTU:        1500,
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{
+						IP:        "2001::1",
+						PrefixLen: 64,
+					}},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		expectedConfig := `tables:
+family ip6 name nat
+chains:
+family ip6 table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip6 table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip6 table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip6 table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip6 table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip6 table nat name KUBEVIRT_POSTINBOUND chainspec []
+rules:
+family ip6 table nat chain postrouting rulespec [ip6 saddr fd10:0:2::2 counter masquerade]
+family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip6 table nat chain prerouting rulespec [iifname k6t-eth0 ip6 daddr fd00:ec2::254 tcp dport 80 counter redirect to :8775]
+family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [ip6 saddr { ::1 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1 } counter dnat to fd10:0:2::2]
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+	})
+
 	It("setup with IPv4 and IPv6, no ports", func() {
 		nftStub := &nftableStub{}
 		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
@@ -339,6 +455,106 @@ family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 8080 cou
 		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 	})
 
+	It("setup with IPv4 and IPv6, including port ranges, SCTP and ICMP", func() {
+		nftStub := &nftableStub{}
+		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
+
+		err := masqPod.Setup(
+			&nmstate.Interface{
+				Name:       "k6t-eth0",
+				Index:      1,
+				TypeName:   nmstate.TypeBridge,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "bb:bb:bb:bb:bb:bb",
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.0.2.1", PrefixLen: 24}},
+				},
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "fd10:0:2::1", PrefixLen: 120}},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			&nmstate.Interface{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "aa:aa:aa:aa:aa:aa",
+				MTU:        1500,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{
+						IP:        "10.222.222.1",
+						PrefixLen: 30,
+					}},
+				},
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{
+						IP:        "2001::1",
+						PrefixLen: 64,
+					}},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports: []v1.Port{
+					{Name: "rtp", Protocol: "UDP", Port: 10000, EndPort: 10100},
+					{Name: "sctp", Protocol: "SCTP", Port: 3868},
+					{Name: "ping", Protocol: "ICMP"},
+				},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		expectedConfig := `tables:
+family ip name nat
+family ip6 name nat
+chains:
+family ip table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip table nat name KUBEVIRT_POSTINBOUND chainspec []
+family ip6 table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip6 table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip6 table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip6 table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip6 table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip6 table nat name KUBEVIRT_POSTINBOUND chainspec []
+rules:
+family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
+family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [udp dport { 10000-10100 } counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [udp dport 10000-10100 ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } udp dport 10000-10100 counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [sctp dport { 3868 } counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [sctp dport 3868 ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } sctp dport 3868 counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [icmp type echo-request counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [icmp type echo-request ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } icmp type echo-request counter dnat to 10.0.2.2]
+family ip6 table nat chain postrouting rulespec [ip6 saddr fd10:0:2::2 counter masquerade]
+family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [udp dport { 10000-10100 } counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [udp dport 10000-10100 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1 } udp dport 10000-10100 counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [sctp dport { 3868 } counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [sctp dport 3868 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1 } sctp dport 3868 counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [icmpv6 type echo-request counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [icmpv6 type echo-request ip6 saddr { ::1 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1 } icmpv6 type echo-request counter dnat to fd10:0:2::2]
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+	})
+
 	Context("with ISTIO", func() {
 		It("setup with IPv4 and IPv6, no ports", func() {
 			nftStub := &nftableStub{}
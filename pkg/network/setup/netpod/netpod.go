@@ -36,6 +36,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/netmachinery"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod/masquerade"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 
@@ -55,6 +56,10 @@ type masqueradeAdapter interface {
 	Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error
 }
 
+type firewallAdapter interface {
+	Setup(tapIfaceName string, firewall v1.InterfaceFirewall) error
+}
+
 type cacheCreator interface {
 	New(filePath string) *cache.Cache
 }
@@ -67,6 +72,7 @@ type NetPod struct {
 	vmiSpecIfaces    []v1.Interface
 	vmiSpecNets      []v1.Network
 	vmiIfaceStatuses []v1.VirtualMachineInstanceNetworkInterface
+	ipAllocations    []v1.VirtualMachineIPAllocation
 	vmiUID           string
 	podPID           int
 	ownerID          int
@@ -74,6 +80,7 @@ type NetPod struct {
 
 	nmstateAdapter    nmstateAdapter
 	masqueradeAdapter masqueradeAdapter
+	firewallAdapter   firewallAdapter
 
 	cacheCreator cacheCreator
 	state        *State
@@ -96,6 +103,7 @@ func NewNetPod(vmiNetworks []v1.Network, vmiIfaces []v1.Interface, vmiUID string
 
 		nmstateAdapter:    nmstate.New(),
 		masqueradeAdapter: masquerade.New(),
+		firewallAdapter:   firewall.New(),
 
 		cacheCreator:         cache.CacheCreator{},
 		bindingPluginsByName: map[string]v1.InterfaceBindingPlugin{},
@@ -123,6 +131,12 @@ func WithMasqueradeAdapter(h masqueradeAdapter) option {
 	}
 }
 
+func WithFirewallAdapter(h firewallAdapter) option {
+	return func(n *NetPod) {
+		n.firewallAdapter = h
+	}
+}
+
 func WithCacheCreator(c cacheCreator) option {
 	return func(n *NetPod) {
 		n.cacheCreator = c
@@ -147,6 +161,13 @@ func WithVMIIfaceStatuses(vmiIfaceStatuses []v1.VirtualMachineInstanceNetworkInt
 	}
 }
 
+// WithIPAllocations sets the addresses KubeVirt IPAM allocated to the VMI networks.
+func WithIPAllocations(ipAllocations []v1.VirtualMachineIPAllocation) option {
+	return func(n *NetPod) {
+		n.ipAllocations = ipAllocations
+	}
+}
+
 func (n NetPod) Setup() error {
 	// Not all network bindings are processed in the network setup.
 	filteredNets, err := filterSupportedBindingNetworks(n.vmiSpecNets, n.vmiSpecIfaces)
@@ -194,7 +215,7 @@ func (n NetPod) Setup() error {
 			return serr
 		}
 
-		if err = n.config(currentStatus); err != nil {
+		if err = n.config(currentStatus, pendingNets); err != nil {
 			log.Log.Reason(err).Errorf("failed to configure pod network")
 			return neterrors.CreateCriticalNetworkError(err)
 		}
@@ -231,7 +252,7 @@ func (n NetPod) validateNoNetworkReconfigured(startedNets []v1.Network) error {
 	return nil
 }
 
-func (n NetPod) config(currentStatus *nmstate.Status) error {
+func (n NetPod) config(currentStatus *nmstate.Status, pendingNets []v1.Network) error {
 	desiredSpec, err := n.composeDesiredSpec(currentStatus)
 	if err != nil {
 		return err
@@ -249,7 +270,11 @@ func (n NetPod) config(currentStatus *nmstate.Status) error {
 
 	// Configuring NAT (nftables) is temporary done outside nmstate.
 	// This should be eventually embedded into the nmstate desired state and applied by it.
-	return n.setupNAT(desiredSpec, currentStatus)
+	if err = n.setupNAT(desiredSpec, currentStatus); err != nil {
+		return err
+	}
+
+	return n.setupFirewall(desiredSpec, pendingNets)
 }
 
 func (n NetPod) composeDesiredSpec(currentStatus *nmstate.Status) (*nmstate.Spec, error) {
@@ -545,6 +570,27 @@ func (n NetPod) setupNAT(desiredSpec *nmstate.Spec, currentStatus *nmstate.Statu
 	return n.masqueradeAdapter.Setup(bridgeIfaceSpec, podIfaceSpec, vmiIface[0])
 }
 
+// setupFirewall applies the interface firewall of the pending bridge bound interfaces.
+// The rules are attached to the tap device, the point through which all the guest traffic passes.
+// Interfaces which have been set up in a previous run already have their rules in place.
+func (n NetPod) setupFirewall(desiredSpec *nmstate.Spec, pendingNets []v1.Network) error {
+	for _, iface := range vmispec.FilterInterfacesByNetworks(n.vmiSpecIfaces, pendingNets) {
+		if iface.Bridge == nil || iface.Firewall == nil {
+			continue
+		}
+		tapIfaceSpec := nmstate.LookupInterface(desiredSpec.Interfaces, func(i nmstate.Interface) bool {
+			return i.Metadata != nil && i.Metadata.NetworkName == iface.Name && i.TypeName == nmstate.TypeTap
+		})
+		if tapIfaceSpec == nil {
+			return fmt.Errorf("setup-firewall: tap device for network %s is missing", iface.Name)
+		}
+		if err := n.firewallAdapter.Setup(tapIfaceSpec.Name, *iface.Firewall); err != nil {
+			return fmt.Errorf("setup-firewall: %w", err)
+		}
+	}
+	return nil
+}
+
 func (n NetPod) lookupMasquradeBridge(desiredIfacesSpec []nmstate.Interface) *nmstate.Interface {
 	masqueradeIfaces := vmispec.FilterInterfacesSpec(n.vmiSpecIfaces, func(i v1.Interface) bool {
 		return i.Masquerade != nil
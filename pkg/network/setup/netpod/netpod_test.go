@@ -664,6 +664,115 @@ var _ = Describe("netpod", func() {
 			Equal(&cache.DHCPConfig{IPAMDisabled: true}))
 	})
 
+	It("setup bridge binding without IP serves the address allocated by KubeVirt IPAM", func() {
+		const podIfaceOrignalMAC = "12:34:56:78:90:ab"
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: podIfaceOrignalMAC,
+				MTU:        1500,
+				IPv4:       ipDisabled,
+				IPv6:       ipDisabled,
+			}},
+		}}
+
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{{
+				Name:                   defaultPodNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithCacheCreator(&baseCacheCreator),
+			netpod.WithIPAllocations([]v1.VirtualMachineIPAllocation{{
+				Network:     defaultPodNetworkName,
+				IPAddresses: []string{"10.10.0.2/24"},
+				Gateway:     "10.10.0.1",
+				Nameservers: []string{"10.10.0.53"},
+			}}),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+
+		addr, err := vishnetlink.ParseAddr("10.10.0.2/24")
+		Expect(err).NotTo(HaveOccurred())
+		mac, err := net.ParseMAC(podIfaceOrignalMAC)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cache.ReadDHCPInterfaceCache(&baseCacheCreator, "0", "eth0")).To(Equal(&cache.DHCPConfig{
+			IP:          *addr,
+			MAC:         mac,
+			Gateway:     net.ParseIP("10.10.0.1"),
+			Nameservers: []net.IP{net.ParseIP("10.10.0.53")},
+		}))
+	})
+
+	Context("bridge binding with a firewall", func() {
+		var (
+			nmstatestub nmstateStub
+			vmiIface    v1.Interface
+		)
+
+		BeforeEach(func() {
+			nmstatestub = nmstateStub{status: nmstate.Status{
+				Interfaces: []nmstate.Interface{{
+					Name:       "eth0",
+					Index:      0,
+					TypeName:   nmstate.TypeVETH,
+					State:      nmstate.IfaceStateUp,
+					MacAddress: "12:34:56:78:90:ab",
+					MTU:        1500,
+					IPv4:       ipDisabled,
+					IPv6:       ipDisabled,
+				}},
+			}}
+			vmiIface = v1.Interface{
+				Name:                   defaultPodNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				Firewall: &v1.InterfaceFirewall{
+					DefaultAction: v1.FirewallActionDeny,
+					Rules: []v1.FirewallRule{{
+						Direction: v1.FirewallDirectionIngress,
+						Action:    v1.FirewallActionAllow,
+						Protocol:  "TCP",
+						Port:      22,
+					}},
+				},
+			}
+		})
+
+		It("setup applies the firewall on the tap device", func() {
+			fwstub := firewallStub{}
+			netPod := netpod.NewNetPod(
+				[]v1.Network{*v1.DefaultPodNetwork()},
+				[]v1.Interface{vmiIface},
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithFirewallAdapter(&fwstub),
+				netpod.WithCacheCreator(&baseCacheCreator),
+			)
+			Expect(netPod.Setup()).To(Succeed())
+
+			Expect(fwstub.firewallByTap).To(Equal(map[string]v1.InterfaceFirewall{
+				"tap0": *vmiIface.Firewall,
+			}))
+		})
+
+		It("setup fails when the firewall setup fails", func() {
+			netPod := netpod.NewNetPod(
+				[]v1.Network{*v1.DefaultPodNetwork()},
+				[]v1.Interface{vmiIface},
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithFirewallAdapter(&firewallStub{setupErr: errFirewallSetup}),
+				netpod.WithCacheCreator(&baseCacheCreator),
+			)
+			Expect(netPod.Setup()).To(MatchError(errFirewallSetup))
+		})
+	})
+
 	It("setup passt binding", func() {
 		nmstatestub := nmstateStub{status: nmstate.Status{
 			Interfaces: []nmstate.Interface{{Name: "eth0"}},
@@ -1908,6 +2017,24 @@ func (m *masqueradeStub) Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface,
 	return nil
 }
 
+type firewallStub struct {
+	setupErr      error
+	firewallByTap map[string]v1.InterfaceFirewall
+}
+
+var errFirewallSetup = errors.New("firewall Setup Test Error")
+
+func (f *firewallStub) Setup(tapIfaceName string, firewall v1.InterfaceFirewall) error {
+	if f.setupErr != nil {
+		return f.setupErr
+	}
+	if f.firewallByTap == nil {
+		f.firewallByTap = map[string]v1.InterfaceFirewall{}
+	}
+	f.firewallByTap[tapIfaceName] = firewall
+	return nil
+}
+
 type tempCacheCreator struct {
 	once   sync.Once
 	tmpDir string
@@ -84,7 +84,8 @@ func (c *NetStat) CachePodInterfaceVolatileData(vmi *v1.VirtualMachineInstance,
 // UpdateStatus calculates the vmi.Status.Interfaces based on the following data sets:
 //   - Pod interface cache: interfaces data (IP/s) collected from the cache (which was populated during the network setup).
 //   - domain.Spec: interfaces configuration as seen by the (libvirt) domain.
-//   - domain.Status.Interfaces: interfaces reported by the guest agent (empty if Qemu agent not running).
+//   - domain.Status.Interfaces: interfaces reported by the guest agent (empty if Qemu agent not running),
+//     and guest addresses learned by virt-launcher without the agent (marked with their info source).
 //   - Multus status: Interfaces reported by multus on the pod annotation.
 //     The virt-controller updates the VMI interfaces status my setting the infoSource field.
 //
@@ -113,8 +114,13 @@ func (c *NetStat) UpdateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domai
 		return err
 	}
 
+	guestAgentInterfaces, learnedInterfaces := splitLearnedInterfaces(domain.Status.Interfaces)
+
 	// Guest Agent information will add and conditionally override data gathered from the cache.
-	interfacesStatus = ifacesStatusFromGuestAgent(interfacesStatus, domain.Status.Interfaces, vmiInterfacesSpecByName)
+	interfacesStatus = ifacesStatusFromGuestAgent(interfacesStatus, guestAgentInterfaces, vmiInterfacesSpecByName)
+
+	// Learned addresses are a fallback for interfaces the Guest Agent does not report.
+	interfacesStatus = ifacesStatusFromLearnedAddresses(interfacesStatus, learnedInterfaces, vmiInterfacesSpecByName)
 
 	if primaryNetwork := netvmispec.LookupPodNetwork(vmi.Spec.Networks); primaryNetwork != nil {
 		interfacesStatus = restorePrimaryIfaceStatus(interfacesStatus, vmi.Status.Interfaces, primaryNetwork.Name)
@@ -357,6 +363,47 @@ func ifacesStatusFromGuestAgent(
 	return vmiIfacesStatus
 }
 
+func splitLearnedInterfaces(domainStatusIfaces []api.InterfaceStatus) (guestAgentIfaces, learnedIfaces []api.InterfaceStatus) {
+	for _, domainStatusIface := range domainStatusIfaces {
+		if domainStatusIface.InfoSource != "" {
+			learnedIfaces = append(learnedIfaces, domainStatusIface)
+		} else {
+			guestAgentIfaces = append(guestAgentIfaces, domainStatusIface)
+		}
+	}
+	return guestAgentIfaces, learnedIfaces
+}
+
+// ifacesStatusFromLearnedAddresses merges the guest addresses learned without the guest agent
+// into the statuses of the interfaces the guest agent did not report.
+// Masquerade interfaces are skipped, as their guest addresses are internal to the pod.
+func ifacesStatusFromLearnedAddresses(
+	vmiIfacesStatus []v1.VirtualMachineInstanceNetworkInterface,
+	learnedInterfaces []api.InterfaceStatus,
+	vmiInterfacesSpecByName map[string]v1.Interface,
+) []v1.VirtualMachineInstanceNetworkInterface {
+	for _, learnedInterface := range learnedInterfaces {
+		vmiIfaceStatus := netvmispec.LookupInterfaceStatusByMac(vmiIfacesStatus, learnedInterface.Mac)
+		if vmiIfaceStatus == nil || netvmispec.ContainsInfoSource(vmiIfaceStatus.InfoSource, netvmispec.InfoSourceGuestAgent) {
+			continue
+		}
+		if vmiIfaceSpec, exists := vmiInterfacesSpecByName[vmiIfaceStatus.Name]; exists && vmiIfaceSpec.Masquerade != nil {
+			continue
+		}
+
+		for _, ip := range learnedInterface.IPs {
+			if !slices.Contains(vmiIfaceStatus.IPs, ip) {
+				vmiIfaceStatus.IPs = append(vmiIfaceStatus.IPs, ip)
+			}
+		}
+		if len(vmiIfaceStatus.IPs) > 0 {
+			vmiIfaceStatus.IP = vmiIfaceStatus.IPs[0]
+		}
+		vmiIfaceStatus.InfoSource = netvmispec.AddInfoSource(vmiIfaceStatus.InfoSource, learnedInterface.InfoSource)
+	}
+	return vmiIfacesStatus
+}
+
 // For backward compatability with older virt-launchers, apply this logic:
 //   - When the domain status `InterfaceName` field is set, the data originates from the guest-agent.
 //     This is true for old virt-launchers and new ones alike.
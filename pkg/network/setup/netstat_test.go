@@ -679,6 +679,94 @@ var _ = Describe("netstat", func() {
 		}))
 	})
 
+	Context("with guest addresses learned without the guest-agent", func() {
+		const (
+			primaryNetworkName = "primary"
+			primaryPodIPv4     = "1.1.1.1"
+			primaryMAC         = "1c:ce:c0:01:be:e7"
+
+			secondaryNetworkName = "secondary"
+			secondaryIPv4        = "192.168.1.10"
+			secondaryIPv6        = "fd20:244::10"
+			secondaryMAC         = "1c:ce:c0:01:be:e9"
+		)
+
+		BeforeEach(func() {
+			Expect(
+				setup.addNetworkInterface(
+					newVMISpecIfaceWithMasqueradeBinding(primaryNetworkName),
+					newVMISpecPodNetwork(primaryNetworkName),
+					newDomainSpecIface(primaryNetworkName, primaryMAC),
+					primaryPodIPv4,
+				),
+			).To(Succeed())
+			Expect(
+				setup.addNetworkInterface(
+					newVMISpecIfaceWithBridgeBinding(secondaryNetworkName),
+					newVMISpecMultusNetwork(secondaryNetworkName),
+					newDomainSpecIface(secondaryNetworkName, secondaryMAC),
+				),
+			).To(Succeed())
+		})
+
+		It("reports the learned addresses with their info sources", func() {
+			setup.addGuestAgentInterfaces(
+				newLearnedDomainStatusIface([]string{secondaryIPv4}, secondaryMAC, netvmispec.InfoSourceDHCPLease),
+				newLearnedDomainStatusIface([]string{secondaryIPv4, secondaryIPv6}, secondaryMAC, netvmispec.InfoSourceNeighbor),
+			)
+
+			Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+			Expect(setup.Vmi.Status.Interfaces).To(Equal([]v1.VirtualMachineInstanceNetworkInterface{
+				{
+					Name:       primaryNetworkName,
+					IP:         primaryPodIPv4,
+					IPs:        []string{primaryPodIPv4},
+					MAC:        primaryMAC,
+					InfoSource: netvmispec.InfoSourceDomain,
+					QueueCount: netsetup.DefaultInterfaceQueueCount,
+					LinkState:  linkStateUp,
+				},
+				{
+					Name: secondaryNetworkName,
+					IP:   secondaryIPv4,
+					IPs:  []string{secondaryIPv4, secondaryIPv6},
+					MAC:  secondaryMAC,
+					InfoSource: netvmispec.NewInfoSource(
+						netvmispec.InfoSourceDomain, netvmispec.InfoSourceDHCPLease, netvmispec.InfoSourceNeighbor,
+					),
+					QueueCount: netsetup.DefaultInterfaceQueueCount,
+					LinkState:  linkStateUp,
+				},
+			}))
+		})
+
+		It("does not report learned addresses of a masquerade interface", func() {
+			setup.addGuestAgentInterfaces(
+				newLearnedDomainStatusIface([]string{"10.0.2.2"}, primaryMAC, netvmispec.InfoSourceNeighbor),
+			)
+
+			Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+			primaryIfaceStatus := netvmispec.LookupInterfaceStatusByName(setup.Vmi.Status.Interfaces, primaryNetworkName)
+			Expect(primaryIfaceStatus.IPs).To(Equal([]string{primaryPodIPv4}))
+			Expect(primaryIfaceStatus.InfoSource).To(Equal(netvmispec.InfoSourceDomain))
+		})
+
+		It("prefers the guest-agent data over the learned addresses", func() {
+			setup.addGuestAgentInterfaces(
+				newDomainStatusIface([]string{secondaryIPv6}, secondaryMAC, "eth1"),
+				newLearnedDomainStatusIface([]string{secondaryIPv4}, secondaryMAC, netvmispec.InfoSourceNeighbor),
+			)
+
+			Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+			secondaryIfaceStatus := netvmispec.LookupInterfaceStatusByName(setup.Vmi.Status.Interfaces, secondaryNetworkName)
+			Expect(secondaryIfaceStatus.IPs).To(Equal([]string{secondaryIPv6}))
+			Expect(secondaryIfaceStatus.InfoSource).To(Equal(netvmispec.InfoSourceDomainAndGA))
+		})
+	})
+
 	When("the desired state (VMI spec) is not in sync with the state in the guest (guest-agent)", func() {
 		const (
 			primaryNetworkName = "primary"
@@ -1478,6 +1566,15 @@ func newDomainStatusIface(IPs []string, mac, interfaceName string) api.Interface
 	}
 }
 
+func newLearnedDomainStatusIface(IPs []string, mac, infoSource string) api.InterfaceStatus {
+	return api.InterfaceStatus{
+		Mac:        mac,
+		Ip:         IPs[0],
+		IPs:        IPs,
+		InfoSource: infoSource,
+	}
+}
+
 func newVMISpecIfaceWithMasqueradeBinding(name string) v1.Interface {
 	return v1.Interface{
 		Name: name,
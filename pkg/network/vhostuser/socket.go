@@ -0,0 +1,39 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vhostuser
+
+import (
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+)
+
+const (
+	SocketsVolumeName = "vhostuser-sockets"
+	SocketsDir        = "/var/run/kubevirt/vhostuser"
+)
+
+// SocketPath returns the path of the vhost-user socket of the network in the virt-launcher pod.
+// The socket is named after the pod interface, hence the path is the same on a migration target.
+func SocketPath(network v1.Network, ifaceStatuses []v1.VirtualMachineInstanceNetworkInterface) string {
+	return filepath.Join(SocketsDir, namescheme.HashedPodInterfaceName(network, ifaceStatuses))
+}
@@ -0,0 +1,46 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vhostuser_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
+)
+
+var _ = Describe("vhost-user socket", func() {
+	It("should be named after the hashed pod interface of a secondary network", func() {
+		network := v1.Network{
+			Name:          "dpdk",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "ovs-dpdk"}},
+		}
+		Expect(vhostuser.SocketPath(network, nil)).To(
+			Equal("/var/run/kubevirt/vhostuser/" + namescheme.GenerateHashedInterfaceName("dpdk")))
+	})
+
+	It("should be named after the primary pod interface of the pod network", func() {
+		network := *v1.DefaultPodNetwork()
+		Expect(vhostuser.SocketPath(network, nil)).To(Equal("/var/run/kubevirt/vhostuser/eth0"))
+	})
+})
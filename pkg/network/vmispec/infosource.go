@@ -26,6 +26,11 @@ const (
 	InfoSourceMultusStatus string = "multus-status"
 	InfoSourceDomainAndGA  string = InfoSourceDomain + ", " + InfoSourceGuestAgent
 
+	// Sources of guest addresses learned without the guest agent.
+	InfoSourceDHCPLease string = "dhcp-lease"
+	InfoSourceNeighbor  string = "arp-ndp"
+	InfoSourcePasst     string = "passt"
+
 	separator = ", "
 )
 
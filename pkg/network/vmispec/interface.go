@@ -169,6 +169,23 @@ func HasBindingPluginDeviceInfo(iface v1.Interface, bindingPlugins map[string]v1
 	return false
 }
 
+func BindingPluginNetworkWithVhostUserExist(ifaces []v1.Interface, bindingPlugins map[string]v1.InterfaceBindingPlugin) bool {
+	for _, iface := range ifaces {
+		if HasBindingPluginVhostUser(iface, bindingPlugins) {
+			return true
+		}
+	}
+	return false
+}
+
+func HasBindingPluginVhostUser(iface v1.Interface, bindingPlugins map[string]v1.InterfaceBindingPlugin) bool {
+	if iface.Binding != nil {
+		binding, exist := bindingPlugins[iface.Binding.Name]
+		return exist && binding.DomainAttachmentType == v1.VhostUser
+	}
+	return false
+}
+
 // hasVirtioIface checks whether a VMI references at least one "virtio" network interface.
 // Note that the reference can be explicit or implicit (unspecified nic models defaults to "virtio").
 func hasVirtioIface(vmi *v1.VirtualMachineInstance) bool {
@@ -173,6 +173,33 @@ var _ = Describe("VMI network spec", func() {
 			Expect(netvmispec.BindingPluginNetworkWithDeviceInfoExist(ifaces, bindingPlugins)).To(BeTrue())
 		})
 	})
+
+	Context("binding plugin network with vhost-user", func() {
+		const vhostUserPlugin = "vhostuser"
+
+		vhostUserBindingPlugins := map[string]v1.InterfaceBindingPlugin{
+			vhostUserPlugin:     {DomainAttachmentType: v1.VhostUser},
+			nonDeviceInfoPlugin: {DomainAttachmentType: v1.Tap},
+		}
+
+		It("returns false given non binding-plugin interface", func() {
+			Expect(netvmispec.HasBindingPluginVhostUser(
+				libvmi.InterfaceDeviceWithBridgeBinding("net1"),
+				vhostUserBindingPlugins,
+			)).To(BeFalse())
+		})
+		It("returns false when the plugin domain attachment is not vhost-user", func() {
+			ifaces := []v1.Interface{interfaceWithBindingPlugin("net1", nonDeviceInfoPlugin)}
+			Expect(netvmispec.BindingPluginNetworkWithVhostUserExist(ifaces, vhostUserBindingPlugins)).To(BeFalse())
+		})
+		It("returns true when there is at least one network with a vhost-user plugin", func() {
+			ifaces := []v1.Interface{
+				interfaceWithBindingPlugin("net1", nonDeviceInfoPlugin),
+				interfaceWithBindingPlugin("net2", vhostUserPlugin),
+			}
+			Expect(netvmispec.BindingPluginNetworkWithVhostUserExist(ifaces, vhostUserBindingPlugins)).To(BeTrue())
+		})
+	})
 })
 
 func interfaceWithBindingPlugin(name, pluginName string) v1.Interface {
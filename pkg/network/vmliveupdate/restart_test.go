@@ -105,6 +105,28 @@ var _ = Describe("IsRestartRequired", func() {
 		Entry("From down to down", v1.InterfaceStateLinkDown, v1.InterfaceStateLinkDown),
 	)
 
+	DescribeTable("should not require restart when interface bandwidth changes", func(current, desired *v1.InterfaceBandwidth) {
+		iface := libvmi.InterfaceDeviceWithBridgeBinding(secondaryNetName1)
+		iface.Bandwidth = current
+
+		vmi := libvmi.New(
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(libvmi.MultusNetwork(secondaryNetName1, secondaryNADName1)),
+		)
+
+		vm := libvmi.NewVirtualMachine(vmi).DeepCopy()
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = desired
+
+		Expect(vmliveupdate.IsRestartRequired(vm, vmi, stubClusterConfigurer{liveUpdateNADRefEnabled})).To(BeFalse())
+	},
+		Entry("From none to limited", nil, &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}}),
+		Entry("From limited to none", &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}}, nil),
+		Entry("From limited to other limit",
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}},
+			&v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimit{Average: 1000, Peak: 2000}},
+		),
+	)
+
 	DescribeTable("should not require restart when secondary NICs are hotplugged", func(
 		isliveUpdateNADRefEnabled bool,
 	) {
@@ -61,6 +61,7 @@ func ValidateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 		// name can become a container name which will fail to schedule if invalid
 		causes = append(causes, validateDiskNameAsContainerName(field, idx, disk)...)
 		causes = append(causes, validateBlockSize(field, idx, disk)...)
+		causes = append(causes, validateIOTune(field, idx, disk)...)
 	}
 	return causes
 }
@@ -395,3 +396,80 @@ func ValidatePath(field *k8sfield.Path, path string) []metav1.StatusCause {
 
 	return causes
 }
+
+func validateIOTune(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	if disk.IOTune == nil {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	ioTuneField := field.Index(idx).Child("ioTune")
+	causes = append(causes, validateIOLimits(ioTuneField.Child("bytes"), disk.IOTune.Bytes)...)
+	causes = append(causes, validateIOLimits(ioTuneField.Child("iops"), disk.IOTune.IOPS)...)
+	if disk.IOTune.GroupName != "" && !isValidExpression(disk.IOTune.GroupName) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s has invalid value \"%s\"", ioTuneField.Child("groupName").String(), disk.IOTune.GroupName),
+			Field:   ioTuneField.Child("groupName").String(),
+		})
+	}
+	return causes
+}
+
+func validateIOLimits(field *k8sfield.Path, limits *v1.DiskIOLimits) []metav1.StatusCause {
+	if limits == nil {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	if limits.Total != nil && (limits.Read != nil || limits.Write != nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can not be combined with read or write limits", field.Child("total").String()),
+			Field:   field.Child("total").String(),
+		})
+	}
+	burst := limits.Burst
+	if burst == nil {
+		return causes
+	}
+	burstField := field.Child("burst")
+	if burst.Total != nil && (burst.Read != nil || burst.Write != nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can not be combined with read or write bursts", burstField.Child("total").String()),
+			Field:   burstField.Child("total").String(),
+		})
+	}
+	for _, value := range []struct {
+		name      string
+		sustained *uint64
+		burst     *uint64
+	}{
+		{"total", limits.Total, burst.Total},
+		{"read", limits.Read, burst.Read},
+		{"write", limits.Write, burst.Write},
+	} {
+		switch {
+		case value.burst == nil:
+		case value.sustained == nil:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s requires %s to be set", burstField.Child(value.name).String(), field.Child(value.name).String()),
+				Field:   burstField.Child(value.name).String(),
+			})
+		case *value.burst < *value.sustained:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than or equal to %s", burstField.Child(value.name).String(), field.Child(value.name).String()),
+				Field:   burstField.Child(value.name).String(),
+			})
+		}
+	}
+	if burst.LengthSeconds != nil && *burst.LengthSeconds == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", burstField.Child("lengthSeconds").String()),
+			Field:   burstField.Child("lengthSeconds").String(),
+		})
+	}
+	return causes
+}
@@ -329,6 +329,64 @@ var _ = Describe("Disk Validation", func() {
 			Entry("enospace", v1.DiskErrorPolicyEnospace),
 		)
 
+		DescribeTable("should reject disk with invalid ioTune", func(ioTune *v1.DiskIOTune, expectedField string) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", IOTune: ioTune, DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(ContainElement(HaveField("Field", expectedField)))
+		},
+			Entry("with total and read limits",
+				&v1.DiskIOTune{Bytes: &v1.DiskIOLimits{Total: pointer.P(uint64(100)), Read: pointer.P(uint64(100))}},
+				"fake[0].ioTune.bytes.total"),
+			Entry("with total and write bursts",
+				&v1.DiskIOTune{IOPS: &v1.DiskIOLimits{
+					Total: pointer.P(uint64(100)),
+					Burst: &v1.DiskIOBurst{Total: pointer.P(uint64(200)), Write: pointer.P(uint64(200))},
+				}},
+				"fake[0].ioTune.iops.burst.total"),
+			Entry("with a burst without a sustained limit",
+				&v1.DiskIOTune{IOPS: &v1.DiskIOLimits{
+					Read:  pointer.P(uint64(100)),
+					Burst: &v1.DiskIOBurst{Write: pointer.P(uint64(200))},
+				}},
+				"fake[0].ioTune.iops.burst.write"),
+			Entry("with a burst lower than the sustained limit",
+				&v1.DiskIOTune{Bytes: &v1.DiskIOLimits{
+					Read:  pointer.P(uint64(100)),
+					Burst: &v1.DiskIOBurst{Read: pointer.P(uint64(50))},
+				}},
+				"fake[0].ioTune.bytes.burst.read"),
+			Entry("with a zero burst length",
+				&v1.DiskIOTune{Bytes: &v1.DiskIOLimits{
+					Total: pointer.P(uint64(100)),
+					Burst: &v1.DiskIOBurst{Total: pointer.P(uint64(200)), LengthSeconds: pointer.P(uint64(0))},
+				}},
+				"fake[0].ioTune.bytes.burst.lengthSeconds"),
+			Entry("with an invalid group name",
+				&v1.DiskIOTune{IOPS: &v1.DiskIOLimits{Total: pointer.P(uint64(100))}, GroupName: "a group"},
+				"fake[0].ioTune.groupName"),
+		)
+
+		It("should accept a disk with a valid ioTune", func() {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+				IOTune: &v1.DiskIOTune{
+					Bytes: &v1.DiskIOLimits{
+						Read:  pointer.P(uint64(1024)),
+						Write: pointer.P(uint64(2048)),
+						Burst: &v1.DiskIOBurst{Read: pointer.P(uint64(4096)), LengthSeconds: pointer.P(uint64(10))},
+					},
+					IOPS:      &v1.DiskIOLimits{Total: pointer.P(uint64(100))},
+					GroupName: "group1",
+				},
+			})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		})
+
 		It("should reject invalid SN characters", func() {
 			order := uint(1)
 			sn := "$$$$"
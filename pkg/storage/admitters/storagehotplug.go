@@ -172,7 +172,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 						},
 					})
 				}
-				if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+				if !equalDisksIgnoringIOTune(newDisks[k], oldDisks[k]) {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
 							Type:    metav1.CauseTypeFieldValueInvalid,
@@ -256,7 +256,7 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 				},
 			})
 		}
-		if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+		if !equalDisksIgnoringIOTune(newDisks[k], oldDisks[k]) {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
 					Type:    metav1.CauseTypeFieldValueInvalid,
@@ -268,6 +268,13 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 	return nil
 }
 
+// equalDisksIgnoringIOTune compares two disks without their I/O limits, which can be updated live.
+func equalDisksIgnoringIOTune(newDisk, oldDisk v1.Disk) bool {
+	newDisk.IOTune = nil
+	oldDisk.IOTune = nil
+	return equality.Semantic.DeepEqual(newDisk, oldDisk)
+}
+
 func getDiskMap(disks []v1.Disk) map[string]v1.Disk {
 	newDiskMap := make(map[string]v1.Disk, 0)
 	for _, disk := range disks {
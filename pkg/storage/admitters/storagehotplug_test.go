@@ -124,6 +124,14 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 		return makeDisksWithBus(v1.DiskBusSCSI, indexes...)
 	}
 
+	makeDisksWithIOTune := func(indexes ...int) []v1.Disk {
+		res := makeDisks(indexes...)
+		for i := range res {
+			res[i].IOTune = &v1.DiskIOTune{IOPS: &v1.DiskIOLimits{Total: pointer.P(uint64(100))}}
+		}
+		return res
+	}
+
 	makeLUNDisks := func(indexes ...int) []v1.Disk {
 		res := make([]v1.Disk, 0)
 		for _, index := range indexes {
@@ -382,6 +390,14 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 			makeFilesystems(),
 			makeStatus(1, 0),
 			makeExpected("permanent disk volume-name-0, changed", "")),
+		Entry("Should accept if the I/O limits of a permanent disk changed",
+			makeVolumes(0),
+			makeVolumes(0),
+			makeDisksWithIOTune(0),
+			makeDisks(0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			nil),
 		Entry("Should reject if a hotplug volume changed",
 			makeInvalidVolumes(2, 1),
 			makeVolumes(0, 1),
@@ -401,6 +401,15 @@ func (app *virtAPIApp) composeSubresources() {
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.VSOCKPortParameter(subws)).Param(definitions.VSOCKTLSParameter(subws)).
 			Operation(version.Version + "VSOCK").
 			Doc("Open a websocket connection forwarding traffic to the specified VirtualMachineInstance and port via VSOCK."))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("pcap")).
+			To(subresourceApp.PcapRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.PcapInterfaceParameter(subws)).
+			Param(definitions.PcapFilterParameter(subws)).
+			Param(definitions.PcapDurationParameter(subws)).
+			Param(definitions.PcapPacketCountParameter(subws)).
+			Operation(version.Version + "Pcap").
+			Doc("Open a websocket connection streaming the traffic of the specified VirtualMachineInstance interface in the pcap format."))
 
 		// VM endpoint
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
@@ -488,6 +497,64 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("netstat")).
+			To(subresourceApp.NetworkStatistics).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Netstat").
+			Doc("Get the traffic statistics of the VirtualMachineInstance interfaces").
+			Writes(v1.VirtualMachineInstanceNetworkStatistics{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceNetworkStatistics{}))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestexec")).
+			To(subresourceApp.GuestExecHandler).
+			Consumes(mime.MIME_ANY).
+			Produces(restful.MIME_JSON).
+			Reads(v1.VirtualMachineInstanceGuestExecOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"GuestExec").
+			Doc("Run a command allowed by the cluster configuration in the guest of the VirtualMachineInstance").
+			Writes(v1.VirtualMachineInstanceGuestExecResult{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestExecResult{}).
+			Returns(http.StatusForbidden, "Forbidden", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile")).
+			To(subresourceApp.GuestFileReadHandler).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.GuestFilePathParameter(subws)).
+			Param(definitions.GuestFileOffsetParameter(subws)).
+			Param(definitions.GuestFileLengthParameter(subws)).
+			Operation(version.Version+"GuestFileRead").
+			Doc("Read a chunk of a file in the guest of the VirtualMachineInstance").
+			Writes(v1.VirtualMachineInstanceGuestFileChunk{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestFileChunk{}).
+			Returns(http.StatusRequestEntityTooLarge, "Request Entity Too Large", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile")).
+			To(subresourceApp.GuestFileWriteHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.VirtualMachineInstanceGuestFileWriteOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"GuestFileWrite").
+			Doc("Write a chunk of a file in the guest of the VirtualMachineInstance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusRequestEntityTooLarge, "Request Entity Too Large", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestuser")).
+			To(subresourceApp.GuestUserHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.VirtualMachineInstanceGuestUserOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"GuestUser").
+			Doc("Create, lock or unlock a user in the guest of the VirtualMachineInstance, or set its password").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("objectgraph")).
 			To(subresourceApp.VMIObjectGraph).
 			Consumes(restful.MIME_JSON).
@@ -761,6 +828,26 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/netstat",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/pcap",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestexec",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestfile",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestuser",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
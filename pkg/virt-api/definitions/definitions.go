@@ -698,6 +698,15 @@ const (
 	PortPath          = "/{port}"
 	ProtocolParamName = "protocol"
 	ProtocolPath      = "/{protocol}"
+
+	PcapInterfaceParamName   = "interface"
+	PcapFilterParamName      = "filter"
+	PcapDurationParamName    = "duration"
+	PcapPacketCountParamName = "packetCount"
+
+	GuestFilePathParamName   = "path"
+	GuestFileOffsetParamName = "offset"
+	GuestFileLengthParamName = "length"
 )
 
 func PortForwardPortParameter(ws *restful.WebService) *restful.Parameter {
@@ -717,3 +726,31 @@ func VSOCKPortParameter(ws *restful.WebService) *restful.Parameter {
 func VSOCKTLSParameter(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(TLSParamName, "Weather to request a TLS encrypted session from the VSOCK application.").DataType("boolean").Required(false)
 }
+
+func PcapInterfaceParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(PcapInterfaceParamName, "The name of the VirtualMachineInstance interface to capture the traffic of.").DataType("string").Required(true)
+}
+
+func PcapFilterParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(PcapFilterParamName, "A pcap-filter expression the captured packets are filtered with, e.g. 'tcp port 22'.").DataType("string").Required(false)
+}
+
+func PcapDurationParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(PcapDurationParamName, "The duration after which the capture ends, at most 30 minutes. Defaults to one minute.").DataType("string").Required(false)
+}
+
+func PcapPacketCountParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(PcapPacketCountParamName, "The number of packets after which the capture ends, at most 1000000. Defaults to 10000.").DataType("integer").Required(false)
+}
+
+func GuestFilePathParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(GuestFilePathParamName, "The absolute path of the file in the guest.").DataType("string").Required(true)
+}
+
+func GuestFileOffsetParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(GuestFileOffsetParamName, "The offset in bytes the file is read from.").DataType("integer").Required(false)
+}
+
+func GuestFileLengthParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(GuestFileLengthParamName, "The maximum number of bytes read, at most 1MiB.").DataType("integer").Required(false)
+}
@@ -42,6 +42,9 @@ const (
 	groupHeader           = "X-Remote-Group"
 	userExtraHeaderPrefix = "X-Remote-Extra-"
 
+	// userAttribute is the request attribute holding the name of the authorized user
+	userAttribute = "kubevirt.io/user"
+
 	namespacedResourceAttributesMinParts  = 9
 	namespacedResourceBaseAttributesParts = 7
 )
@@ -345,6 +348,7 @@ func (a *authorizor) Authorize(req *restful.Request) (bool, string, error) {
 	}
 
 	if result.Status.Allowed {
+		req.SetAttribute(userAttribute, r.Spec.User)
 		return true, "", nil
 	}
 
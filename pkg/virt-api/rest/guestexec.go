@@ -0,0 +1,135 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/json"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	guestexec "kubevirt.io/kubevirt/pkg/guest-exec"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+// GuestExecHandler runs a command allowed by the cluster configuration in the guest of the VMI
+func (app *SubresourceAPIApp) GuestExecHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.GuestExecEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.GuestExec)), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: the command to run is required"), response)
+		return
+	}
+	opts := &v1.VirtualMachineInstanceGuestExecOptions{}
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+	if opts.Command == "" {
+		writeError(errors.NewBadRequest("command must not be empty"), response)
+		return
+	}
+
+	config := app.clusterConfig.GetGuestExecConfiguration()
+	timeout, err := guestexec.Timeout(config, opts.TimeoutSeconds)
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+	opts.TimeoutSeconds = &timeout
+
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+	allowed, err := guestexec.IsCommandAllowed(config, opts.Command, func() (map[string]string, error) {
+		ns, err := app.virtCli.CoreV1().Namespaces().Get(context.Background(), namespace, k8smetav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return ns.Labels, nil
+	})
+	if err != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("failed to evaluate the guest command allow-list: %v", err)), response)
+		return
+	}
+	if !allowed {
+		writeError(errors.NewForbidden(v1.Resource("virtualmachineinstances/guestexec"), name,
+			fmt.Errorf("command %s is not allowed in namespace %s", opts.Command, namespace)), response)
+		return
+	}
+
+	user, _ := request.Attribute(userAttribute).(string)
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		query := url.Values{}
+		query.Set(guestexec.UserParam, user)
+		query.Set(guestexec.MaxOutputBytesParam, strconv.FormatInt(guestexec.MaxOutputBytes(config), 10))
+		return conn.GuestExecURI(vmi, query)
+	}
+	_, handlerURL, conn, statusErr := app.prepareConnection(request, validateVMIForGuestAgent, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	resp, err := conn.PutWithResponse(handlerURL, io.NopCloser(bytes.NewReader(body)))
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to run command %s in the guest of vmi %s/%s", opts.Command, namespace, name)
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	result := v1.VirtualMachineInstanceGuestExecResult{}
+	if err := json.Unmarshal([]byte(resp), &result); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling response")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	response.WriteEntity(result)
+}
+
+func validateVMIForGuestAgent(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if !vmi.IsRunning() {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/json"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	guestfile "kubevirt.io/kubevirt/pkg/guest-file"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+// GuestFileReadHandler returns a chunk of a file of the guest of the VMI
+func (app *SubresourceAPIApp) GuestFileReadHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.GuestFileTransferEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.GuestFileTransfer)), response)
+		return
+	}
+
+	opts, err := guestfile.ParseReadQuery(request.Request.URL.Query())
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+	maxFileSize := app.clusterConfig.GetGuestFileTransferMaxFileSizeBytes()
+	if opts.Offset >= maxFileSize {
+		writeError(guestFileTooLargeError(maxFileSize), response)
+		return
+	}
+
+	user, _ := request.Attribute(userAttribute).(string)
+	query := guestfile.ReadQuery(opts)
+	query.Set(guestfile.UserParam, user)
+	query.Set(guestfile.MaxSizeParam, strconv.FormatInt(maxFileSize, 10))
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestFileURI(vmi, query)
+	}
+	_, handlerURL, conn, statusErr := app.prepareConnection(request, validateVMIForGuestAgent, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	resp, err := conn.Get(handlerURL, restful.MIME_JSON)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to read guest file %s", opts.Path)
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	chunk := v1.VirtualMachineInstanceGuestFileChunk{}
+	if err := json.Unmarshal([]byte(resp), &chunk); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling response")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	response.WriteEntity(chunk)
+}
+
+// GuestFileWriteHandler writes a chunk of a file of the guest of the VMI
+func (app *SubresourceAPIApp) GuestFileWriteHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.GuestFileTransferEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.GuestFileTransfer)), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: the chunk to write is required"), response)
+		return
+	}
+	opts := &v1.VirtualMachineInstanceGuestFileWriteOptions{}
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+	if err := guestfile.ValidateWrite(opts); err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+	maxFileSize := app.clusterConfig.GetGuestFileTransferMaxFileSizeBytes()
+	if opts.Offset+int64(len(opts.Data)) > maxFileSize {
+		writeError(guestFileTooLargeError(maxFileSize), response)
+		return
+	}
+
+	user, _ := request.Attribute(userAttribute).(string)
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestFileURI(vmi, url.Values{guestfile.UserParam: {user}})
+	}
+	_, handlerURL, conn, statusErr := app.prepareConnection(request, validateVMIForGuestAgent, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	if err := conn.Put(handlerURL, io.NopCloser(bytes.NewReader(body))); err != nil {
+		log.Log.Reason(err).Errorf("Failed to write guest file %s", opts.Path)
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+func guestFileTooLargeError(maxFileSize int64) *errors.StatusError {
+	return errors.NewRequestEntityTooLargeError(fmt.Sprintf("guest files larger than %d bytes cannot be copied", maxFileSize))
+}
@@ -0,0 +1,278 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Guest file subresource api", func() {
+	const (
+		testUser    = "jdoe"
+		testPath    = "/etc/hostname"
+		maxFileSize = int64(10)
+		handlerPath = "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfile"
+	)
+
+	var (
+		backend    *ghttp.Server
+		recorder   *httptest.ResponseRecorder
+		response   *restful.Response
+		kubeClient *fake.Clientset
+		virtClient *kubevirtfake.Clientset
+		app        *SubresourceAPIApp
+	)
+
+	guestFileTransferConfig := &v1.GuestFileTransferConfiguration{
+		MaxFileSizeBytes: pointer.P(maxFileSize),
+	}
+
+	newApp := func(kvConfig *v1.KubeVirtConfiguration) {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kvConfig)
+		ctrl := gomock.NewController(GinkgoT())
+		mockVirtClient := kubecli.NewMockKubevirtClient(ctrl)
+		mockVirtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		backendAddr := strings.Split(backend.Addr(), ":")
+		backendPort, err := strconv.Atoi(backendAddr[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		app = NewSubresourceAPIApp(mockVirtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+		app.handlerHttpClient = &http.Client{
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+			Timeout:   10 * time.Second,
+		}
+	}
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+		kubeClient = fake.NewSimpleClientset()
+		virtClient = kubevirtfake.NewSimpleClientset()
+		backend = ghttp.NewTLSServer()
+		DeferCleanup(backend.Close)
+
+		handlerPod := k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "virt-handler", Labels: map[string]string{v1.AppLabel: "virt-handler"}},
+			Spec:       k8sv1.PodSpec{NodeName: "node01"},
+			Status:     k8sv1.PodStatus{Phase: k8sv1.PodRunning, PodIP: strings.Split(backend.Addr(), ":")[0]},
+		}
+		kubeClient.Fake.PrependReactor("list", "pods", func(action testing.Action) (bool, runtime.Object, error) {
+			return true, &k8sv1.PodList{Items: []k8sv1.Pod{handlerPod}}, nil
+		})
+
+		newApp(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: []string{featuregate.GuestFileTransfer}},
+			GuestFileTransfer:      guestFileTransferConfig,
+		})
+	})
+
+	newReadRequest := func(query url.Values) *restful.Request {
+		request := restful.NewRequest(&http.Request{URL: &url.URL{RawQuery: query.Encode()}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		request.SetAttribute(userAttribute, testUser)
+		return request
+	}
+
+	newWriteRequest := func(opts *v1.VirtualMachineInstanceGuestFileWriteOptions) *restful.Request {
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request := restful.NewRequest(&http.Request{Body: io.NopCloser(bytes.NewReader(body))})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		request.SetAttribute(userAttribute, testUser)
+		return request
+	}
+
+	createVMI := func(statusOpts ...libvmistatus.Option) {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(statusOpts...)),
+		)
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	createRunningVMI := func() {
+		createVMI(
+			libvmistatus.WithPhase(v1.Running),
+			libvmistatus.WithNodeName("node01"),
+			libvmistatus.WithCondition(v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceAgentConnected,
+				Status: k8sv1.ConditionTrue,
+			}),
+		)
+	}
+
+	Context("read", func() {
+		It("should fail if the feature gate is disabled", func() {
+			newApp(&v1.KubeVirtConfiguration{GuestFileTransfer: guestFileTransferConfig})
+
+			app.GuestFileReadHandler(newReadRequest(url.Values{"path": {testPath}}), response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			ExpectMessage(recorder, ContainSubstring(featuregate.GuestFileTransfer))
+		})
+
+		DescribeTable("should reject invalid queries", func(query url.Values, expectedMessage string) {
+			app.GuestFileReadHandler(newReadRequest(query), response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			ExpectMessage(recorder, ContainSubstring(expectedMessage))
+		},
+			Entry("without path", url.Values{}, "path must not be empty"),
+			Entry("with a negative offset", url.Values{"path": {testPath}, "offset": {"-1"}}, "offset must not be negative"),
+		)
+
+		It("should reject offsets beyond the maximum file size", func() {
+			app.GuestFileReadHandler(newReadRequest(url.Values{"path": {testPath}, "offset": {"10"}}), response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusRequestEntityTooLarge)
+		})
+
+		It("should fail if the guest agent is not connected", func() {
+			createVMI(libvmistatus.WithPhase(v1.Running), libvmistatus.WithNodeName("node01"))
+
+			app.GuestFileReadHandler(newReadRequest(url.Values{"path": {testPath}}), response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+			ExpectMessage(recorder, ContainSubstring(vmiGuestAgentErr))
+		})
+
+		It("should return the chunk read by virt-handler within the maximum file size", func() {
+			createRunningVMI()
+			expectedChunk := v1.VirtualMachineInstanceGuestFileChunk{Data: []byte("node"), Size: 6}
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, handlerPath, "length=4&maxSize=10&offset=0&path=%2Fetc%2Fhostname&user="+testUser),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedChunk),
+				),
+			)
+
+			app.GuestFileReadHandler(newReadRequest(url.Values{"path": {testPath}, "length": {"4"}}), response)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			chunk := v1.VirtualMachineInstanceGuestFileChunk{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &chunk)).To(Succeed())
+			Expect(chunk).To(Equal(expectedChunk))
+		})
+
+		It("should fail if virt-handler fails to read the file", func() {
+			createRunningVMI()
+			backend.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, "No such file or directory"))
+
+			app.GuestFileReadHandler(newReadRequest(url.Values{"path": {testPath}}), response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusInternalServerError)
+			ExpectMessage(recorder, ContainSubstring("No such file or directory"))
+		})
+	})
+
+	Context("write", func() {
+		It("should fail if the feature gate is disabled", func() {
+			newApp(&v1.KubeVirtConfiguration{GuestFileTransfer: guestFileTransferConfig})
+
+			app.GuestFileWriteHandler(newWriteRequest(&v1.VirtualMachineInstanceGuestFileWriteOptions{Path: testPath}), response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			ExpectMessage(recorder, ContainSubstring(featuregate.GuestFileTransfer))
+		})
+
+		It("should reject a chunk without path", func() {
+			app.GuestFileWriteHandler(newWriteRequest(&v1.VirtualMachineInstanceGuestFileWriteOptions{Data: []byte("data")}), response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			ExpectMessage(recorder, Equal("path must not be empty"))
+		})
+
+		It("should reject chunks beyond the maximum file size", func() {
+			app.GuestFileWriteHandler(newWriteRequest(&v1.VirtualMachineInstanceGuestFileWriteOptions{
+				Path: testPath, Offset: 8, Data: []byte("data"),
+			}), response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusRequestEntityTooLarge)
+		})
+
+		It("should pass the chunk to virt-handler", func() {
+			createRunningVMI()
+			opts := &v1.VirtualMachineInstanceGuestFileWriteOptions{Path: testPath, Offset: 2, Data: []byte("data")}
+			expectedBody, err := json.Marshal(opts)
+			Expect(err).ToNot(HaveOccurred())
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPut, handlerPath, "user="+testUser),
+					ghttp.VerifyBody(expectedBody),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+
+			app.GuestFileWriteHandler(newWriteRequest(opts), response)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(backend.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("should fail if virt-handler fails to write the file", func() {
+			createRunningVMI()
+			backend.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, "No space left on device"))
+
+			app.GuestFileWriteHandler(newWriteRequest(&v1.VirtualMachineInstanceGuestFileWriteOptions{Path: testPath}), response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusInternalServerError)
+			ExpectMessage(recorder, ContainSubstring("No space left on device"))
+		})
+	})
+})
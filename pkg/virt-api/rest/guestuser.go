@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/json"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	guestuser "kubevirt.io/kubevirt/pkg/guest-user"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+// GuestUserHandler creates, locks or unlocks a user of the guest of the VMI, or sets its password
+func (app *SubresourceAPIApp) GuestUserHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.GuestUserManagementEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.GuestUserManagement)), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: the change of the guest user is required"), response)
+		return
+	}
+	opts := &v1.VirtualMachineInstanceGuestUserOptions{}
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+	if err := guestuser.Validate(opts); err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	user, _ := request.Attribute(userAttribute).(string)
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestUserURI(vmi, url.Values{guestuser.UserParam: {user}})
+	}
+	_, handlerURL, conn, statusErr := app.prepareConnection(request, validateVMIForGuestAgent, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	if err := conn.Put(handlerURL, io.NopCloser(bytes.NewReader(body))); err != nil {
+		log.Log.Reason(err).Errorf("Failed to apply %s to guest user %s", opts.Action, opts.Username)
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
@@ -365,6 +365,12 @@ func (og *ObjectGraph) addVolumeGraph(obj any, namespace string) ([]v1.ObjectGra
 					nodes = append(nodes, *node)
 				}
 			}
+			if volume.CloudInitNoCloud.VendorDataSecretRef != nil {
+				node := og.newGraphNode(volume.CloudInitNoCloud.VendorDataSecretRef.Name, namespace, "secrets", nil, false)
+				if node != nil {
+					nodes = append(nodes, *node)
+				}
+			}
 		case volume.CloudInitConfigDrive != nil:
 			if volume.CloudInitConfigDrive.UserDataSecretRef != nil {
 				node := og.newGraphNode(volume.CloudInitConfigDrive.UserDataSecretRef.Name, namespace, "secrets", nil, false)
@@ -378,6 +384,19 @@ func (og *ObjectGraph) addVolumeGraph(obj any, namespace string) ([]v1.ObjectGra
 					nodes = append(nodes, *node)
 				}
 			}
+			if volume.CloudInitConfigDrive.VendorDataSecretRef != nil {
+				node := og.newGraphNode(volume.CloudInitConfigDrive.VendorDataSecretRef.Name, namespace, "secrets", nil, false)
+				if node != nil {
+					nodes = append(nodes, *node)
+				}
+			}
+		case volume.Ignition != nil:
+			if volume.Ignition.ConfigSecretRef != nil {
+				node := og.newGraphNode(volume.Ignition.ConfigSecretRef.Name, namespace, "secrets", nil, false)
+				if node != nil {
+					nodes = append(nodes, *node)
+				}
+			}
 		}
 	}
 	return nodes, err
@@ -0,0 +1,87 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/network/pcap"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+func (app *SubresourceAPIApp) PcapRequestHandler(request *restful.Request, response *restful.Response) {
+	if request.Request == nil || request.Request.URL == nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf("%s must not be empty", pcap.InterfaceParam)), response)
+		return
+	}
+	query := request.Request.URL.Query()
+	opts, err := pcap.ParseQuery(query)
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	streamer := NewRawStreamer(
+		app.FetchVirtualMachineInstance,
+		func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+			return validateVMIForPcap(vmi, opts.Interface)
+		},
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.PcapURI(vmi, query)
+		}),
+	)
+
+	streamer.Handle(request, response)
+}
+
+func validateVMIForPcap(vmi *v1.VirtualMachineInstance, ifaceName string) *errors.StatusError {
+	if !vmi.IsRunning() {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, ifaceName)
+	if iface == nil {
+		return errors.NewBadRequest(fmt.Sprintf("interface %s does not exist", ifaceName))
+	}
+	if iface.SRIOV != nil {
+		return errors.NewBadRequest(fmt.Sprintf("interface %s is a passed through device and cannot be captured", ifaceName))
+	}
+	return nil
+}
+
+// NetworkStatistics handles the subresource for providing the VMI interfaces statistics
+func (app *SubresourceAPIApp) NetworkStatistics(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi == nil || vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.NetworkStatisticsURI(vmi)
+	}
+
+	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceNetworkStatistics{})
+}
@@ -0,0 +1,124 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Pcap Subresource api", func() {
+	var (
+		recorder   *httptest.ResponseRecorder
+		response   *restful.Response
+		virtClient *kubevirtfake.Clientset
+		app        *SubresourceAPIApp
+	)
+
+	config, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{})
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		mockVirtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		app = NewSubresourceAPIApp(mockVirtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	})
+
+	newRequest := func(query url.Values) *restful.Request {
+		request := restful.NewRequest(&http.Request{URL: &url.URL{RawQuery: query.Encode()}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		return request
+	}
+
+	createVMI := func(vmi *v1.VirtualMachineInstance) {
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	DescribeTable("should reject invalid capture options", func(query url.Values, expectedMessage string) {
+		app.PcapRequestHandler(newRequest(query), response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		ExpectMessage(recorder, ContainSubstring(expectedMessage))
+	},
+		Entry("without interface", url.Values{}, "interface must not be empty"),
+		Entry("with an invalid filter", url.Values{"interface": {"default"}, "filter": {"tcp port"}}, "filter"),
+		Entry("with an invalid duration", url.Values{"interface": {"default"}, "duration": {"-1s"}}, "duration must be positive"),
+		Entry("with a too long duration", url.Values{"interface": {"default"}, "duration": {"2h"}}, "at most 30m0s"),
+		Entry("with an invalid packet count", url.Values{"interface": {"default"}, "packetCount": {"many"}}, "invalid packetCount"),
+	)
+
+	It("should fail if the vmi is not running", func() {
+		createVMI(libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Scheduling))),
+		))
+
+		app.PcapRequestHandler(newRequest(url.Values{"interface": {"default"}}), response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		ExpectMessage(recorder, ContainSubstring(vmiNotRunning))
+	})
+
+	DescribeTable("should fail if the interface cannot be captured", func(iface v1.Interface, expectedMessage string) {
+		createVMI(libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(libvmi.MultusNetwork("secondary", "nad")),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+		))
+
+		app.PcapRequestHandler(newRequest(url.Values{"interface": {"secondary"}}), response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		ExpectMessage(recorder, Equal(expectedMessage))
+	},
+		Entry("when it does not exist",
+			libvmi.InterfaceDeviceWithBridgeBinding("other"), "interface secondary does not exist"),
+		Entry("when it is an SR-IOV interface",
+			libvmi.InterfaceDeviceWithSRIOVBinding("secondary"), "interface secondary is a passed through device and cannot be captured"),
+	)
+})
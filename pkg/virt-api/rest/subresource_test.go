@@ -913,6 +913,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for Filesystem", app.FilesystemList),
+			Entry("for NetworkStatistics", app.NetworkStatistics),
 		)
 
 		DescribeTable("should fail when the VMI is not running", func(fn subRes) {
@@ -932,6 +933,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for FilesystemList", app.FilesystemList),
+			Entry("for NetworkStatistics", app.NetworkStatistics),
 		)
 
 		DescribeTable("should fail when VMI does not have agent connected", func(fn subRes) {
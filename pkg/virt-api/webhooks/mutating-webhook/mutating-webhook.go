@@ -80,7 +80,7 @@ func ServeVMs(resp http.ResponseWriter, req *http.Request, clusterConfig *virtco
 }
 
 func ServeVMIs(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, informers *webhooks.Informers, kubeVirtServiceAccounts map[string]struct{}) {
-	serve(resp, req, &mutators.VMIsMutator{ClusterConfig: clusterConfig, VMIPresetInformer: informers.VMIPresetInformer, KubeVirtServiceAccounts: kubeVirtServiceAccounts})
+	serve(resp, req, &mutators.VMIsMutator{ClusterConfig: clusterConfig, VMIPresetInformer: informers.VMIPresetInformer, NamespaceInformer: informers.NamespaceInformer, KubeVirtServiceAccounts: kubeVirtServiceAccounts})
 }
 
 func ServeMigrationCreate(resp http.ResponseWriter, req *http.Request) {
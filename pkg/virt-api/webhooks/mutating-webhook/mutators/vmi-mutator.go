@@ -26,6 +26,7 @@ import (
 	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
@@ -35,6 +36,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/defaults"
+	"kubevirt.io/kubevirt/pkg/hooks/domainpatch"
 	kvpointer "kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
@@ -45,6 +47,7 @@ import (
 type VMIsMutator struct {
 	ClusterConfig           *virtconfig.ClusterConfig
 	VMIPresetInformer       cache.SharedIndexInformer
+	NamespaceInformer       cache.SharedIndexInformer
 	KubeVirtServiceAccounts map[string]struct{}
 }
 
@@ -123,6 +126,14 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 		// Add foreground finalizer
 		newVMI.Finalizers = append(newVMI.Finalizers, v1.VirtualMachineInstanceFinalizer)
 
+		// Record the cluster domain patches selected by the VMI and namespace
+		// labels. Any user provided value is overwritten, only the cluster
+		// admin decides which patches apply.
+		newVMI.Status.DomainPatches, err = mutator.selectDomainPatches(newVMI, ar.Request.Namespace)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
 		// Set the phase to pending to avoid blank status
 		newVMI.Status.Phase = v1.Pending
 
@@ -177,6 +188,20 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 	return response
 }
 
+func (mutator *VMIsMutator) selectDomainPatches(vmi *v1.VirtualMachineInstance, namespace string) ([]v1.VirtualMachineInstanceDomainPatch, error) {
+	patches := mutator.ClusterConfig.GetDomainPatches()
+	if len(patches) == 0 {
+		return nil, nil
+	}
+	obj, exists, err := mutator.NamespaceInformer.GetStore().GetByKey(namespace)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, fmt.Errorf("namespace %s does not exist", namespace)
+	}
+	return domainpatch.Select(patches, vmi.Labels, obj.(*k8sv1.Namespace).Labels)
+}
+
 func markAsNonroot(vmi *v1.VirtualMachineInstance) {
 	vmi.Status.RuntimeUser = 107
 }
@@ -873,6 +873,80 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		}
 		Expect(ok).To(BeTrue())
 	})
+	Context("with domain patches", func() {
+		var patches []v1.DomainPatch
+
+		BeforeEach(func() {
+			vmi.Namespace = "tenant"
+			namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+			Expect(namespaceInformer.GetStore().Add(&k8sv1.Namespace{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: "tenant", Labels: map[string]string{"tier": "gold"}},
+			})).To(Succeed())
+			mutator.NamespaceInformer = namespaceInformer
+
+			patches = []v1.DomainPatch{
+				{
+					Name:       "all",
+					Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/memballoon"}},
+				},
+				{
+					Name:       "gpu",
+					Selector:   &k8smetav1.LabelSelector{MatchLabels: map[string]string{"workload": "gpu"}},
+					Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationAdd, Path: "/domain/features/kvm/hidden/@state", Value: "on"}},
+				},
+				{
+					Name:              "silver",
+					Selector:          &k8smetav1.LabelSelector{MatchLabels: map[string]string{"workload": "gpu"}},
+					NamespaceSelector: &k8smetav1.LabelSelector{MatchLabels: map[string]string{"tier": "silver"}},
+					Operations:        []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/rng"}},
+				},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DomainPatches: patches,
+					},
+				},
+			})
+		})
+
+		It("should record the patches selected by the VMI and namespace labels", func() {
+			vmi.Labels = map[string]string{"workload": "gpu"}
+			_, _, status := getMetaSpecStatusFromAdmit()
+			Expect(status.DomainPatches).To(Equal([]v1.VirtualMachineInstanceDomainPatch{
+				{Name: "all", Operations: patches[0].Operations},
+				{Name: "gpu", Operations: patches[1].Operations},
+			}))
+		})
+
+		It("should overwrite the patches provided by the user", func() {
+			vmi.Status.DomainPatches = []v1.VirtualMachineInstanceDomainPatch{{
+				Name:       "user",
+				Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/seclabel"}},
+			}}
+			_, _, status := getMetaSpecStatusFromAdmit()
+			Expect(status.DomainPatches).To(Equal([]v1.VirtualMachineInstanceDomainPatch{
+				{Name: "all", Operations: patches[0].Operations},
+			}))
+		})
+
+		It("should reject the VMI when its namespace is unknown", func() {
+			vmi.Namespace = "unknown"
+			resp := admitVMI()
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring("namespace unknown does not exist"))
+		})
+	})
+
+	It("should drop user provided domain patches when none are configured", func() {
+		vmi.Status.DomainPatches = []v1.VirtualMachineInstanceDomainPatch{{
+			Name:       "user",
+			Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/seclabel"}},
+		}}
+		_, _, status := getMetaSpecStatusFromAdmit()
+		Expect(status.DomainPatches).To(BeEmpty())
+	})
+
 	DescribeTable("modify the VMI status", func(user string, shouldChange bool) {
 		oldVMI := &v1.VirtualMachineInstance{}
 		oldVMI.Status = v1.VirtualMachineInstanceStatus{
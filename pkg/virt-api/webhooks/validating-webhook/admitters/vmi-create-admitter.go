@@ -37,9 +37,12 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	draadmitter "kubevirt.io/kubevirt/pkg/dra/admitter"
+	guestservice "kubevirt.io/kubevirt/pkg/guest-service"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/ignition"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
@@ -60,8 +63,12 @@ const (
 	// to 2K to allow scaling of config as edits will cause entire object
 	// to be distributed to large no of nodes. For larger than 2K, user should
 	// use NetworkDataSecretRef and UserDataSecretRef
-	cloudInitUserMaxLen    = 2048
-	cloudInitNetworkMaxLen = 2048
+	cloudInitUserMaxLen     = 2048
+	cloudInitNetworkMaxLen  = 2048
+	cloudInitVendorMaxLen   = 2048
+	cloudInitMetaDataMaxLen = 2048
+	// ignitionConfigMaxLen is limited alike, larger configs should use ConfigSecretRef
+	ignitionConfigMaxLen = 2048
 
 	// Copied from kubernetes/pkg/apis/core/validation/validation.go
 	maxDNSNameservers     = 3
@@ -186,10 +193,12 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateCPURequestNotNegative(field, spec)...)
 	causes = append(causes, validateCPULimitNotNegative(field, spec)...)
 	causes = append(causes, validateCpuRequestDoesNotExceedLimit(field, spec)...)
+	causes = append(causes, validateIOWeight(field, spec)...)
 	causes = append(causes, validateCpuPinning(field, spec, config)...)
 	causes = append(causes, validateNUMA(field, spec, config)...)
 	causes = append(causes, validateCPUIsolatorThread(field, spec)...)
 	causes = append(causes, validateCPUFeaturePolicies(field, spec)...)
+	causes = append(causes, validateCPUModelGroup(field, spec, config)...)
 	causes = append(causes, validateCPUHotplug(field, spec)...)
 	causes = append(causes, validateStartStrategy(field, spec)...)
 	causes = append(causes, validateRealtime(field, spec)...)
@@ -208,6 +217,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateIOThreadsPolicy(field, spec)...)
 	causes = append(causes, validateProbe(field.Child("readinessProbe"), spec.ReadinessProbe)...)
 	causes = append(causes, validateProbe(field.Child("livenessProbe"), spec.LivenessProbe)...)
+	causes = append(causes, validateGuestServiceWatch(field.Child("guestServiceWatch"), spec.GuestServiceWatch, config)...)
 
 	if podNetwork := vmispec.LookupPodNetwork(spec.Networks); podNetwork == nil {
 		causes = appendStatusCauseForProbeNotAllowedWithNoPodNetworkPresent(field.Child("readinessProbe"), spec.ReadinessProbe, causes)
@@ -216,6 +226,9 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 
 	causes = append(causes, validateDomainSpec(field.Child("domain"), &spec.Domain)...)
 	causes = append(causes, validateVolumes(field.Child("volumes"), spec.Volumes, config)...)
+	causes = append(causes, validateCloudInitVendorAndMetaData(field, spec, config)...)
+	causes = append(causes, validateCloudInitUpdatePolicy(field, spec, config)...)
+	causes = append(causes, validateIgnitionVolumes(field, spec, config)...)
 	causes = append(causes, storageadmitters.ValidateContainerDisks(field, spec)...)
 	causes = append(causes, storageadmitters.ValidateUtilityVolumesNotPresentOnCreation(field, spec)...)
 
@@ -282,6 +295,316 @@ func validateDownwardMetrics(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 	return causes
 }
 
+// validateCloudInitVendorAndMetaData validates the cloud-init vendor data, the user defined metadata
+// and the metadata service
+func validateCloudInitVendorAndMetaData(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, volume := range spec.Volumes {
+		var sourceField *k8sfield.Path
+		var vendorDataSecretRef *k8sv1.LocalObjectReference
+		var vendorData, vendorDataBase64 string
+		var metaData *v1.CloudInitMetaData
+		switch {
+		case volume.CloudInitNoCloud != nil:
+			sourceField = field.Child("volumes").Index(idx).Child("cloudInitNoCloud")
+			vendorDataSecretRef = volume.CloudInitNoCloud.VendorDataSecretRef
+			vendorData = volume.CloudInitNoCloud.VendorData
+			vendorDataBase64 = volume.CloudInitNoCloud.VendorDataBase64
+			metaData = volume.CloudInitNoCloud.MetaData
+			causes = append(causes, validateCloudInitMetadataService(sourceField.Child("metadataService"), spec, volume.CloudInitNoCloud, config)...)
+		case volume.CloudInitConfigDrive != nil:
+			sourceField = field.Child("volumes").Index(idx).Child("cloudInitConfigDrive")
+			vendorDataSecretRef = volume.CloudInitConfigDrive.VendorDataSecretRef
+			vendorData = volume.CloudInitConfigDrive.VendorData
+			vendorDataBase64 = volume.CloudInitConfigDrive.VendorDataBase64
+			metaData = volume.CloudInitConfigDrive.MetaData
+		default:
+			continue
+		}
+
+		vendorDataSourceCount := 0
+		vendorDataLen := len(vendorData)
+		if vendorDataSecretRef != nil && vendorDataSecretRef.Name != "" {
+			vendorDataSourceCount++
+		}
+		if vendorData != "" {
+			vendorDataSourceCount++
+		}
+		if vendorDataBase64 != "" {
+			vendorDataSourceCount++
+			decoded, err := base64.StdEncoding.DecodeString(vendorDataBase64)
+			if err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s is not a valid base64 value.", sourceField.Child("vendorDataBase64").String()),
+					Field:   sourceField.Child("vendorDataBase64").String(),
+				})
+			}
+			vendorDataLen = len(decoded)
+		}
+		if vendorDataSourceCount > 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must have only one vendordata source set.", sourceField.String()),
+				Field:   sourceField.String(),
+			})
+		}
+		if vendorDataLen > cloudInitVendorMaxLen {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s vendordata exceeds %d byte limit. Should use VendorDataSecretRef for larger data.", sourceField.String(), cloudInitVendorMaxLen),
+				Field:   sourceField.String(),
+			})
+		}
+
+		if metaData != nil {
+			causes = append(causes, validateCloudInitMetaData(sourceField.Child("metaData"), metaData, volume.CloudInitNoCloud != nil)...)
+		}
+	}
+	return causes
+}
+
+func validateCloudInitMetaData(field *k8sfield.Path, metaData *v1.CloudInitMetaData, isNoCloud bool) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	metaDataLen := 0
+	for key, value := range metaData.Keys {
+		metaDataLen += len(key) + len(value)
+		if key == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not contain an empty key.", field.Child("keys").String()),
+				Field:   field.Child("keys").String(),
+			})
+		} else if isNoCloud && cloudinit.IsReservedNoCloudMetaDataKey(key) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not override the %q metadata key generated by KubeVirt.", field.Child("keys").String(), key),
+				Field:   field.Child("keys").Key(key).String(),
+			})
+		}
+	}
+	if metaDataLen > cloudInitMetaDataMaxLen {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s exceeds %d byte limit.", field.Child("keys").String(), cloudInitMetaDataMaxLen),
+			Field:   field.Child("keys").String(),
+		})
+	}
+	for idx, label := range metaData.Labels {
+		if label == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be empty.", field.Child("labels").Index(idx).String()),
+				Field:   field.Child("labels").Index(idx).String(),
+			})
+		}
+	}
+	for idx, annotation := range metaData.Annotations {
+		if annotation == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be empty.", field.Child("annotations").Index(idx).String()),
+				Field:   field.Child("annotations").Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateCloudInitMetadataService(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, source *v1.CloudInitNoCloudSource, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if source.MetadataService == nil {
+		return nil
+	}
+	if !config.CloudInitMetadataServiceEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is not allowed: %s feature gate is not enabled.", field.String(), featuregate.CloudInitMetadataService),
+			Field:   field.String(),
+		}}
+	}
+
+	// The guest reaches the service through the masquerade gateway of the pod network
+	if podNetwork := vmispec.LookupPodNetwork(spec.Networks); podNetwork != nil {
+		if iface := vmispec.LookupInterfaceByName(spec.Domain.Devices.Interfaces, podNetwork.Name); iface != nil && iface.Masquerade != nil {
+			return nil
+		}
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("%s requires the pod network to use the masquerade binding.", field.String()),
+		Field:   field.String(),
+	}}
+}
+
+// validateCloudInitUpdatePolicy validates the update policy of the cloud-init volume. The Live policy
+// re-inserts the regenerated data into the cloud-init disk, which is only possible with a cdrom.
+func validateCloudInitUpdatePolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	for idx, volume := range spec.Volumes {
+		var policyField *k8sfield.Path
+		var policy *v1.CloudInitUpdatePolicy
+		switch {
+		case volume.CloudInitNoCloud != nil:
+			policyField = field.Child("volumes").Index(idx).Child("cloudInitNoCloud", "updatePolicy")
+			policy = volume.CloudInitNoCloud.UpdatePolicy
+		case volume.CloudInitConfigDrive != nil:
+			policyField = field.Child("volumes").Index(idx).Child("cloudInitConfigDrive", "updatePolicy")
+			policy = volume.CloudInitConfigDrive.UpdatePolicy
+		default:
+			continue
+		}
+		if policy == nil {
+			continue
+		}
+
+		switch *policy {
+		case v1.CloudInitUpdatePolicyNone, v1.CloudInitUpdatePolicyRestart:
+		case v1.CloudInitUpdatePolicyLive:
+			if !config.CloudInitLiveUpdateEnabled() {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s %s is not allowed: %s feature gate is not enabled.", policyField.String(), *policy, featuregate.CloudInitLiveUpdate),
+					Field:   policyField.String(),
+				}}
+			}
+			isCDRom := false
+			for _, disk := range spec.Domain.Devices.Disks {
+				if disk.Name == volume.Name {
+					isCDRom = disk.CDRom != nil
+				}
+			}
+			if !isCDRom {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s %s requires the cloud-init volume to be attached as a cdrom.", policyField.String(), *policy),
+					Field:   policyField.String(),
+				}}
+			}
+		default:
+			return []metav1.StatusCause{{
+				Type: metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is set with an unrecognized option: %s. Supported options are %s, %s and %s.", policyField.String(), *policy,
+					v1.CloudInitUpdatePolicyNone, v1.CloudInitUpdatePolicyRestart, v1.CloudInitUpdatePolicyLive),
+				Field: policyField.String(),
+			}}
+		}
+	}
+	return nil
+}
+
+// validateIgnitionVolumes validates the Ignition volume and its config. The config is passed through
+// fw_cfg, which s390x lacks, unless the volume is attached as a disk.
+func validateIgnitionVolumes(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	ignitionVolumeCount := 0
+	for idx, volume := range spec.Volumes {
+		if volume.Ignition == nil {
+			continue
+		}
+		ignitionVolumeCount++
+		volumeField := field.Child("volumes").Index(idx).Child("ignition")
+
+		if !config.IgnitionEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not allowed: %s feature gate is not enabled.", volumeField.String(), featuregate.IgnitionGate),
+				Field:   volumeField.String(),
+			})
+			continue
+		}
+
+		causes = append(causes, validateIgnitionConfig(volumeField, volume.Ignition)...)
+
+		var disk *v1.Disk
+		for i := range spec.Domain.Devices.Disks {
+			if spec.Domain.Devices.Disks[i].Name == volume.Name {
+				disk = &spec.Domain.Devices.Disks[i]
+			}
+		}
+		if disk != nil && (disk.CDRom != nil || disk.LUN != nil) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s can only be attached as a disk, not as a cdrom or lun.", volumeField.String()),
+				Field:   volumeField.String(),
+			})
+		}
+		if disk == nil && webhooks.IsS390X(spec) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be attached as a disk on s390x, which does not support fw_cfg.", volumeField.String()),
+				Field:   volumeField.String(),
+			})
+		}
+	}
+
+	if ignitionVolumeCount > 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have max one ignition volume set", field.Child("volumes").String()),
+			Field:   field.Child("volumes").String(),
+		})
+	}
+	return causes
+}
+
+func validateIgnitionConfig(field *k8sfield.Path, source *v1.IgnitionSource) []metav1.StatusCause {
+	sourceCount := 0
+	var data []byte
+	if source.ConfigSecretRef != nil {
+		sourceCount++
+		if source.ConfigSecretRef.Name == "" {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf(requiredFieldFmt, field.Child("configSecretRef", "name").String()),
+				Field:   field.Child("configSecretRef", "name").String(),
+			}}
+		}
+	}
+	if source.ConfigBase64 != "" {
+		sourceCount++
+		var err error
+		data, err = base64.StdEncoding.DecodeString(source.ConfigBase64)
+		if err != nil {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid base64 value.", field.Child("configBase64").String()),
+				Field:   field.Child("configBase64").String(),
+			}}
+		}
+	}
+	if source.Config != "" {
+		sourceCount++
+		data = []byte(source.Config)
+	}
+
+	if sourceCount != 1 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have exactly one of configSecretRef, configBase64 or config set.", field.String()),
+			Field:   field.String(),
+		}}
+	}
+	// The config of a secret is only known when the VMI starts
+	if data == nil {
+		return nil
+	}
+
+	if len(data) > ignitionConfigMaxLen {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s config exceeds %d byte limit. Should use configSecretRef for larger data.", field.String(), ignitionConfigMaxLen),
+			Field:   field.String(),
+		}}
+	}
+	if err := ignition.ValidateConfig(data); err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is invalid: %v", field.String(), err),
+			Field:   field.String(),
+		}}
+	}
+	return nil
+}
+
 func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -298,7 +621,8 @@ func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v
 
 	// Validate that volumes match disks and filesystems correctly
 	for idx, volume := range spec.Volumes {
-		if volume.MemoryDump != nil {
+		// Ignition volumes are passed through fw_cfg unless they are attached as a disk
+		if volume.MemoryDump != nil || volume.Ignition != nil {
 			continue
 		}
 
@@ -700,6 +1024,27 @@ func validateCPUFeaturePolicies(field *k8sfield.Path, spec *v1.VirtualMachineIns
 	return causes
 }
 
+func validateCPUModelGroup(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.CPU == nil || spec.Domain.CPU.ModelGroup == "" {
+		return causes
+	}
+	if spec.Domain.CPU.Model != v1.CPUModeClusterBaseline {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("ModelGroup should be only set in combination with the %s CPU model", v1.CPUModeClusterBaseline),
+			Field:   field.Child("domain", "cpu", "modelGroup").String(),
+		})
+	} else if _, exists := config.GetCPUModelGroup(spec.Domain.CPU.ModelGroup); !exists {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: fmt.Sprintf("CPU model group %s is not defined in the KubeVirt configuration", spec.Domain.CPU.ModelGroup),
+			Field:   field.Child("domain", "cpu", "modelGroup").String(),
+		})
+	}
+	return causes
+}
+
 func validateCPUIsolatorThread(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.IsolateEmulatorThread && !spec.Domain.CPU.DedicatedCPUPlacement {
@@ -991,6 +1336,20 @@ func validateCPURequestNotNegative(field *k8sfield.Path, spec *v1.VirtualMachine
 	return causes
 }
 
+func validateIOWeight(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	const minIOWeight, maxIOWeight = 1, 10000
+	if ioWeight := spec.Domain.Resources.IOWeight; ioWeight != nil && (*ioWeight < minIOWeight || *ioWeight > maxIOWeight) {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%d': must be between %d and %d", field.Child("domain", "resources", "ioWeight").String(),
+				*ioWeight, minIOWeight, maxIOWeight),
+			Field: field.Child("domain", "resources", "ioWeight").String(),
+		})
+	}
+	return causes
+}
+
 func validateEmulatedMachine(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if machine := spec.Domain.Machine; machine != nil && len(machine.Type) > 0 {
@@ -1254,6 +1613,14 @@ func ValidateVirtualMachineInstanceMetadata(field *k8sfield.Path, metadata *meta
 				Field:   field.Child("labels").String(),
 			})
 		}
+		// The IP allocations are handed to the VMI by virt-controller, from the VM status.
+		if _, exists := annotations[v1.IPAllocationsAnnotation]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("creation of the reserved %s annotation on a VMI object is prohibited", v1.IPAllocationsAnnotation),
+				Field:   field.Child("annotations", v1.IPAllocationsAnnotation).String(),
+			})
+		}
 	}
 
 	// Validate ignition feature gate if set when the corresponding annotation is found
@@ -1644,6 +2011,9 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 		if volume.CloudInitConfigDrive != nil {
 			volumeSourceSetCount++
 		}
+		if volume.Ignition != nil {
+			volumeSourceSetCount++
+		}
 		if volume.ContainerDisk != nil {
 			volumeSourceSetCount++
 		}
@@ -1807,6 +2177,10 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			}
 		}
 
+		if volume.Sysprep != nil {
+			causes = append(causes, validateSysprepSource(field.Index(idx).Child("sysprep"), volume.Sysprep)...)
+		}
+
 		if volume.DownwardMetrics != nil && !config.DownwardMetricsEnabled() {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
@@ -1907,6 +2281,78 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 	return causes
 }
 
+// maxSysprepComputerNameLength is the length of a NetBIOS name, which Windows limits computer names to
+const maxSysprepComputerNameLength = 15
+
+func validateSysprepSource(field *k8sfield.Path, sysprep *v1.SysprepSource) []metav1.StatusCause {
+	unattend := sysprep.Unattend
+	if unattend == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	if sysprep.Secret != nil || sysprep.ConfigMap != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be set together with a secret or configMap", field.Child("unattend").String()),
+			Field:   field.Child("unattend").String(),
+		})
+	}
+
+	if unattend.ComputerName != "" && !isValidComputerName(unattend.ComputerName) {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have at most %d letters, digits or hyphens, and not only digits",
+				field.Child("unattend", "computerName").String(), maxSysprepComputerNameLength),
+			Field: field.Child("unattend", "computerName").String(),
+		})
+	}
+
+	if unattend.AdminPasswordSecretRef != nil && unattend.AdminPasswordSecretRef.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf(requiredFieldFmt, field.Child("unattend", "adminPasswordSecretRef", "name").String()),
+			Field:   field.Child("unattend", "adminPasswordSecretRef", "name").String(),
+		})
+	}
+
+	if domainJoin := unattend.DomainJoin; domainJoin != nil {
+		if domainJoin.Domain == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf(requiredFieldFmt, field.Child("unattend", "domainJoin", "domain").String()),
+				Field:   field.Child("unattend", "domainJoin", "domain").String(),
+			})
+		}
+		if domainJoin.CredentialsSecretRef == nil || domainJoin.CredentialsSecretRef.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf(requiredFieldFmt, field.Child("unattend", "domainJoin", "credentialsSecretRef", "name").String()),
+				Field:   field.Child("unattend", "domainJoin", "credentialsSecretRef", "name").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func isValidComputerName(name string) bool {
+	if len(name) > maxSysprepComputerNameLength {
+		return false
+	}
+	onlyDigits := true
+	for _, c := range name {
+		switch {
+		case c >= '0' && c <= '9':
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '-':
+			onlyDigits = false
+		default:
+			return false
+		}
+	}
+	return !onlyDigits
+}
+
 // Rejects kernel boot defined with initrd/kernel path but without an image
 func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) []metav1.StatusCause {
 	var causes []metav1.StatusCause
@@ -2123,6 +2569,64 @@ func validatePanicDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+// validateGuestServiceWatch validates the services virt-handler checks through the guest agent.
+// The number of services is capped, since every service adds a series to the guest service metric.
+func validateGuestServiceWatch(field *k8sfield.Path, watch *v1.GuestServiceWatch, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if watch == nil {
+		return nil
+	}
+	if !config.GuestServiceWatchEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is not allowed: %s feature gate is not enabled.", field.String(), featuregate.GuestServiceWatch),
+			Field:   field.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	servicesField := field.Child("services")
+	if len(watch.Services) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must not be empty.", servicesField.String()),
+			Field:   servicesField.String(),
+		})
+	}
+	if len(watch.Services) > guestservice.MaxServices {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not contain more than %d services.", servicesField.String(), guestservice.MaxServices),
+			Field:   servicesField.String(),
+		})
+	}
+	seen := map[string]struct{}{}
+	for idx, service := range watch.Services {
+		if err := guestservice.ValidateServiceName(service); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s: %v.", servicesField.Index(idx).String(), err),
+				Field:   servicesField.Index(idx).String(),
+			})
+		}
+		if _, exists := seen[service]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s: service %s is listed more than once.", servicesField.Index(idx).String(), service),
+				Field:   servicesField.Index(idx).String(),
+			})
+		}
+		seen[service] = struct{}{}
+	}
+	if watch.PeriodSeconds != 0 && watch.PeriodSeconds < guestservice.MinPeriodSeconds {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be at least %d.", field.Child("periodSeconds").String(), guestservice.MinPeriodSeconds),
+			Field:   field.Child("periodSeconds").String(),
+		})
+	}
+	return causes
+}
+
 func validateRebootPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
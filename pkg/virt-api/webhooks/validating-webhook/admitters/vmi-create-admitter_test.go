@@ -21,6 +21,7 @@ package admitters
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"runtime"
@@ -432,6 +433,30 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(resp.Result.Details.Causes[0].Message).To(Equal("creation of the following reserved kubevirt.io/ labels on a VMI object is prohibited"))
 		})
 
+		It("should reject the IP allocations annotation by non kubevirt user", func() {
+			vmi := newBaseVmi(libvmi.WithAnnotation(v1.IPAllocationsAnnotation, "[]"))
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo = authv1.UserInfo{Username: "system:serviceaccount:fake:" + "user-account"}
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("metadata.annotations." + v1.IPAllocationsAnnotation))
+		})
+
+		It("should accept the IP allocations annotation by the controller", func() {
+			vmi := newBaseVmi(libvmi.WithAnnotation(v1.IPAllocationsAnnotation, "[]"))
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo = authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName}
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
 		DescribeTable("should reject annotations which require feature gate enabled", func(annotations map[string]string, expectedMsg string) {
 			vmi := newBaseVmi()
 			vmi.Annotations = annotations
@@ -753,6 +778,22 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.domain.resources.limits.cpu"))
 		})
 
+		DescribeTable("should validate the io weight", func(ioWeight uint32, expectedCauses int) {
+			vm := api.NewMinimalVMI("testvm")
+			vm.Spec.Domain.Resources.IOWeight = pointer.P(ioWeight)
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
+			Expect(causes).To(HaveLen(expectedCauses))
+			if expectedCauses > 0 {
+				Expect(causes[0].Field).To(Equal("fake.domain.resources.ioWeight"))
+			}
+		},
+			Entry("accept the minimum", uint32(1), 0),
+			Entry("accept the maximum", uint32(10000), 0),
+			Entry("reject zero", uint32(0), 1),
+			Entry("reject values above the maximum", uint32(10001), 1),
+		)
+
 		It("should reject greater requests.cpu than limits.cpu", func() {
 			vm := api.NewMinimalVMI("testvm")
 
@@ -2014,6 +2055,35 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with CPU model groups", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.CPUModelGroups = []v1.CPUModelGroup{
+				{Name: "pool-a", NodeSelector: map[string]string{"pool": "a"}},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+		})
+
+		It("should accept a defined group with the cluster-baseline CPU model", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeClusterBaseline, ModelGroup: "pool-a"}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(cpu *v1.CPU, causeType metav1.CauseType) {
+			vmi.Spec.Domain.CPU = cpu
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(ConsistOf(HaveField("Type", causeType)))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.modelGroup"))
+		},
+			Entry("a group with another CPU model", &v1.CPU{Model: v1.CPUModeHostModel, ModelGroup: "pool-a"}, metav1.CauseTypeFieldValueInvalid),
+			Entry("an undefined group", &v1.CPU{Model: v1.CPUModeClusterBaseline, ModelGroup: "pool-b"}, metav1.CauseTypeFieldValueNotFound),
+		)
+	})
+
 	Context("with downwardmetrics virtio serial", func() {
 		var vmi *v1.VirtualMachineInstance
 		validate := func() []metav1.StatusCause {
@@ -2164,6 +2234,48 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("should validate generated sysprep answer files", func(sysprep *v1.SysprepSource, expectedFields ...string) {
+			vmi := api.NewMinimalVMI("fake-vmi")
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name:         "sysprep-volume",
+				VolumeSource: v1.VolumeSource{Sysprep: sysprep},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			Entry("accept a complete unattend", &v1.SysprepSource{Unattend: &v1.SysprepUnattend{
+				ComputerName:           "WIN-2022-01",
+				AdminPasswordSecretRef: &k8sv1.LocalObjectReference{Name: "admin"},
+				DomainJoin: &v1.SysprepDomainJoin{
+					Domain:               "ad.example.com",
+					CredentialsSecretRef: &k8sv1.LocalObjectReference{Name: "domain"},
+				},
+			}}),
+			Entry("reject an unattend together with a configMap", &v1.SysprepSource{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "test-config"},
+				Unattend:  &v1.SysprepUnattend{},
+			}, "fake[0].sysprep.unattend"),
+			Entry("reject a too long computer name", &v1.SysprepSource{Unattend: &v1.SysprepUnattend{
+				ComputerName: "windows-server-2022",
+			}}, "fake[0].sysprep.unattend.computerName"),
+			Entry("reject a computer name with invalid characters", &v1.SysprepSource{Unattend: &v1.SysprepUnattend{
+				ComputerName: "win_2022",
+			}}, "fake[0].sysprep.unattend.computerName"),
+			Entry("reject a computer name of only digits", &v1.SysprepSource{Unattend: &v1.SysprepUnattend{
+				ComputerName: "2022",
+			}}, "fake[0].sysprep.unattend.computerName"),
+			Entry("reject an admin password secret without name", &v1.SysprepSource{Unattend: &v1.SysprepUnattend{
+				AdminPasswordSecretRef: &k8sv1.LocalObjectReference{},
+			}}, "fake[0].sysprep.unattend.adminPasswordSecretRef.name"),
+			Entry("reject a domain join without domain and credentials", &v1.SysprepSource{Unattend: &v1.SysprepUnattend{
+				DomainJoin: &v1.SysprepDomainJoin{},
+			}}, "fake[0].sysprep.unattend.domainJoin.domain", "fake[0].sysprep.unattend.domainJoin.credentialsSecretRef.name"),
+		)
+
 		It("should reject CloudInitNoCloud volume if either userData or networkData is missing", func() {
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
 				Name: "testdisk",
@@ -2224,6 +2336,230 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("should validate the cloud-init vendor data and metadata", func(volumeSource v1.VolumeSource, expectedFields ...string) {
+			spec := &v1.VirtualMachineInstanceSpec{
+				Volumes: []v1.Volume{{Name: "cloudinit", VolumeSource: volumeSource}},
+			}
+			causes := validateCloudInitVendorAndMetaData(k8sfield.NewPath("fake"), spec, config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for idx, field := range expectedFields {
+				Expect(causes[idx].Field).To(Equal(field))
+			}
+		},
+			Entry("accept inline vendor data",
+				v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: " ", VendorData: "#cloud-config"}},
+			),
+			Entry("accept a vendor data secret",
+				v1.VolumeSource{CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UserData: " ", VendorDataSecretRef: &k8sv1.LocalObjectReference{Name: "vendor"}}},
+			),
+			Entry("reject more than one vendor data source",
+				v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: " ", VendorData: " ", VendorDataSecretRef: &k8sv1.LocalObjectReference{Name: "vendor"}}},
+				"fake.volumes[0].cloudInitNoCloud",
+			),
+			Entry("reject invalid base64 vendor data",
+				v1.VolumeSource{CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UserData: " ", VendorDataBase64: "not base64"}},
+				"fake.volumes[0].cloudInitConfigDrive.vendorDataBase64",
+			),
+			Entry("reject too large vendor data",
+				v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: " ", VendorData: strings.Repeat("a", 2049)}},
+				"fake.volumes[0].cloudInitNoCloud",
+			),
+			Entry("accept user defined metadata",
+				v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: " ", MetaData: &v1.CloudInitMetaData{
+					Keys:        map[string]string{"zone": "zone-a"},
+					Labels:      []string{"app"},
+					Annotations: []string{"example.com/owner"},
+				}}},
+			),
+			Entry("reject overriding a generated nocloud metadata key",
+				v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: " ", MetaData: &v1.CloudInitMetaData{
+					Keys: map[string]string{"instance-id": "1234"},
+				}}},
+				"fake.volumes[0].cloudInitNoCloud.metaData.keys[instance-id]",
+			),
+			Entry("accept config drive metadata keys named like the generated ones",
+				v1.VolumeSource{CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UserData: " ", MetaData: &v1.CloudInitMetaData{
+					Keys: map[string]string{"instance-id": "1234"},
+				}}},
+			),
+			Entry("reject empty metadata keys, labels and annotations",
+				v1.VolumeSource{CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UserData: " ", MetaData: &v1.CloudInitMetaData{
+					Keys:        map[string]string{"": "value"},
+					Labels:      []string{"app", ""},
+					Annotations: []string{""},
+				}}},
+				"fake.volumes[0].cloudInitConfigDrive.metaData.keys",
+				"fake.volumes[0].cloudInitConfigDrive.metaData.labels[1]",
+				"fake.volumes[0].cloudInitConfigDrive.metaData.annotations[0]",
+			),
+			Entry("reject too large metadata",
+				v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: " ", MetaData: &v1.CloudInitMetaData{
+					Keys: map[string]string{"key": strings.Repeat("a", 2048)},
+				}}},
+				"fake.volumes[0].cloudInitNoCloud.metaData.keys",
+			),
+		)
+
+		Context("with the cloud-init metadata service", func() {
+			newVMI := func(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+				return libvmi.New(append(opts, libvmi.WithCloudInitNoCloud(
+					libvmici.WithNoCloudUserData(" "),
+					libvmici.WithNoCloudMetadataService(),
+				))...)
+			}
+
+			It("should reject it when the feature gate is disabled", func() {
+				vmi := newVMI(libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()), libvmi.WithNetwork(v1.DefaultPodNetwork()))
+				causes := validateCloudInitVendorAndMetaData(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.volumes[0].cloudInitNoCloud.metadataService"))
+				Expect(causes[0].Message).To(ContainSubstring(featuregate.CloudInitMetadataService))
+			})
+
+			It("should accept it with a masquerade pod network", func() {
+				enableFeatureGates(featuregate.CloudInitMetadataService)
+				vmi := newVMI(libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()), libvmi.WithNetwork(v1.DefaultPodNetwork()))
+				Expect(validateCloudInitVendorAndMetaData(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+			})
+
+			It("should reject it with a bridged pod network", func() {
+				enableFeatureGates(featuregate.CloudInitMetadataService)
+				vmi := newVMI(libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(v1.DefaultPodNetwork().Name)), libvmi.WithNetwork(v1.DefaultPodNetwork()))
+				causes := validateCloudInitVendorAndMetaData(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(ContainSubstring("requires the pod network to use the masquerade binding"))
+			})
+
+			It("should reject it without a pod network", func() {
+				enableFeatureGates(featuregate.CloudInitMetadataService)
+				vmi := newVMI(libvmi.WithAutoAttachPodInterface(false))
+				causes := validateCloudInitVendorAndMetaData(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+			})
+		})
+
+		Context("with an Ignition volume", func() {
+			const ignitionConfig = `{"ignition":{"version":"3.4.0"}}`
+
+			It("should reject the volume when the feature gate is disabled", func() {
+				vmi := libvmi.New(libvmi.WithIgnition("ignition", &v1.IgnitionSource{Config: ignitionConfig}))
+				causes := validateIgnitionVolumes(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ConsistOf(HaveField("Field", "fake.volumes[0].ignition")))
+				Expect(causes[0].Message).To(ContainSubstring(featuregate.IgnitionGate))
+			})
+
+			DescribeTable("should accept", func(vmi *v1.VirtualMachineInstance) {
+				enableFeatureGates(featuregate.IgnitionGate)
+				Expect(validateIgnitionVolumes(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+				Expect(validateVirtualMachineInstanceSpecVolumeDisks(k8sfield.NewPath("fake"), &vmi.Spec)).To(BeEmpty())
+			},
+				Entry("an inline config passed through fw_cfg", libvmi.New(
+					libvmi.WithIgnition("ignition", &v1.IgnitionSource{Config: ignitionConfig}),
+				)),
+				Entry("a base64 config attached as a disk", libvmi.New(
+					libvmi.WithIgnitionDisk("ignition", &v1.IgnitionSource{ConfigBase64: base64.StdEncoding.EncodeToString([]byte(ignitionConfig))}),
+				)),
+				Entry("a secret config attached as a disk on s390x", libvmi.New(
+					libvmi.WithArchitecture("s390x"),
+					libvmi.WithIgnitionDisk("ignition", &v1.IgnitionSource{ConfigSecretRef: &k8sv1.LocalObjectReference{Name: "ignition"}}),
+				)),
+			)
+
+			DescribeTable("should reject", func(vmi *v1.VirtualMachineInstance, expectedField, expectedMessage string) {
+				enableFeatureGates(featuregate.IgnitionGate)
+				causes := validateIgnitionVolumes(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+				Expect(causes[0].Message).To(ContainSubstring(expectedMessage))
+			},
+				Entry("a volume without config", libvmi.New(
+					libvmi.WithIgnition("ignition", &v1.IgnitionSource{}),
+				), "fake.volumes[0].ignition", "must have exactly one of"),
+				Entry("a volume with two configs", libvmi.New(
+					libvmi.WithIgnition("ignition", &v1.IgnitionSource{Config: ignitionConfig, ConfigSecretRef: &k8sv1.LocalObjectReference{Name: "ignition"}}),
+				), "fake.volumes[0].ignition", "must have exactly one of"),
+				Entry("an invalid base64 config", libvmi.New(
+					libvmi.WithIgnition("ignition", &v1.IgnitionSource{ConfigBase64: "not base64"}),
+				), "fake.volumes[0].ignition.configBase64", "not a valid base64 value"),
+				Entry("a config which is not JSON", libvmi.New(
+					libvmi.WithIgnition("ignition", &v1.IgnitionSource{Config: "#cloud-config"}),
+				), "fake.volumes[0].ignition", "not valid JSON"),
+				Entry("a config without version", libvmi.New(
+					libvmi.WithIgnition("ignition", &v1.IgnitionSource{Config: `{"storage":{}}`}),
+				), "fake.volumes[0].ignition", "ignition.version"),
+				Entry("a too large config", libvmi.New(
+					libvmi.WithIgnition("ignition", &v1.IgnitionSource{Config: fmt.Sprintf(`{"ignition":{"version":"3.4.0"},"padding":"%s"}`, strings.Repeat("a", 2048))}),
+				), "fake.volumes[0].ignition", "exceeds 2048 byte limit"),
+				Entry("a volume attached as a cdrom", libvmi.New(
+					libvmi.WithCDRomAndVolume(v1.DiskBusSATA, v1.Volume{
+						Name:         "ignition",
+						VolumeSource: v1.VolumeSource{Ignition: &v1.IgnitionSource{Config: ignitionConfig}},
+					}),
+				), "fake.volumes[0].ignition", "not as a cdrom or lun"),
+				Entry("a volume passed through fw_cfg on s390x", libvmi.New(
+					libvmi.WithArchitecture("s390x"),
+					libvmi.WithIgnition("ignition", &v1.IgnitionSource{Config: ignitionConfig}),
+				), "fake.volumes[0].ignition", "must be attached as a disk on s390x"),
+				Entry("two volumes", libvmi.New(
+					libvmi.WithIgnition("ignition", &v1.IgnitionSource{Config: ignitionConfig}),
+					libvmi.WithIgnition("ignition2", &v1.IgnitionSource{Config: ignitionConfig}),
+				), "fake.volumes", "max one ignition volume"),
+			)
+		})
+
+		Context("with a cloud-init update policy", func() {
+			liveCDRom := libvmi.WithCDRomAndVolume(v1.DiskBusSATA, v1.Volume{
+				Name: "cloudinit",
+				VolumeSource: v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+					UserData:     " ",
+					UpdatePolicy: pointer.P(v1.CloudInitUpdatePolicyLive),
+				}},
+			})
+
+			DescribeTable("should accept", func(vmi *v1.VirtualMachineInstance) {
+				Expect(validateCloudInitUpdatePolicy(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+			},
+				Entry("None", libvmi.New(libvmi.WithCloudInitNoCloud(
+					libvmici.WithNoCloudUserData(" "), libvmici.WithNoCloudUpdatePolicy(v1.CloudInitUpdatePolicyNone),
+				))),
+				Entry("Restart on a disk", libvmi.New(libvmi.WithCloudInitConfigDrive(
+					libvmici.WithConfigDriveUserData(" "), libvmici.WithConfigDriveUpdatePolicy(v1.CloudInitUpdatePolicyRestart),
+				))),
+			)
+
+			It("should reject an unknown policy", func() {
+				vmi := libvmi.New(libvmi.WithCloudInitNoCloud(
+					libvmici.WithNoCloudUserData(" "), libvmici.WithNoCloudUpdatePolicy("Sometimes"),
+				))
+				causes := validateCloudInitUpdatePolicy(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ConsistOf(HaveField("Field", "fake.volumes[0].cloudInitNoCloud.updatePolicy")))
+				Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+			})
+
+			It("should reject Live when the feature gate is disabled", func() {
+				vmi := libvmi.New(liveCDRom)
+				causes := validateCloudInitUpdatePolicy(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(ContainSubstring(featuregate.CloudInitLiveUpdate))
+			})
+
+			It("should accept Live on a cdrom", func() {
+				enableFeatureGates(featuregate.CloudInitLiveUpdate)
+				vmi := libvmi.New(liveCDRom)
+				Expect(validateCloudInitUpdatePolicy(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+			})
+
+			It("should reject Live on a disk", func() {
+				enableFeatureGates(featuregate.CloudInitLiveUpdate)
+				vmi := libvmi.New(libvmi.WithCloudInitNoCloud(
+					libvmici.WithNoCloudUserData(" "), libvmici.WithNoCloudUpdatePolicy(v1.CloudInitUpdatePolicyLive),
+				))
+				causes := validateCloudInitUpdatePolicy(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(ContainSubstring("requires the cloud-init volume to be attached as a cdrom"))
+			})
+		})
+
 		It("should accept a single memoryDump volume without a matching disk", func() {
 			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
 				Name: "testMemoryDump",
@@ -3845,6 +4181,58 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with guest service watch", func() {
+		newVMIWithServiceWatch := func(watch *v1.GuestServiceWatch) *v1.VirtualMachineInstance {
+			vmi := libvmi.New(
+				libvmi.WithArchitecture(runtime.GOARCH),
+				libvmi.WithResourceMemory("128M"),
+			)
+			vmi.Spec.GuestServiceWatch = watch
+			return vmi
+		}
+
+		It("should reject the watch when the feature gate is disabled", func() {
+			disableFeatureGates()
+			vmi := newVMIWithServiceWatch(&v1.GuestServiceWatch{Services: []string{"sshd.service"}})
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("fake.guestServiceWatch is not allowed: %s feature gate is not enabled.", featuregate.GuestServiceWatch),
+				Field:   "fake.guestServiceWatch",
+			}))
+		})
+
+		It("should accept a valid watch", func() {
+			enableFeatureGates(featuregate.GuestServiceWatch)
+			vmi := newVMIWithServiceWatch(&v1.GuestServiceWatch{Services: []string{"sshd.service", "W32Time"}, PeriodSeconds: 120})
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject an invalid watch", func(watch *v1.GuestServiceWatch, expectedField, expectedMessage string) {
+			enableFeatureGates(featuregate.GuestServiceWatch)
+			vmi := newVMIWithServiceWatch(watch)
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("without services", &v1.GuestServiceWatch{},
+				"fake.guestServiceWatch.services", "fake.guestServiceWatch.services must not be empty."),
+			Entry("with too many services", &v1.GuestServiceWatch{Services: []string{"s0", "s1", "s2", "s3", "s4", "s5", "s6", "s7", "s8", "s9", "s10"}},
+				"fake.guestServiceWatch.services", "fake.guestServiceWatch.services must not contain more than 10 services."),
+			Entry("with an invalid service name", &v1.GuestServiceWatch{Services: []string{"sshd", "--all"}},
+				"fake.guestServiceWatch.services[1]", "fake.guestServiceWatch.services[1]: service name must not start with - or /."),
+			Entry("with a duplicated service", &v1.GuestServiceWatch{Services: []string{"sshd", "sshd"}},
+				"fake.guestServiceWatch.services[1]", "fake.guestServiceWatch.services[1]: service sshd is listed more than once."),
+			Entry("with a too short period", &v1.GuestServiceWatch{Services: []string{"sshd"}, PeriodSeconds: 10},
+				"fake.guestServiceWatch.periodSeconds", "fake.guestServiceWatch.periodSeconds must be at least 30."),
+		)
+	})
+
 	Context("with DRA GPUs", func() {
 		It("Should require deviceName without DRA", func() {
 			vmi := libvmi.New(
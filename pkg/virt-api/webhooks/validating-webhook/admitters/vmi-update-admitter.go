@@ -21,11 +21,13 @@ package admitters
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -121,6 +123,19 @@ func (admitter *VMIUpdateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 		if reviewResponse := admitVMILabelsUpdate(newVMI, oldVMI); reviewResponse != nil {
 			return reviewResponse
 		}
+		if reviewResponse := admitVMIIPAllocationsUpdate(newVMI, oldVMI); reviewResponse != nil {
+			return reviewResponse
+		}
+		// The domain patches are selected by virt-api from the cluster config, users must not inject their own.
+		if !equality.Semantic.DeepEqual(newVMI.Status.DomainPatches, oldVMI.Status.DomainPatches) {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: "modification of the VMI domain patches is restricted",
+					Field:   k8sfield.NewPath("status", "domainPatches").String(),
+				},
+			})
+		}
 	}
 
 	return &admissionv1.AdmissionResponse{
@@ -148,6 +163,21 @@ func admitVMILabelsUpdate(
 	return nil
 }
 
+func admitVMIIPAllocationsUpdate(newVMI, oldVMI *v1.VirtualMachineInstance) *admissionv1.AdmissionResponse {
+	oldValue, oldExists := oldVMI.Annotations[v1.IPAllocationsAnnotation]
+	newValue, newExists := newVMI.Annotations[v1.IPAllocationsAnnotation]
+	if oldExists != newExists || oldValue != newValue {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("modification of the reserved %s annotation on a VMI object is prohibited", v1.IPAllocationsAnnotation),
+			},
+		})
+	}
+
+	return nil
+}
+
 func filterKubevirtLabels(labels map[string]string) map[string]string {
 	m := make(map[string]string)
 	if len(labels) == 0 {
@@ -422,6 +422,63 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		),
 	)
 
+	DescribeTable("should admit the IP allocations annotation update only by kubevirt service accounts",
+		func(user string, expected types.GomegaMatcher) {
+			vmi := api.NewMinimalVMI("testvmi")
+			updateVmi := vmi.DeepCopy()
+			updateVmi.Annotations = map[string]string{v1.IPAllocationsAnnotation: "[]"}
+			newVMIBytes, _ := json.Marshal(&updateVmi)
+			oldVMIBytes, _ := json.Marshal(&vmi)
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					UserInfo: authv1.UserInfo{Username: user},
+					Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: newVMIBytes,
+					},
+					OldObject: runtime.RawExtension{
+						Raw: oldVMIBytes,
+					},
+					Operation: admissionv1.Update,
+				},
+			}
+			resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(expected)
+		},
+		Entry("Update by Controller", "system:serviceaccount:kubevirt:"+components.ControllerServiceAccountName, BeTrue()),
+		Entry("Update by non kubevirt user", "system:serviceaccount:someNamespace:someUser", BeFalse()),
+	)
+
+	DescribeTable("should admit the domain patches update only by kubevirt service accounts",
+		func(user string, expected types.GomegaMatcher) {
+			vmi := api.NewMinimalVMI("testvmi")
+			updateVmi := vmi.DeepCopy()
+			updateVmi.Status.DomainPatches = []v1.VirtualMachineInstanceDomainPatch{{
+				Name:       "injected",
+				Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/memballoon"}},
+			}}
+			newVMIBytes, _ := json.Marshal(&updateVmi)
+			oldVMIBytes, _ := json.Marshal(&vmi)
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					UserInfo: authv1.UserInfo{Username: user},
+					Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: newVMIBytes,
+					},
+					OldObject: runtime.RawExtension{
+						Raw: oldVMIBytes,
+					},
+					Operation: admissionv1.Update,
+				},
+			}
+			resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(expected)
+		},
+		Entry("Update by Handler", "system:serviceaccount:kubevirt:"+components.HandlerServiceAccountName, BeTrue()),
+		Entry("Update by non kubevirt user", "system:serviceaccount:someNamespace:someUser", BeFalse()),
+	)
+
 	DescribeTable("Admit or deny based on user", func(user string, expected types.GomegaMatcher) {
 		vmi := api.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.CPU = &v1.CPU{}
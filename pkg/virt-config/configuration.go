@@ -41,6 +41,7 @@ const (
 	NodeDrainTaintDefaultKey = "kubevirt.io/drain"
 	CdiGroupName             = "cdi.kubevirt.io"
 	MonitoringGroupName      = "monitoring.coreos.com"
+	NetworkAttachmentGroup   = "k8s.cni.cncf.io"
 )
 
 type ConfigModifiedFn func()
@@ -136,11 +137,16 @@ func isPrometheusRules(crd *extv1.CustomResourceDefinition) bool {
 	return crd.Spec.Names.Kind == "PrometheusRule" && crd.Spec.Group == MonitoringGroupName
 }
 
+func isNetworkAttachmentDefinitionCrd(crd *extv1.CustomResourceDefinition) bool {
+	return crd.Spec.Names.Kind == "NetworkAttachmentDefinition" && crd.Spec.Group == NetworkAttachmentGroup
+}
+
 func (c *ClusterConfig) crdAddedDeleted(obj interface{}) {
 	go c.GetConfig()
 	crd := obj.(*extv1.CustomResourceDefinition)
 	if !isDataVolumeCrd(crd) && !isDataSourceCrd(crd) &&
-		!isServiceMonitor(crd) && !isPrometheusRules(crd) {
+		!isServiceMonitor(crd) && !isPrometheusRules(crd) &&
+		!isNetworkAttachmentDefinitionCrd(crd) {
 		return
 	}
 
@@ -271,6 +277,14 @@ func defaultClusterConfig(cpuArch string) *v1.KubeVirtConfiguration {
 			MaxHotplugRatio: DefaultMaxHotplugRatio,
 		},
 		VMRolloutStrategy: pointer.P(DefaultVMRolloutStrategy),
+		GuestExec: &v1.GuestExecConfiguration{
+			MaxOutputBytes:        pointer.P(DefaultGuestExecMaxOutputBytes),
+			DefaultTimeoutSeconds: pointer.P(DefaultGuestExecTimeoutSeconds),
+			MaxTimeoutSeconds:     pointer.P(DefaultGuestExecMaxTimeoutSeconds),
+		},
+		GuestFileTransfer: &v1.GuestFileTransferConfiguration{
+			MaxFileSizeBytes: pointer.P(DefaultGuestFileTransferMaxFileSizeBytes),
+		},
 		Hypervisors: []v1.HypervisorConfiguration{
 			{
 				Name: v1.KvmHypervisorName,
@@ -412,6 +426,21 @@ func (c *ClusterConfig) HasDataVolumeAPI() bool {
 	return false
 }
 
+func (c *ClusterConfig) HasNetworkAttachmentDefinitionAPI() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	objects := c.crdStore.List()
+	for _, obj := range objects {
+		if crd, ok := obj.(*extv1.CustomResourceDefinition); ok && crd.DeletionTimestamp == nil {
+			if isNetworkAttachmentDefinitionCrd(crd) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (c *ClusterConfig) HasServiceMonitorAPI() bool {
 	c.lock.Lock()
 	defer c.lock.Unlock()
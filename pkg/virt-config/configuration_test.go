@@ -391,6 +391,21 @@ var _ = Describe("test configuration", func() {
 		Expect(result.BandwidthPerMigration.String()).To(Equal("0"))
 	})
 
+	It("Should return the guest exec defaults for the limits which are not set", func() {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			GuestExec: &v1.GuestExecConfiguration{
+				AllowedCommands:   []string{"/usr/bin/uptime"},
+				MaxTimeoutSeconds: pointer.P(int32(120)),
+			},
+		})
+
+		result := clusterConfig.GetGuestExecConfiguration()
+		Expect(result.AllowedCommands).To(ConsistOf("/usr/bin/uptime"))
+		Expect(*result.MaxTimeoutSeconds).To(BeNumerically("==", 120))
+		Expect(*result.DefaultTimeoutSeconds).To(Equal(virtconfig.DefaultGuestExecTimeoutSeconds))
+		Expect(*result.MaxOutputBytes).To(Equal(virtconfig.DefaultGuestExecMaxOutputBytes))
+	})
+
 	It("Should update the config if a newer version is available", func() {
 		oldValue := uint32(10)
 		clusterConfig, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
@@ -895,6 +910,18 @@ var _ = Describe("test configuration", func() {
 			Expect(cfg.HasDataVolumeAPI()).To(BeFalse())
 		})
 
+		It("returns true for a NetworkAttachmentDefinition CRD", func() {
+			addCustomResourceDefinition(crdInformer, virtconfig.NetworkAttachmentGroup, "NetworkAttachmentDefinition")
+
+			Expect(cfg.HasNetworkAttachmentDefinitionAPI()).To(BeTrue())
+		})
+
+		It("returns false for NetworkAttachmentDefinition when group is wrong even if kind matches", func() {
+			addCustomResourceDefinition(crdInformer, "not.cncf.io", "NetworkAttachmentDefinition")
+
+			Expect(cfg.HasNetworkAttachmentDefinitionAPI()).To(BeFalse())
+		})
+
 		It("returns true for a ServiceMonitor CRD", func() {
 			addCustomResourceDefinition(crdInformer, virtconfig.MonitoringGroupName, "ServiceMonitor")
 
@@ -244,3 +244,27 @@ func (config *ClusterConfig) OptOutRoleAggregationEnabled() bool {
 func (config *ClusterConfig) LiveUpdateNADRefEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.LiveUpdateNADRef)
 }
+
+func (config *ClusterConfig) CloudInitMetadataServiceEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.CloudInitMetadataService)
+}
+
+func (config *ClusterConfig) CloudInitLiveUpdateEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.CloudInitLiveUpdate)
+}
+
+func (config *ClusterConfig) GuestExecEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestExec)
+}
+
+func (config *ClusterConfig) GuestFileTransferEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestFileTransfer)
+}
+
+func (config *ClusterConfig) GuestUserManagementEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestUserManagement)
+}
+
+func (config *ClusterConfig) GuestServiceWatchEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestServiceWatch)
+}
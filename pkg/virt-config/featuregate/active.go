@@ -229,6 +229,48 @@ const (
 	// Owner: SIG network
 	// Beta: v1.8
 	LiveUpdateNADRef = "LiveUpdateNADRef"
+
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	//
+	// CloudInitMetadataService enables serving the cloud-init NoCloud data to the guest over HTTP,
+	// on 169.254.169.254, from virt-launcher.
+	CloudInitMetadataService = "CloudInitMetadataService"
+
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	//
+	// CloudInitLiveUpdate enables the Live cloud-init update policy, which regenerates the cloud-init
+	// data of running VMIs when the referenced secrets change.
+	CloudInitLiveUpdate = "CloudInitLiveUpdate"
+
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	//
+	// GuestExec enables the guestexec subresource, which runs the commands allowed by the cluster
+	// configuration in the guests through the guest agent.
+	GuestExec = "GuestExec"
+
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	//
+	// GuestFileTransfer enables the guestfile subresource, which reads and writes guest files
+	// through the guest agent.
+	GuestFileTransfer = "GuestFileTransfer"
+
+	// Owner: sig-compute
+	// Alpha: v1.8.0
+	//
+	// GuestUserManagement enables the guestuser subresource, which creates, locks and unlocks guest
+	// users and sets their passwords through the guest agent.
+	GuestUserManagement = "GuestUserManagement"
+
+	// Owner: sig-monitoring
+	// Alpha: v1.8.0
+	//
+	// GuestServiceWatch enables virt-handler to check the services listed in spec.guestServiceWatch
+	// of a VMI through the guest agent.
+	GuestServiceWatch = "GuestServiceWatch"
 )
 
 func init() {
@@ -275,4 +317,10 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: ReservedOverheadMemlock, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: OptOutRoleAggregation, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: LiveUpdateNADRef, State: Beta})
+	RegisterFeatureGate(FeatureGate{Name: CloudInitMetadataService, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: CloudInitLiveUpdate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestExec, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestFileTransfer, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestUserManagement, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestServiceWatch, State: Alpha})
 }
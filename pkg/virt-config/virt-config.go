@@ -93,6 +93,12 @@ const (
 
 	DefaultMaxHotplugRatio   = 4
 	DefaultVMRolloutStrategy = v1.VMRolloutStrategyLiveUpdate
+
+	DefaultGuestExecMaxOutputBytes    int64 = 64 * 1024
+	DefaultGuestExecTimeoutSeconds    int32 = 10
+	DefaultGuestExecMaxTimeoutSeconds int32 = 60
+
+	DefaultGuestFileTransferMaxFileSizeBytes int64 = 100 * 1024 * 1024
 )
 
 func IsARM64(arch string) bool {
@@ -166,6 +172,16 @@ func (c *ClusterConfig) GetCPUModel() string {
 	return c.GetConfig().CPUModel
 }
 
+// GetCPUModelGroup returns the CPU model group with the given name, if it is defined
+func (c *ClusterConfig) GetCPUModelGroup(name string) (*v1.CPUModelGroup, bool) {
+	for _, group := range c.GetConfig().CPUModelGroups {
+		if group.Name == name {
+			return group.DeepCopy(), true
+		}
+	}
+	return nil, false
+}
+
 func (c *ClusterConfig) GetCPURequest() *resource.Quantity {
 	return c.GetConfig().CPURequest
 }
@@ -475,6 +491,30 @@ func (c *ClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugi
 	return nil
 }
 
+func (c *ClusterConfig) GetMACPoolConfiguration() *v1.MACPoolConfiguration {
+	networkConfig := c.GetConfig().NetworkConfiguration
+	if networkConfig != nil {
+		return networkConfig.MACPool
+	}
+	return nil
+}
+
+func (c *ClusterConfig) GetDomainPatches() []v1.DomainPatch {
+	return c.GetConfig().DomainPatches
+}
+
+func (c *ClusterConfig) GetGuestExecConfiguration() *v1.GuestExecConfiguration {
+	return c.GetConfig().GuestExec
+}
+
+func (c *ClusterConfig) GetGuestFileTransferMaxFileSizeBytes() int64 {
+	guestFileTransfer := c.GetConfig().GuestFileTransfer
+	if guestFileTransfer == nil || guestFileTransfer.MaxFileSizeBytes == nil {
+		return DefaultGuestFileTransferMaxFileSizeBytes
+	}
+	return *guestFileTransfer.MaxFileSizeBytes
+}
+
 func (config *ClusterConfig) VGADisplayForEFIGuestsEnabled() bool {
 	VGADisplayForEFIGuestsAnnotationExists := false
 	kv := config.GetConfigFromKubeVirtCR()
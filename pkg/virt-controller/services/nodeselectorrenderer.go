@@ -14,6 +14,7 @@ import (
 type NodeSelectorRenderer struct {
 	cpuFeatureLabels       []string
 	cpuModelLabel          string
+	cpuModelGroupSelectors map[string]string
 	machineTypeLabel       string
 	hasDedicatedCPU        bool
 	hyperv                 bool
@@ -62,6 +63,8 @@ func (nsr *NodeSelectorRenderer) Render() map[string]string {
 		nsr.enableSelectorLabel(nsr.cpuModelLabel)
 	}
 
+	maps.Copy(nsr.podNodeSelectors, nsr.cpuModelGroupSelectors)
+
 	if nsr.machineTypeLabel != "" {
 		nsr.enableSelectorLabel(nsr.machineTypeLabel)
 	}
@@ -157,6 +160,12 @@ func WithModelAndFeatureLabels(modelLabel string, cpuFeatureLabels ...string) No
 	}
 }
 
+func WithCPUModelGroup(group *v1.CPUModelGroup) NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.cpuModelGroupSelectors = group.NodeSelector
+	}
+}
+
 func WithMachineType(machineType string) NodeSelectorRendererOption {
 	return func(renderer *NodeSelectorRenderer) {
 		machineTypeLabelKey := v1.SupportedMachineTypeLabel + machineType
@@ -195,6 +204,14 @@ func CPUFeatureLabelsFromCPUFeatures(vmi *v1.VirtualMachineInstance) []string {
 	return labels
 }
 
+func CPUFeatureLabelsFromCPUBaseline(baseline *v1.CPUBaseline) []string {
+	var labels []string
+	for _, feature := range baseline.Features {
+		labels = append(labels, v1.CPUFeatureLabel+feature)
+	}
+	return labels
+}
+
 func hypervNodeSelectors(vmiFeatures *v1.Features) map[string]string {
 	nodeSelectors := make(map[string]string)
 	if vmiFeatures == nil || vmiFeatures.Hyperv == nil {
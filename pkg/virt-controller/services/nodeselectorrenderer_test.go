@@ -92,6 +92,24 @@ var _ = Describe("Node Selector Renderer", func() {
 				})
 			})
 
+			When("a CPU model group is defined", func() {
+				BeforeEach(func() {
+					nsr = NewNodeSelectorRenderer(
+						emptySelectors(),
+						emptySelectors(),
+						"",
+						WithCPUModelGroup(&v1.CPUModelGroup{Name: "pool-a", NodeSelector: map[string]string{"pool": "a"}}))
+				})
+
+				It("requires the node to be part of the group", func() {
+					Expect(nsr.Render()).To(
+						Equal(map[string]string{
+							"kubevirt.io/schedulable": "true",
+							"pool":                    "a",
+						}))
+				})
+			})
+
 			When("architecture set on VMI", func() {
 
 				BeforeEach(func() {
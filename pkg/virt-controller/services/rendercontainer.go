@@ -219,17 +219,25 @@ func securityContext(userId int64, requiredCapabilities *k8sv1.Capabilities) *k8
 	return context
 }
 
+// containerPortsFromVMI lists the ports forwarded to the VMI as ports of the compute container. Port ranges
+// are expanded, the name of a range is given to its first port. ICMP has no port and is not listed.
 func containerPortsFromVMI(vmi *v1.VirtualMachineInstance) []k8sv1.ContainerPort {
 	var ports []k8sv1.ContainerPort
 
 	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
 		if iface.Ports != nil {
 			for _, port := range iface.Ports {
+				if port.Protocol == "ICMP" || port.Port == 0 {
+					continue
+				}
 				if port.Protocol == "" {
 					port.Protocol = "TCP"
 				}
 
 				ports = append(ports, k8sv1.ContainerPort{Protocol: k8sv1.Protocol(port.Protocol), Name: port.Name, ContainerPort: port.Port})
+				for rangePort := port.Port + 1; rangePort <= port.EndPort; rangePort++ {
+					ports = append(ports, k8sv1.ContainerPort{Protocol: k8sv1.Protocol(port.Protocol), ContainerPort: rangePort})
+				}
 			}
 		}
 	}
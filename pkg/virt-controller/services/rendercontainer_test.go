@@ -181,6 +181,24 @@ var _ = Describe("Container spec renderer", func() {
 		})
 	})
 
+	Context("vmi with ICMP and port ranges allowed in its spec", func() {
+		It("the container should feature every port of the ranges and no ICMP port", func() {
+			const ifaceName = "not-relevant"
+			specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithPorts(
+				vmiWithInterfaceWithPortAllowList(ifaceName,
+					v1.Port{Protocol: "ICMP"},
+					v1.Port{Name: "rtp", Protocol: "UDP", Port: 5000, EndPort: 5002},
+					v1.Port{Port: 22, EndPort: 22},
+				)))
+			Expect(specRenderer.Render(exampleCommand).Ports).To(Equal([]k8sv1.ContainerPort{
+				{Name: "rtp", Protocol: k8sv1.ProtocolUDP, ContainerPort: 5000},
+				{Protocol: k8sv1.ProtocolUDP, ContainerPort: 5001},
+				{Protocol: k8sv1.ProtocolUDP, ContainerPort: 5002},
+				{Protocol: k8sv1.ProtocolTCP, ContainerPort: 22},
+			}))
+		})
+	})
+
 	Context("container command and arguments", func() {
 		DescribeTable("", func(args ...string) {
 			specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithArgs(args))
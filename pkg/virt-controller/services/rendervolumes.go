@@ -15,11 +15,14 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
 	"kubevirt.io/kubevirt/pkg/config"
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	"kubevirt.io/kubevirt/pkg/ignition"
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -188,6 +191,10 @@ func withVMIVolumes(pvcStore cache.Store, vmiSpecVolumes []v1.Volume, vmiVolumeS
 			if volume.CloudInitConfigDrive != nil {
 				renderer.handleCloudInitConfigDrive(volume)
 			}
+
+			if volume.Ignition != nil {
+				renderer.handleIgnition(volume)
+			}
 		}
 		return nil
 	}
@@ -261,7 +268,7 @@ func (vr *VolumeRenderer) handleCloudInitConfigDrive(volume v1.Volume) {
 	if volume.CloudInitConfigDrive != nil {
 		if volume.CloudInitConfigDrive.UserDataSecretRef != nil {
 			// attach a secret referenced by the user
-			volumeName := volume.Name + "-udata"
+			volumeName := volume.Name + cloudinit.UserDataSecretVolumeSuffix
 			vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
 				Name: volumeName,
 				VolumeSource: k8sv1.VolumeSource{
@@ -270,22 +277,11 @@ func (vr *VolumeRenderer) handleCloudInitConfigDrive(volume v1.Volume) {
 					},
 				},
 			})
-			vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
-				Name:      volumeName,
-				MountPath: filepath.Join(config.SecretSourceDir, volume.Name, "userdata"),
-				SubPath:   "userdata",
-				ReadOnly:  true,
-			})
-			vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
-				Name:      volumeName,
-				MountPath: filepath.Join(config.SecretSourceDir, volume.Name, "userData"),
-				SubPath:   "userData",
-				ReadOnly:  true,
-			})
+			vr.podVolumeMounts = append(vr.podVolumeMounts, cloudInitSecretVolumeMounts(volume, volumeName, "userdata", "userData")...)
 		}
 		if volume.CloudInitConfigDrive.NetworkDataSecretRef != nil {
 			// attach a secret referenced by the networkdata
-			volumeName := volume.Name + "-ndata"
+			volumeName := volume.Name + cloudinit.NetworkDataSecretVolumeSuffix
 			vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
 				Name: volumeName,
 				VolumeSource: k8sv1.VolumeSource{
@@ -294,23 +290,79 @@ func (vr *VolumeRenderer) handleCloudInitConfigDrive(volume v1.Volume) {
 					},
 				},
 			})
-			vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
-				Name:      volumeName,
-				MountPath: filepath.Join(config.SecretSourceDir, volume.Name, "networkdata"),
-				SubPath:   "networkdata",
-				ReadOnly:  true,
-			})
-			vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
-				Name:      volumeName,
-				MountPath: filepath.Join(config.SecretSourceDir, volume.Name, "networkData"),
-				SubPath:   "networkData",
-				ReadOnly:  true,
+			vr.podVolumeMounts = append(vr.podVolumeMounts, cloudInitSecretVolumeMounts(volume, volumeName, "networkdata", "networkData")...)
+		}
+		if volume.CloudInitConfigDrive.VendorDataSecretRef != nil {
+			// attach a secret referenced by the vendordata
+			volumeName := volume.Name + cloudinit.VendorDataSecretVolumeSuffix
+			vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+				Name: volumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					Secret: &k8sv1.SecretVolumeSource{
+						SecretName: volume.CloudInitConfigDrive.VendorDataSecretRef.Name,
+					},
+				},
 			})
+			vr.podVolumeMounts = append(vr.podVolumeMounts, cloudInitSecretVolumeMounts(volume, volumeName, "vendordata", "vendorData")...)
 		}
 	}
 }
 
+// cloudInitSecretVolumeMounts mounts the given files of a cloud-init secret in the directory of the cloud-init volume.
+// With the Live update policy the whole secret is mounted instead, as the kubelet does not refresh subPath mounts.
+func cloudInitSecretVolumeMounts(volume v1.Volume, secretVolumeName string, files ...string) []k8sv1.VolumeMount {
+	if cloudinit.GetVolumeUpdatePolicy(&volume) == v1.CloudInitUpdatePolicyLive {
+		return []k8sv1.VolumeMount{{
+			Name:      secretVolumeName,
+			MountPath: filepath.Join(config.SecretSourceDir, secretVolumeName),
+			ReadOnly:  true,
+		}}
+	}
+
+	var volumeMounts []k8sv1.VolumeMount
+	for _, file := range files {
+		volumeMounts = append(volumeMounts, k8sv1.VolumeMount{
+			Name:      secretVolumeName,
+			MountPath: filepath.Join(config.SecretSourceDir, volume.Name, file),
+			SubPath:   file,
+			ReadOnly:  true,
+		})
+	}
+	return volumeMounts
+}
+
+func (vr *VolumeRenderer) handleIgnition(volume v1.Volume) {
+	if volume.Ignition.ConfigSecretRef == nil {
+		return
+	}
+	volumeName := volume.Name + ignition.SecretVolumeSuffix
+	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+		Name: volumeName,
+		VolumeSource: k8sv1.VolumeSource{
+			Secret: &k8sv1.SecretVolumeSource{
+				SecretName: volume.Ignition.ConfigSecretRef.Name,
+			},
+		},
+	})
+	vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
+		Name:      volumeName,
+		MountPath: ignition.GetSecretSourcePath(volume.Name),
+		ReadOnly:  true,
+	})
+}
+
 func (vr *VolumeRenderer) handleSysprep(volume v1.Volume) error {
+	if volume.Sysprep != nil && volume.Sysprep.Unattend != nil {
+		// the answer file is generated by virt-launcher, only the secrets it reads are attached
+		unattend := volume.Sysprep.Unattend
+		if unattend.AdminPasswordSecretRef != nil {
+			vr.addSysprepSecretVolume(volume.Name+config.SysprepAdminPasswordVolumeSuffix, unattend.AdminPasswordSecretRef.Name)
+		}
+		if unattend.DomainJoin != nil && unattend.DomainJoin.CredentialsSecretRef != nil {
+			vr.addSysprepSecretVolume(volume.Name+config.SysprepDomainJoinVolumeSuffix, unattend.DomainJoin.CredentialsSecretRef.Name)
+		}
+		return nil
+	}
 	if volume.Sysprep != nil {
 		var volumeSource k8sv1.VolumeSource
 		// attach a Secret or ConfigMap referenced by the user
@@ -331,6 +383,22 @@ func (vr *VolumeRenderer) handleSysprep(volume v1.Volume) error {
 	return nil
 }
 
+func (vr *VolumeRenderer) addSysprepSecretVolume(volumeName, secretName string) {
+	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+		Name: volumeName,
+		VolumeSource: k8sv1.VolumeSource{
+			Secret: &k8sv1.SecretVolumeSource{
+				SecretName: secretName,
+			},
+		},
+	})
+	vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
+		Name:      volumeName,
+		MountPath: config.GetSysprepSourcePath(volumeName),
+		ReadOnly:  true,
+	})
+}
+
 func hotplugVolumes(vmiVolumeStatus []v1.VolumeStatus, vmiSpecVolumes []v1.Volume) map[string]struct{} {
 	hotplugVolumeSet := map[string]struct{}{}
 	for _, volumeStatus := range vmiVolumeStatus {
@@ -525,6 +593,14 @@ func withHotplugSupport(hotplugDiskDir string) VolumeRendererOption {
 	}
 }
 
+func withVhostUserSockets() VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(vhostuser.SocketsVolumeName, vhostuser.SocketsDir))
+		renderer.podVolumes = append(renderer.podVolumes, emptyDirVolume(vhostuser.SocketsVolumeName))
+		return nil
+	}
+}
+
 func withNetworkDeviceInfoMapAnnotation() VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		renderer.podVolumes = append(renderer.podVolumes,
@@ -780,7 +856,7 @@ func (vr *VolumeRenderer) addLauncherBinaryVolume() {
 func (vr *VolumeRenderer) handleCloudInitNoCloud(volume v1.Volume) {
 	if volume.CloudInitNoCloud.UserDataSecretRef != nil {
 		// attach a secret referenced by the user
-		volumeName := volume.Name + "-udata"
+		volumeName := volume.Name + cloudinit.UserDataSecretVolumeSuffix
 		vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
 			Name: volumeName,
 			VolumeSource: k8sv1.VolumeSource{
@@ -789,22 +865,11 @@ func (vr *VolumeRenderer) handleCloudInitNoCloud(volume v1.Volume) {
 				},
 			},
 		})
-		vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
-			Name:      volumeName,
-			MountPath: filepath.Join(config.SecretSourceDir, volume.Name, "userdata"),
-			SubPath:   "userdata",
-			ReadOnly:  true,
-		})
-		vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
-			Name:      volumeName,
-			MountPath: filepath.Join(config.SecretSourceDir, volume.Name, "userData"),
-			SubPath:   "userData",
-			ReadOnly:  true,
-		})
+		vr.podVolumeMounts = append(vr.podVolumeMounts, cloudInitSecretVolumeMounts(volume, volumeName, "userdata", "userData")...)
 	}
 	if volume.CloudInitNoCloud.NetworkDataSecretRef != nil {
 		// attach a secret referenced by the networkdata
-		volumeName := volume.Name + "-ndata"
+		volumeName := volume.Name + cloudinit.NetworkDataSecretVolumeSuffix
 		vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
 			Name: volumeName,
 			VolumeSource: k8sv1.VolumeSource{
@@ -813,18 +878,20 @@ func (vr *VolumeRenderer) handleCloudInitNoCloud(volume v1.Volume) {
 				},
 			},
 		})
-		vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
-			Name:      volumeName,
-			MountPath: filepath.Join(config.SecretSourceDir, volume.Name, "networkdata"),
-			SubPath:   "networkdata",
-			ReadOnly:  true,
-		})
-		vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
-			Name:      volumeName,
-			MountPath: filepath.Join(config.SecretSourceDir, volume.Name, "networkData"),
-			SubPath:   "networkData",
-			ReadOnly:  true,
+		vr.podVolumeMounts = append(vr.podVolumeMounts, cloudInitSecretVolumeMounts(volume, volumeName, "networkdata", "networkData")...)
+	}
+	if volume.CloudInitNoCloud.VendorDataSecretRef != nil {
+		// attach a secret referenced by the vendordata
+		volumeName := volume.Name + cloudinit.VendorDataSecretVolumeSuffix
+		vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+			Name: volumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{
+					SecretName: volume.CloudInitNoCloud.VendorDataSecretRef.Name,
+				},
+			},
 		})
+		vr.podVolumeMounts = append(vr.podVolumeMounts, cloudInitSecretVolumeMounts(volume, volumeName, "vendordata", "vendorData")...)
 	}
 }
 
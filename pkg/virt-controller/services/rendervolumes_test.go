@@ -14,6 +14,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -168,6 +169,7 @@ var _ = Describe("Container spec renderer", func() {
 			cloudInitDriveName = "pepitos-drive"
 			userData           = "break-dancing-flamingo"
 			networkData        = "hoooonk.hooooonk"
+			vendorData         = "tap-tap-tap"
 		)
 
 		BeforeEach(func() {
@@ -177,6 +179,7 @@ var _ = Describe("Container spec renderer", func() {
 					CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{
 						UserDataSecretRef:    &k8sv1.LocalObjectReference{Name: userData},
 						NetworkDataSecretRef: &k8sv1.LocalObjectReference{Name: networkData},
+						VendorDataSecretRef:  &k8sv1.LocalObjectReference{Name: vendorData},
 					},
 				},
 			}
@@ -216,6 +219,16 @@ var _ = Describe("Container spec renderer", func() {
 						ReadOnly:  true,
 						MountPath: "/var/run/kubevirt-private/secret/pepitos-drive/networkData",
 						SubPath:   "networkData",
+					}, k8sv1.VolumeMount{
+						Name:      "pepitos-drive-vdata",
+						ReadOnly:  true,
+						MountPath: "/var/run/kubevirt-private/secret/pepitos-drive/vendordata",
+						SubPath:   "vendordata",
+					}, k8sv1.VolumeMount{
+						Name:      "pepitos-drive-vdata",
+						ReadOnly:  true,
+						MountPath: "/var/run/kubevirt-private/secret/pepitos-drive/vendorData",
+						SubPath:   "vendorData",
 					})))
 		})
 
@@ -235,6 +248,12 @@ var _ = Describe("Container spec renderer", func() {
 							Secret: &k8sv1.SecretVolumeSource{
 								SecretName: "hoooonk.hooooonk",
 							},
+						}}, k8sv1.Volume{
+						Name: "pepitos-drive-vdata",
+						VolumeSource: k8sv1.VolumeSource{
+							Secret: &k8sv1.SecretVolumeSource{
+								SecretName: "tap-tap-tap",
+							},
 						}})))
 		})
 
@@ -243,6 +262,34 @@ var _ = Describe("Container spec renderer", func() {
 		})
 	})
 
+	It("should mount the whole cloud-init secrets with the Live update policy", func() {
+		cloudInitNoCloud := v1.Volume{
+			Name: "cloudinit",
+			VolumeSource: v1.VolumeSource{
+				CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+					UserDataSecretRef:   &k8sv1.LocalObjectReference{Name: "userdata-secret"},
+					VendorDataSecretRef: &k8sv1.LocalObjectReference{Name: "vendordata-secret"},
+					UpdatePolicy:        pointer.P(v1.CloudInitUpdatePolicyLive),
+				},
+			},
+		}
+
+		vsr, err := NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(nil, []v1.Volume{cloudInitNoCloud}, nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vsr.Mounts()).To(ConsistOf(
+			append(
+				defaultVolumeMounts(),
+				k8sv1.VolumeMount{
+					Name:      "cloudinit-udata",
+					ReadOnly:  true,
+					MountPath: "/var/run/kubevirt-private/secret/cloudinit-udata",
+				}, k8sv1.VolumeMount{
+					Name:      "cloudinit-vdata",
+					ReadOnly:  true,
+					MountPath: "/var/run/kubevirt-private/secret/cloudinit-vdata",
+				})))
+	})
+
 	Context("with DataVolume option", func() {
 		const (
 			dataVolumeName = "dv1"
@@ -149,6 +149,7 @@ type TemplateService struct {
 	annotationsGenerators         []annotationsGenerator
 	netTargetAnnotationsGenerator targetAnnotationsGenerator
 	launcherHypervisorResources   hypervisor.LauncherHypervisorResources
+	numaTopologyStore             cache.Store
 }
 
 func isFeatureStateEnabled(fs *v1.FeatureState) bool {
@@ -178,12 +179,88 @@ func setNodeAffinityForbiddenFeaturePolicy(vmi *v1.VirtualMachineInstance, pod *
 	}
 }
 
-func modifyNodeAffintyToRejectLabel(origAffinity *k8sv1.Affinity, labelToReject string) *k8sv1.Affinity {
+// setNodeAffinityForNUMAPassthrough restricts VMIs with guest mapping passthrough to the nodes where the NUMA
+// cells have enough free huge pages for the guest cells, according to the NUMA topology the node labeller
+// publishes for each node. Nodes which do not publish a NUMA topology remain eligible.
+func (t *TemplateService) setNodeAffinityForNUMAPassthrough(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) {
+	if t.numaTopologyStore == nil {
+		return
+	}
+	pageSize, pagesPerCell := topology.NUMAHugepagesPerGuestCell(vmi)
+	if len(pagesPerCell) == 0 {
+		return
+	}
+
+	var fittingNodes []string
+	for _, obj := range t.numaTopologyStore.List() {
+		configMap := obj.(*k8sv1.ConfigMap)
+		numaTopology, err := topology.NUMATopologyFromConfigMap(configMap)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warning("Ignoring the NUMA topology of a node")
+			continue
+		}
+		if numaTopology.FitsGuestCells(pageSize, pagesPerCell) {
+			fittingNodes = append(fittingNodes, configMap.Labels[v1.NUMATopologyNodeLabel])
+		}
+	}
+	sort.Strings(fittingNodes)
+
+	terms := []k8sv1.NodeSelectorTerm{{
+		MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+			Key:      topology.NUMACellsLabel,
+			Operator: k8sv1.NodeSelectorOpDoesNotExist,
+		}},
+	}}
+	if len(fittingNodes) > 0 {
+		terms = append(terms, k8sv1.NodeSelectorTerm{
+			MatchFields: []k8sv1.NodeSelectorRequirement{{
+				Key:      "metadata.name",
+				Operator: k8sv1.NodeSelectorOpIn,
+				Values:   fittingNodes,
+			}},
+		})
+	}
+	pod.Spec.Affinity = modifyNodeAffinityWithAnyOfTerms(pod.Spec.Affinity, terms)
+}
+
+// modifyNodeAffinityWithAnyOfTerms requires a node to match one of the given terms in addition to the
+// existing required terms. Since NodeSelectorTerms are ORed, each existing term is combined with each given term.
+func modifyNodeAffinityWithAnyOfTerms(origAffinity *k8sv1.Affinity, terms []k8sv1.NodeSelectorTerm) *k8sv1.Affinity {
 	affinity := origAffinity.DeepCopy()
-	requirement := k8sv1.NodeSelectorRequirement{
+	if affinity == nil {
+		affinity = &k8sv1.Affinity{}
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &k8sv1.NodeAffinity{}
+	}
+	required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if required == nil || len(required.NodeSelectorTerms) == 0 {
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &k8sv1.NodeSelector{NodeSelectorTerms: terms}
+		return affinity
+	}
+
+	var combinedTerms []k8sv1.NodeSelectorTerm
+	for _, existingTerm := range required.NodeSelectorTerms {
+		for _, term := range terms {
+			combinedTerm := existingTerm.DeepCopy()
+			combinedTerm.MatchExpressions = append(combinedTerm.MatchExpressions, term.MatchExpressions...)
+			combinedTerm.MatchFields = append(combinedTerm.MatchFields, term.MatchFields...)
+			combinedTerms = append(combinedTerms, *combinedTerm)
+		}
+	}
+	required.NodeSelectorTerms = combinedTerms
+	return affinity
+}
+
+func modifyNodeAffintyToRejectLabel(origAffinity *k8sv1.Affinity, labelToReject string) *k8sv1.Affinity {
+	return modifyNodeAffinityWithRequirement(origAffinity, k8sv1.NodeSelectorRequirement{
 		Key:      labelToReject,
 		Operator: k8sv1.NodeSelectorOpDoesNotExist,
-	}
+	})
+}
+
+func modifyNodeAffinityWithRequirement(origAffinity *k8sv1.Affinity, requirement k8sv1.NodeSelectorRequirement) *k8sv1.Affinity {
+	affinity := origAffinity.DeepCopy()
 	term := k8sv1.NodeSelectorTerm{
 		MatchExpressions: []k8sv1.NodeSelectorRequirement{requirement}}
 
@@ -195,7 +272,7 @@ func modifyNodeAffintyToRejectLabel(origAffinity *k8sv1.Affinity, labelToReject
 	if affinity != nil && affinity.NodeAffinity != nil {
 		if affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
 			terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
-			// Since NodeSelectorTerms are ORed , the anti affinity requirement will be added to each term.
+			// Since NodeSelectorTerms are ORed , the requirement will be added to each term.
 			for i, selectorTerm := range terms {
 				affinity.NodeAffinity.
 					RequiredDuringSchedulingIgnoredDuringExecution.
@@ -684,6 +761,7 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	}
 
 	setNodeAffinityForPod(vmi, &pod)
+	t.setNodeAffinityForNUMAPassthrough(vmi, &pod)
 
 	serviceAccountName := serviceAccount(vmi.Spec.Volumes...)
 	if len(serviceAccountName) > 0 {
@@ -712,7 +790,16 @@ func (t *TemplateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 		opts = append(opts, WithHyperv(vmi.Spec.Domain.Features))
 	}
 
-	if modelLabel, err := CPUModelLabelFromCPUModel(vmi); err == nil {
+	if topology.AreCPUBaselineTopologyHintsDefined(vmi) {
+		baseline := vmi.Status.TopologyHints.CPUBaseline
+		opts = append(
+			opts,
+			WithModelAndFeatureLabels(cpuModelLabel(baseline.Model), append(CPUFeatureLabelsFromCPUBaseline(baseline), CPUFeatureLabelsFromCPUFeatures(vmi)...)...),
+		)
+		if group, exists := t.clusterConfig.GetCPUModelGroup(baseline.Group); exists {
+			opts = append(opts, WithCPUModelGroup(group))
+		}
+	} else if modelLabel, err := CPUModelLabelFromCPUModel(vmi); err == nil && !topology.IsClusterBaselineCPUModel(vmi) {
 		opts = append(
 			opts,
 			WithModelAndFeatureLabels(modelLabel, CPUFeatureLabelsFromCPUFeatures(vmi)...),
@@ -890,6 +977,10 @@ func (t *TemplateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, imag
 		volumeOpts = append(volumeOpts, withNetworkDeviceInfoMapAnnotation())
 	}
 
+	if vmispec.BindingPluginNetworkWithVhostUserExist(vmi.Spec.Domain.Devices.Interfaces, t.clusterConfig.GetNetworkBindings()) {
+		volumeOpts = append(volumeOpts, withVhostUserSockets())
+	}
+
 	if util.IsVMIVirtiofsEnabled(vmi) {
 		volumeOpts = append(volumeOpts, withVirioFS())
 	}
@@ -1638,6 +1729,12 @@ func WithNetMemoryCalculator(netMemoryCalculator netMemoryCalculator) templateSe
 	}
 }
 
+func WithNUMATopologyStore(numaTopologyStore cache.Store) templateServiceOption {
+	return func(service *TemplateService) {
+		service.numaTopologyStore = numaTopologyStore
+	}
+}
+
 func WithAnnotationsGenerators(generators ...annotationsGenerator) templateServiceOption {
 	return func(service *TemplateService) {
 		service.annotationsGenerators = append(service.annotationsGenerators, generators...)
@@ -1178,6 +1178,35 @@ var _ = Describe("Template", func() {
 				}
 			})
 
+			It("should add node selectors for the baseline of the cluster-baseline CPU model", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.CPUModelGroups = []v1.CPUModelGroup{
+					{Name: "pool-a", NodeSelector: map[string]string{"pool": "a"}},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi := libvmi.New(libvmi.WithCPUModel(v1.CPUModeClusterBaseline), libvmi.WithCPUFeature("vmx", "require"))
+				vmi.Namespace = "default"
+				vmi.UID = "1234"
+				vmi.Spec.Domain.CPU.ModelGroup = "pool-a"
+				vmi.Status.TopologyHints = &v1.TopologyHints{CPUBaseline: &v1.CPUBaseline{
+					Group:    "pool-a",
+					Model:    "Skylake-Server",
+					Features: []string{"aes", "avx2"},
+				}}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue("pool", "a"))
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.CPUModelLabel+"Skylake-Server", "true"))
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.CPUFeatureLabel+"aes", "true"))
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.CPUFeatureLabel+"avx2", "true"))
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.CPUFeatureLabel+"vmx", "true"))
+				Expect(pod.Spec.NodeSelector).ToNot(HaveKey(v1.CPUModelLabel + v1.CPUModeClusterBaseline))
+			})
+
 			DescribeTable("should add node selector for machine type", func(specMachineType, statusMachineType, expectedMachineType string) {
 				config, kvStore, svc = configFactory(defaultArch)
 				vmi := v1.VirtualMachineInstance{
@@ -2013,6 +2042,50 @@ var _ = Describe("Template", func() {
 				Entry("empty string should be treated as host-model", ""),
 				Entry("nil should be treated as host-model", nil),
 			)
+			It("should require nodes with enough free huge pages per NUMA cell for guest mapping passthrough", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				numaTopologyStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+				for node, topologyJSON := range map[string]string{
+					"node01": `{"cells":[{"id":0,"hugepages":[{"pageSize":"2Mi","total":1024,"free":600}]}]}`,
+					"node02": `{"cells":[{"id":0,"hugepages":[{"pageSize":"2Mi","total":512,"free":256}]},{"id":1,"hugepages":[{"pageSize":"2Mi","total":512,"free":256}]}]}`,
+					"node03": `{"cells":[{"id":0,"hugepages":[{"pageSize":"2Mi","total":512,"free":100}]},{"id":1,"hugepages":[{"pageSize":"1Gi","total":4,"free":4}]}]}`,
+				} {
+					Expect(numaTopologyStore.Add(&k8sv1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      topology.NUMATopologyConfigMapName(node),
+							Namespace: "kubevirt",
+							Labels:    map[string]string{v1.NUMATopologyNodeLabel: node},
+						},
+						Data: map[string]string{topology.NUMATopologyConfigMapKey: topologyJSON},
+					})).To(Succeed())
+				}
+				WithNUMATopologyStore(numaTopologyStore)(svc)
+				vmi := libvmi.New(
+					libvmi.WithNUMAGuestMappingPassthrough(),
+					libvmi.WithHugepages("2Mi"),
+					libvmi.WithGuestMemory("1Gi"),
+					libvmi.WithCPUCount(2, 1, 1),
+				)
+				vmi.Namespace = "default"
+				vmi.UID = "1234"
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				hostModelRequirement := k8sv1.NodeSelectorRequirement{Key: v1.NodeHostModelIsObsoleteLabel, Operator: k8sv1.NodeSelectorOpDoesNotExist}
+				Expect(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(
+					k8sv1.NodeSelectorTerm{MatchExpressions: []k8sv1.NodeSelectorRequirement{
+						hostModelRequirement,
+						{Key: topology.NUMACellsLabel, Operator: k8sv1.NodeSelectorOpDoesNotExist},
+					}},
+					k8sv1.NodeSelectorTerm{
+						MatchExpressions: []k8sv1.NodeSelectorRequirement{hostModelRequirement},
+						MatchFields: []k8sv1.NodeSelectorRequirement{
+							{Key: "metadata.name", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"node01", "node02"}},
+						},
+					},
+				))
+				Expect(pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(BeEmpty())
+			})
 		})
 		Context("with cpu and memory constraints", func() {
 			DescribeTable("should add cpu and memory constraints to a template", func(arch string, requestMemory string, limitMemory string) {
@@ -3339,6 +3412,94 @@ var _ = Describe("Template", func() {
 					}))
 				})
 			})
+			Context("with an Unattend", func() {
+				It("Should add the secrets of the generated answer file to template", func() {
+					config, kvStore, svc = configFactory(defaultArch)
+					volumes := []v1.Volume{
+						{
+							Name: "sysprep-volume",
+							VolumeSource: v1.VolumeSource{
+								Sysprep: &v1.SysprepSource{
+									Unattend: &v1.SysprepUnattend{
+										AdminPasswordSecretRef: &k8sv1.LocalObjectReference{Name: "admin-password"},
+										DomainJoin: &v1.SysprepDomainJoin{
+											Domain:               "ad.example.com",
+											CredentialsSecretRef: &k8sv1.LocalObjectReference{Name: "domain-join"},
+										},
+									},
+								},
+							},
+						},
+					}
+					vmi := v1.VirtualMachineInstance{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "testvmi", Namespace: "default", UID: "1234",
+						},
+						Spec: v1.VirtualMachineInstanceSpec{Volumes: volumes, Domain: v1.DomainSpec{}},
+					}
+
+					pod, err := svc.RenderLaunchManifest(&vmi)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(pod.Spec.Volumes).To(HaveLen(10))
+					Expect(pod.Spec.Volumes).To(ContainElements(
+						k8sv1.Volume{
+							Name: "sysprep-volume-admin",
+							VolumeSource: k8sv1.VolumeSource{
+								Secret: &k8sv1.SecretVolumeSource{SecretName: "admin-password"},
+							},
+						},
+						k8sv1.Volume{
+							Name: "sysprep-volume-domain",
+							VolumeSource: k8sv1.VolumeSource{
+								Secret: &k8sv1.SecretVolumeSource{SecretName: "domain-join"},
+							},
+						},
+					))
+					Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+						Name:      "sysprep-volume-admin",
+						MountPath: "/var/run/kubevirt-private/sysprep/sysprep-volume-admin",
+						ReadOnly:  true,
+					}))
+				})
+			})
+		})
+
+		Context("with an Ignition volume source", func() {
+			It("should add the Ignition config secret to template", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				volumes := []v1.Volume{
+					{
+						Name: "ignition",
+						VolumeSource: v1.VolumeSource{
+							Ignition: &v1.IgnitionSource{
+								ConfigSecretRef: &k8sv1.LocalObjectReference{Name: "ignition-config"},
+							},
+						},
+					},
+				}
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Volumes: volumes, Domain: v1.DomainSpec{}},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+					Name: "ignition-ignition",
+					VolumeSource: k8sv1.VolumeSource{
+						Secret: &k8sv1.SecretVolumeSource{SecretName: "ignition-config"},
+					},
+				}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+					Name:      "ignition-ignition",
+					MountPath: "/var/run/kubevirt-private/secret/ignition",
+					ReadOnly:  true,
+				}))
+			})
 		})
 
 		Context("with a secret volume source", func() {
@@ -5693,6 +5854,51 @@ var _ = Describe("Template", func() {
 		)
 	})
 
+	Context("vhost-user sockets", func() {
+		const vhostUserPlugin = "vhostuser"
+
+		BeforeEach(func() {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{
+					vhostUserPlugin: {DomainAttachmentType: v1.VhostUser},
+				},
+			}
+			_, kvStore, svc = configFactory(defaultArch)
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+		})
+
+		It("should share a sockets directory with the CNI when a vhost-user binding is used", func() {
+			vmi := libvmi.New(libvmi.WithNamespace("default"),
+				libvmi.WithNetwork(libvmi.MultusNetwork("dpdk", "default/default")),
+				libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin("dpdk", v1.PluginBinding{Name: vhostUserPlugin})),
+			)
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+				Name:         "vhostuser-sockets",
+				VolumeSource: k8sv1.VolumeSource{EmptyDir: &k8sv1.EmptyDirVolumeSource{}},
+			}))
+			Expect(pod.Spec.Containers[0].Name).To(Equal("compute"))
+			Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+				Name:      "vhostuser-sockets",
+				MountPath: "/var/run/kubevirt/vhostuser",
+			}))
+		})
+
+		It("should not add a sockets directory without vhost-user bindings", func() {
+			vmi := libvmi.New(libvmi.WithNamespace("default"),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				libvmi.WithInterface(*v1.DefaultMasqueradeNetworkInterface()),
+			)
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Spec.Volumes).ToNot(ContainElement(HaveField("Name", "vhostuser-sockets")))
+		})
+	})
+
 	Context("Network binding plugin", func() {
 		It("Should consider network binding plugin memory overhead", func() {
 			const (
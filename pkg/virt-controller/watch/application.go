@@ -188,6 +188,8 @@ type VirtControllerApp struct {
 	cdiInformer            cache.SharedIndexInformer
 	cdiConfigInformer      cache.SharedIndexInformer
 
+	nadInformer cache.SharedIndexInformer
+
 	migrationController *migration.Controller
 	migrationInformer   cache.SharedIndexInformer
 
@@ -211,6 +213,8 @@ type VirtControllerApp struct {
 	allPodInformer               cache.SharedIndexInformer
 	resourceQuotaInformer        cache.SharedIndexInformer
 
+	numaTopologyConfigMapInformer cache.SharedIndexInformer
+
 	crdInformer cache.SharedIndexInformer
 
 	migrationPolicyInformer cache.SharedIndexInformer
@@ -249,6 +253,8 @@ type VirtControllerApp struct {
 	hasCDI bool
 	// indicates if controllers were started with or without DRA support
 	isDRAEnabled bool
+	// indicates if controllers were started with or without NetworkAttachmentDefinition support
+	hasNADAPI bool
 	// the channel used to trigger re-initialization.
 	reInitChan chan string
 
@@ -357,6 +363,7 @@ func Execute() {
 	app.reInitChan = make(chan string, 10)
 	app.hasCDI = app.clusterConfig.HasDataVolumeAPI()
 	app.isDRAEnabled = app.clusterConfig.GPUsWithDRAGateEnabled() || app.clusterConfig.HostDevicesWithDRAEnabled()
+	app.hasNADAPI = app.hasNetworkAttachmentDefinitionAPI()
 	app.clusterConfig.SetConfigModifiedCallback(app.configModificationCallback)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeLogVerbosity)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeRateLimiter)
@@ -409,6 +416,7 @@ func Execute() {
 	app.allPodInformer = app.informerFactory.Pod()
 	app.exportServiceInformer = app.informerFactory.ExportService()
 	app.resourceQuotaInformer = app.informerFactory.ResourceQuota()
+	app.numaTopologyConfigMapInformer = app.informerFactory.NUMATopologyConfigMap()
 
 	if app.hasCDI {
 		app.dataVolumeInformer = app.informerFactory.DataVolume()
@@ -429,6 +437,12 @@ func Execute() {
 		log.Log.Infof("CDI not detected, DataVolume integration disabled")
 	}
 
+	if app.hasNADAPI {
+		app.nadInformer = app.informerFactory.NetworkAttachmentDefinition()
+	} else {
+		app.nadInformer = app.informerFactory.DummyNetworkAttachmentDefinition()
+	}
+
 	onOpenShift, err := clusterutil.IsOnOpenShift(app.clientSet)
 	if err != nil {
 		golog.Fatalf("Error determining cluster type: %v", err)
@@ -518,6 +532,22 @@ func (vca *VirtControllerApp) configModificationCallback() {
 		vca.reInitChan <- "reinit"
 		return
 	}
+	newHasNADAPI := vca.hasNetworkAttachmentDefinitionAPI()
+	if newHasNADAPI != vca.hasNADAPI {
+		if newHasNADAPI {
+			log.Log.Infof("Reinitialize virt-controller, network attachment definition api has been introduced")
+		} else {
+			log.Log.Infof("Reinitialize virt-controller, network attachment definition api has been removed")
+		}
+		vca.reInitChan <- "reinit"
+		return
+	}
+}
+
+// hasNetworkAttachmentDefinitionAPI reports if the NetworkAttachmentDefinitions can be watched.
+// virt-controller is not granted access to them when the network resources are injected externally.
+func (vca *VirtControllerApp) hasNetworkAttachmentDefinitionAPI() bool {
+	return vca.clusterConfig.HasNetworkAttachmentDefinitionAPI() && !vca.clusterConfig.ExternalNetResourceInjectionEnabled()
 }
 
 // Update virt-controller rate limiter
@@ -608,7 +638,11 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
 		go vca.rsController.Run(vca.rsControllerThreads, stop)
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
-		go vca.vmController.Run(vca.vmControllerThreads, stop)
+		go func() {
+			// The VM controller allocates IP addresses from the pools of the NetworkAttachmentDefinitions.
+			cache.WaitForCacheSync(stop, vca.nadInformer.HasSynced)
+			vca.vmController.Run(vca.vmControllerThreads, stop)
+		}()
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
 		go func() {
 			if err := vca.snapshotController.Run(vca.snapshotControllerThreads, stop); err != nil {
@@ -638,7 +672,8 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 			}
 		}()
 
-		cache.WaitForCacheSync(stop, vca.persistentVolumeClaimInformer.HasSynced, vca.namespaceInformer.HasSynced, vca.resourceQuotaInformer.HasSynced)
+		cache.WaitForCacheSync(stop, vca.persistentVolumeClaimInformer.HasSynced, vca.namespaceInformer.HasSynced, vca.resourceQuotaInformer.HasSynced,
+			vca.numaTopologyConfigMapInformer.HasSynced)
 		close(vca.readyChan)
 		metrics.SetVirtControllerLeading()
 	}
@@ -685,6 +720,7 @@ func (vca *VirtControllerApp) initCommon() {
 		services.WithNetMemoryCalculator(netresources.MemoryCalculator{}),
 		services.WithAnnotationsGenerators(netAnnotationsGenerator, storageannotations.Generator{}),
 		services.WithNetTargetAnnotationsGenerator(netAnnotationsGenerator),
+		services.WithNUMATopologyStore(vca.numaTopologyConfigMapInformer.GetStore()),
 	)
 
 	topologyHinter := topology.NewTopologyHinter(vca.nodeInformer.GetStore(), vca.vmiInformer.GetStore(), vca.clusterConfig)
@@ -799,6 +835,17 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 			vca.clientSet.GeneratedKubeVirtClient(),
 			vca.clusterConfig,
 		),
+		netcontrollers.NewMACPoolController(
+			vca.clientSet.GeneratedKubeVirtClient(),
+			vca.clusterConfig,
+			vca.vmInformer.GetStore(),
+			vca.vmiInformer.GetStore(),
+		),
+		netcontrollers.NewIPAMController(
+			vca.clientSet.GeneratedKubeVirtClient(),
+			vca.nadInformer.GetStore(),
+			vca.vmInformer.GetStore(),
+		),
 		vm.NewFirmwareController(vca.clientSet.GeneratedKubeVirtClient()),
 		instancetypecontroller.New(
 			vca.instancetypeInformer.GetStore(),
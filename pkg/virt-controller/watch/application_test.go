@@ -32,6 +32,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/emicklei/go-restful/v3"
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
@@ -105,6 +106,7 @@ var _ = Describe("Application", func() {
 		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
 		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
 		resourceQuotaInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
+		numaTopologyConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 		crInformer, _ := testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
@@ -113,6 +115,7 @@ var _ = Describe("Application", func() {
 		storageProfileInformer, _ := testutils.NewFakeInformerFor(&cdiv1.StorageProfile{})
 		cdiInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		cdiConfigInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		nadInformer, _ := testutils.NewFakeInformerFor(&networkv1.NetworkAttachmentDefinition{})
 		rsInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceReplicaSet{})
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
@@ -180,6 +183,8 @@ var _ = Describe("Application", func() {
 			config,
 			nil,
 			nil,
+			nil,
+			nil,
 			instancetypecontroller.NewControllerStub(),
 			[]string{},
 			[]string{},
@@ -258,7 +263,9 @@ var _ = Describe("Application", func() {
 		app.persistentVolumeClaimInformer = pvcInformer
 		app.nodeInformer = nodeInformer
 		app.resourceQuotaInformer = resourceQuotaInformer
+		app.numaTopologyConfigMapInformer = numaTopologyConfigMapInformer
 		app.namespaceInformer = namespaceInformer
+		app.nadInformer = nadInformer
 		app.vmCloneController, _ = clonecontroller.NewVmCloneController(
 			virtClient,
 			cloneInformer,
@@ -298,6 +305,7 @@ var _ = Describe("Application", func() {
 		go pvcInformer.Run(ctx.Done())
 		go nodeInformer.Run(ctx.Done())
 		go resourceQuotaInformer.Run(ctx.Done())
+		go numaTopologyConfigMapInformer.Run(ctx.Done())
 		go namespaceInformer.Run(ctx.Done())
 		time.Sleep(time.Second)
 
@@ -816,6 +816,9 @@ func indexVMSpec(poolSpec *poolv1.VirtualMachinePoolSpec, idx int) *virtv1.Virtu
 			if volume.VolumeSource.CloudInitNoCloud.NetworkDataSecretRef != nil {
 				volume.CloudInitNoCloud.NetworkDataSecretRef.Name += suffix
 			}
+			if volume.VolumeSource.CloudInitNoCloud.VendorDataSecretRef != nil {
+				volume.CloudInitNoCloud.VendorDataSecretRef.Name += suffix
+			}
 		} else if volume.VolumeSource.CloudInitConfigDrive != nil && appendIndexToSecretRefs {
 			if volume.VolumeSource.CloudInitConfigDrive.UserDataSecretRef != nil {
 				volume.CloudInitConfigDrive.UserDataSecretRef.Name += suffix
@@ -823,6 +826,13 @@ func indexVMSpec(poolSpec *poolv1.VirtualMachinePoolSpec, idx int) *virtv1.Virtu
 			if volume.VolumeSource.CloudInitConfigDrive.NetworkDataSecretRef != nil {
 				volume.CloudInitConfigDrive.NetworkDataSecretRef.Name += suffix
 			}
+			if volume.VolumeSource.CloudInitConfigDrive.VendorDataSecretRef != nil {
+				volume.CloudInitConfigDrive.VendorDataSecretRef.Name += suffix
+			}
+		} else if volume.VolumeSource.Ignition != nil && appendIndexToSecretRefs {
+			if volume.VolumeSource.Ignition.ConfigSecretRef != nil {
+				volume.Ignition.ConfigSecretRef.Name += suffix
+			}
 		}
 	}
 
@@ -1201,6 +1201,56 @@ var _ = Describe("Pool", func() {
 			Entry("append index if set to true", pointer.P(true)),
 		)
 
+		DescribeTable("should respect name generation settings for Ignition volumes", func(appendIndex *bool) {
+			const configSecretName = "ignition-secret"
+
+			pool, _ := DefaultPool(3)
+			pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Volumes = []v1.Volume{
+				{
+					Name: "ignition",
+					VolumeSource: v1.VolumeSource{
+						Ignition: &v1.IgnitionSource{
+							ConfigSecretRef: &k8sv1.LocalObjectReference{
+								Name: configSecretName,
+							},
+						},
+					},
+				},
+			}
+			pool.Spec.NameGeneration = &poolv1.VirtualMachinePoolNameGeneration{
+				AppendIndexToSecretRefs: appendIndex,
+			}
+
+			addPool(pool)
+
+			createPoolRevision(pool)
+
+			sanityExecute()
+
+			vms, err := fakeVirtClient.KubevirtV1().VirtualMachines(pool.Namespace).List(context.TODO(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vms.Items).To(HaveLen(3))
+
+			for i, vm := range vms.Items {
+				Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+				Expect(vm.Spec.Template.Spec.Volumes[0].VolumeSource.Ignition).ToNot(BeNil())
+
+				if appendIndex != nil && *appendIndex {
+					Expect(vm.Spec.Template.Spec.Volumes[0].VolumeSource.Ignition.ConfigSecretRef.Name).To(Equal(fmt.Sprintf("%s-%d", configSecretName, i)))
+				} else {
+					Expect(vm.Spec.Template.Spec.Volumes[0].VolumeSource.Ignition.ConfigSecretRef.Name).To(Equal(configSecretName))
+				}
+			}
+
+			testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+			testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+			testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+		},
+			Entry("do not append index by default", nil),
+			Entry("do not append index if set to false", pointer.P(false)),
+			Entry("append index if set to true", pointer.P(true)),
+		)
+
 		DescribeTable("should respect name generation settings for CloudInitConfigDrive volumes", func(appendIndex *bool) {
 			const (
 				userDataSecretName    = "userdata-secret"
@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package topology
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+func IsClusterBaselineCPUModel(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.CPU != nil && vmi.Spec.Domain.CPU.Model == virtv1.CPUModeClusterBaseline
+}
+
+func AreCPUBaselineTopologyHintsDefined(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi.Status.TopologyHints != nil && vmi.Status.TopologyHints.CPUBaseline != nil
+}
+
+// MatchesNodeSelector selects the nodes which have all the labels of the selector
+func MatchesNodeSelector(selector map[string]string) FilterPredicateFunc {
+	return func(node *v1.Node) bool {
+		for key, value := range selector {
+			if nodeValue, exists := node.Labels[key]; !exists || nodeValue != value {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// cpuModelRanking lists the named CPU models in the order of the libvirt CPU map, which is also the order in
+// which libvirt reports them in the domain capabilities: within a vendor, each model is at least as capable
+// as the models before it.
+var cpuModelRanking = []string{
+	"486", "pentium", "pentium2", "pentium3", "pentiumpro", "coreduo", "n270", "core2duo",
+	"qemu32", "kvm32", "cpu64-rhel5", "cpu64-rhel6", "kvm64", "qemu64",
+	"Conroe", "Penryn",
+	"Nehalem", "Nehalem-IBRS",
+	"Westmere", "Westmere-IBRS",
+	"SandyBridge", "SandyBridge-IBRS",
+	"IvyBridge", "IvyBridge-IBRS",
+	"Haswell-noTSX", "Haswell-noTSX-IBRS", "Haswell", "Haswell-IBRS",
+	"Broadwell-noTSX", "Broadwell-noTSX-IBRS", "Broadwell", "Broadwell-IBRS",
+	"Skylake-Client", "Skylake-Client-IBRS", "Skylake-Client-noTSX-IBRS",
+	"Skylake-Server", "Skylake-Server-IBRS", "Skylake-Server-noTSX-IBRS",
+	"Cascadelake-Server", "Cascadelake-Server-noTSX",
+	"Icelake-Client", "Icelake-Client-noTSX", "Icelake-Server", "Icelake-Server-noTSX",
+	"Cooperlake", "Snowridge", "SapphireRapids", "SierraForest", "GraniteRapids",
+	"athlon", "phenom",
+	"Opteron_G1", "Opteron_G2", "Opteron_G3", "Opteron_G4", "Opteron_G5",
+	"EPYC", "EPYC-IBPB", "EPYC-Rome", "EPYC-Milan", "EPYC-Genoa",
+	"Dhyana",
+}
+
+// cpuModelRank returns the position of a CPU model in the libvirt CPU map, models missing from it rank lowest
+func cpuModelRank(model string) int {
+	return slices.Index(cpuModelRanking, model)
+}
+
+// ClusterBaselineCPU computes the CPU model and features which all the given nodes support, based on the
+// labels of the node labeller. The model is the most capable CPU model, in the order of the libvirt CPU map,
+// which is usable on all the nodes; models missing from the map are only picked when no other model qualifies,
+// in alphabetical order, which keeps the baseline stable across syncs. The features are the host-model CPU
+// features all the nodes report: the node labeller expands the host-model CPU of each node into its
+// features with virsh hypervisor-cpu-baseline and labels them with the CPU feature label.
+func ClusterBaselineCPU(group string, nodes []*v1.Node) (*virtv1.CPUBaseline, error) {
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no schedulable nodes found in CPU model group %q", group)
+	}
+
+	commonModels := labelSuffixes(nodes[0], virtv1.CPUModelLabel)
+	commonFeatures := labelSuffixes(nodes[0], virtv1.CPUFeatureLabel)
+	for _, node := range nodes {
+		intersect(commonModels, labelSuffixes(node, virtv1.CPUModelLabel))
+		intersect(commonFeatures, labelSuffixes(node, virtv1.CPUFeatureLabel))
+	}
+	if len(commonModels) == 0 {
+		return nil, fmt.Errorf("the nodes of CPU model group %q have no CPU model in common", group)
+	}
+
+	candidates := make([]string, 0, len(commonModels))
+	for model := range commonModels {
+		candidates = append(candidates, model)
+	}
+	slices.SortFunc(candidates, func(a, b string) int {
+		if rankA, rankB := cpuModelRank(a), cpuModelRank(b); rankA != rankB {
+			return rankB - rankA
+		}
+		return strings.Compare(a, b)
+	})
+
+	features := make([]string, 0, len(commonFeatures))
+	for feature := range commonFeatures {
+		features = append(features, feature)
+	}
+	slices.Sort(features)
+
+	return &virtv1.CPUBaseline{
+		Group:    group,
+		Model:    candidates[0],
+		Features: features,
+	}, nil
+}
+
+func labelSuffixes(node *v1.Node, prefix string) map[string]struct{} {
+	suffixes := map[string]struct{}{}
+	for key, value := range node.Labels {
+		if value == "true" && strings.HasPrefix(key, prefix) {
+			suffixes[strings.TrimPrefix(key, prefix)] = struct{}{}
+		}
+	}
+	return suffixes
+}
+
+func intersect(set, other map[string]struct{}) {
+	for key := range set {
+		if _, exists := other[key]; !exists {
+			delete(set, key)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package topology_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
+)
+
+var _ = Describe("CPU baseline", func() {
+
+	nodeWithLabels := func(name string, labels ...string) *k8sv1.Node {
+		node := &k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}}}
+		for _, label := range labels {
+			node.Labels[label] = "true"
+		}
+		return node
+	}
+
+	It("should pick the model which all the nodes support and the common features", func() {
+		baseline, err := topology.ClusterBaselineCPU("group", []*k8sv1.Node{
+			nodeWithLabels("new",
+				v1.HostModelCPULabel+"Icelake-Server",
+				v1.CPUModelLabel+"Icelake-Server", v1.CPUModelLabel+"Skylake-Server",
+				v1.CPUFeatureLabel+"aes", v1.CPUFeatureLabel+"gfni",
+			),
+			nodeWithLabels("old",
+				v1.HostModelCPULabel+"Skylake-Server",
+				v1.CPUModelLabel+"Skylake-Server",
+				v1.CPUFeatureLabel+"aes", v1.CPUFeatureLabel+"pdpe1gb",
+			),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(baseline).To(Equal(&v1.CPUBaseline{Group: "group", Model: "Skylake-Server", Features: []string{"aes"}}))
+	})
+
+	DescribeTable("should pick the most capable model which all the nodes support", func(expectedModel string, models ...string) {
+		var labels []string
+		for _, model := range models {
+			labels = append(labels, v1.CPUModelLabel+model)
+		}
+		baseline, err := topology.ClusterBaselineCPU("group", []*k8sv1.Node{
+			nodeWithLabels("new", append(labels, v1.CPUModelLabel+"Icelake-Server")...),
+			nodeWithLabels("old", labels...),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(baseline.Model).To(Equal(expectedModel))
+	},
+		Entry("in the order of the libvirt CPU map", "Cascadelake-Server",
+			"Broadwell", "Cascadelake-Server", "Haswell-noTSX", "Skylake-Server"),
+		Entry("among models of the same generation", "Skylake-Server-IBRS",
+			"Skylake-Client-IBRS", "Skylake-Server", "Skylake-Server-IBRS"),
+		Entry("with models missing from the libvirt CPU map", "Penryn",
+			"Penryn", "Unknown-B", "Unknown-A"),
+		Entry("with models missing from the libvirt CPU map only", "Unknown-A",
+			"Unknown-B", "Unknown-A"),
+	)
+
+	It("should fail without nodes", func() {
+		_, err := topology.ClusterBaselineCPU("group", nil)
+		Expect(err).To(MatchError(ContainSubstring("no schedulable nodes")))
+	})
+
+	It("should fail when no model is usable on all nodes", func() {
+		_, err := topology.ClusterBaselineCPU("group", []*k8sv1.Node{
+			nodeWithLabels("intel", v1.HostModelCPULabel+"Skylake-Server", v1.CPUModelLabel+"Skylake-Server"),
+			nodeWithLabels("amd", v1.HostModelCPULabel+"EPYC", v1.CPUModelLabel+"EPYC"),
+		})
+		Expect(err).To(MatchError(ContainSubstring("no CPU model in common")))
+	})
+
+	It("should select nodes matching the node selector", func() {
+		matches := topology.MatchesNodeSelector(map[string]string{"pool": "a"})
+		Expect(matches(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"pool": "a", "other": "x"}}})).To(BeTrue())
+		Expect(matches(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"pool": "b"}}})).To(BeFalse())
+		Expect(topology.MatchesNodeSelector(nil)(&k8sv1.Node{})).To(BeTrue())
+	})
+})
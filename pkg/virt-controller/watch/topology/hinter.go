@@ -32,6 +32,14 @@ func (t *topologyHinter) IsTscFrequencyRequired(vmi *k6tv1.VirtualMachineInstanc
 }
 
 func (t *topologyHinter) TopologyHintsForVMI(vmi *k6tv1.VirtualMachineInstance) (hints *k6tv1.TopologyHints, requirement TscFrequencyRequirementType, err error) {
+	if IsClusterBaselineCPUModel(vmi) {
+		baseline, err := t.ClusterBaselineCPUForVMI(vmi)
+		if err != nil {
+			return nil, GetTscFrequencyRequirement(vmi).Type, fmt.Errorf("failed to determine the baseline cpu model of the cluster: %v", err)
+		}
+		hints = &k6tv1.TopologyHints{CPUBaseline: baseline}
+	}
+
 	requirement = GetTscFrequencyRequirement(vmi).Type
 	if requirement == NotRequired || vmi.Spec.Architecture != "amd64" {
 		return
@@ -39,13 +47,34 @@ func (t *topologyHinter) TopologyHintsForVMI(vmi *k6tv1.VirtualMachineInstance)
 
 	freq, err := t.LowestTSCFrequencyOnCluster()
 	if err != nil {
-		return nil, requirement, fmt.Errorf("failed to determine the lowest tsc frequency on the cluster: %v", err)
+		return hints, requirement, fmt.Errorf("failed to determine the lowest tsc frequency on the cluster: %v", err)
 	}
 
-	hints = &k6tv1.TopologyHints{TSCFrequency: pointer.P(int64(freq))}
+	if hints == nil {
+		hints = &k6tv1.TopologyHints{}
+	}
+	hints.TSCFrequency = pointer.P(int64(freq))
 	return
 }
 
+// ClusterBaselineCPUForVMI computes the baseline CPU of the schedulable nodes of the CPU model group of the VMI
+func (t *topologyHinter) ClusterBaselineCPUForVMI(vmi *k6tv1.VirtualMachineInstance) (*k6tv1.CPUBaseline, error) {
+	groupName := vmi.Spec.Domain.CPU.ModelGroup
+	var nodeSelector map[string]string
+	if groupName != "" {
+		group, exists := t.clusterConfig.GetCPUModelGroup(groupName)
+		if !exists {
+			return nil, fmt.Errorf("cpu model group %q is not defined", groupName)
+		}
+		nodeSelector = group.NodeSelector
+	}
+	nodes := FilterNodesFromCache(t.nodeStore.List(),
+		IsSchedulable,
+		MatchesNodeSelector(nodeSelector),
+	)
+	return ClusterBaselineCPU(groupName, nodes)
+}
+
 func (t *topologyHinter) LowestTSCFrequencyOnCluster() (int64, error) {
 	configTSCFrequency := t.clusterConfig.GetMinimumClusterTSCFrequency()
 	if configTSCFrequency != nil {
@@ -86,6 +86,69 @@ var _ = Describe("Hinter", func() {
 	},
 		Entry("arm64", "arm64"),
 	)
+
+	Context("with the cluster-baseline CPU model", func() {
+		nodeWithCPU := func(name string, pool string, hostModel string, models []string, features ...string) *v1.Node {
+			labels := map[string]string{
+				virtv1.NodeSchedulable:               "true",
+				"pool":                               pool,
+				virtv1.HostModelCPULabel + hostModel: "true",
+			}
+			for _, model := range models {
+				labels[virtv1.CPUModelLabel+model] = "true"
+			}
+			for _, feature := range features {
+				labels[virtv1.CPUFeatureLabel+feature] = "true"
+			}
+			return &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+		}
+
+		vmiWithModelGroup := func(group string) *virtv1.VirtualMachineInstance {
+			return &virtv1.VirtualMachineInstance{
+				Spec: virtv1.VirtualMachineInstanceSpec{
+					Domain: virtv1.DomainSpec{
+						CPU: &virtv1.CPU{Model: virtv1.CPUModeClusterBaseline, ModelGroup: group},
+					},
+				},
+			}
+		}
+
+		var hinter *topologyHinter
+
+		BeforeEach(func() {
+			hinter = hinterWithNodes(
+				nodeWithCPU("skylake", "a", "Skylake-Server", []string{"Haswell", "Skylake-Server"}, "aes", "avx2", "avx512f"),
+				nodeWithCPU("icelake", "a", "Icelake-Server", []string{"Haswell", "Skylake-Server", "Icelake-Server"}, "aes", "avx2", "avx512f", "gfni"),
+				nodeWithCPU("haswell", "b", "Haswell", []string{"Haswell"}, "aes", "avx2"),
+			)
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+				CPUModelGroups: []virtv1.CPUModelGroup{{Name: "pool-a", NodeSelector: map[string]string{"pool": "a"}}},
+			})
+			hinter.clusterConfig = config
+		})
+
+		It("should propose the baseline of all schedulable nodes without a group", func() {
+			hints, _, err := hinter.TopologyHintsForVMI(vmiWithModelGroup(""))
+			g.Expect(err).ToNot(g.HaveOccurred())
+			g.Expect(hints).To(g.Equal(&virtv1.TopologyHints{
+				CPUBaseline: &virtv1.CPUBaseline{Model: "Haswell", Features: []string{"aes", "avx2"}},
+			}))
+		})
+
+		It("should propose the baseline of the nodes of the group", func() {
+			hints, _, err := hinter.TopologyHintsForVMI(vmiWithModelGroup("pool-a"))
+			g.Expect(err).ToNot(g.HaveOccurred())
+			g.Expect(hints).To(g.Equal(&virtv1.TopologyHints{
+				CPUBaseline: &virtv1.CPUBaseline{Group: "pool-a", Model: "Skylake-Server", Features: []string{"aes", "avx2", "avx512f"}},
+			}))
+		})
+
+		It("should fail for an undefined group", func() {
+			hints, _, err := hinter.TopologyHintsForVMI(vmiWithModelGroup("pool-c"))
+			g.Expect(err).To(g.HaveOccurred())
+			g.Expect(hints).To(g.BeNil())
+		})
+	})
 })
 
 func hinterWithNodes(nodes ...*v1.Node) *topologyHinter {
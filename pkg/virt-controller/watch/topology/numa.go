@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+const (
+	// NUMACellsLabel is the number of NUMA cells of the node
+	NUMACellsLabel = virtv1.NUMALabel + "cells"
+	// NUMATopologyConfigMapKey is the key of the NUMA topology, in JSON format, in the config map of a node
+	NUMATopologyConfigMapKey = "topology"
+)
+
+// NUMATopology is the capacity of each NUMA cell of a node, as published by the node labeller
+type NUMATopology struct {
+	Cells []NUMACell `json:"cells"`
+}
+
+type NUMACell struct {
+	ID int `json:"id"`
+	// CPUs is the list of host CPUs of the cell, in cpuset format
+	CPUs           string `json:"cpus,omitempty"`
+	ThreadsPerCore int    `json:"threadsPerCore,omitempty"`
+	MemoryKiB      uint64 `json:"memoryKiB,omitempty"`
+	// Hugepages lists the total and free huge pages of the cell, per page size
+	Hugepages []NUMAHugepages `json:"hugepages,omitempty"`
+	// PCIDevices lists the addresses of the PCI devices attached to the cell
+	PCIDevices []string `json:"pciDevices,omitempty"`
+}
+
+type NUMAHugepages struct {
+	PageSize string `json:"pageSize"`
+	Total    uint64 `json:"total"`
+	Free     uint64 `json:"free"`
+}
+
+// FitsGuestCells reports whether the guest NUMA cells of a VMI with guest mapping passthrough fit into the free
+// huge pages of the host cells, for any of the guest cell counts returned by NUMAHugepagesPerGuestCell: with n
+// guest cells, at least n host cells need enough free huge pages for one guest cell each.
+func (t *NUMATopology) FitsGuestCells(pageSize string, pagesPerCell []int64) bool {
+	for i, pages := range pagesPerCell {
+		fittingCells := 0
+		for _, cell := range t.Cells {
+			if cell.freeHugepages(pageSize) >= uint64(pages) {
+				fittingCells++
+			}
+		}
+		if fittingCells >= i+1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *NUMACell) freeHugepages(pageSize string) uint64 {
+	for _, pages := range c.Hugepages {
+		if pages.PageSize == pageSize {
+			return pages.Free
+		}
+	}
+	return 0
+}
+
+// maxGuestNUMACells bounds the guest NUMA cell counts considered when placing a VMI with guest mapping passthrough
+const maxGuestNUMACells = 8
+
+// NUMAHugepagesPerGuestCell returns the huge pages each guest NUMA cell of a VMI with guest mapping passthrough
+// needs, for one to n guest cells: pagesPerCell[i] is the requirement when the guest has i+1 cells. The guest
+// cells mirror the host cells its vCPUs are pinned to, which is only known at launch, and the guest memory is
+// split evenly over them. A guest has at most as many cells as vCPUs.
+func NUMAHugepagesPerGuestCell(vmi *virtv1.VirtualMachineInstance) (pageSize string, pagesPerCell []int64) {
+	domain := vmi.Spec.Domain
+	if domain.CPU == nil || domain.CPU.NUMA == nil || domain.CPU.NUMA.GuestMappingPassthrough == nil ||
+		domain.Memory == nil || domain.Memory.Hugepages == nil {
+		return "", nil
+	}
+
+	pageSizeQuantity, err := resource.ParseQuantity(domain.Memory.Hugepages.PageSize)
+	if err != nil || pageSizeQuantity.Value() <= 0 {
+		return "", nil
+	}
+
+	guestMemory := domain.Resources.Requests.Memory()
+	if domain.Memory.Guest != nil {
+		guestMemory = domain.Memory.Guest
+	}
+	pages := (guestMemory.Value() + pageSizeQuantity.Value() - 1) / pageSizeQuantity.Value()
+	if pages <= 0 {
+		return "", nil
+	}
+
+	maxCells := min(max(hardware.GetNumberOfVCPUs(domain.CPU), 1), pages, maxGuestNUMACells)
+	for cells := int64(1); cells <= maxCells; cells++ {
+		pagesPerCell = append(pagesPerCell, (pages+cells-1)/cells)
+	}
+	return pageSizeQuantity.String(), pagesPerCell
+}
+
+// NUMATopologyConfigMapName returns the name of the config map which holds the NUMA topology of a node
+func NUMATopologyConfigMapName(nodeName string) string {
+	return "numa-topology-" + nodeName
+}
+
+// NUMATopologyFromConfigMap returns the NUMA topology held by a config map published by the node labeller
+func NUMATopologyFromConfigMap(configMap *v1.ConfigMap) (*NUMATopology, error) {
+	topology := &NUMATopology{}
+	if err := json.Unmarshal([]byte(configMap.Data[NUMATopologyConfigMapKey]), topology); err != nil {
+		return nil, fmt.Errorf("numa topology in config map %s is invalid: %v", configMap.Name, err)
+	}
+	return topology, nil
+}
@@ -0,0 +1,88 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package topology_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
+)
+
+var _ = Describe("NUMA", func() {
+
+	DescribeTable("should calculate the huge pages each guest NUMA cell needs", func(vmi *v1.VirtualMachineInstance, pageSize string, pagesPerCell []int64) {
+		actualPageSize, actualPagesPerCell := topology.NUMAHugepagesPerGuestCell(vmi)
+		Expect(actualPageSize).To(Equal(pageSize))
+		Expect(actualPagesPerCell).To(Equal(pagesPerCell))
+	},
+		Entry("with guest mapping passthrough and guest memory",
+			libvmi.New(libvmi.WithNUMAGuestMappingPassthrough(), libvmi.WithHugepages("2Mi"), libvmi.WithGuestMemory("1Gi")),
+			"2Mi", []int64{512}),
+		Entry("with guest mapping passthrough and a memory request",
+			libvmi.New(libvmi.WithNUMAGuestMappingPassthrough(), libvmi.WithHugepages("1Gi"), libvmi.WithMemoryRequest("1500Mi")),
+			"1Gi", []int64{2}),
+		Entry("with as many guest cells as vCPUs",
+			libvmi.New(libvmi.WithNUMAGuestMappingPassthrough(), libvmi.WithHugepages("1Gi"), libvmi.WithGuestMemory("5Gi"),
+				libvmi.WithCPUCount(3, 1, 1)),
+			"1Gi", []int64{5, 3, 2}),
+		Entry("with at most one huge page per guest cell",
+			libvmi.New(libvmi.WithNUMAGuestMappingPassthrough(), libvmi.WithHugepages("1Gi"), libvmi.WithGuestMemory("2Gi"),
+				libvmi.WithCPUCount(4, 1, 1)),
+			"1Gi", []int64{2, 1}),
+		Entry("without guest mapping passthrough",
+			libvmi.New(libvmi.WithHugepages("2Mi"), libvmi.WithGuestMemory("1Gi")),
+			"", nil),
+		Entry("without huge pages",
+			libvmi.New(libvmi.WithNUMAGuestMappingPassthrough(), libvmi.WithGuestMemory("1Gi")),
+			"", nil),
+	)
+
+	It("should extract the NUMA topology from a node config map", func() {
+		configMap := &k8sv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: topology.NUMATopologyConfigMapName("mynode")},
+			Data: map[string]string{
+				topology.NUMATopologyConfigMapKey: `{"cells":[{"id":0,"cpus":"0-3","pciDevices":["0000:00:01.0"]}]}`,
+			},
+		}
+		numaTopology, err := topology.NUMATopologyFromConfigMap(configMap)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(numaTopology.Cells).To(ConsistOf(topology.NUMACell{ID: 0, CPUs: "0-3", PCIDevices: []string{"0000:00:01.0"}}))
+	})
+
+	DescribeTable("should check whether the guest NUMA cells fit into the free huge pages", func(pagesPerCell []int64, expected bool) {
+		numaTopology := &topology.NUMATopology{Cells: []topology.NUMACell{
+			{ID: 0, Hugepages: []topology.NUMAHugepages{{PageSize: "2Mi", Total: 512, Free: 100}, {PageSize: "1Gi", Total: 4, Free: 4}}},
+			{ID: 1, Hugepages: []topology.NUMAHugepages{{PageSize: "2Mi", Total: 512, Free: 300}}},
+		}}
+		Expect(numaTopology.FitsGuestCells("2Mi", pagesPerCell)).To(Equal(expected))
+	},
+		Entry("with a single guest cell fitting into one host cell", []int64{300}, true),
+		Entry("with a single guest cell fitting into no host cell", []int64{400}, false),
+		Entry("with two guest cells fitting into two host cells", []int64{400, 100}, true),
+		Entry("with two guest cells fitting into a single host cell", []int64{400, 200}, false),
+		Entry("with three guest cells and two host cells", []int64{400, 250, 50}, false),
+	)
+})
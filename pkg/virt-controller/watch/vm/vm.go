@@ -37,6 +37,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
+	netipam "kubevirt.io/kubevirt/pkg/network/ipam"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	netvmliveupdate "kubevirt.io/kubevirt/pkg/network/vmliveupdate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
@@ -125,6 +126,8 @@ const (
 	hotplugMemoryErrorReason           = "HotPlugMemoryError"
 	volumesUpdateErrorReason           = "VolumesUpdateError"
 	tolerationsChangeErrorReason       = "TolerationsChangeError"
+	ioTuneChangeErrorReason            = "IOTuneChangeError"
+	resourceLimitsChangeErrorReason    = "ResourceLimitsChangeError"
 	annotationsLabelsChangeErrorReason = "AnnotationsLabelsChangeError"
 )
 
@@ -142,6 +145,8 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
 	netSynchronizer synchronizer,
+	macPoolSynchronizer synchronizer,
+	ipamSynchronizer synchronizer,
 	firmwareSynchronizer synchronizer,
 	instancetypeController instancetypeHandler,
 	additionalLauncherAnnotationsSync []string,
@@ -171,6 +176,8 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 		},
 		clusterConfig:                     clusterConfig,
 		netSynchronizer:                   netSynchronizer,
+		macPoolSynchronizer:               macPoolSynchronizer,
+		ipamSynchronizer:                  ipamSynchronizer,
 		firmwareSynchronizer:              firmwareSynchronizer,
 		additionalLauncherAnnotationsSync: additionalLauncherAnnotationsSync,
 		additionalLauncherLabelsSync:      additionalLauncherLabelsSync,
@@ -298,6 +305,8 @@ type Controller struct {
 	hasSynced              func() bool
 
 	netSynchronizer      synchronizer
+	macPoolSynchronizer  synchronizer
+	ipamSynchronizer     synchronizer
 	firmwareSynchronizer synchronizer
 
 	additionalLauncherAnnotationsSync []string
@@ -771,6 +780,226 @@ func (c *Controller) handleTolerationsChangeRequest(vm *virtv1.VirtualMachine, v
 	return nil
 }
 
+func vmiIOTunePatch(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) *patch.PatchSet {
+	patchset := patch.New()
+
+	vmDisks := storagetypes.GetDisksByName(&vm.Spec.Template.Spec)
+	for idx, vmiDisk := range vmi.Spec.Domain.Devices.Disks {
+		vmDisk, exists := vmDisks[vmiDisk.Name]
+		if !exists || equality.Semantic.DeepEqual(vmDisk.IOTune, vmiDisk.IOTune) {
+			continue
+		}
+		diskPath := fmt.Sprintf("/spec/domain/devices/disks/%d", idx)
+		ioTunePath := diskPath + "/ioTune"
+		switch {
+		case vmDisk.IOTune == nil:
+			patchset.AddOption(
+				patch.WithTest(ioTunePath, vmiDisk.IOTune),
+				patch.WithRemove(ioTunePath))
+		case vmiDisk.IOTune == nil:
+			patchset.AddOption(
+				patch.WithTest(diskPath+"/name", vmiDisk.Name),
+				patch.WithAdd(ioTunePath, vmDisk.IOTune))
+		default:
+			patchset.AddOption(
+				patch.WithTest(ioTunePath, vmiDisk.IOTune),
+				patch.WithReplace(ioTunePath, vmDisk.IOTune))
+		}
+	}
+
+	vmIOWeight := vm.Spec.Template.Spec.Domain.Resources.IOWeight
+	vmiIOWeight := vmi.Spec.Domain.Resources.IOWeight
+	const ioWeightPath = "/spec/domain/resources/ioWeight"
+	switch {
+	case equality.Semantic.DeepEqual(vmIOWeight, vmiIOWeight):
+	case vmIOWeight == nil:
+		patchset.AddOption(
+			patch.WithTest(ioWeightPath, vmiIOWeight),
+			patch.WithRemove(ioWeightPath))
+	case vmiIOWeight == nil:
+		patchset.AddOption(patch.WithAdd(ioWeightPath, vmIOWeight))
+	default:
+		patchset.AddOption(
+			patch.WithTest(ioWeightPath, vmiIOWeight),
+			patch.WithReplace(ioWeightPath, vmIOWeight))
+	}
+
+	return patchset
+}
+
+func (c *Controller) handleIOTuneChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+
+	patchset := vmiIOTunePatch(vmCopyWithInstancetype, vmi)
+	if patchset.IsEmpty() {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("I/O limits should not be changed during VMI migration")
+	}
+
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update I/O limits: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// isLiveUpdatableLimit returns whether a change of the resource limit can be applied to a running VMI.
+// The virt-launcher pod keeps the limits it was created with, so a limit can only be lowered
+// or raised back up to its initial value without a restart.
+func isLiveUpdatableLimit(bootLimits, limits k8score.ResourceList, name k8score.ResourceName) bool {
+	limit, hasLimit := limits[name]
+	bootLimit, hadLimit := bootLimits[name]
+	if !hasLimit {
+		return !hadLimit
+	}
+	return !hadLimit || limit.Cmp(bootLimit) <= 0
+}
+
+// liveUpdateResources ignores the resource limit changes which can be applied to the running VMI.
+// Changes of dedicatedCpuPlacement always require a restart: the static CPU manager of kubelet
+// assigns the exclusive CPUs when the pod is created and keeps them for the lifetime of the pod.
+func liveUpdateResources(lastSeenVM, currentVM *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	lastSeenDomain := &lastSeenVM.Spec.Template.Spec.Domain
+	currentDomain := &currentVM.Spec.Template.Spec.Domain
+
+	bootDedicatedCPUs := lastSeenDomain.CPU != nil && lastSeenDomain.CPU.DedicatedCPUPlacement
+	liveUpdatable := map[k8score.ResourceName]bool{
+		k8score.ResourceCPU: !bootDedicatedCPUs,
+		// The memory limit of the pod includes the overhead, which is only known once reported on the VMI status
+		k8score.ResourceMemory: vmi != nil && vmi.Status.Memory != nil && vmi.Status.Memory.MemoryOverhead != nil &&
+			(lastSeenDomain.Memory == nil || lastSeenDomain.Memory.Hugepages == nil),
+	}
+	for name, supported := range liveUpdatable {
+		if !supported || !isLiveUpdatableLimit(lastSeenDomain.Resources.Limits, currentDomain.Resources.Limits, name) {
+			continue
+		}
+		if limit, exists := currentDomain.Resources.Limits[name]; exists {
+			if lastSeenDomain.Resources.Limits == nil {
+				lastSeenDomain.Resources.Limits = k8score.ResourceList{}
+			}
+			lastSeenDomain.Resources.Limits[name] = limit
+		}
+	}
+}
+
+// memoryLimitWithHotplug returns the memory limit of a VMI whose guest memory is guest: the limit of the
+// VM template, which is sized for the guest memory at boot, plus the memory hotplugged since the VMI started.
+func memoryLimitWithHotplug(templateLimit resource.Quantity, guest *resource.Quantity, vmi *virtv1.VirtualMachineInstance) resource.Quantity {
+	limit := templateLimit.DeepCopy()
+	if guest != nil && vmi.Status.Memory != nil && vmi.Status.Memory.GuestAtBoot != nil {
+		limit.Add(*guest)
+		limit.Sub(*vmi.Status.Memory.GuestAtBoot)
+	}
+	return limit
+}
+
+// vmiResourceLimitsPatch aligns the resource limits of the VMI with the VM template, on top of the
+// resources which were hotplugged since the VMI started.
+func vmiResourceLimitsPatch(vm *virtv1.VirtualMachine, bootVMSpec *virtv1.VirtualMachineSpec, vmi *virtv1.VirtualMachineInstance, cpuAllocationRatio int) *patch.PatchSet {
+	patchset := patch.New()
+	vmDomain := &vm.Spec.Template.Spec.Domain
+
+	desiredLimits := k8score.ResourceList{}
+	if cpuLimit, exists := vmDomain.Resources.Limits[k8score.ResourceCPU]; exists && !vmi.IsCPUDedicated() {
+		if bootVMSpec.Template != nil && bootVMSpec.Template.Spec.Domain.CPU != nil && vmi.Spec.Domain.CPU != nil {
+			vcpusDelta := hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU) - hardware.GetNumberOfVCPUs(bootVMSpec.Template.Spec.Domain.CPU)
+			cpuLimit.Add(*resource.NewMilliQuantity(vcpusDelta*int64(1000/cpuAllocationRatio), resource.DecimalSI))
+		}
+		desiredLimits[k8score.ResourceCPU] = cpuLimit
+	}
+	if memoryLimit, exists := vmDomain.Resources.Limits[k8score.ResourceMemory]; exists {
+		if vmi.Spec.Domain.Memory != nil {
+			memoryLimit = memoryLimitWithHotplug(memoryLimit, vmi.Spec.Domain.Memory.Guest, vmi)
+		}
+		desiredLimits[k8score.ResourceMemory] = memoryLimit
+	}
+
+	if vmi.Spec.Domain.Resources.Limits == nil && len(desiredLimits) > 0 {
+		patchset.AddOption(patch.WithAdd("/spec/domain/resources/limits", desiredLimits))
+		return patchset
+	}
+	for _, name := range []k8score.ResourceName{k8score.ResourceCPU, k8score.ResourceMemory} {
+		desiredLimit, exists := desiredLimits[name]
+		if !exists {
+			continue
+		}
+		limitPath := fmt.Sprintf("/spec/domain/resources/limits/%s", name)
+		currentLimit, hasLimit := vmi.Spec.Domain.Resources.Limits[name]
+		switch {
+		case !hasLimit:
+			patchset.AddOption(patch.WithAdd(limitPath, desiredLimit.String()))
+		case !currentLimit.Equal(desiredLimit):
+			patchset.AddOption(
+				patch.WithTest(limitPath, currentLimit.String()),
+				patch.WithReplace(limitPath, desiredLimit.String()))
+		}
+	}
+
+	return patchset
+}
+
+func (c *Controller) handleResourceLimitsChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, startVMSpec *virtv1.VirtualMachineSpec) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil || startVMSpec == nil {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+	bootVM := &virtv1.VirtualMachine{
+		ObjectMeta: vmCopyWithInstancetype.ObjectMeta,
+		Spec:       *startVMSpec.DeepCopy(),
+	}
+	if err := c.instancetypeController.ApplyToVM(bootVM); err != nil {
+		return err
+	}
+
+	// Hotplugged CPUs and memory are taken into account once the change completes
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUChange, k8score.ConditionTrue) ||
+		vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceMemoryChange, k8score.ConditionTrue) {
+		return nil
+	}
+
+	patchset := vmiResourceLimitsPatch(vmCopyWithInstancetype, &bootVM.Spec, vmi, c.clusterConfig.GetCPUAllocationRatio())
+	if patchset.IsEmpty() {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("resource limits should not be changed during VMI migration")
+	}
+
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update resource limits: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (c *Controller) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		return nil
@@ -1289,6 +1518,17 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 
 	setGenerationAnnotationOnVmi(vm.Generation, vmi)
 
+	// The IP allocations are taken from the VM status only, never from the template.
+	delete(vmi.Annotations, virtv1.IPAllocationsAnnotation)
+	if len(vm.Status.IPAllocations) > 0 {
+		ipAllocations, err := netipam.AllocationsAnnotationValue(vm.Status.IPAllocations)
+		if err != nil {
+			log.Log.Object(vm).Reason(err).Error("Failed to encode the IP allocations of the VMI")
+			return vm, err
+		}
+		vmi.Annotations[virtv1.IPAllocationsAnnotation] = ipAllocations
+	}
+
 	if vm.Spec.RunStrategy != nil && *vm.Spec.RunStrategy == virtv1.RunStrategyWaitAsReceiver {
 		log.Log.Infof("Setting up receiver VMI %s/%s", vmi.Namespace, vmi.Name)
 		vmi.Annotations[virtv1.CreateMigrationTarget] = "true"
@@ -3004,6 +3244,16 @@ func (c *Controller) syncRestartRequired(lastSeenVMSpec *virtv1.VirtualMachineSp
 		return false
 	}
 
+	// Disk I/O limits can be changed live, ignore them before evaluating disk changes
+	if c.clusterConfig.IsVMRolloutStrategyLiveUpdate() {
+		currentDisks := storagetypes.GetDisksByName(&currentVM.Spec.Template.Spec)
+		for idx, disk := range lastSeenVM.Spec.Template.Spec.Domain.Devices.Disks {
+			if currentDisk, exists := currentDisks[disk.Name]; exists {
+				lastSeenVM.Spec.Template.Spec.Domain.Devices.Disks[idx].IOTune = currentDisk.IOTune
+			}
+		}
+	}
+
 	if validLiveUpdateVolumes(&lastSeenVM.Spec, currentVM) {
 		lastSeenVM.Spec.Template.Spec.Volumes = currentVM.Spec.Template.Spec.Volumes
 	}
@@ -3029,6 +3279,8 @@ func (c *Controller) syncRestartRequired(lastSeenVMSpec *virtv1.VirtualMachineSp
 		lastSeenVM.Spec.Template.Spec.NodeSelector = currentVM.Spec.Template.Spec.NodeSelector
 		lastSeenVM.Spec.Template.Spec.Affinity = currentVM.Spec.Template.Spec.Affinity
 		lastSeenVM.Spec.Template.Spec.Tolerations = currentVM.Spec.Template.Spec.Tolerations
+		lastSeenVM.Spec.Template.Spec.Domain.Resources.IOWeight = currentVM.Spec.Template.Spec.Domain.Resources.IOWeight
+		liveUpdateResources(lastSeenVM, currentVM, vmi)
 	}
 
 	if !netvmliveupdate.IsRestartRequired(currentVM, vmi, c.clusterConfig) {
@@ -3209,6 +3461,28 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	vm.ObjectMeta = syncedVM.ObjectMeta
 	vm.Spec = syncedVM.Spec
 
+	// MAC addresses are allocated before the VMI is created, for the VMI to start with them.
+	if c.macPoolSynchronizer != nil {
+		syncedVM, err = c.macPoolSynchronizer.Sync(vm, vmi)
+		if err != nil {
+			return vm, vmi, handleSynchronizerErr(err), nil
+		}
+		if !equality.Semantic.DeepEqual(vm.Spec, syncedVM.Spec) {
+			return syncedVM, vmi, nil, nil
+		}
+	}
+
+	// IP addresses are allocated before the VMI is created, for the VMI to start with them.
+	if c.ipamSynchronizer != nil {
+		syncedVM, err = c.ipamSynchronizer.Sync(vm, vmi)
+		if err != nil {
+			return vm, vmi, handleSynchronizerErr(err), nil
+		}
+		if !equality.Semantic.DeepEqual(vm.Status, syncedVM.Status) {
+			return syncedVM, vmi, nil, nil
+		}
+	}
+
 	// eventually, would like the condition to be `== "true"`, but for now we need to support legacy behavior by default
 	if vm.Annotations[virtv1.ImmediateDataVolumeCreation] != "false" {
 		dataVolumesReady, err := c.handleDataVolumes(vm)
@@ -3292,6 +3566,14 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling memory hotplug requests: %v", err), hotplugMemoryErrorReason), nil
 		}
 
+		if err := c.handleIOTuneChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling I/O limits change request: %v", err), ioTuneChangeErrorReason), nil
+		}
+
+		if err := c.handleResourceLimitsChangeRequest(vmCopy, vmi, startVMSpec); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling resource limits change request: %v", err), resourceLimitsChangeErrorReason), nil
+		}
+
 		if isWaitAsReceiverRunStrategy(vm) {
 			if err := c.handleWaitAsReceiverVolumeInfo(vmCopy, vmi); err != nil {
 				return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling wait as receiver volume migration requests: %v", err), volumesUpdateErrorReason), nil
@@ -3454,8 +3736,6 @@ func (c *Controller) handleMemoryHotplugRequest(vm *virtv1.VirtualMachine, vmi *
 		// this is necessary as weirdness can arise after hot-unplugs as not all memory is guaranteed to be released when doing hot-unplug.
 		if newMemoryReq.Cmp(*vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest) == -1 {
 			newMemoryReq = *vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest
-			// adjusting memoryDelta too for the new limits computation (if required)
-			memoryDelta = resource.NewQuantity(vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest.Value()-newMemoryReq.Value(), resource.BinarySI)
 		}
 
 		patchSet.AddOption(
@@ -3466,8 +3746,8 @@ func (c *Controller) handleMemoryHotplugRequest(vm *virtv1.VirtualMachine, vmi *
 	}
 
 	if !vmCopyWithInstancetype.Spec.Template.Spec.Domain.Resources.Limits.Memory().IsZero() {
-		newMemoryLimit := vmi.Spec.Domain.Resources.Limits.Memory().DeepCopy()
-		newMemoryLimit.Add(*memoryDelta)
+		newMemoryLimit := memoryLimitWithHotplug(*vmCopyWithInstancetype.Spec.Template.Spec.Domain.Resources.Limits.Memory(),
+			vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest, vmi)
 
 		patchSet.AddOption(
 			patch.WithTest("/spec/domain/resources/limits/memory", vmi.Spec.Domain.Resources.Limits.Memory().String()),
@@ -152,6 +152,8 @@ var _ = Describe("VirtualMachine", func() {
 				config,
 				nil,
 				nil,
+				nil,
+				nil,
 				instancetypecontroller.NewControllerStub(),
 				[]string{},
 				[]string{},
@@ -5214,6 +5216,88 @@ var _ = Describe("VirtualMachine", func() {
 			),
 		)
 
+		It("should not create the VMI before the MAC pool synchronizer persists the allocated addresses", func() {
+			vm, _ := watchtesting.DefaultVirtualMachine(true)
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default"}}
+			vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			addVirtualMachine(vm)
+			controller.macPoolSynchronizer = macAllocatingSynchronizer{mac: "02:00:00:00:00:01"}
+
+			sanityExecute(vm)
+
+			_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		})
+
+		It("should create the VMI once the MAC pool synchronizer has no addresses to allocate", func() {
+			vm, _ := watchtesting.DefaultVirtualMachine(true)
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", MacAddress: "02:00:00:00:00:01"}}
+			vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			addVirtualMachine(vm)
+			controller.macPoolSynchronizer = macAllocatingSynchronizer{mac: "02:00:00:00:00:02"}
+
+			sanityExecute(vm)
+
+			vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:00:00:00:01"))
+		})
+
+		It("should not create the VMI before the IPAM synchronizer persists the allocated addresses", func() {
+			vm, _ := watchtesting.DefaultVirtualMachine(true)
+			vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			addVirtualMachine(vm)
+			controller.ipamSynchronizer = ipAllocatingSynchronizer{allocation: v1.VirtualMachineIPAllocation{
+				Network: "blue", NetworkAttachmentDefinition: "default/blue", IPAddresses: []string{"10.10.0.1/24"},
+			}}
+
+			sanityExecute(vm)
+
+			_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+			updatedVM, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVM.Status.IPAllocations).To(HaveLen(1))
+		})
+
+		It("should create the VMI with the IP allocations of the VM", func() {
+			vm, _ := watchtesting.DefaultVirtualMachine(true)
+			allocation := v1.VirtualMachineIPAllocation{
+				Network: "blue", NetworkAttachmentDefinition: "default/blue", IPAddresses: []string{"10.10.0.1/24"},
+			}
+			vm.Status.IPAllocations = []v1.VirtualMachineIPAllocation{allocation}
+			vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			addVirtualMachine(vm)
+			controller.ipamSynchronizer = ipAllocatingSynchronizer{allocation: allocation}
+
+			sanityExecute(vm)
+
+			vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmi.Annotations).To(HaveKeyWithValue(v1.IPAllocationsAnnotation,
+				`[{"network":"blue","networkAttachmentDefinition":"default/blue","ipAddresses":["10.10.0.1/24"]}]`))
+		})
+
+		It("should not create the VMI with IP allocations set on the VM template", func() {
+			vm, _ := watchtesting.DefaultVirtualMachine(true)
+			vm.Spec.Template.ObjectMeta.Annotations = map[string]string{
+				v1.IPAllocationsAnnotation: `[{"network":"blue","networkAttachmentDefinition":"default/blue","ipAddresses":["10.10.0.1/24"]}]`,
+			}
+			vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			addVirtualMachine(vm)
+
+			sanityExecute(vm)
+
+			vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmi.Annotations).ToNot(HaveKey(v1.IPAllocationsAnnotation))
+		})
+
 		It("should add a missing volume disk", func() {
 			vm, _ := watchtesting.DefaultVirtualMachine(true)
 			presentVolumeName := "present-vol"
@@ -5524,6 +5608,11 @@ var _ = Describe("VirtualMachine", func() {
 						expectedMemReq := resources.Requests.Memory().Value() + newMemory.Value() - guestMemory.Value()
 						Expect(vmi.Spec.Domain.Resources.Requests.Memory().Value()).To(Equal(expectedMemReq))
 					}
+
+					if !resources.Limits.Memory().IsZero() {
+						expectedMemLimit := resources.Limits.Memory().Value() + newMemory.Value() - guestMemory.Value()
+						Expect(vmi.Spec.Domain.Resources.Limits.Memory().Value()).To(Equal(expectedMemLimit))
+					}
 				},
 					Entry("with memory request set", v1.ResourceRequirements{
 						Requests: k8sv1.ResourceList{
@@ -5924,6 +6013,206 @@ var _ = Describe("VirtualMachine", func() {
 				)
 			})
 
+			Context("I/O limits", func() {
+				DescribeTable("should be live-updated", func(existingIOTune, updatedIOTune *v1.DiskIOTune, existingIOWeight, updatedIOWeight *uint32) {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+
+					vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0", IOTune: updatedIOTune}}
+					vm.Spec.Template.Spec.Domain.Resources.IOWeight = updatedIOWeight
+					vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0", IOTune: existingIOTune}}
+					vmi.Spec.Domain.Resources.IOWeight = existingIOWeight
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+
+					By("Expecting to see the updated VMI with the new I/O limits")
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.Disks[0].IOTune).To(Equal(updatedIOTune))
+					Expect(vmi.Spec.Domain.Resources.IOWeight).To(Equal(updatedIOWeight))
+				},
+					Entry("when adding limits",
+						nil,
+						&v1.DiskIOTune{IOPS: &v1.DiskIOLimits{Total: pointer.P(uint64(100))}},
+						nil,
+						pointer.P(uint32(200)),
+					),
+					Entry("when changing limits",
+						&v1.DiskIOTune{IOPS: &v1.DiskIOLimits{Total: pointer.P(uint64(100))}},
+						&v1.DiskIOTune{IOPS: &v1.DiskIOLimits{Total: pointer.P(uint64(200))}},
+						pointer.P(uint32(200)),
+						pointer.P(uint32(300)),
+					),
+					Entry("when removing limits",
+						&v1.DiskIOTune{IOPS: &v1.DiskIOLimits{Total: pointer.P(uint64(100))}},
+						nil,
+						pointer.P(uint32(200)),
+						nil,
+					),
+				)
+
+				It("should not require a restart", func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0"}}
+					lastSeenVMSpec := vm.Spec.DeepCopy()
+					vm.Spec.Template.Spec.Domain.Devices.Disks[0].IOTune = &v1.DiskIOTune{
+						Bytes: &v1.DiskIOLimits{Read: pointer.P(uint64(1024))},
+					}
+					vm.Spec.Template.Spec.Domain.Resources.IOWeight = pointer.P(uint32(500))
+
+					Expect(controller.syncRestartRequired(lastSeenVMSpec, vm, vmi)).To(BeFalse())
+				})
+			})
+
+			Context("Resource limits", func() {
+				BeforeEach(func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+				})
+
+				DescribeTable("should require a restart only when the limits can't be applied live", func(bootLimits, newLimits k8sv1.ResourceList, memoryOverheadReported, expectRestart bool) {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Resources.Limits = bootLimits
+					lastSeenVMSpec := vm.Spec.DeepCopy()
+					vm.Spec.Template.Spec.Domain.Resources.Limits = newLimits
+					if memoryOverheadReported {
+						vmi.Status.Memory = &v1.MemoryStatus{MemoryOverhead: pointer.P(resource.MustParse("200Mi"))}
+					}
+
+					Expect(controller.syncRestartRequired(lastSeenVMSpec, vm, vmi)).To(Equal(expectRestart))
+				},
+					Entry("when lowering the CPU limit",
+						k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("2")},
+						k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("1500m")},
+						false, false),
+					Entry("when adding a CPU limit",
+						nil,
+						k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("1")},
+						false, false),
+					Entry("when raising the CPU limit above the initial one",
+						k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("2")},
+						k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("3")},
+						false, true),
+					Entry("when removing the CPU limit",
+						k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("2")},
+						nil,
+						false, true),
+					Entry("when lowering the memory limit",
+						k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("2Gi")},
+						k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")},
+						true, false),
+					Entry("when lowering the memory limit without a reported memory overhead",
+						k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("2Gi")},
+						k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")},
+						false, true),
+				)
+
+				DescribeTable("with dedicated CPUs", func(bootDedicated, newDedicated, expectRestart bool) {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{Cores: 2, DedicatedCPUPlacement: bootDedicated}
+					lastSeenVMSpec := vm.Spec.DeepCopy()
+					vm.Spec.Template.Spec.Domain.CPU.DedicatedCPUPlacement = newDedicated
+
+					Expect(controller.syncRestartRequired(lastSeenVMSpec, vm, vmi)).To(Equal(expectRestart))
+				},
+					Entry("should require a restart to release them", true, false, true),
+					Entry("should require a restart to request them", false, true, true),
+				)
+
+				DescribeTable("should be live-updated", func(vmSpec *v1.VirtualMachineSpec, updateVM, updateVMI func(*v1.VirtualMachine, *v1.VirtualMachineInstance), expectedLimits k8sv1.ResourceList) {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec = *vmSpec.DeepCopy()
+					vmi.Spec = *vmSpec.Template.Spec.DeepCopy()
+					updateVM(vm, vmi)
+					updateVMI(vm, vmi)
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleResourceLimitsChangeRequest(vm, vmi, vmSpec)).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Resources.Limits.Cpu().Equal(*expectedLimits.Cpu())).To(BeTrue())
+					Expect(vmi.Spec.Domain.Resources.Limits.Memory().Equal(*expectedLimits.Memory())).To(BeTrue())
+				},
+					Entry("when lowering the limits",
+						&v1.VirtualMachineSpec{Template: &v1.VirtualMachineInstanceTemplateSpec{Spec: v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{
+							Resources: v1.ResourceRequirements{Limits: k8sv1.ResourceList{
+								k8sv1.ResourceCPU:    resource.MustParse("2"),
+								k8sv1.ResourceMemory: resource.MustParse("2Gi"),
+							}},
+						}}}},
+						func(vm *v1.VirtualMachine, _ *v1.VirtualMachineInstance) {
+							vm.Spec.Template.Spec.Domain.Resources.Limits = k8sv1.ResourceList{
+								k8sv1.ResourceCPU:    resource.MustParse("1"),
+								k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+							}
+						},
+						func(*v1.VirtualMachine, *v1.VirtualMachineInstance) {},
+						k8sv1.ResourceList{
+							k8sv1.ResourceCPU:    resource.MustParse("1"),
+							k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					),
+					Entry("when lowering the limits of a VMI with hotplugged CPUs",
+						&v1.VirtualMachineSpec{Template: &v1.VirtualMachineInstanceTemplateSpec{Spec: v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{
+							CPU:       &v1.CPU{Sockets: 1, Cores: 1, Threads: 1, MaxSockets: 4},
+							Resources: v1.ResourceRequirements{Limits: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("2")}},
+						}}}},
+						func(vm *v1.VirtualMachine, _ *v1.VirtualMachineInstance) {
+							vm.Spec.Template.Spec.Domain.CPU.Sockets = 2
+							vm.Spec.Template.Spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("1")}
+						},
+						func(_ *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
+							vmi.Spec.Domain.CPU.Sockets = 2
+							vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("2100m")}
+						},
+						k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("1100m")},
+					),
+				)
+
+				It("should not patch the VMI when the limits are unchanged", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("2")}
+					vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("2000m")}
+
+					Expect(controller.handleResourceLimitsChangeRequest(vm, vmi, vm.Spec.DeepCopy())).To(Succeed())
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				})
+			})
+
 			Context("Affinity", func() {
 				It("should be live-updated", func() {
 					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
@@ -7390,6 +7679,8 @@ var _ = Describe("VirtualMachine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 		})
 
@@ -7533,6 +7824,32 @@ func failVMSpecUpdate(virtFakeClient *fake.Clientset) {
 	})
 }
 
+// macAllocatingSynchronizer sets the MAC address of the VM interfaces which have none.
+type macAllocatingSynchronizer struct {
+	mac string
+}
+
+func (m macAllocatingSynchronizer) Sync(vm *v1.VirtualMachine, _ *v1.VirtualMachineInstance) (*v1.VirtualMachine, error) {
+	vmCopy := vm.DeepCopy()
+	for i := range vmCopy.Spec.Template.Spec.Domain.Devices.Interfaces {
+		if vmCopy.Spec.Template.Spec.Domain.Devices.Interfaces[i].MacAddress == "" {
+			vmCopy.Spec.Template.Spec.Domain.Devices.Interfaces[i].MacAddress = m.mac
+		}
+	}
+	return vmCopy, nil
+}
+
+// ipAllocatingSynchronizer sets the VM IP allocations to a single allocation.
+type ipAllocatingSynchronizer struct {
+	allocation v1.VirtualMachineIPAllocation
+}
+
+func (i ipAllocatingSynchronizer) Sync(vm *v1.VirtualMachine, _ *v1.VirtualMachineInstance) (*v1.VirtualMachine, error) {
+	vmCopy := vm.DeepCopy()
+	vmCopy.Status.IPAllocations = []v1.VirtualMachineIPAllocation{i.allocation}
+	return vmCopy, nil
+}
+
 type testSynchronizer struct {
 	err error
 }
@@ -102,7 +102,7 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 			return nil, pod
 		}
 		// let's check if we already have topology hints or if we are still waiting for them
-		if vmi.Status.TopologyHints == nil && c.topologyHinter.IsTscFrequencyRequired(vmi) {
+		if vmi.Status.TopologyHints == nil && (c.topologyHinter.IsTscFrequencyRequired(vmi) || topology.IsClusterBaselineCPUModel(vmi)) {
 			log.Log.V(3).Object(vmi).Infof("Delaying pod creation until topology hints are set")
 			return nil, pod
 		}
@@ -607,7 +607,8 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 
 func (c *Controller) addTopologyHints(vmi *virtv1.VirtualMachineInstance, vmiCopy *virtv1.VirtualMachineInstance) error {
 	if vmi.Status.TopologyHints == nil {
-		if topologyHints, tscRequirement, err := c.topologyHinter.TopologyHintsForVMI(vmi); err != nil && tscRequirement == topology.RequiredForBoot {
+		topologyHints, tscRequirement, err := c.topologyHinter.TopologyHintsForVMI(vmi)
+		if err != nil && (tscRequirement == topology.RequiredForBoot || (topology.IsClusterBaselineCPUModel(vmi) && topologyHints == nil)) {
 			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedGatherhingClusterTopologyHints, err.Error())
 			return common.NewSyncError(err, controller.FailedGatherhingClusterTopologyHints)
 		} else if topologyHints != nil {
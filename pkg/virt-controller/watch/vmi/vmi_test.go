@@ -4292,6 +4292,23 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				testutils.ExpectEvent(recorder, kvcontroller.SuccessfulCreatePodReason)
 				expectMatchingPodCreation(vmi)
 			})
+
+			It("should not happen if the cluster baseline CPU model can't be determined", func() {
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Spec.Domain.CPU = &virtv1.CPU{Model: virtv1.CPUModeClusterBaseline}
+				mockHinter := topology.NewMockHinter(gomock.NewController(GinkgoT()))
+				mockHinter.EXPECT().TopologyHintsForVMI(gomock.Any()).Return(nil, topology.NotRequired, fmt.Errorf("no schedulable nodes found")).AnyTimes()
+				mockHinter.EXPECT().IsTscFrequencyRequired(gomock.Any()).Return(false).AnyTimes()
+				controller.topologyHinter = mockHinter
+				addVirtualMachine(vmi)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, kvcontroller.FailedGatherhingClusterTopologyHints)
+				pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pods.Items).To(BeEmpty())
+			})
 		})
 
 		Context("decentralized live migration", func() {
@@ -73,6 +73,16 @@ type Manager interface {
 
 	// Get list of threads attached to cgroup
 	GetCgroupThreads() ([]int, error)
+
+	// SetIOWeight sets the default relative I/O weight of the pod cgroup the cgroup belongs to,
+	// against the other pods. Only cgroup v2 supports it, on v1 this is a no-op.
+	SetIOWeight(weight uint32) error
+	// SetCPULimit sets the CPU bandwidth of the cgroup to quota microseconds per period.
+	// A quota lower or equal to zero removes the limit.
+	SetCPULimit(quota int64, period uint64) error
+	// SetMemoryLimit sets the memory limit of the cgroup in bytes.
+	// A limit lower or equal to zero removes the limit.
+	SetMemoryLimit(limit int64) error
 }
 
 // This is here so that mockgen would create a mock out of it. That way we would have a mocked runc manager.
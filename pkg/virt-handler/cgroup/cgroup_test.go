@@ -22,6 +22,7 @@ package cgroup
 import (
 	"os"
 	"path"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -197,6 +198,112 @@ var _ = Describe("cgroup manager", func() {
 			},
 		),
 	)
+
+	Context("io weight", func() {
+		var podDirPath string
+
+		readIOWeight := func() string {
+			content, err := os.ReadFile(path.Join(podDirPath, "io.weight"))
+			Expect(err).ToNot(HaveOccurred())
+			return string(content)
+		}
+
+		BeforeEach(func() {
+			podDirPath = path.Join(GinkgoT().TempDir(), "kubepods-burstable-pod1234.slice")
+			v2DirPath = path.Join(podDirPath, "cri-containerd-1234.scope")
+			Expect(os.MkdirAll(v2DirPath, 0755)).To(Succeed())
+			runc_cgroups.TestMode = true
+			DeferCleanup(func() {
+				runc_cgroups.TestMode = false
+			})
+		})
+
+		It("should write the default weight on v2", func() {
+			Expect(os.WriteFile(path.Join(podDirPath, "io.weight"), []byte("default 100\n"), 0644)).To(Succeed())
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(manager.SetIOWeight(500)).To(Succeed())
+			Expect(readIOWeight()).To(Equal("default 500"))
+			Expect(path.Join(v2DirPath, "io.weight")).ToNot(BeAnExistingFile())
+		})
+
+		DescribeTable("should find the pod cgroup", func(dirPath, expected string) {
+			Expect(podCgroupDirPath(dirPath)).To(Equal(expected))
+		},
+			Entry("of a container cgroup", "/sys/fs/cgroup/kubepods.slice/pod.slice/cri-containerd-1.scope", "/sys/fs/cgroup/kubepods.slice/pod.slice"),
+			Entry("of a crun container cgroup", "/sys/fs/cgroup/kubepods.slice/pod.slice/crio-1.scope/container", "/sys/fs/cgroup/kubepods.slice/pod.slice"),
+		)
+
+		It("should fail on v2 when the io controller is not enabled", func() {
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(manager.SetIOWeight(500)).ToNot(Succeed())
+		})
+
+		It("should ignore the weight on v1", func() {
+			manager, err := newMockManager(V1)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(manager.SetIOWeight(500)).To(Succeed())
+		})
+	})
+
+	Context("cpu and memory limits", func() {
+		readFile := func(name string) string {
+			content, err := os.ReadFile(path.Join(v2DirPath, name))
+			Expect(err).ToNot(HaveOccurred())
+			return string(content)
+		}
+
+		writeFile := func(name, content string) {
+			Expect(os.WriteFile(path.Join(v2DirPath, name), []byte(content), 0644)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			v2DirPath = GinkgoT().TempDir()
+			runc_cgroups.TestMode = true
+			DeferCleanup(func() {
+				runc_cgroups.TestMode = false
+			})
+		})
+
+		DescribeTable("should write the cpu bandwidth on v2", func(quota int64, expected string) {
+			writeFile("cpu.max", "max 100000\n")
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(manager.SetCPULimit(quota, 100000)).To(Succeed())
+			Expect(strings.TrimSpace(readFile("cpu.max"))).To(Equal(expected))
+		},
+			Entry("with a quota", int64(150000), "150000 100000"),
+			Entry("without a quota", int64(0), "max 100000"),
+		)
+
+		It("should write the memory limit on v2", func() {
+			writeFile("memory.max", "max\n")
+			writeFile("memory.current", "1024\n")
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(manager.SetMemoryLimit(4096)).To(Succeed())
+			Expect(readFile("memory.max")).To(Equal("4096"))
+
+			Expect(manager.SetMemoryLimit(0)).To(Succeed())
+			Expect(readFile("memory.max")).To(Equal("max"))
+		})
+
+		It("should refuse to set the memory limit below the current usage on v2", func() {
+			writeFile("memory.max", "max\n")
+			writeFile("memory.current", "8192\n")
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(manager.SetMemoryLimit(4096)).To(MatchError(ContainSubstring("lower than the current usage")))
+			Expect(readFile("memory.max")).To(Equal("max\n"))
+		})
+	})
 })
 
 var _ = Describe("GetMiscCapacity", func() {
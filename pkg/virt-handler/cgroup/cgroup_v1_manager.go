@@ -224,3 +224,32 @@ func (v *v1Manager) GetCgroupThreads() ([]int, error) {
 func (v *v1Manager) SetCpuSet(subcgroup string, cpulist []int) error {
 	return setCpuSetHelper(v, subcgroup, cpulist)
 }
+
+func (v *v1Manager) SetIOWeight(_ uint32) error {
+	log.Log.V(4).Info("io weight is not supported on cgroup v1, ignoring")
+	return nil
+}
+
+func (v *v1Manager) SetCPULimit(quota int64, period uint64) error {
+	cpuPath, err := v.GetBasePathToHostSubsystem("cpu")
+	if err != nil {
+		return err
+	}
+
+	if quota <= 0 {
+		quota = -1
+	}
+	if err := writeFileIfChanged(cpuPath, "cpu.cfs_period_us", strconv.FormatUint(period, 10)); err != nil {
+		return err
+	}
+	return writeFileIfChanged(cpuPath, "cpu.cfs_quota_us", strconv.FormatInt(quota, 10))
+}
+
+func (v *v1Manager) SetMemoryLimit(limit int64) error {
+	memoryPath, err := v.GetBasePathToHostSubsystem("memory")
+	if err != nil {
+		return err
+	}
+
+	return setMemoryLimitHelper(memoryPath, "memory.limit_in_bytes", "memory.usage_in_bytes", "-1", limit)
+}
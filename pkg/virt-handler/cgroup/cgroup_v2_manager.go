@@ -21,6 +21,7 @@ package cgroup
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"path/filepath"
@@ -181,3 +182,42 @@ func (v *v2Manager) GetCgroupThreads() ([]int, error) {
 func (v *v2Manager) SetCpuSet(subcgroup string, cpulist []int) error {
 	return setCpuSetHelper(v, subcgroup, cpulist)
 }
+
+func (v *v2Manager) SetIOWeight(weight uint32) error {
+	wVal := fmt.Sprintf("default %d", weight)
+	podDirPath := podCgroupDirPath(v.dirPath)
+
+	current, err := runc_cgroups.ReadFile(podDirPath, "io.weight")
+	if err != nil {
+		return fmt.Errorf("failed to read io.weight, is the io controller enabled? %v", err)
+	}
+	if strings.TrimSpace(strings.SplitN(current, "\n", 2)[0]) == wVal {
+		return nil
+	}
+
+	return runc_cgroups.WriteFile(podDirPath, "io.weight", wVal)
+}
+
+// podCgroupDirPath returns the cgroup of the pod the container cgroup belongs to. The io weight is
+// only relative to the sibling cgroups, the cgroups of the other pods are siblings of the pod cgroup.
+func podCgroupDirPath(dirPath string) string {
+	containerDirPath := dirPath
+	if targetDir, parentPath := filepath.Base(dirPath), path.Dir(dirPath); targetDir == "container" && strings.HasSuffix(parentPath, ".scope") {
+		// crun based installations nest the container cgroup in the scope
+		containerDirPath = parentPath
+	}
+	return path.Dir(containerDirPath)
+}
+
+func (v *v2Manager) SetCPULimit(quota int64, period uint64) error {
+	wVal := fmt.Sprintf("max %d", period)
+	if quota > 0 {
+		wVal = fmt.Sprintf("%d %d", quota, period)
+	}
+
+	return writeFileIfChanged(v.dirPath, "cpu.max", wVal)
+}
+
+func (v *v2Manager) SetMemoryLimit(limit int64) error {
+	return setMemoryLimitHelper(v.dirPath, "memory.max", "memory.current", "max", limit)
+}
@@ -142,6 +142,20 @@ func (mr *MockManagerMockRecorder) Set(r any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockManager)(nil).Set), r)
 }
 
+// SetCPULimit mocks base method.
+func (m *MockManager) SetCPULimit(quota int64, period uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCPULimit", quota, period)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCPULimit indicates an expected call of SetCPULimit.
+func (mr *MockManagerMockRecorder) SetCPULimit(quota, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCPULimit", reflect.TypeOf((*MockManager)(nil).SetCPULimit), quota, period)
+}
+
 // SetCpuSet mocks base method.
 func (m *MockManager) SetCpuSet(subcgroup string, cpulist []int) error {
 	m.ctrl.T.Helper()
@@ -156,6 +170,34 @@ func (mr *MockManagerMockRecorder) SetCpuSet(subcgroup, cpulist any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCpuSet", reflect.TypeOf((*MockManager)(nil).SetCpuSet), subcgroup, cpulist)
 }
 
+// SetIOWeight mocks base method.
+func (m *MockManager) SetIOWeight(weight uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIOWeight", weight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIOWeight indicates an expected call of SetIOWeight.
+func (mr *MockManagerMockRecorder) SetIOWeight(weight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIOWeight", reflect.TypeOf((*MockManager)(nil).SetIOWeight), weight)
+}
+
+// SetMemoryLimit mocks base method.
+func (m *MockManager) SetMemoryLimit(limit int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemoryLimit", limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemoryLimit indicates an expected call of SetMemoryLimit.
+func (mr *MockManagerMockRecorder) SetMemoryLimit(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemoryLimit", reflect.TypeOf((*MockManager)(nil).SetMemoryLimit), limit)
+}
+
 // MockruncManager is a mock of runcManager interface.
 type MockruncManager struct {
 	ctrl     *gomock.Controller
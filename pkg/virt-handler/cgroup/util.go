@@ -384,3 +384,48 @@ func setCpuSetHelper(manager Manager, subCgroup string, cpusList []int) error {
 
 	return runc_cgroups.WriteFile(subSysPath, "cpuset.cpus", wVal)
 }
+
+// writeFileIfChanged writes value to the cgroup file unless it already holds it.
+func writeFileIfChanged(dir, file, value string) error {
+	current, err := runc_cgroups.ReadFile(dir, file)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(current) == value {
+		return nil
+	}
+	return runc_cgroups.WriteFile(dir, file, value)
+}
+
+// setMemoryLimitHelper sets the memory limit of the cgroup. Lowering the limit below the
+// current usage is refused, since the kernel would reclaim until the guest gets OOM-killed.
+func setMemoryLimitHelper(dir, limitFile, usageFile, unlimited string, limit int64) error {
+	value := unlimited
+	if limit > 0 {
+		value = strconv.FormatInt(limit, 10)
+	}
+
+	current, err := runc_cgroups.ReadFile(dir, limitFile)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(current) == value {
+		return nil
+	}
+
+	if limit > 0 {
+		usageStr, err := runc_cgroups.ReadFile(dir, usageFile)
+		if err != nil {
+			return err
+		}
+		usage, err := strconv.ParseInt(strings.TrimSpace(usageStr), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %v", usageFile, err)
+		}
+		if usage > limit {
+			return fmt.Errorf("memory limit %d is lower than the current usage %d", limit, usage)
+		}
+	}
+
+	return runc_cgroups.WriteFile(dir, limitFile, value)
+}
@@ -100,8 +100,15 @@ type LauncherClient interface {
 	GetUsers() (v1.VirtualMachineInstanceGuestOSUserList, error)
 	GetFilesystems() (v1.VirtualMachineInstanceFileSystemList, error)
 	Exec(string, string, []string, int32) (int, string, error)
+	GuestExec(domainName, command string, args []string, timeoutSeconds int32, maxOutputBytes int64) (int, string, error)
+	GuestFileOpen(domainName, path string, write bool) (*cmdv1.GuestFileOpenResponse, error)
+	GuestFileRead(domainName string, handle, length int64) (*cmdv1.GuestFileReadResponse, error)
+	GuestFileWrite(domainName string, handle int64, data []byte) error
+	GuestFileClose(domainName string, handle int64) error
+	GuestUser(domainName string, opts *v1.VirtualMachineInstanceGuestUserOptions) error
 	Ping() error
 	GuestPing(string, int32) error
+	SyncGuestTime(string, int32) (time.Duration, error)
 	Close()
 	VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	GetQemuVersion() (string, error)
@@ -638,11 +645,17 @@ func (c *VirtLauncherClient) GetFilesystems() (v1.VirtualMachineInstanceFileSyst
 
 // Exec the command with args on the guest and return the resulting status code, stdOut and error
 func (c *VirtLauncherClient) Exec(domainName, command string, args []string, timeoutSeconds int32) (int, string, error) {
+	return c.GuestExec(domainName, command, args, timeoutSeconds, 0)
+}
+
+// GuestExec runs the command like Exec, with at most maxOutputBytes of its output read; 0 means no limit
+func (c *VirtLauncherClient) GuestExec(domainName, command string, args []string, timeoutSeconds int32, maxOutputBytes int64) (int, string, error) {
 	request := &cmdv1.ExecRequest{
 		DomainName:     domainName,
 		Command:        command,
 		Args:           args,
 		TimeoutSeconds: timeoutSeconds,
+		MaxOutputBytes: maxOutputBytes,
 	}
 	exitCode := -1
 	stdOut := ""
@@ -666,6 +679,83 @@ func (c *VirtLauncherClient) Exec(domainName, command string, args []string, tim
 	return exitCode, stdOut, err
 }
 
+// GuestFileOpen opens the guest file at path for reading, or for writing, in which case it is
+// created or truncated. The size of a file opened for reading is returned with its handle.
+func (c *VirtLauncherClient) GuestFileOpen(domainName, path string, write bool) (*cmdv1.GuestFileOpenResponse, error) {
+	request := &cmdv1.GuestFileOpenRequest{
+		DomainName: domainName,
+		Path:       path,
+		Write:      write,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	resp, err := c.v1client.GuestFileOpen(ctx, request)
+	if err = handleError(err, "GuestFileOpen", resp.GetResponse()); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GuestFileRead reads at most length bytes of the opened guest file, from its current position
+func (c *VirtLauncherClient) GuestFileRead(domainName string, handle, length int64) (*cmdv1.GuestFileReadResponse, error) {
+	request := &cmdv1.GuestFileReadRequest{
+		DomainName: domainName,
+		Handle:     handle,
+		Length:     length,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	resp, err := c.v1client.GuestFileRead(ctx, request)
+	if err = handleError(err, "GuestFileRead", resp.GetResponse()); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GuestFileWrite writes data to the opened guest file, at its current position
+func (c *VirtLauncherClient) GuestFileWrite(domainName string, handle int64, data []byte) error {
+	request := &cmdv1.GuestFileWriteRequest{
+		DomainName: domainName,
+		Handle:     handle,
+		Data:       data,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	resp, err := c.v1client.GuestFileWrite(ctx, request)
+	return handleError(err, "GuestFileWrite", resp)
+}
+
+// GuestFileClose closes the opened guest file
+func (c *VirtLauncherClient) GuestFileClose(domainName string, handle int64) error {
+	request := &cmdv1.GuestFileCloseRequest{
+		DomainName: domainName,
+		Handle:     handle,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	resp, err := c.v1client.GuestFileClose(ctx, request)
+	return handleError(err, "GuestFileClose", resp)
+}
+
+// GuestUser creates, locks or unlocks a guest user, or sets its password
+func (c *VirtLauncherClient) GuestUser(domainName string, opts *v1.VirtualMachineInstanceGuestUserOptions) error {
+	request := &cmdv1.GuestUserRequest{
+		DomainName: domainName,
+		Action:     string(opts.Action),
+		Username:   opts.Username,
+		Password:   opts.Password,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	resp, err := c.v1client.GuestUser(ctx, request)
+	return handleError(err, "GuestUser", resp)
+}
+
 func (c *VirtLauncherClient) GuestPing(domainName string, timeoutSeconds int32) error {
 	request := &cmdv1.GuestPingRequest{
 		DomainName:     domainName,
@@ -683,6 +773,21 @@ func (c *VirtLauncherClient) GuestPing(domainName string, timeoutSeconds int32)
 	return err
 }
 
+func (c *VirtLauncherClient) SyncGuestTime(domainName string, timeoutSeconds int32) (time.Duration, error) {
+	request := &cmdv1.GuestTimeSyncRequest{
+		DomainName:     domainName,
+		TimeoutSeconds: timeoutSeconds,
+	}
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		time.Duration(timeoutSeconds)*time.Second+shortTimeout,
+	)
+	defer cancel()
+
+	resp, err := c.v1client.SyncGuestTime(ctx, request)
+	return time.Duration(resp.GetDriftNanoseconds()), handleError(err, "SyncGuestTime", resp.GetResponse())
+}
+
 func (c *VirtLauncherClient) GetScreenshot(vmi *v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error) {
 	vmiJson, err := json.Marshal(vmi)
 	if err != nil {
@@ -219,6 +219,71 @@ var _ = Describe("Virt remote commands", func() {
 				err := client.GuestPing(testDomainName, testTimeoutSeconds)
 				Expect(err).ToNot(HaveOccurred())
 			})
+			It("calls cmdclient.GuestFileOpen", func() {
+				mockCmdClient.EXPECT().GuestFileOpen(gomock.Any(), &cmdv1.GuestFileOpenRequest{
+					DomainName: testDomainName,
+					Path:       "/tmp/file",
+				}).Return(&cmdv1.GuestFileOpenResponse{Response: &cmdv1.Response{Success: true}, Handle: 3, Size: 8}, nil)
+				resp, err := client.GuestFileOpen(testDomainName, "/tmp/file", false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Handle).To(Equal(int64(3)))
+				Expect(resp.Size).To(Equal(int64(8)))
+			})
+			It("returns the guest file open failures", func() {
+				mockCmdClient.EXPECT().GuestFileOpen(gomock.Any(), gomock.Any()).
+					Return(&cmdv1.GuestFileOpenResponse{Response: &cmdv1.Response{Message: "no such file"}}, nil)
+				_, err := client.GuestFileOpen(testDomainName, "/tmp/file", false)
+				Expect(err).To(MatchError(ContainSubstring("no such file")))
+			})
+			It("calls cmdclient.GuestFileRead", func() {
+				mockCmdClient.EXPECT().GuestFileRead(gomock.Any(), &cmdv1.GuestFileReadRequest{
+					DomainName: testDomainName,
+					Handle:     3,
+					Length:     8,
+				}).Return(&cmdv1.GuestFileReadResponse{Response: &cmdv1.Response{Success: true}, Data: []byte("data"), Eof: true}, nil)
+				resp, err := client.GuestFileRead(testDomainName, 3, 8)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Data).To(Equal([]byte("data")))
+			})
+			It("calls cmdclient.GuestFileWrite", func() {
+				mockCmdClient.EXPECT().GuestFileWrite(gomock.Any(), &cmdv1.GuestFileWriteRequest{
+					DomainName: testDomainName,
+					Handle:     3,
+					Data:       []byte("data"),
+				}).Return(&cmdv1.Response{Success: true}, nil)
+				Expect(client.GuestFileWrite(testDomainName, 3, []byte("data"))).To(Succeed())
+			})
+			It("returns the guest file write failures", func() {
+				mockCmdClient.EXPECT().GuestFileWrite(gomock.Any(), gomock.Any()).Return(nil, testClientErr)
+				Expect(client.GuestFileWrite(testDomainName, 3, []byte("data"))).To(MatchError(ContainSubstring("client error")))
+			})
+			It("calls cmdclient.GuestFileClose", func() {
+				mockCmdClient.EXPECT().GuestFileClose(gomock.Any(), &cmdv1.GuestFileCloseRequest{
+					DomainName: testDomainName,
+					Handle:     3,
+				}).Return(&cmdv1.Response{Success: true}, nil)
+				Expect(client.GuestFileClose(testDomainName, 3)).To(Succeed())
+			})
+			It("calls cmdclient.GuestUser", func() {
+				mockCmdClient.EXPECT().GuestUser(gomock.Any(), &cmdv1.GuestUserRequest{
+					DomainName: testDomainName,
+					Action:     string(v1.GuestUserSetPassword),
+					Username:   "Administrator",
+					Password:   "secret",
+				}).Return(&cmdv1.Response{Success: true}, nil)
+				Expect(client.GuestUser(testDomainName, &v1.VirtualMachineInstanceGuestUserOptions{
+					Action:   v1.GuestUserSetPassword,
+					Username: "Administrator",
+					Password: "secret",
+				})).To(Succeed())
+			})
+			It("returns the guest user failures", func() {
+				mockCmdClient.EXPECT().GuestUser(gomock.Any(), gomock.Any()).Return(&cmdv1.Response{Message: "user does not exist"}, nil)
+				Expect(client.GuestUser(testDomainName, &v1.VirtualMachineInstanceGuestUserOptions{
+					Action:   v1.GuestUserLock,
+					Username: "jdoe",
+				})).To(MatchError(ContainSubstring("user does not exist")))
+			})
 		})
 	})
 })
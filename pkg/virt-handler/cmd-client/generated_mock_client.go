@@ -11,6 +11,7 @@ package cmdclient
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 	v1alpha1 "kubevirt.io/api/backup/v1alpha1"
@@ -281,6 +282,94 @@ func (mr *MockLauncherClientMockRecorder) GetUsers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockLauncherClient)(nil).GetUsers))
 }
 
+// GuestExec mocks base method.
+func (m *MockLauncherClient) GuestExec(domainName, command string, args []string, timeoutSeconds int32, maxOutputBytes int64) (int, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExec", domainName, command, args, timeoutSeconds, maxOutputBytes)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GuestExec indicates an expected call of GuestExec.
+func (mr *MockLauncherClientMockRecorder) GuestExec(domainName, command, args, timeoutSeconds, maxOutputBytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExec", reflect.TypeOf((*MockLauncherClient)(nil).GuestExec), domainName, command, args, timeoutSeconds, maxOutputBytes)
+}
+
+// GuestFileClose mocks base method.
+func (m *MockLauncherClient) GuestFileClose(domainName string, handle int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileClose", domainName, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileClose indicates an expected call of GuestFileClose.
+func (mr *MockLauncherClientMockRecorder) GuestFileClose(domainName, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileClose", reflect.TypeOf((*MockLauncherClient)(nil).GuestFileClose), domainName, handle)
+}
+
+// GuestFileOpen mocks base method.
+func (m *MockLauncherClient) GuestFileOpen(domainName, path string, write bool) (*v10.GuestFileOpenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileOpen", domainName, path, write)
+	ret0, _ := ret[0].(*v10.GuestFileOpenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileOpen indicates an expected call of GuestFileOpen.
+func (mr *MockLauncherClientMockRecorder) GuestFileOpen(domainName, path, write any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileOpen", reflect.TypeOf((*MockLauncherClient)(nil).GuestFileOpen), domainName, path, write)
+}
+
+// GuestFileRead mocks base method.
+func (m *MockLauncherClient) GuestFileRead(domainName string, handle, length int64) (*v10.GuestFileReadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", domainName, handle, length)
+	ret0, _ := ret[0].(*v10.GuestFileReadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockLauncherClientMockRecorder) GuestFileRead(domainName, handle, length any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockLauncherClient)(nil).GuestFileRead), domainName, handle, length)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockLauncherClient) GuestFileWrite(domainName string, handle int64, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", domainName, handle, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockLauncherClientMockRecorder) GuestFileWrite(domainName, handle, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockLauncherClient)(nil).GuestFileWrite), domainName, handle, data)
+}
+
+// GuestUser mocks base method.
+func (m *MockLauncherClient) GuestUser(domainName string, opts *v1.VirtualMachineInstanceGuestUserOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestUser", domainName, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestUser indicates an expected call of GuestUser.
+func (mr *MockLauncherClientMockRecorder) GuestUser(domainName, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestUser", reflect.TypeOf((*MockLauncherClient)(nil).GuestUser), domainName, opts)
+}
+
 // GuestPing mocks base method.
 func (m *MockLauncherClient) GuestPing(arg0 string, arg1 int32) error {
 	m.ctrl.T.Helper()
@@ -450,6 +539,21 @@ func (mr *MockLauncherClientMockRecorder) SoftRebootVirtualMachine(vmi any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftRebootVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).SoftRebootVirtualMachine), vmi)
 }
 
+// SyncGuestTime mocks base method.
+func (m *MockLauncherClient) SyncGuestTime(arg0 string, arg1 int32) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncGuestTime", arg0, arg1)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncGuestTime indicates an expected call of SyncGuestTime.
+func (mr *MockLauncherClientMockRecorder) SyncGuestTime(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncGuestTime", reflect.TypeOf((*MockLauncherClient)(nil).SyncGuestTime), arg0, arg1)
+}
+
 // SyncMigrationTarget mocks base method.
 func (m *MockLauncherClient) SyncMigrationTarget(vmi *v1.VirtualMachineInstance, options *v10.VirtualMachineOptions) error {
 	m.ctrl.T.Helper()
@@ -85,6 +85,8 @@ const (
 	VMIGracefulShutdown = "Signaled Graceful Shutdown"
 	//VMISignalDeletion is the reason set when the VMI has signal deletion
 	VMISignalDeletion = "Signaled Deletion"
+	//DomainPatchSkippedReason is the reason set when a domain patch recorded on the VMI is no longer in the cluster config
+	DomainPatchSkippedReason = "DomainPatchSkipped"
 
 	// MemoryHotplugFailedReason is the reason set when the VM cannot hotplug memory
 	memoryHotplugFailedReason = "Memory Hotplug Failed"
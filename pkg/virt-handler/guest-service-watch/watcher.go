@@ -0,0 +1,348 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package guestservicewatch periodically checks, through the guest agent, the
+// state of the services listed in spec.guestServiceWatch of the VMIs running
+// on the node.
+package guestservicewatch
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/flowcontrol"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	guestservice "kubevirt.io/kubevirt/pkg/guest-service"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	// MaxServicesPerNode caps the services watched by a virt-handler, and thus the
+	// series of the kubevirt_vmi_guest_service_up metric it exports
+	MaxServicesPerNode = 1000
+
+	// ReasonServicesUp is the reason of the GuestServicesUp condition when all the services run
+	ReasonServicesUp = "ServicesUp"
+	// ReasonServicesDown is the reason of the GuestServicesUp condition when a service does not run
+	ReasonServicesDown = "ServicesDown"
+	// ReasonAgentNotConnected is the reason of the GuestServicesUp condition when the services can not be checked
+	ReasonAgentNotConnected = "AgentNotConnected"
+	// ReasonWatchLimitReached is the reason of the GuestServicesUp condition when the node watches too many services
+	ReasonWatchLimitReached = "WatchLimitReached"
+
+	// checksPerSecond and checksBurst limit the commands a virt-handler runs through the guest agents
+	checksPerSecond    = 5
+	checksBurst        = 20
+	tickInterval       = 5 * time.Second
+	execTimeoutSeconds = 5
+)
+
+type serviceState struct {
+	checked time.Time
+	up      bool
+	message string
+}
+
+type vmiState struct {
+	key               string
+	limited           bool
+	agentDisconnected bool
+	services          map[string]*serviceState
+}
+
+type Watcher struct {
+	host            string
+	vmiStore        cache.Store
+	launcherClients launcherclients.LauncherClientsManager
+	clusterConfig   *virtconfig.ClusterConfig
+	enqueue         func(key string)
+	limiter         flowcontrol.RateLimiter
+	now             func() time.Time
+
+	lock            sync.Mutex
+	vmis            map[types.UID]*vmiState
+	watchedServices int
+}
+
+// NewWatcher returns a watcher of the guest services of the VMIs in vmiStore.
+// enqueue is called with the key of a VMI whose GuestServicesUp condition changes.
+func NewWatcher(
+	host string,
+	vmiStore cache.Store,
+	launcherClients launcherclients.LauncherClientsManager,
+	clusterConfig *virtconfig.ClusterConfig,
+	enqueue func(key string),
+) *Watcher {
+	return &Watcher{
+		host:            host,
+		vmiStore:        vmiStore,
+		launcherClients: launcherClients,
+		clusterConfig:   clusterConfig,
+		enqueue:         enqueue,
+		limiter:         flowcontrol.NewTokenBucketRateLimiter(checksPerSecond, checksBurst),
+		now:             time.Now,
+		vmis:            map[types.UID]*vmiState{},
+	}
+}
+
+func (w *Watcher) Run(stopCh <-chan struct{}) {
+	wait.Until(w.Check, tickInterval, stopCh)
+}
+
+// Check runs the checks which are due and forgets the VMIs whose services are no
+// longer watched. Checks refused by the rate limiter are run on the next call.
+func (w *Watcher) Check() {
+	watched := map[types.UID]*v1.VirtualMachineInstance{}
+	if w.clusterConfig.GuestServiceWatchEnabled() {
+		for _, obj := range w.vmiStore.List() {
+			vmi, ok := obj.(*v1.VirtualMachineInstance)
+			if ok && w.isWatched(vmi) {
+				watched[vmi.UID] = vmi
+			}
+		}
+	}
+
+	w.forget(watched)
+	for _, vmi := range watched {
+		w.checkVMI(vmi)
+	}
+}
+
+func (w *Watcher) isWatched(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.GuestServiceWatch != nil &&
+		len(vmi.Spec.GuestServiceWatch.Services) > 0 &&
+		vmi.IsRunning() &&
+		vmi.Status.NodeName == w.host
+}
+
+func (w *Watcher) forget(watched map[types.UID]*v1.VirtualMachineInstance) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for uid, state := range w.vmis {
+		if _, exists := watched[uid]; exists {
+			continue
+		}
+		w.release(state)
+		delete(w.vmis, uid)
+		w.enqueue(state.key)
+	}
+}
+
+// release stops exporting the metrics of a VMI and frees its share of MaxServicesPerNode
+func (w *Watcher) release(state *vmiState) {
+	namespace, name, _ := cache.SplitMetaNamespaceKey(state.key)
+	for service := range state.services {
+		metrics.DeleteGuestServiceUp(w.host, namespace, name, service)
+	}
+	w.watchedServices -= len(state.services)
+	state.services = nil
+}
+
+func (w *Watcher) checkVMI(vmi *v1.VirtualMachineInstance) {
+	due := w.dueServices(vmi)
+	if len(due) == 0 {
+		return
+	}
+
+	client, err := w.launcherClients.GetLauncherClient(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("Failed to get the launcher client to check guest services")
+		return
+	}
+
+	changed := false
+	for _, service := range due {
+		if !w.limiter.TryAccept() {
+			log.Log.Object(vmi).V(4).Info("Guest service checks are rate limited, continuing on the next tick")
+			break
+		}
+		command, args := guestservice.Command(vmi.Status.GuestOSInfo.ID, service)
+		exitCode, stdout, err := client.Exec(api.VMINamespaceKeyFunc(vmi), command, args, execTimeoutSeconds)
+
+		up, message := false, ""
+		if err != nil {
+			message = fmt.Sprintf("check failed: %v", err)
+		} else {
+			up = guestservice.IsRunning(vmi.Status.GuestOSInfo.ID, exitCode, stdout)
+		}
+		if w.record(vmi, service, up, message) {
+			changed = true
+		}
+	}
+	if changed {
+		w.enqueue(controller.VirtualMachineInstanceKey(vmi))
+	}
+}
+
+// dueServices updates the state of the VMI and returns the services whose period elapsed
+func (w *Watcher) dueServices(vmi *v1.VirtualMachineInstance) []string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	state, exists := w.vmis[vmi.UID]
+	if !exists {
+		state = &vmiState{key: controller.VirtualMachineInstanceKey(vmi), limited: true}
+		w.vmis[vmi.UID] = state
+	}
+	services := watchedServices(vmi)
+
+	if state.limited {
+		if w.watchedServices+len(services) > MaxServicesPerNode {
+			if !exists {
+				log.Log.Object(vmi).Warningf("Not watching guest services, the node already watches %d services", w.watchedServices)
+				w.enqueue(state.key)
+			}
+			return nil
+		}
+		state.limited = false
+		state.services = map[string]*serviceState{}
+		for _, service := range services {
+			state.services[service] = &serviceState{}
+		}
+		w.watchedServices += len(services)
+		w.enqueue(state.key)
+	}
+
+	agentConnected := controller.NewVirtualMachineInstanceConditionManager().
+		HasConditionWithStatus(vmi, v1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue)
+	if !agentConnected {
+		if !state.agentDisconnected {
+			// The services are checked again once the agent reconnects
+			for service, s := range state.services {
+				*s = serviceState{}
+				metrics.DeleteGuestServiceUp(w.host, vmi.Namespace, vmi.Name, service)
+			}
+			state.agentDisconnected = true
+			w.enqueue(state.key)
+		}
+		return nil
+	}
+	state.agentDisconnected = false
+
+	period := guestservice.Period(vmi.Spec.GuestServiceWatch)
+	now := w.now()
+	var due []string
+	for _, service := range services {
+		checked := state.services[service].checked
+		if checked.IsZero() || now.Sub(checked) >= period {
+			due = append(due, service)
+		}
+	}
+	return due
+}
+
+// record stores the result of a check and returns whether it differs from the previous one
+func (w *Watcher) record(vmi *v1.VirtualMachineInstance, service string, up bool, message string) bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	state, exists := w.vmis[vmi.UID]
+	if !exists || state.services[service] == nil {
+		return false
+	}
+	s := state.services[service]
+	changed := s.checked.IsZero() || s.up != up || s.message != message
+	s.checked = w.now()
+	s.up = up
+	s.message = message
+
+	metrics.SetGuestServiceUp(w.host, vmi.Namespace, vmi.Name, service, up)
+	return changed
+}
+
+// Condition returns the GuestServicesUp condition of the VMI, or nil when its
+// services are not watched or were not all checked yet.
+func (w *Watcher) Condition(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstanceCondition {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	state, exists := w.vmis[vmi.UID]
+	if !exists || vmi.Spec.GuestServiceWatch == nil {
+		return nil
+	}
+
+	switch {
+	case state.limited:
+		return &v1.VirtualMachineInstanceCondition{
+			Type:    v1.VirtualMachineInstanceGuestServicesUp,
+			Status:  k8sv1.ConditionUnknown,
+			Reason:  ReasonWatchLimitReached,
+			Message: fmt.Sprintf("Node %s already watches the maximum of %d guest services", w.host, MaxServicesPerNode),
+		}
+	case state.agentDisconnected:
+		return &v1.VirtualMachineInstanceCondition{
+			Type:    v1.VirtualMachineInstanceGuestServicesUp,
+			Status:  k8sv1.ConditionUnknown,
+			Reason:  ReasonAgentNotConnected,
+			Message: "Guest services can not be checked without a connected guest agent",
+		}
+	}
+
+	var down []string
+	for _, service := range watchedServices(vmi) {
+		s := state.services[service]
+		if s == nil || s.checked.IsZero() {
+			return nil
+		}
+		if s.up {
+			continue
+		}
+		if s.message != "" {
+			down = append(down, fmt.Sprintf("%s (%s)", service, s.message))
+		} else {
+			down = append(down, service)
+		}
+	}
+
+	if len(down) > 0 {
+		return &v1.VirtualMachineInstanceCondition{
+			Type:    v1.VirtualMachineInstanceGuestServicesUp,
+			Status:  k8sv1.ConditionFalse,
+			Reason:  ReasonServicesDown,
+			Message: "Guest services not running: " + strings.Join(down, ", "),
+		}
+	}
+	return &v1.VirtualMachineInstanceCondition{
+		Type:   v1.VirtualMachineInstanceGuestServicesUp,
+		Status: k8sv1.ConditionTrue,
+		Reason: ReasonServicesUp,
+	}
+}
+
+func watchedServices(vmi *v1.VirtualMachineInstance) []string {
+	services := vmi.Spec.GuestServiceWatch.Services
+	if len(services) > guestservice.MaxServices {
+		services = services[:guestservice.MaxServices]
+	}
+	return services
+}
@@ -0,0 +1,202 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestservicewatch
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/flowcontrol"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
+)
+
+var _ = Describe("Guest service watch", func() {
+	const (
+		host    = "node01"
+		domName = "default_testvmi"
+		vmiKey  = "default/testvmi"
+	)
+
+	var (
+		client    *cmdclient.MockLauncherClient
+		vmiStore  cache.Store
+		watcher   *Watcher
+		enqueued  []string
+		now       time.Time
+		vmi       *v1.VirtualMachineInstance
+		gateFlags []string
+	)
+
+	newWatcher := func() *Watcher {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: gateFlags},
+		})
+		ctrl := gomock.NewController(GinkgoT())
+		client = cmdclient.NewMockLauncherClient(ctrl)
+		clients := &launcherclients.MockLauncherClientManager{Client: client}
+
+		w := NewWatcher(host, vmiStore, clients, config, func(key string) { enqueued = append(enqueued, key) })
+		w.limiter = flowcontrol.NewFakeAlwaysRateLimiter()
+		w.now = func() time.Time { return now }
+		return w
+	}
+
+	BeforeEach(func() {
+		enqueued = nil
+		now = time.Now()
+		gateFlags = []string{featuregate.GuestServiceWatch}
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+
+		vmi = libvmi.New(libvmi.WithName("testvmi"), libvmi.WithNamespace("default"))
+		vmi.UID = "1234"
+		vmi.Spec.GuestServiceWatch = &v1.GuestServiceWatch{Services: []string{"sshd", "nginx"}}
+		vmi.Status.Phase = v1.Running
+		vmi.Status.NodeName = host
+		vmi.Status.GuestOSInfo.ID = "fedora"
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+			{Type: v1.VirtualMachineInstanceAgentConnected, Status: k8sv1.ConditionTrue},
+		}
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+
+		watcher = newWatcher()
+	})
+
+	expectCheck := func(service string, exitCode int, err error) {
+		client.EXPECT().
+			Exec(domName, "systemctl", []string{"is-active", "--quiet", service}, int32(execTimeoutSeconds)).
+			Return(exitCode, "", err)
+	}
+
+	It("should report all services up", func() {
+		expectCheck("sshd", 0, nil)
+		expectCheck("nginx", 0, nil)
+
+		watcher.Check()
+
+		condition := watcher.Condition(vmi)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(ReasonServicesUp))
+		Expect(enqueued).To(ContainElement(vmiKey))
+	})
+
+	It("should report the services which are not running", func() {
+		expectCheck("sshd", 0, nil)
+		expectCheck("nginx", 3, nil)
+
+		watcher.Check()
+
+		condition := watcher.Condition(vmi)
+		Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(ReasonServicesDown))
+		Expect(condition.Message).To(Equal("Guest services not running: nginx"))
+	})
+
+	It("should report failed checks as not running", func() {
+		expectCheck("sshd", 0, nil)
+		expectCheck("nginx", 0, errors.New("guest-exec is disabled"))
+
+		watcher.Check()
+
+		condition := watcher.Condition(vmi)
+		Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+		Expect(condition.Message).To(Equal("Guest services not running: nginx (check failed: guest-exec is disabled)"))
+	})
+
+	It("should only check the services again once the period elapsed", func() {
+		expectCheck("sshd", 0, nil)
+		expectCheck("nginx", 0, nil)
+		watcher.Check()
+
+		now = now.Add(30 * time.Second)
+		watcher.Check()
+
+		enqueued = nil
+		now = now.Add(30 * time.Second)
+		expectCheck("sshd", 0, nil)
+		expectCheck("nginx", 0, nil)
+		watcher.Check()
+		Expect(enqueued).To(BeEmpty(), "unchanged results should not trigger a sync")
+	})
+
+	It("should defer the checks refused by the rate limiter", func() {
+		watcher.limiter = flowcontrol.NewFakeNeverRateLimiter()
+
+		watcher.Check()
+
+		Expect(watcher.Condition(vmi)).To(BeNil())
+	})
+
+	It("should not check the services without a connected guest agent", func() {
+		vmi.Status.Conditions = nil
+
+		watcher.Check()
+
+		condition := watcher.Condition(vmi)
+		Expect(condition.Status).To(Equal(k8sv1.ConditionUnknown))
+		Expect(condition.Reason).To(Equal(ReasonAgentNotConnected))
+	})
+
+	It("should not watch more services than the node limit", func() {
+		watcher.watchedServices = MaxServicesPerNode - 1
+
+		watcher.Check()
+
+		condition := watcher.Condition(vmi)
+		Expect(condition.Status).To(Equal(k8sv1.ConditionUnknown))
+		Expect(condition.Reason).To(Equal(ReasonWatchLimitReached))
+		Expect(enqueued).To(ContainElement(vmiKey))
+	})
+
+	It("should forget VMIs which are gone", func() {
+		expectCheck("sshd", 0, nil)
+		expectCheck("nginx", 0, nil)
+		watcher.Check()
+
+		enqueued = nil
+		Expect(vmiStore.Delete(vmi)).To(Succeed())
+		watcher.Check()
+
+		Expect(watcher.Condition(vmi)).To(BeNil())
+		Expect(watcher.watchedServices).To(BeZero())
+		Expect(enqueued).To(ConsistOf(vmiKey))
+	})
+
+	It("should not check any service when the feature gate is disabled", func() {
+		gateFlags = nil
+		watcher = newWatcher()
+
+		watcher.Check()
+
+		Expect(watcher.Condition(vmi)).To(BeNil())
+	})
+})
@@ -0,0 +1,88 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guesttime
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	TriggerUnpause   = "unpause"
+	TriggerMigration = "migration"
+	TriggerStart     = "start"
+
+	// SyncedReason is the reason of the event recorded after the guest time was set
+	SyncedReason = "GuestTimeSynced"
+	// SyncFailedReason is the reason of the event recorded when the guest time could not be set
+	SyncFailedReason = "GuestTimeSyncFailed"
+
+	syncTimeoutSeconds = 10
+)
+
+func IsEnabled(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.Clock != nil && vmi.Spec.Domain.Clock.GuestTimeSync != nil
+}
+
+// Sync sets the guest time through the guest agent if requested in the VMI spec. The outcome is
+// recorded as an event on the VMI and the drift observed before the update is reported as a metric.
+// Failures are not returned since a guest without a responsive agent must not block the caller.
+func Sync(client cmdclient.LauncherClient, recorder record.EventRecorder, vmi *v1.VirtualMachineInstance, trigger string) {
+	if !IsEnabled(vmi) {
+		return
+	}
+
+	drift, err := client.SyncGuestTime(api.VMINamespaceKeyFunc(vmi), syncTimeoutSeconds)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warningf("failed to synchronise the guest time after %s", trigger)
+		recorder.Eventf(vmi, k8sv1.EventTypeWarning, SyncFailedReason, "Failed to synchronise the guest time after %s: %v", trigger, err)
+		return
+	}
+
+	metrics.ObserveGuestTimeDrift(trigger, drift)
+	recorder.Eventf(vmi, k8sv1.EventTypeNormal, SyncedReason, "Guest time synchronised after %s, observed drift %v", trigger, drift)
+}
+
+// SyncAsync runs Sync in the background so that the caller does not wait for the guest agent.
+// If release is not nil, it is called once the synchronisation has finished and can be used to
+// close a client owned by the caller.
+func SyncAsync(client cmdclient.LauncherClient, recorder record.EventRecorder, vmi *v1.VirtualMachineInstance, trigger string, release func()) {
+	if !IsEnabled(vmi) {
+		if release != nil {
+			release()
+		}
+		return
+	}
+
+	vmi = vmi.DeepCopy()
+	go func() {
+		if release != nil {
+			defer release()
+		}
+		Sync(client, recorder, vmi, trigger)
+	}()
+}
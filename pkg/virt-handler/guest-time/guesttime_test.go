@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guesttime
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+)
+
+var _ = Describe("Guest time synchronisation", func() {
+	var (
+		client   *cmdclient.MockLauncherClient
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		client = cmdclient.NewMockLauncherClient(gomock.NewController(GinkgoT()))
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	withGuestTimeSync := func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Clock = &v1.Clock{GuestTimeSync: &v1.GuestTimeSync{}}
+	}
+
+	It("should not do anything if guest time sync is not enabled", func() {
+		vmi := libvmi.New(libvmi.WithNamespace("default"), libvmi.WithName("testvmi"))
+		// no call to SyncGuestTime
+		Sync(client, recorder, vmi, TriggerUnpause)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should record the observed drift", func() {
+		vmi := libvmi.New(libvmi.WithNamespace("default"), libvmi.WithName("testvmi"))
+		withGuestTimeSync(vmi)
+
+		client.EXPECT().SyncGuestTime("default_testvmi", int32(syncTimeoutSeconds)).Return(-3*time.Second, nil)
+		Sync(client, recorder, vmi, TriggerMigration)
+		Expect(recorder.Events).To(Receive(Equal("Normal GuestTimeSynced Guest time synchronised after migration, observed drift -3s")))
+	})
+
+	It("should record a warning if the guest time could not be set", func() {
+		vmi := libvmi.New(libvmi.WithNamespace("default"), libvmi.WithName("testvmi"))
+		withGuestTimeSync(vmi)
+
+		client.EXPECT().SyncGuestTime("default_testvmi", int32(syncTimeoutSeconds)).Return(time.Duration(0), fmt.Errorf("guest agent is not connected"))
+		Sync(client, recorder, vmi, TriggerStart)
+		Expect(recorder.Events).To(Receive(Equal("Warning GuestTimeSyncFailed Failed to synchronise the guest time after start: guest agent is not connected")))
+	})
+
+	It("should synchronise in the background and release the client afterwards", func() {
+		vmi := libvmi.New(libvmi.WithNamespace("default"), libvmi.WithName("testvmi"))
+		withGuestTimeSync(vmi)
+
+		client.EXPECT().SyncGuestTime("default_testvmi", int32(syncTimeoutSeconds)).Return(time.Second, nil)
+		released := make(chan struct{})
+		SyncAsync(client, recorder, vmi, TriggerUnpause, func() { close(released) })
+		Eventually(released).Should(BeClosed())
+		Expect(recorder.Events).To(Receive(Equal("Normal GuestTimeSynced Guest time synchronised after unpause, observed drift 1s")))
+	})
+
+	It("should release the client right away if guest time sync is not enabled", func() {
+		vmi := libvmi.New(libvmi.WithNamespace("default"), libvmi.WithName("testvmi"))
+		released := false
+		SyncAsync(client, recorder, vmi, TriggerUnpause, func() { released = true })
+		Expect(released).To(BeTrue())
+	})
+})
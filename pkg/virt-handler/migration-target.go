@@ -29,6 +29,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"libvirt.org/go/libvirtxml"
@@ -38,6 +39,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -60,6 +62,7 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	containerdisk "kubevirt.io/kubevirt/pkg/virt-handler/container-disk"
+	guesttime "kubevirt.io/kubevirt/pkg/virt-handler/guest-time"
 	hotplugvolume "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
@@ -75,6 +78,10 @@ type passtRepairTargetHandler interface {
 	HandleMigrationTarget(*v1.VirtualMachineInstance, func(*v1.VirtualMachineInstance) (string, error)) error
 }
 
+type guestAddressAnnouncer interface {
+	Announce(vmi *v1.VirtualMachineInstance, launcherPID int) error
+}
+
 type MigrationTargetController struct {
 	*BaseController
 	capabilities                     *libvirtxml.Caps
@@ -84,6 +91,12 @@ type MigrationTargetController struct {
 	netBindingPluginMemoryCalculator netBindingPluginMemoryCalculator
 	netConf                          netconf
 	passtRepairHandler               passtRepairTargetHandler
+	guestAddressAnnouncer            guestAddressAnnouncer
+
+	// announcedMigrations holds the migration whose guest addresses were announced, keyed by VMI UID.
+	// It lets a failed status update be retried without announcing the guest addresses again.
+	announcedMigrations     map[types.UID]types.UID
+	announcedMigrationsLock sync.Mutex
 }
 
 func NewMigrationTargetController(
@@ -105,6 +118,7 @@ func NewMigrationTargetController(
 	netStat netstat,
 	netBindingPluginMemoryCalculator netBindingPluginMemoryCalculator,
 	passtRepairHandler passtRepairTargetHandler,
+	guestAddressAnnouncer guestAddressAnnouncer,
 ) (*MigrationTargetController, error) {
 
 	queue := workqueue.NewTypedRateLimitingQueueWithConfig[string](
@@ -155,6 +169,8 @@ func NewMigrationTargetController(
 		netBindingPluginMemoryCalculator: netBindingPluginMemoryCalculator,
 		netConf:                          netConf,
 		passtRepairHandler:               passtRepairHandler,
+		guestAddressAnnouncer:            guestAddressAnnouncer,
+		announcedMigrations:              map[types.UID]types.UID{},
 	}
 
 	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -225,6 +241,43 @@ func (c *MigrationTargetController) ackMigrationCompletion(vmi *v1.VirtualMachin
 	c.logger.Object(vmi).Info("The target node detected that the migration has completed")
 }
 
+// announceGuestAddresses announces the new location of the guest to the networks it is bridged to,
+// right after the handoff, instead of waiting for the guest to send traffic.
+// The addresses are announced once per migration.
+func (c *MigrationTargetController) announceGuestAddresses(vmi *v1.VirtualMachineInstance) {
+	if !c.markMigrationAnnounced(vmi) {
+		return
+	}
+	isolationRes, err := c.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		c.logger.Object(vmi).Reason(err).Warning("failed to detect the target pod, the guest addresses are not announced")
+		return
+	}
+	if err := c.guestAddressAnnouncer.Announce(vmi, isolationRes.Pid()); err != nil {
+		c.logger.Object(vmi).Reason(err).Warning("failed to announce the guest addresses")
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.Migrated.String(), fmt.Sprintf("Failed to announce the guest addresses: %v", err))
+	}
+}
+
+// markMigrationAnnounced records the current migration of the VMI as announced.
+// It returns false when it was already announced.
+func (c *MigrationTargetController) markMigrationAnnounced(vmi *v1.VirtualMachineInstance) bool {
+	c.announcedMigrationsLock.Lock()
+	defer c.announcedMigrationsLock.Unlock()
+	migrationUID := vmi.Status.MigrationState.MigrationUID
+	if announcedUID, exists := c.announcedMigrations[vmi.UID]; exists && announcedUID == migrationUID {
+		return false
+	}
+	c.announcedMigrations[vmi.UID] = migrationUID
+	return true
+}
+
+func (c *MigrationTargetController) forgetAnnouncedMigration(vmi *v1.VirtualMachineInstance) {
+	c.announcedMigrationsLock.Lock()
+	defer c.announcedMigrationsLock.Unlock()
+	delete(c.announcedMigrations, vmi.UID)
+}
+
 func (c *MigrationTargetController) updateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	if migrations.MigrationFailed(vmi) {
 		c.logger.Object(vmi).V(4).Info("migration has failed, nothing to report on the target node")
@@ -260,6 +313,7 @@ func (c *MigrationTargetController) updateStatus(vmi *v1.VirtualMachineInstance,
 		}
 
 		c.logger.Object(vmi).Info("The target node received the running migrated domain")
+		c.announceGuestAddresses(vmi)
 
 		cm := controller.NewVirtualMachineInstanceConditionManager()
 		cm.RemoveCondition(vmi, v1.VirtualMachineInstanceMigrationRequired)
@@ -449,6 +503,7 @@ func (c *MigrationTargetController) finalCleanup(vmi *v1.VirtualMachineInstance,
 	} else {
 		options := &cmdv1.VirtualMachineOptions{}
 		options.InterfaceMigration = domainspec.BindingMigrationByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, c.clusterConfig.GetNetworkBindings())
+		options.InterfaceDomainAttachment = domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, c.clusterConfig.GetNetworkBindings())
 		if err = client.FinalizeVirtualMachineMigration(vmi, options); err != nil {
 			return err
 		}
@@ -526,6 +581,7 @@ func (c *MigrationTargetController) execute(key string) error {
 		_ = c.netConf.Teardown(vmi)
 		c.netStat.Teardown(vmi)
 		c.launcherClients.CloseLauncherClient(vmi)
+		c.forgetAnnouncedMigration(vmi)
 		return nil
 	}
 
@@ -1001,10 +1057,12 @@ func (c *MigrationTargetController) finalizeMigration(vmi *v1.VirtualMachineInst
 
 	options := &cmdv1.VirtualMachineOptions{}
 	options.InterfaceMigration = domainspec.BindingMigrationByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, c.clusterConfig.GetNetworkBindings())
+	options.InterfaceDomainAttachment = domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, c.clusterConfig.GetNetworkBindings())
 	if err := client.FinalizeVirtualMachineMigration(vmi, options); err != nil {
 		c.logger.Object(vmi).Reason(err).Error(errorMessage)
 		return fmt.Errorf("%s: %v", errorMessage, err)
 	}
+	guesttime.SyncAsync(client, c.recorder, vmi, guesttime.TriggerMigration, nil)
 
 	if cbt.HasCBTStateEnabled(vmi.Status.ChangedBlockTracking) {
 		cbt.SetCBTState(&vmi.Status.ChangedBlockTracking, v1.ChangedBlockTrackingInitializing)
@@ -91,6 +91,7 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 
 		networkBindingPluginMemoryCalculator *stubNetBindingPluginMemoryCalculator
 		migrationTargetPasstRepairHandler    *stubTargetPasstRepairHandler
+		guestAddressAnnouncer                *stubGuestAddressAnnouncer
 	)
 
 	const host = "master"
@@ -195,6 +196,7 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 			Initialized: true,
 		}
 		migrationTargetPasstRepairHandler = &stubTargetPasstRepairHandler{isHandleMigrationTargetCalled: false}
+		guestAddressAnnouncer = &stubGuestAddressAnnouncer{}
 
 		controller, _ = NewMigrationTargetController(
 			recorder,
@@ -215,6 +217,7 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 			&netStatStub{},
 			networkBindingPluginMemoryCalculator,
 			migrationTargetPasstRepairHandler,
+			guestAddressAnnouncer,
 		)
 
 		controller.hotplugVolumeMounter = mockHotplugVolumeMounter
@@ -499,6 +502,81 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		Expect(updatedVMI.Status.Interfaces).To(BeEmpty())
 	})
 
+	DescribeTable("should announce the guest addresses", func(domainReadyTimestamp *metav1.Time, expectedAnnouncements int) {
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.UID = vmiTestUUID
+		vmi.ObjectMeta.ResourceVersion = "1"
+		vmi.Status.Phase = v1.Running
+		vmi.Labels = map[string]string{v1.MigrationTargetNodeNameLabel: "othernode"}
+		vmi.Status.NodeName = host
+		controller.host = "othernode"
+		now := metav1.Now()
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			TargetNode:                     "othernode",
+			TargetNodeAddress:              "127.0.0.1:12345",
+			SourceNode:                     host,
+			MigrationUID:                   "123",
+			TargetNodeDomainDetected:       true,
+			TargetNodeDomainReadyTimestamp: domainReadyTimestamp,
+			StartTimestamp:                 &now,
+		}
+
+		domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+		domain.Status.Status = api.Running
+		domain.Status.Reason = api.ReasonMigrated
+		domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+			UID:            "123",
+			StartTimestamp: &now,
+			EndTimestamp:   &now,
+		}
+
+		addVMI(vmi, domain)
+
+		sanityExecute()
+
+		Expect(guestAddressAnnouncer.launcherPIDs).To(HaveLen(expectedAnnouncements))
+		for _, pid := range guestAddressAnnouncer.launcherPIDs {
+			Expect(pid).To(Equal(1))
+		}
+	},
+		Entry("once the domain is running on the target", nil, 1),
+		Entry("only once", pointer.P(metav1.Now()), 0),
+	)
+
+	It("should not announce the guest addresses again when the status update is retried", func() {
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.UID = vmiTestUUID
+		vmi.ObjectMeta.ResourceVersion = "1"
+		vmi.Status.Phase = v1.Running
+		vmi.Labels = map[string]string{v1.MigrationTargetNodeNameLabel: "othernode"}
+		vmi.Status.NodeName = host
+		controller.host = "othernode"
+		now := metav1.Now()
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			TargetNode:               "othernode",
+			TargetNodeAddress:        "127.0.0.1:12345",
+			SourceNode:               host,
+			MigrationUID:             "123",
+			TargetNodeDomainDetected: true,
+			StartTimestamp:           &now,
+		}
+
+		domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+		domain.Status.Status = api.Running
+		domain.Status.Reason = api.ReasonMigrated
+
+		addVMI(vmi, domain)
+		sanityExecute()
+
+		// The VMI in the cache still misses the domain ready timestamp, as if its update failed.
+		key, err := virtcontroller.KeyFunc(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		controller.queue.Add(key)
+		sanityExecute()
+
+		Expect(guestAddressAnnouncer.launcherPIDs).To(HaveLen(1))
+	})
+
 	It("should always remove the VirtualMachineInstanceVCPUChange condition even if hotplug CPU has failed", func() {
 		vmi := api2.NewMinimalVMI("testvmi")
 		vmi.UID = vmiTestUUID
@@ -792,3 +870,12 @@ func (s *stubTargetPasstRepairHandler) HandleMigrationTarget(*v1.VirtualMachineI
 	s.isHandleMigrationTargetCalled = true
 	return nil
 }
+
+type stubGuestAddressAnnouncer struct {
+	launcherPIDs []int
+}
+
+func (s *stubGuestAddressAnnouncer) Announce(_ *v1.VirtualMachineInstance, launcherPID int) error {
+	s.launcherPIDs = append(s.launcherPIDs, launcherPID)
+	return nil
+}
@@ -61,12 +61,14 @@ var nodeLabellerLabels = []string{
 	kubevirtv1.HostModelRequiredFeaturesLabel,
 	kubevirtv1.NodeHostModelIsObsoleteLabel,
 	kubevirtv1.SupportedMachineTypeLabel,
+	kubevirtv1.NUMALabel,
 }
 
 // NodeLabeller struct holds information needed to run node-labeller
 type NodeLabeller struct {
 	recorder                record.EventRecorder
 	nodeClient              k8scli.NodeInterface
+	configMapClient         k8scli.ConfigMapInterface
 	nodeStore               cache.Store
 	host                    string
 	logger                  *log.FilteredLogger
@@ -80,6 +82,8 @@ type NodeLabeller struct {
 	domCapabilitiesFileName string
 	cpuCounter              *libvirtxml.CapsHostCPUCounter
 	supportedMachines       []libvirtxml.CapsGuestMachine
+	hostNUMA                *libvirtxml.CapsHostNUMATopology
+	sysfsPath               string
 	hostCPUModel            hostCPUModel
 	SEV                     SEVConfiguration
 	SecureExecution         SecureExecutionConfiguration
@@ -87,18 +91,19 @@ type NodeLabeller struct {
 	arch                    archLabeller
 }
 
-func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, nodeStore cache.Store, host string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, supportedMachines []libvirtxml.CapsGuestMachine) (*NodeLabeller, error) {
-	return newNodeLabeller(clusterConfig, nodeClient, nodeStore, host, NodeLabellerVolumePath, recorder, cpuCounter, supportedMachines)
+func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, configMapClient k8scli.ConfigMapInterface, nodeStore cache.Store, host string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, supportedMachines []libvirtxml.CapsGuestMachine, hostNUMA *libvirtxml.CapsHostNUMATopology) (*NodeLabeller, error) {
+	return newNodeLabeller(clusterConfig, nodeClient, configMapClient, nodeStore, host, NodeLabellerVolumePath, recorder, cpuCounter, supportedMachines, hostNUMA)
 
 }
-func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, nodeStore cache.Store, host, volumePath string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, supportedMachines []libvirtxml.CapsGuestMachine) (*NodeLabeller, error) {
+func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, configMapClient k8scli.ConfigMapInterface, nodeStore cache.Store, host, volumePath string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, supportedMachines []libvirtxml.CapsGuestMachine, hostNUMA *libvirtxml.CapsHostNUMATopology) (*NodeLabeller, error) {
 	n := &NodeLabeller{
-		recorder:      recorder,
-		nodeClient:    nodeClient,
-		nodeStore:     nodeStore,
-		host:          host,
-		logger:        log.DefaultLogger(),
-		clusterConfig: clusterConfig,
+		recorder:        recorder,
+		nodeClient:      nodeClient,
+		configMapClient: configMapClient,
+		nodeStore:       nodeStore,
+		host:            host,
+		logger:          log.DefaultLogger(),
+		clusterConfig:   clusterConfig,
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
 			workqueue.DefaultTypedControllerRateLimiter[string](),
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-handler-node-labeller"},
@@ -107,6 +112,8 @@ func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.
 		domCapabilitiesFileName: "virsh_domcapabilities.xml",
 		cpuCounter:              cpuCounter,
 		supportedMachines:       supportedMachines,
+		hostNUMA:                hostNUMA,
+		sysfsPath:               hostSysfsPath,
 		hostCPUModel:            hostCPUModel{requiredFeatures: make(map[string]bool)},
 		arch:                    newArchLabeller(runtime.GOARCH),
 	}
@@ -197,6 +204,19 @@ func (n *NodeLabeller) run() error {
 	node := originalNode.DeepCopy()
 	//prepare new labels
 	newLabels := n.prepareLabels(node)
+
+	numaTopology, err := n.numaTopology()
+	if err != nil {
+		n.logger.Reason(err).Error("node-labeller could not read the NUMA topology of the host")
+	} else {
+		for key, value := range n.prepareNUMALabels(numaTopology) {
+			newLabels[key] = value
+		}
+		if err := n.syncNUMATopologyConfigMap(originalNode, numaTopology); err != nil {
+			return err
+		}
+	}
+
 	//remove old labeller labels
 	n.removeLabellerLabels(node)
 	//add new labels
@@ -24,11 +24,14 @@ package nodelabeller
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
@@ -40,10 +43,14 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-handler/node-labeller/util"
 )
 
-const nodeName = "testNode"
+const (
+	nodeName  = "testNode"
+	namespace = "kubevirt"
+)
 
 var _ = Describe("Node-labeller ", func() {
 	var nlController *NodeLabeller
@@ -51,6 +58,7 @@ var _ = Describe("Node-labeller ", func() {
 	var fakeNodeStore cache.Store
 	var cpuCounter *libvirtxml.CapsHostCPUCounter
 	var supportedMachines []libvirtxml.CapsGuestMachine
+	var hostNUMA *libvirtxml.CapsHostNUMATopology
 
 	initNodeLabeller := func(kubevirt *v1.KubeVirt) {
 		config, _, _ := testutils.NewFakeClusterConfigUsingKV(kubevirt)
@@ -58,7 +66,7 @@ var _ = Describe("Node-labeller ", func() {
 		recorder.IncludeObject = true
 
 		var err error
-		nlController, err = newNodeLabeller(config, kubeClient.CoreV1().Nodes(), fakeNodeStore, nodeName, "testdata", recorder, cpuCounter, supportedMachines)
+		nlController, err = newNodeLabeller(config, kubeClient.CoreV1().Nodes(), kubeClient.CoreV1().ConfigMaps(namespace), fakeNodeStore, nodeName, "testdata", recorder, cpuCounter, supportedMachines, hostNUMA)
 		Expect(err).ToNot(HaveOccurred())
 	}
 
@@ -70,6 +78,7 @@ var _ = Describe("Node-labeller ", func() {
 		}
 
 		supportedMachines = []libvirtxml.CapsGuestMachine{{Name: "testmachine"}}
+		hostNUMA = nil
 
 		node := newNode(nodeName)
 		kubeClient = fake.NewSimpleClientset(node)
@@ -382,6 +391,119 @@ var _ = Describe("Node-labeller ", func() {
 		Entry("for arm64", []libvirtxml.CapsGuestMachine{{Name: "virt"}, {Name: "virt-rhel9.6.0"}}, arm64),
 	)
 
+	Context("NUMA topology", func() {
+		var sysfsPath string
+
+		writeSysfsFile := func(value string, elem ...string) {
+			path := filepath.Join(append([]string{sysfsPath}, elem...)...)
+			Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+			Expect(os.WriteFile(path, []byte(value+"\n"), 0o644)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			sysfsPath = GinkgoT().TempDir()
+			hostNUMA = &libvirtxml.CapsHostNUMATopology{
+				Cells: &libvirtxml.CapsHostNUMACells{
+					Cells: []libvirtxml.CapsHostNUMACell{
+						{
+							ID:     0,
+							Memory: &libvirtxml.CapsHostNUMAMemory{Size: 8388608, Unit: "KiB"},
+							CPUS: &libvirtxml.CapsHostNUMACPUs{CPUs: []libvirtxml.CapsHostNUMACPU{
+								{ID: 0, Siblings: "0,2"}, {ID: 1, Siblings: "1,3"}, {ID: 2, Siblings: "0,2"}, {ID: 3, Siblings: "1,3"},
+							}},
+						},
+						{
+							ID:     1,
+							Memory: &libvirtxml.CapsHostNUMAMemory{Size: 8388608, Unit: "KiB"},
+							CPUS: &libvirtxml.CapsHostNUMACPUs{CPUs: []libvirtxml.CapsHostNUMACPU{
+								{ID: 4, Siblings: "4,6"}, {ID: 5, Siblings: "5,7"}, {ID: 6, Siblings: "4,6"}, {ID: 7, Siblings: "5,7"},
+							}},
+						},
+					},
+				},
+			}
+
+			hugepagesDir := func(cell string) []string {
+				return []string{"devices", "system", "node", cell, "hugepages", "hugepages-2048kB"}
+			}
+			writeSysfsFile("512", append(hugepagesDir("node0"), "nr_hugepages")...)
+			writeSysfsFile("100", append(hugepagesDir("node0"), "free_hugepages")...)
+			writeSysfsFile("512", append(hugepagesDir("node1"), "nr_hugepages")...)
+			writeSysfsFile("512", append(hugepagesDir("node1"), "free_hugepages")...)
+			writeSysfsFile("0", append([]string{"devices", "system", "node", "node1", "hugepages", "hugepages-1048576kB"}, "nr_hugepages")...)
+			writeSysfsFile("1", "bus", "pci", "devices", "0000:81:00.0", "numa_node")
+			writeSysfsFile("-1", "bus", "pci", "devices", "0000:00:01.0", "numa_node")
+
+			nlController.hostNUMA = hostNUMA
+			nlController.sysfsPath = sysfsPath
+		})
+
+		retrieveNUMATopology := func() *topology.NUMATopology {
+			configMap, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(context.TODO(), topology.NUMATopologyConfigMapName(nodeName), metav1.GetOptions{})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			ExpectWithOffset(1, configMap.Labels).To(HaveKeyWithValue(v1.NUMATopologyNodeLabel, nodeName))
+			numaTopology, err := topology.NUMATopologyFromConfigMap(configMap)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			return numaTopology
+		}
+
+		It("should add NUMA labels and publish the topology in a config map", func() {
+			Expect(nlController.execute()).To(BeTrue())
+
+			node := retrieveNode(kubeClient)
+			Expect(node.Labels).To(HaveKeyWithValue(v1.NUMALabel+"cells", "2"))
+			Expect(node.Labels).To(HaveKeyWithValue(v1.NUMALabel+"threads-per-core", "2"))
+			Expect(node.Annotations).To(BeEmpty())
+
+			numaTopology := retrieveNUMATopology()
+			Expect(numaTopology.Cells).To(Equal([]topology.NUMACell{
+				{
+					ID:             0,
+					CPUs:           "0-3",
+					ThreadsPerCore: 2,
+					MemoryKiB:      8388608,
+					Hugepages:      []topology.NUMAHugepages{{PageSize: "2Mi", Total: 512, Free: 100}},
+				},
+				{
+					ID:             1,
+					CPUs:           "4-7",
+					ThreadsPerCore: 2,
+					MemoryKiB:      8388608,
+					Hugepages:      []topology.NUMAHugepages{{PageSize: "2Mi", Total: 512, Free: 512}},
+					PCIDevices:     []string{"0000:81:00.0"},
+				},
+			}))
+		})
+
+		It("should update the topology config map without patching the node when free huge pages change", func() {
+			Expect(nlController.execute()).To(BeTrue())
+			Expect(fakeNodeStore.Update(retrieveNode(kubeClient))).To(Succeed())
+
+			writeSysfsFile("20", "devices", "system", "node", "node0", "hugepages", "hugepages-2048kB", "free_hugepages")
+			kubeClient.Fake.PrependReactor("patch", "nodes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Fail("the node should not be patched")
+				return true, nil, nil
+			})
+			nlController.queue.Add(nodeName)
+			Expect(nlController.execute()).To(BeTrue())
+
+			Expect(retrieveNUMATopology().Cells[0].Hugepages).To(Equal([]topology.NUMAHugepages{{PageSize: "2Mi", Total: 512, Free: 20}}))
+		})
+
+		It("should remove the topology config map when the host reports no NUMA cells", func() {
+			Expect(nlController.execute()).To(BeTrue())
+			retrieveNUMATopology()
+			Expect(fakeNodeStore.Update(retrieveNode(kubeClient))).To(Succeed())
+
+			nlController.hostNUMA = nil
+			nlController.queue.Add(nodeName)
+			Expect(nlController.execute()).To(BeTrue())
+
+			_, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(context.TODO(), topology.NUMATopologyConfigMapName(nodeName), metav1.GetOptions{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(retrieveNode(kubeClient).Labels).ToNot(HaveKey(v1.NUMALabel + "cells"))
+		})
+	})
 })
 
 func newNode(name string) *k8sv1.Node {
@@ -0,0 +1,271 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"libvirt.org/go/libvirtxml"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
+)
+
+// hostSysfsPath is the sysfs of the host, as seen from virt-handler
+const hostSysfsPath = "/proc/1/root/sys"
+
+const (
+	numaCellsLabel          = topology.NUMACellsLabel
+	numaThreadsPerCoreLabel = kubevirtv1.NUMALabel + "threads-per-core"
+)
+
+// numaTopology combines the NUMA cells reported by libvirt with the huge pages and PCI devices
+// found in sysfs. Huge pages are read on every call since their free count changes over time.
+func (n *NodeLabeller) numaTopology() (*topology.NUMATopology, error) {
+	if n.hostNUMA == nil || n.hostNUMA.Cells == nil || len(n.hostNUMA.Cells.Cells) == 0 {
+		return nil, nil
+	}
+
+	pciDevices, err := n.pciDevicesPerNUMACell()
+	if err != nil {
+		return nil, err
+	}
+
+	numaTopology := &topology.NUMATopology{}
+	for _, cell := range n.hostNUMA.Cells.Cells {
+		numaCell := topology.NUMACell{
+			ID:         cell.ID,
+			MemoryKiB:  numaCellMemoryKiB(cell.Memory),
+			PCIDevices: pciDevices[cell.ID],
+		}
+		if cell.CPUS != nil {
+			var cpus []int
+			for _, cpu := range cell.CPUS.CPUs {
+				cpus = append(cpus, cpu.ID)
+			}
+			numaCell.CPUs = formatCPUSet(cpus)
+			numaCell.ThreadsPerCore = threadsPerCore(cell.CPUS.CPUs)
+		}
+		numaCell.Hugepages, err = n.numaCellHugepages(cell.ID)
+		if err != nil {
+			return nil, err
+		}
+		numaTopology.Cells = append(numaTopology.Cells, numaCell)
+	}
+	return numaTopology, nil
+}
+
+func (n *NodeLabeller) prepareNUMALabels(numaTopology *topology.NUMATopology) map[string]string {
+	labels := map[string]string{}
+	if numaTopology == nil {
+		return labels
+	}
+	maxThreadsPerCore := 0
+	for _, cell := range numaTopology.Cells {
+		maxThreadsPerCore = max(maxThreadsPerCore, cell.ThreadsPerCore)
+	}
+	labels[numaCellsLabel] = strconv.Itoa(len(numaTopology.Cells))
+	if maxThreadsPerCore > 0 {
+		labels[numaThreadsPerCoreLabel] = strconv.Itoa(maxThreadsPerCore)
+	}
+	return labels
+}
+
+// syncNUMATopologyConfigMap publishes the NUMA topology of the node in a config map named after the node,
+// so that the free huge page counts, which change with every allocation, do not cause updates of the node.
+// The config map is owned by the node so that it is removed together with the node.
+func (n *NodeLabeller) syncNUMATopologyConfigMap(node *v1.Node, numaTopology *topology.NUMATopology) error {
+	name := topology.NUMATopologyConfigMapName(node.Name)
+	configMap, err := n.configMapClient.Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+
+	if numaTopology == nil {
+		if !exists {
+			return nil
+		}
+		err := n.configMapClient.Delete(context.Background(), name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	value, err := json.Marshal(numaTopology)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		configMap = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{kubevirtv1.NUMATopologyNodeLabel: node.Name},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(node, v1.SchemeGroupVersion.WithKind("Node")),
+				},
+			},
+			Data: map[string]string{topology.NUMATopologyConfigMapKey: string(value)},
+		}
+		_, err := n.configMapClient.Create(context.Background(), configMap, metav1.CreateOptions{})
+		return err
+	}
+
+	if configMap.Data[topology.NUMATopologyConfigMapKey] == string(value) {
+		return nil
+	}
+	configMap.Data = map[string]string{topology.NUMATopologyConfigMapKey: string(value)}
+	_, err = n.configMapClient.Update(context.Background(), configMap, metav1.UpdateOptions{})
+	return err
+}
+
+func numaCellMemoryKiB(memory *libvirtxml.CapsHostNUMAMemory) uint64 {
+	if memory == nil {
+		return 0
+	}
+	switch memory.Unit {
+	case "", "KiB":
+		return memory.Size
+	case "MiB":
+		return memory.Size * 1024
+	case "GiB":
+		return memory.Size * 1024 * 1024
+	case "b", "bytes":
+		return memory.Size / 1024
+	}
+	return 0
+}
+
+func threadsPerCore(cpus []libvirtxml.CapsHostNUMACPU) int {
+	threads := 0
+	for _, cpu := range cpus {
+		if cpu.Siblings == "" {
+			continue
+		}
+		siblings, err := hardware.ParseCPUSetLine(cpu.Siblings, 1024)
+		if err != nil {
+			continue
+		}
+		threads = max(threads, len(siblings))
+	}
+	return threads
+}
+
+// formatCPUSet formats a list of CPUs in the cpuset list format, e.g. 0-3,8
+func formatCPUSet(cpus []int) string {
+	sort.Ints(cpus)
+	var ranges []string
+	for i := 0; i < len(cpus); {
+		j := i
+		for j+1 < len(cpus) && cpus[j+1] == cpus[j]+1 {
+			j++
+		}
+		if i == j {
+			ranges = append(ranges, strconv.Itoa(cpus[i]))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", cpus[i], cpus[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(ranges, ",")
+}
+
+func (n *NodeLabeller) numaCellHugepages(cellID int) ([]topology.NUMAHugepages, error) {
+	hugepagesDir := filepath.Join(n.sysfsPath, "devices", "system", "node", fmt.Sprintf("node%d", cellID), "hugepages")
+	entries, err := os.ReadDir(hugepagesDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var hugepages []topology.NUMAHugepages
+	for _, entry := range entries {
+		// Entries are named after the page size, e.g. hugepages-2048kB
+		sizeKiB, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(entry.Name(), "hugepages-"), "kB"), 10, 64)
+		if err != nil {
+			continue
+		}
+		total, err := readUintFile(filepath.Join(hugepagesDir, entry.Name(), "nr_hugepages"))
+		if err != nil {
+			return nil, err
+		}
+		if total == 0 {
+			continue
+		}
+		free, err := readUintFile(filepath.Join(hugepagesDir, entry.Name(), "free_hugepages"))
+		if err != nil {
+			return nil, err
+		}
+		hugepages = append(hugepages, topology.NUMAHugepages{
+			PageSize: resource.NewQuantity(sizeKiB*1024, resource.BinarySI).String(),
+			Total:    total,
+			Free:     free,
+		})
+	}
+	return hugepages, nil
+}
+
+func (n *NodeLabeller) pciDevicesPerNUMACell() (map[int][]string, error) {
+	devicesDir := filepath.Join(n.sysfsPath, "bus", "pci", "devices")
+	entries, err := os.ReadDir(devicesDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	devices := map[int][]string{}
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(devicesDir, entry.Name(), "numa_node"))
+		if err != nil {
+			continue
+		}
+		// Devices without NUMA affinity report -1
+		cellID, err := strconv.Atoi(strings.TrimSpace(string(content)))
+		if err != nil || cellID < 0 {
+			continue
+		}
+		devices[cellID] = append(devices[cellID], entry.Name())
+	}
+	return devices, nil
+}
+
+func readUintFile(path string) (uint64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+}
@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	guestexec "kubevirt.io/kubevirt/pkg/guest-exec"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	guestExecReason       = "GuestExec"
+	guestExecFailedReason = "GuestExecFailed"
+)
+
+// GuestExecHandler runs a command in the guest through the guest agent. The command was checked
+// against the allow-list by virt-api; every invocation is recorded as an event of the VMI.
+func (lh *LifecycleHandler) GuestExecHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	if request.Request.Body == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("the command to run is required"))
+		return
+	}
+	defer request.Request.Body.Close()
+	opts := &v1.VirtualMachineInstanceGuestExecOptions{}
+	if err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal the guest exec options")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal the guest exec options"))
+		return
+	}
+	if opts.Command == "" || opts.TimeoutSeconds == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("the command and its timeout are required"))
+		return
+	}
+	maxOutputBytes, err := strconv.ParseInt(request.QueryParameter(guestexec.MaxOutputBytesParam), 10, 64)
+	if err == nil && maxOutputBytes <= 0 {
+		err = fmt.Errorf("must be positive")
+	}
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("invalid %s: %v", guestexec.MaxOutputBytesParam, err))
+		return
+	}
+	user := request.QueryParameter(guestexec.UserParam)
+	argv := append([]string{opts.Command}, opts.Args...)
+
+	// One byte more than returned is read from the guest, which tells the output was truncated
+	exitCode, stdout, err := client.GuestExec(api.VMINamespaceKeyFunc(vmi), opts.Command, opts.Args, *opts.TimeoutSeconds, maxOutputBytes+1)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("User %s failed to run %q in the guest", user, argv)
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeWarning, guestExecFailedReason, "User %s failed to run %q in the guest: %v", user, argv, err)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	log.Log.Object(vmi).Infof("User %s ran %q in the guest, exit code %d", user, argv, exitCode)
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, guestExecReason, "User %s ran %q in the guest, exit code %d", user, argv, exitCode)
+
+	result := v1.VirtualMachineInstanceGuestExecResult{ExitCode: int32(exitCode)}
+	result.Stdout, result.Truncated = guestexec.TruncateOutput(stdout, maxOutputBytes)
+	response.WriteEntity(result)
+}
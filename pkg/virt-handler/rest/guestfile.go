@@ -0,0 +1,232 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	guestfile "kubevirt.io/kubevirt/pkg/guest-file"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	guestFileReadReason        = "GuestFileRead"
+	guestFileReadFailedReason  = "GuestFileReadFailed"
+	guestFileWriteReason       = "GuestFileWrite"
+	guestFileWriteFailedReason = "GuestFileWriteFailed"
+
+	// guestFileTransferIdleTimeout is the time the guest file of a copy is kept open between two chunks
+	guestFileTransferIdleTimeout = time.Minute
+)
+
+// GuestFileReadHandler reads a chunk of a guest file through the guest agent. A copy starts at offset
+// zero, which opens the guest file, and continues with the following chunks through the same handle.
+// The copy is recorded as an event of the VMI when it starts.
+func (lh *LifecycleHandler) GuestFileReadHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	opts, err := guestfile.ParseReadQuery(request.Request.URL.Query())
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	maxSize, err := strconv.ParseInt(request.QueryParameter(guestfile.MaxSizeParam), 10, 64)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("invalid %s: %v", guestfile.MaxSizeParam, err))
+		return
+	}
+	user := request.QueryParameter(guestfile.UserParam)
+
+	key := guestFileTransferKey(vmi, "read", user, opts.Path)
+	transfer := lh.guestFileTransfers.Take(key, opts.Offset)
+	if transfer == nil {
+		if opts.Offset != 0 {
+			response.WriteError(http.StatusConflict, noGuestFileTransferError(opts.Path, opts.Offset))
+			return
+		}
+		transfer, err = openGuestFile(vmi, client, opts.Path, false)
+		if err != nil {
+			lh.guestFileReadFailed(vmi, user, opts.Path, err)
+			response.WriteError(http.StatusInternalServerError, err)
+			return
+		}
+		if transfer.Size > maxSize {
+			_ = transfer.Close()
+			err := fmt.Errorf("the file of %d bytes is larger than %d bytes", transfer.Size, maxSize)
+			lh.guestFileReadFailed(vmi, user, opts.Path, err)
+			response.WriteError(http.StatusRequestEntityTooLarge, err)
+			return
+		}
+		log.Log.Object(vmi).Infof("User %s copies %s of %d bytes from the guest", user, opts.Path, transfer.Size)
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, guestFileReadReason, "User %s copies %s of %d bytes from the guest", user, opts.Path, transfer.Size)
+	}
+
+	chunk := v1.VirtualMachineInstanceGuestFileChunk{Size: transfer.Size, EOF: true}
+	if length := min(opts.Length, transfer.Size-transfer.Offset); length > 0 {
+		resp, err := client.GuestFileRead(api.VMINamespaceKeyFunc(vmi), transfer.Handle, length)
+		if err != nil {
+			_ = transfer.Close()
+			lh.guestFileReadFailed(vmi, user, opts.Path, err)
+			response.WriteError(http.StatusInternalServerError, err)
+			return
+		}
+		transfer.Offset += int64(len(resp.Data))
+		chunk.Data = resp.Data
+		chunk.EOF = resp.Eof || transfer.Offset >= transfer.Size
+	}
+
+	if chunk.EOF {
+		_ = transfer.Close()
+	} else {
+		lh.guestFileTransfers.Keep(key, transfer)
+	}
+	response.WriteEntity(chunk)
+}
+
+// GuestFileWriteHandler writes a chunk of a guest file through the guest agent. A copy starts at offset
+// zero, which creates the guest file, and continues with the following chunks through the same handle,
+// until a chunk shorter than the maximum chunk size. The copy is recorded as an event of the VMI when it starts.
+func (lh *LifecycleHandler) GuestFileWriteHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	if request.Request.Body == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("the chunk to write is required"))
+		return
+	}
+	defer request.Request.Body.Close()
+	opts := &v1.VirtualMachineInstanceGuestFileWriteOptions{}
+	if err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal the guest file write options")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal the guest file write options"))
+		return
+	}
+	if err := guestfile.ValidateWrite(opts); err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	user := request.QueryParameter(guestfile.UserParam)
+
+	key := guestFileTransferKey(vmi, "write", user, opts.Path)
+	transfer := lh.guestFileTransfers.Take(key, opts.Offset)
+	if transfer == nil {
+		if opts.Offset != 0 {
+			response.WriteError(http.StatusConflict, noGuestFileTransferError(opts.Path, opts.Offset))
+			return
+		}
+		transfer, err = openGuestFile(vmi, client, opts.Path, true)
+		if err != nil {
+			lh.guestFileWriteFailed(vmi, user, opts.Path, err)
+			response.WriteError(http.StatusInternalServerError, err)
+			return
+		}
+		log.Log.Object(vmi).Infof("User %s copies %s to the guest", user, opts.Path)
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, guestFileWriteReason, "User %s copies %s to the guest", user, opts.Path)
+	}
+
+	if len(opts.Data) > 0 {
+		if err := client.GuestFileWrite(api.VMINamespaceKeyFunc(vmi), transfer.Handle, opts.Data); err != nil {
+			_ = transfer.Close()
+			lh.guestFileWriteFailed(vmi, user, opts.Path, err)
+			response.WriteError(http.StatusInternalServerError, err)
+			return
+		}
+		transfer.Offset += int64(len(opts.Data))
+	}
+
+	if int64(len(opts.Data)) < guestfile.MaxChunkBytes {
+		if err := transfer.Close(); err != nil {
+			lh.guestFileWriteFailed(vmi, user, opts.Path, err)
+			response.WriteError(http.StatusInternalServerError, err)
+			return
+		}
+	} else {
+		lh.guestFileTransfers.Keep(key, transfer)
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+func (lh *LifecycleHandler) guestFileReadFailed(vmi *v1.VirtualMachineInstance, user, path string, err error) {
+	log.Log.Object(vmi).Reason(err).Errorf("User %s failed to copy %s from the guest", user, path)
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeWarning, guestFileReadFailedReason, "User %s failed to copy %s from the guest: %v", user, path, err)
+}
+
+func (lh *LifecycleHandler) guestFileWriteFailed(vmi *v1.VirtualMachineInstance, user, path string, err error) {
+	log.Log.Object(vmi).Reason(err).Errorf("User %s failed to copy %s to the guest", user, path)
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeWarning, guestFileWriteFailedReason, "User %s failed to copy %s to the guest: %v", user, path, err)
+}
+
+// guestFileTransferKey identifies the copy of a guest file by a user, in one direction
+func guestFileTransferKey(vmi *v1.VirtualMachineInstance, direction, user, path string) string {
+	return strings.Join([]string{string(vmi.UID), direction, user, path}, "/")
+}
+
+func noGuestFileTransferError(path string, offset int64) error {
+	return fmt.Errorf("no copy of %s continues at offset %d, copies start at offset 0", path, offset)
+}
+
+// openGuestFile opens the guest file of a copy. Its handle is closed through a new launcher client,
+// as the copy may outlive the client it was opened with.
+func openGuestFile(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient, path string, write bool) (*guestfile.Transfer, error) {
+	resp, err := client.GuestFileOpen(api.VMINamespaceKeyFunc(vmi), path, write)
+	if err != nil {
+		return nil, err
+	}
+	handle := resp.Handle
+	return guestfile.NewTransfer(handle, resp.Size, func() error {
+		err := closeGuestFile(vmi, handle)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("Failed to close guest file %s", path)
+		}
+		return err
+	}), nil
+}
+
+func closeGuestFile(vmi *v1.VirtualMachineInstance, handle int64) error {
+	sockFile, err := cmdclient.FindSocket(vmi)
+	if err != nil {
+		return err
+	}
+	client, err := cmdclient.NewClient(sockFile)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	return client.GuestFileClose(api.VMINamespaceKeyFunc(vmi), handle)
+}
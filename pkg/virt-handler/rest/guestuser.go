@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	guestuser "kubevirt.io/kubevirt/pkg/guest-user"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	guestUserUpdatedReason      = "GuestUserUpdated"
+	guestUserUpdateFailedReason = "GuestUserUpdateFailed"
+)
+
+// GuestUserHandler applies a change to a guest user through the guest agent. Every change is
+// recorded as an event of the VMI, the password is never logged nor recorded.
+func (lh *LifecycleHandler) GuestUserHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	if request.Request.Body == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("the guest user options are required"))
+		return
+	}
+	defer request.Request.Body.Close()
+	opts := &v1.VirtualMachineInstanceGuestUserOptions{}
+	if err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal the guest user options")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal the guest user options"))
+		return
+	}
+	if err := guestuser.Validate(opts); err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	user := request.QueryParameter(guestuser.UserParam)
+
+	if err := client.GuestUser(api.VMINamespaceKeyFunc(vmi), opts); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("User %s failed to apply %s to guest user %s", user, opts.Action, opts.Username)
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeWarning, guestUserUpdateFailedReason, "User %s failed to apply %s to guest user %s: %v", user, opts.Action, opts.Username, err)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	log.Log.Object(vmi).Infof("User %s applied %s to guest user %s", user, opts.Action, opts.Username)
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, guestUserUpdatedReason, "User %s applied %s to guest user %s", user, opts.Action, opts.Username)
+
+	response.WriteHeader(http.StatusOK)
+}
@@ -35,7 +35,10 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	guestfile "kubevirt.io/kubevirt/pkg/guest-file"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	guesttime "kubevirt.io/kubevirt/pkg/virt-handler/guest-time"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 const (
@@ -46,16 +49,18 @@ const (
 )
 
 type LifecycleHandler struct {
-	recorder     record.EventRecorder
-	vmiStore     cache.Store
-	virtShareDir string
+	recorder           record.EventRecorder
+	vmiStore           cache.Store
+	virtShareDir       string
+	guestFileTransfers *guestfile.Transfers
 }
 
 func NewLifecycleHandler(recorder record.EventRecorder, vmiStore cache.Store, virtShareDir string) *LifecycleHandler {
 	return &LifecycleHandler{
-		recorder:     recorder,
-		vmiStore:     vmiStore,
-		virtShareDir: virtShareDir,
+		recorder:           recorder,
+		vmiStore:           vmiStore,
+		virtShareDir:       virtShareDir,
+		guestFileTransfers: guestfile.NewTransfers(guestFileTransferIdleTimeout),
 	}
 }
 
@@ -82,16 +87,18 @@ func (lh *LifecycleHandler) UnpauseHandler(request *restful.Request, response *r
 	if err != nil {
 		return
 	}
-	defer client.Close()
 
 	err = client.UnpauseVirtualMachine(vmi)
 	if err != nil {
+		client.Close()
 		log.Log.Object(vmi).Reason(err).Error("Failed to unpause VMI")
 		response.WriteError(http.StatusInternalServerError, err)
 		return
 	}
 
 	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "Unpaused", "VirtualMachineInstance unpaused")
+	// the client is closed once the guest time was synchronised in the background
+	guesttime.SyncAsync(client, lh.recorder, vmi, guesttime.TriggerUnpause, client.Close)
 	response.WriteHeader(http.StatusAccepted)
 }
 
@@ -250,6 +257,53 @@ func (lh *LifecycleHandler) GetFilesystems(request *restful.Request, response *r
 	response.WriteEntity(fsList)
 }
 
+func (lh *LifecycleHandler) GetNetworkStatistics(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if !exists {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("domain stats of %s are not available", vmi.Name))
+		return
+	}
+
+	response.WriteEntity(networkStatistics(domainStats))
+}
+
+func networkStatistics(domainStats *stats.DomainStats) v1.VirtualMachineInstanceNetworkStatistics {
+	netStats := v1.VirtualMachineInstanceNetworkStatistics{Interfaces: []v1.VirtualMachineInstanceInterfaceStatistics{}}
+	for _, net := range domainStats.Net {
+		if !net.NameSet {
+			continue
+		}
+		ifaceStats := v1.VirtualMachineInstanceInterfaceStatistics{
+			Name:      net.Name,
+			Device:    net.Name,
+			RxBytes:   net.RxBytes,
+			RxPackets: net.RxPkts,
+			RxErrors:  net.RxErrs,
+			RxDropped: net.RxDrop,
+			TxBytes:   net.TxBytes,
+			TxPackets: net.TxPkts,
+			TxErrors:  net.TxErrs,
+			TxDropped: net.TxDrop,
+		}
+		if net.AliasSet {
+			ifaceStats.Name = net.Alias
+		}
+		netStats.Interfaces = append(netStats.Interfaces, ifaceStats)
+	}
+	return netStats
+}
+
 func (lh *LifecycleHandler) getVMILauncherClient(request *restful.Request, response *restful.Response) (*v1.VirtualMachineInstance, cmdclient.LauncherClient, error) {
 	vmi, code, err := getVMI(request, lh.vmiStore)
 	if err != nil {
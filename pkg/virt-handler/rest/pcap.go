@@ -0,0 +1,130 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/netns"
+	"kubevirt.io/kubevirt/pkg/network/pcap"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// PcapHandler streams the traffic of the tap device backing a VMI interface, in the pcap format.
+// The packet socket is opened in the virt-launcher network namespace.
+func (t *ConsoleHandler) PcapHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiStore)
+	if err != nil || vmi == nil {
+		log.Log.Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+
+	opts, err := pcap.ParseQuery(request.Request.URL.Query())
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	tapName, err := tapDeviceName(vmi, opts.Interface)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	isolationResult, err := t.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to detect the virt-launcher network namespace")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	var socket *pcap.Socket
+	err = netns.New(isolationResult.Pid()).Do(func() error {
+		var openErr error
+		socket, openErr = pcap.OpenSocket(tapName, opts.Filter)
+		return openErr
+	})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to capture the traffic of interface %s", opts.Interface)
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Capturing the traffic of interface %s on %s", opts.Interface, tapName)
+	captureStarted := false
+	t.stream(vmi, request, response, func() (net.Conn, error) {
+		captureStarted = true
+		return startCapture(vmi, socket, opts), nil
+	}, make(chan struct{}))
+	if !captureStarted {
+		socket.Close()
+	}
+}
+
+// tapDeviceName returns the name of the tap device backing the VMI interface in the virt-launcher pod.
+func tapDeviceName(vmi *v1.VirtualMachineInstance, ifaceName string) (string, error) {
+	iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, ifaceName)
+	network := vmispec.LookupNetworkByName(vmi.Spec.Networks, ifaceName)
+	if iface == nil || network == nil {
+		return "", fmt.Errorf("interface %s does not exist", ifaceName)
+	}
+	if iface.SRIOV != nil {
+		return "", fmt.Errorf("interface %s is a passed through device and cannot be captured", ifaceName)
+	}
+	podIfaceName := namescheme.HashedPodInterfaceName(*network, vmi.Status.Interfaces)
+	return link.GenerateTapDeviceName(podIfaceName, *network), nil
+}
+
+// captureConn is the connection the capture is streamed from, closing it stops the capture.
+type captureConn struct {
+	net.Conn
+	cancel context.CancelFunc
+}
+
+func (c captureConn) Close() error {
+	c.cancel()
+	return c.Conn.Close()
+}
+
+// startCapture runs the capture in the background, and returns the connection it is streamed from.
+// The capture ends once the duration or packet count limit is reached, or the connection is closed.
+func startCapture(vmi *v1.VirtualMachineInstance, socket *pcap.Socket, opts pcap.Options) net.Conn {
+	ctx, cancel := context.WithTimeout(context.Background(), opts.Duration)
+	captureEnd, streamEnd := net.Pipe()
+	go func() {
+		defer socket.Close()
+		defer captureEnd.Close()
+		if err := socket.Capture(ctx, captureEnd, opts.PacketCount); err != nil {
+			log.Log.Object(vmi).Reason(err).Infof("Capture of interface %s ended", opts.Interface)
+		}
+	}()
+	return captureConn{Conn: streamEnd, cancel: cancel}
+}
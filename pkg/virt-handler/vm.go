@@ -72,6 +72,8 @@ import (
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	containerdisk "kubevirt.io/kubevirt/pkg/virt-handler/container-disk"
 	deviceManager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
+	guestservicewatch "kubevirt.io/kubevirt/pkg/virt-handler/guest-service-watch"
+	guesttime "kubevirt.io/kubevirt/pkg/virt-handler/guest-time"
 	"kubevirt.io/kubevirt/pkg/virt-handler/heartbeat"
 	hotplugvolume "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
@@ -99,6 +101,7 @@ type VirtualMachineController struct {
 	clientset                kubecli.KubevirtClient
 	containerDiskMounter     containerdisk.Mounter
 	downwardMetricsManager   downwardMetricsManager
+	guestServiceWatcher      *guestservicewatch.Watcher
 	hotplugVolumeMounter     hotplugvolume.VolumeMounter
 	hostCpuModel             string
 	ioErrorRetryManager      *FailRetryManager
@@ -235,6 +238,7 @@ func NewVirtualMachineController(
 		clusterConfig,
 		nodeStore)
 	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), c.deviceManagerController, clusterConfig, host)
+	c.guestServiceWatcher = guestservicewatch.NewWatcher(host, c.vmiStore, c.launcherClients, clusterConfig, c.queue.Add)
 
 	return c, nil
 }
@@ -270,6 +274,7 @@ func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 	heartBeatDone := c.heartBeat.Run(c.heartBeatInterval, stopCh)
 
 	go c.ioErrorRetryManager.Run(stopCh)
+	go c.guestServiceWatcher.Run(stopCh)
 
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
@@ -724,6 +729,100 @@ func (c *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.Virt
 	}
 }
 
+func (c *VirtualMachineController) updateCloudInitConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.CloudInit == nil {
+		return
+	}
+	cloudInit := domain.Spec.Metadata.KubeVirt.CloudInit
+	if !cloudInit.Succeeded && cloudInit.Message == "" {
+		// Only the secrets changed, the data is not updated yet
+		return
+	}
+
+	status := k8sv1.ConditionFalse
+	message := cloudInit.Message
+	if cloudInit.Succeeded {
+		status = k8sv1.ConditionTrue
+		message = fmt.Sprintf("Updated to revision %s", cloudInit.Revision)
+	}
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceCloudInitSynchronized)
+	if condition != nil {
+		if condition.Status == status && condition.Message == message {
+			return
+		}
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceCloudInitSynchronized)
+	}
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceCloudInitSynchronized,
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Message:            message,
+	})
+	if status == k8sv1.ConditionTrue {
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.CloudInitSyncSuccess.String(), fmt.Sprintf("Cloud-init data sync successful: %s", message))
+	} else {
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.CloudInitSyncFailed.String(), fmt.Sprintf("Cloud-init data sync failed: %s", message))
+	}
+}
+
+func (c *VirtualMachineController) updateGuestServiceConditions(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if vmi.Spec.GuestServiceWatch == nil || !c.clusterConfig.GuestServiceWatchEnabled() {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestServicesUp)
+		return
+	}
+	// Keep the last reported state until all the services were checked, e.g. after a restart of virt-handler
+	newCondition := c.guestServiceWatcher.Condition(vmi)
+	if newCondition == nil {
+		return
+	}
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestServicesUp)
+	if condition != nil {
+		if condition.Status == newCondition.Status && condition.Reason == newCondition.Reason && condition.Message == newCondition.Message {
+			return
+		}
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestServicesUp)
+	}
+	newCondition.LastTransitionTime = metav1.Now()
+	vmi.Status.Conditions = append(vmi.Status.Conditions, *newCondition)
+}
+
+func (c *VirtualMachineController) updateGuestUserConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.GuestUser == nil {
+		return
+	}
+	guestUser := domain.Spec.Metadata.KubeVirt.GuestUser
+
+	status := k8sv1.ConditionFalse
+	message := fmt.Sprintf("%s of guest user %s failed: %s", guestUser.Action, guestUser.Username, guestUser.Message)
+	if guestUser.Succeeded {
+		status = k8sv1.ConditionTrue
+		message = fmt.Sprintf("%s of guest user %s succeeded", guestUser.Action, guestUser.Username)
+	}
+	// The VMI status only keeps seconds, truncate to not replace the condition on every sync
+	lastTransitionTime := metav1.Now().Rfc3339Copy()
+	if guestUser.Timestamp != nil {
+		lastTransitionTime = guestUser.Timestamp.Rfc3339Copy()
+	}
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestUserUpdated)
+	if condition != nil {
+		if condition.Status == status && condition.Reason == guestUser.Action && condition.Message == message &&
+			condition.LastTransitionTime.Equal(&lastTransitionTime) {
+			return
+		}
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestUserUpdated)
+	}
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestUserUpdated,
+		LastTransitionTime: lastTransitionTime,
+		Status:             status,
+		Reason:             guestUser.Action,
+		Message:            message,
+	})
+}
+
 func (c *VirtualMachineController) updateLiveMigrationConditions(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
 	// Calculate whether the VM is migratable
 	liveMigrationCondition, isBlockMigration := c.calculateLiveMigrationCondition(vmi)
@@ -776,6 +875,11 @@ func (c *VirtualMachineController) updateGuestAgentConditions(vmi *v1.VirtualMac
 			Status:        k8sv1.ConditionTrue,
 		}
 		vmi.Status.Conditions = append(vmi.Status.Conditions, agentCondition)
+		if guesttime.IsEnabled(vmi) {
+			if client, err := c.launcherClients.GetLauncherClient(vmi); err == nil {
+				guesttime.SyncAsync(client, c.recorder, vmi, guesttime.TriggerStart, nil)
+			}
+		}
 	case !channelConnected:
 		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceAgentConnected)
 	}
@@ -1041,6 +1145,9 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 
 func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) error {
 	c.updateAccessCredentialConditions(vmi, domain, condManager)
+	c.updateGuestUserConditions(vmi, domain, condManager)
+	c.updateCloudInitConditions(vmi, domain, condManager)
+	c.updateGuestServiceConditions(vmi, condManager)
 	c.updateLiveMigrationConditions(vmi, condManager)
 	err := c.updateGuestAgentConditions(vmi, domain, condManager)
 	if err != nil {
@@ -1868,7 +1975,19 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(vmi *v1.VirtualMachineI
 		return err
 	}
 
+	if ioWeight := vmi.Spec.Domain.Resources.IOWeight; ioWeight != nil {
+		if err := cgroupManager.SetIOWeight(*ioWeight); err != nil {
+			c.recorder.Event(vmi, k8sv1.EventTypeWarning, "IOWeight", err.Error())
+			errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
+		}
+	}
+
 	if vmi.IsRunning() {
+		if err := applyResourceLimits(vmi, cgroupManager); err != nil {
+			c.recorder.Event(vmi, k8sv1.EventTypeWarning, "ResourceLimits", err.Error())
+			errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
+		}
+
 		// Umount any disks no longer mounted
 		if err := c.hotplugVolumeMounter.Unmount(vmi, cgroupManager); err != nil {
 			return err
@@ -1878,6 +1997,42 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(vmi *v1.VirtualMachineI
 	return errors.NewAggregate(errorTolerantFeaturesError)
 }
 
+const (
+	// cfsPeriodUs and minCfsQuotaUs match the CPU bandwidth kubelet configures for containers
+	cfsPeriodUs   = 100000
+	minCfsQuotaUs = 1000
+)
+
+// applyResourceLimits enforces the CPU and memory limits of the VMI on the compute container.
+// The limits can be lowered live by the VM controller, in which case the cgroup still holds the
+// limits the pod was created with.
+func applyResourceLimits(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) error {
+	limits := vmi.Spec.Domain.Resources.Limits
+
+	// Dedicated CPUs get additional CPUs for the emulator and IO threads on the pod
+	if cpuLimit, exists := limits[k8sv1.ResourceCPU]; exists && !vmi.IsCPUDedicated() {
+		quota := cpuLimit.MilliValue() * cfsPeriodUs / 1000
+		if quota < minCfsQuotaUs {
+			quota = minCfsQuotaUs
+		}
+		if err := cgroupManager.SetCPULimit(quota, cfsPeriodUs); err != nil {
+			return fmt.Errorf("failed to set the CPU limit: %v", err)
+		}
+	}
+
+	// The memory overhead is only known if it is reported on the VMI status
+	memoryLimit, exists := limits[k8sv1.ResourceMemory]
+	if !exists || vmi.Status.Memory == nil || vmi.Status.Memory.MemoryOverhead == nil ||
+		(vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Hugepages != nil) {
+		return nil
+	}
+	if err := cgroupManager.SetMemoryLimit(memoryLimit.Value() + vmi.Status.Memory.MemoryOverhead.Value()); err != nil {
+		return fmt.Errorf("failed to set the memory limit: %v", err)
+	}
+
+	return nil
+}
+
 // handleVMIState: Decides whether to call handleRunningVMI or handleStartingVMI based on the VMI's state.
 func (c *VirtualMachineController) handleVMIState(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager, errorTolerantFeaturesError *[]error) (bool, error) {
 	if vmi.IsRunning() {
@@ -1999,6 +2154,8 @@ func (c *VirtualMachineController) syncVirtualMachine(client cmdclient.LauncherC
 	options := virtualMachineOptions(smbios, period, preallocatedVolumes, c.capabilities, c.clusterConfig)
 	options.InterfaceDomainAttachment = domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, c.clusterConfig.GetNetworkBindings())
 
+	vmi = c.withClusterDomainPatches(vmi)
+
 	err := client.SyncVirtualMachine(vmi, options)
 	if err != nil {
 		if strings.Contains(err.Error(), "EFI OVMF rom missing") {
@@ -2009,6 +2166,33 @@ func (c *VirtualMachineController) syncVirtualMachine(client cmdclient.LauncherC
 	return err
 }
 
+// withClusterDomainPatches returns the VMI with the domain patches recorded in its status which the cluster config
+// still holds unchanged. The recorded patches are kept when they are selected, patches removed or changed in the
+// cluster config since then are skipped with a warning event.
+func (c *VirtualMachineController) withClusterDomainPatches(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+	configuredPatches := map[string]v1.DomainPatch{}
+	for _, patch := range c.clusterConfig.GetDomainPatches() {
+		configuredPatches[patch.Name] = patch
+	}
+
+	var domainPatches []v1.VirtualMachineInstanceDomainPatch
+	for _, recordedPatch := range vmi.Status.DomainPatches {
+		patch, exists := configuredPatches[recordedPatch.Name]
+		if !exists || !equality.Semantic.DeepEqual(patch.Operations, recordedPatch.Operations) {
+			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, DomainPatchSkippedReason,
+				"Skipped the domain patch %q, it was removed or changed in the cluster config", recordedPatch.Name)
+			continue
+		}
+		domainPatches = append(domainPatches, recordedPatch)
+	}
+	if len(domainPatches) == len(vmi.Status.DomainPatches) {
+		return vmi
+	}
+	vmi = vmi.DeepCopy()
+	vmi.Status.DomainPatches = domainPatches
+	return vmi
+}
+
 func (c *VirtualMachineController) getPreallocatedVolumes(vmi *v1.VirtualMachineInstance) []string {
 	var preallocatedVolumes []string
 	for _, volumeStatus := range vmi.Status.VolumeStatus {
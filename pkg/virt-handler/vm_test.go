@@ -67,6 +67,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	containerdisk "kubevirt.io/kubevirt/pkg/virt-handler/container-disk"
+	guesttime "kubevirt.io/kubevirt/pkg/virt-handler/guest-time"
 	hotplugvolume "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
@@ -612,6 +613,40 @@ var _ = Describe("VirtualMachineInstance", func() {
 			testutils.ExpectEvent(recorder, VMIDefined)
 		})
 
+		It("should create the Domain with the recorded domain patches which the cluster config still holds", func() {
+			memballoon := []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/memballoon"}}
+			rng := []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/rng"}}
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DomainPatches: []v1.DomainPatch{
+					{Name: "kept", Operations: memballoon},
+					{Name: "changed", Operations: memballoon},
+					{Name: "unselected", Operations: rng},
+				},
+			})
+			controller.clusterConfig = config
+
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+			vmi.Status.DomainPatches = []v1.VirtualMachineInstanceDomainPatch{
+				{Name: "kept", Operations: memballoon},
+				{Name: "changed", Operations: rng},
+				{Name: "removed", Operations: rng},
+			}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			createVMI(vmi)
+			client.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).Do(func(vmi *v1.VirtualMachineInstance, _ *cmdv1.VirtualMachineOptions) {
+				Expect(vmi.Status.DomainPatches).To(Equal([]v1.VirtualMachineInstanceDomainPatch{
+					{Name: "kept", Operations: memballoon},
+				}))
+			})
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+			sanityExecute()
+			testutils.ExpectEvents(recorder, DomainPatchSkippedReason, DomainPatchSkippedReason, VMIDefined)
+		})
+
 		It("should update the qemu machine type on the VMI status", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -647,6 +682,55 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.Machine).To(Equal(&v1.Machine{Type: "q35-123"}))
 		})
 
+		It("should apply the io weight of a running VMI", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Domain.Resources.IOWeight = pointer.P(uint32(500))
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockCgroupManager.EXPECT().SetIOWeight(uint32(500)).Return(nil)
+
+			sanityExecute()
+		})
+
+		It("should apply the resource limits of a running VMI", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse("1500m"),
+				k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+			}
+			vmi.Status.Memory = &v1.MemoryStatus{
+				MemoryOverhead: pointer.P(resource.MustParse("256Mi")),
+			}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockCgroupManager.EXPECT().SetCPULimit(int64(150000), uint64(100000)).Return(nil)
+			mockCgroupManager.EXPECT().SetMemoryLimit(int64(1280 * 1024 * 1024)).Return(nil)
+
+			sanityExecute()
+		})
+
 		It("should update from Scheduled to Running, if it sees a running Domain", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -747,6 +831,38 @@ var _ = Describe("VirtualMachineInstance", func() {
 			))
 		})
 
+		It("should synchronise the guest time when the guest agent connects", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Domain.Clock = &v1.Clock{GuestTimeSync: &v1.GuestTimeSync{}}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Devices.Channels = []api.Channel{
+				{
+					Type: "unix",
+					Target: &api.ChannelTarget{
+						Name:  "org.qemu.guest_agent.0",
+						State: "connected",
+					},
+				},
+			}
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			client.EXPECT().SyncGuestTime("default_testvmi", gomock.Any()).Return(2*time.Second, nil)
+			client.EXPECT().GetGuestInfo().Return(&v1.VirtualMachineInstanceGuestAgentInfo{}, nil)
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+			Eventually(recorder.Events).Should(Receive(ContainSubstring(guesttime.SyncedReason)))
+		})
+
 		It("should maintain unsupported user agent condition when it's already set", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -983,6 +1099,116 @@ var _ = Describe("VirtualMachineInstance", func() {
 			))
 		})
 
+		It("should report the result of the last guest user change as condition", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceGuestUserUpdated,
+					Status: k8sv1.ConditionTrue,
+					Reason: string(v1.GuestUserCreate),
+				},
+			}
+
+			timestamp := metav1.Now()
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.GuestUser = &api.GuestUserMetadata{
+				Action:    string(v1.GuestUserLock),
+				Username:  "jdoe",
+				Timestamp: &timestamp,
+				Succeeded: false,
+				Message:   "usermod failed for user jdoe",
+			}
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Conditions).To(ContainElement(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(v1.VirtualMachineInstanceGuestUserUpdated),
+					"Status":  Equal(k8sv1.ConditionFalse),
+					"Reason":  Equal(string(v1.GuestUserLock)),
+					"Message": Equal("Lock of guest user jdoe failed: usermod failed for user jdoe")},
+				),
+			))
+		})
+
+		It("should report a failed cloud-init data update as condition and event", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.CloudInit = &api.CloudInitMetadata{
+				Succeeded: false,
+				Message:   "reading the cloud-init data failed",
+			}
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, string(v1.CloudInitSyncFailed))
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Conditions).To(ContainElement(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(v1.VirtualMachineInstanceCloudInitSynchronized),
+					"Status":  Equal(k8sv1.ConditionFalse),
+					"Message": Equal("reading the cloud-init data failed")},
+				),
+			))
+		})
+
+		It("should remove the guest services condition when no service is watched", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceGuestServicesUp,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Conditions).ToNot(ContainElement(
+				MatchFields(IgnoreExtras, Fields{"Type": Equal(v1.VirtualMachineInstanceGuestServicesUp)}),
+			))
+		})
+
 		type domainIsPausedTest struct {
 			domainStateChangeReason api.StateChangeReason
 			vmiMigrationState       v1.VirtualMachineInstanceMigrationState
@@ -33,6 +33,8 @@ type Cache struct {
 	MemoryDump        SafeData[api.MemoryDumpMetadata]
 	Backup            SafeData[api.BackupMetadata]
 	GuestPanicHandled SafeData[bool]
+	GuestUser         SafeData[api.GuestUserMetadata]
+	CloudInit         SafeData[api.CloudInitMetadata]
 
 	notificationSignal chan struct{}
 }
@@ -48,6 +50,8 @@ func NewCache() *Cache {
 	cache.MemoryDump.dirtyChanel = cache.notificationSignal
 	cache.Backup.dirtyChanel = cache.notificationSignal
 	cache.GuestPanicHandled.dirtyChanel = cache.notificationSignal
+	cache.GuestUser.dirtyChanel = cache.notificationSignal
+	cache.CloudInit.dirtyChanel = cache.notificationSignal
 	return cache
 }
 
@@ -28,6 +28,7 @@ import (
 	com "kubevirt.io/kubevirt/pkg/handler-launcher-com"
 	"kubevirt.io/kubevirt/pkg/handler-launcher-com/notify/info"
 	notifyv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/notify/v1"
+	"kubevirt.io/kubevirt/pkg/network/guestaddr"
 	virtutil "kubevirt.io/kubevirt/pkg/util"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	agentpoller "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-poller"
@@ -431,6 +432,20 @@ func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvir
 	client.updateEvents(event, domain, events)
 }
 
+func domainInterfaces(domainConn cli.Connection, domainName string) ([]api.Interface, error) {
+	dom, err := domainConn.LookupDomainByName(domainName)
+	if err != nil {
+		return nil, err
+	}
+	defer dom.Free()
+
+	spec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return nil, err
+	}
+	return spec.Devices.Interfaces, nil
+}
+
 func (n *Notifier) StartDomainNotifier(
 	domainConn cli.Connection,
 	deleteNotificationSent chan watch.Event,
@@ -465,6 +480,14 @@ func (n *Notifier) StartDomainNotifier(
 		qemuAgentFSFreezeStatusInterval,
 	)
 
+	guestAddrPoller := guestaddr.NewPoller(
+		guestaddr.NewLearner(),
+		func() ([]api.Interface, error) { return domainInterfaces(domainConn, domainName) },
+		agentStore.StoreLearnedInterfaces,
+		qemuAgentSysInterval,
+	)
+	go guestAddrPoller.Run()
+
 	// Run the event process logic in a separate go-routine to not block libvirt
 	go func() {
 		var interfaceStatuses []api.InterfaceStatus
@@ -216,7 +216,7 @@ func (l *AccessCredentialManager) readGuestFile(domName, filePath string) (strin
 
 func (l *AccessCredentialManager) agentGuestExec(domName, command string, args []string) (string, error) {
 	var timeoutInSeconds int32 = 10
-	return agent.GuestExec(l.virConn, domName, command, args, timeoutInSeconds)
+	return agent.GuestExec(l.virConn, domName, command, args, timeoutInSeconds, 0)
 }
 
 // Requires usage of mkdir, chown, chmod
@@ -103,6 +103,20 @@ var _ = Describe("AccessCredentials", func() {
 		Expect(manager.agentGuestExec(domName, command, args)).To(Equal("ssh somekey123 test-key\n"))
 	})
 
+	It("should escape the command and the arguments of qemu agent exec", func() {
+		const domName = "some-domain"
+		const command = `some-"command"`
+		args := []string{`arg" ], "path": "other-command`, `back\slash`}
+
+		const expectedCmd = `{"execute": "guest-exec", "arguments": { "path": "some-\"command\"", "arg": [ "arg\" ], \"path\": \"other-command", "back\\slash" ], "capture-output":true } }`
+		const expectedStatusCmd = `{"execute": "guest-exec-status", "arguments": { "pid": 789 } }`
+
+		mockLibvirt.ConnectionEXPECT().QemuAgentCommand(expectedCmd, domName).Return(`{"return":{"pid":789}}`, nil)
+		mockLibvirt.ConnectionEXPECT().QemuAgentCommand(expectedStatusCmd, domName).Return(`{"return":{"exitcode":0,"out-data":"","exited":true}}`, nil)
+
+		Expect(manager.agentGuestExec(domName, command, args)).To(BeEmpty())
+	})
+
 	It("should handle dynamically updating user/password with qemu agent", func() {
 		const domName = "some-domain"
 		const user = "myuser"
@@ -0,0 +1,117 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package accesscredentials
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// windowsOSID is the id the guest agent reports for Windows guests
+const windowsOSID = "mswindows"
+
+type guestUserCommand struct {
+	command string
+	args    []string
+}
+
+// ManageGuestUser applies the given action to a user of the guest and reports
+// the outcome through the domain metadata. osID is the operating system id
+// reported by the guest agent, it selects the tools used inside the guest.
+func (l *AccessCredentialManager) ManageGuestUser(domName, osID string, action v1.GuestUserAction, user, password string) error {
+	err := l.manageGuestUser(domName, osID, action, user, password)
+	l.reportGuestUserResult(action, user, err)
+	return err
+}
+
+func (l *AccessCredentialManager) manageGuestUser(domName, osID string, action v1.GuestUserAction, user, password string) error {
+	if action == v1.GuestUserSetPassword {
+		if err := l.agentSetUserPassword(domName, user, password); err != nil {
+			return fmt.Errorf("failed to set the password of user %s: %w", user, err)
+		}
+		return nil
+	}
+
+	cmd, err := guestUserCommandFor(osID, action, user, password)
+	if err != nil {
+		return err
+	}
+	if _, err := l.agentGuestExec(domName, cmd.command, cmd.args); err != nil {
+		return fmt.Errorf("%s failed for user %s: %w", cmd.command, user, err)
+	}
+
+	// Windows users are created with their password
+	if action == v1.GuestUserCreate && password != "" && osID != windowsOSID {
+		if err := l.agentSetUserPassword(domName, user, password); err != nil {
+			return fmt.Errorf("user %s was created but setting its password failed: %w", user, err)
+		}
+	}
+	return nil
+}
+
+// guestUserCommandFor returns the command applying the action in the guest. Windows rejects users
+// without a password under its default password policy, so they are created with their password
+// in the same command.
+func guestUserCommandFor(osID string, action v1.GuestUserAction, user, password string) (*guestUserCommand, error) {
+	if osID == windowsOSID {
+		switch action {
+		case v1.GuestUserCreate:
+			if password == "" {
+				return nil, fmt.Errorf("a password is required to create Windows user %s", user)
+			}
+			return &guestUserCommand{"net.exe", []string{"user", user, password, "/add"}}, nil
+		case v1.GuestUserLock:
+			return &guestUserCommand{"net.exe", []string{"user", user, "/active:no"}}, nil
+		case v1.GuestUserUnlock:
+			return &guestUserCommand{"net.exe", []string{"user", user, "/active:yes"}}, nil
+		}
+	} else {
+		switch action {
+		case v1.GuestUserCreate:
+			return &guestUserCommand{"useradd", []string{"--create-home", user}}, nil
+		case v1.GuestUserLock:
+			// Expiring the account also rejects logins that do not use the password, like ssh keys
+			return &guestUserCommand{"usermod", []string{"--lock", "--expiredate", "1", user}}, nil
+		case v1.GuestUserUnlock:
+			return &guestUserCommand{"usermod", []string{"--unlock", "--expiredate", "", user}}, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported guest user action %q", action)
+}
+
+func (l *AccessCredentialManager) reportGuestUserResult(action v1.GuestUserAction, user string, err error) {
+	now := metav1.Now()
+	guMetadata := api.GuestUserMetadata{
+		Action:    string(action),
+		Username:  user,
+		Timestamp: &now,
+		Succeeded: err == nil,
+	}
+	if err != nil {
+		guMetadata.Message = err.Error()
+	}
+	l.metadataCache.GuestUser.Store(guMetadata)
+	log.Log.V(logVerbosityDebug).Infof("Guest user result set in metadata: %v", guMetadata)
+}
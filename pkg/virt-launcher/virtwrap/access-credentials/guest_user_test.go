@@ -0,0 +1,151 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+//nolint:lll
+package accesscredentials
+
+import (
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/testing"
+)
+
+var _ = Describe("Guest users", func() {
+	const (
+		domName           = "some-domain"
+		user              = "jdoe"
+		password          = "s3cr3t"
+		expectedExecRes   = `{"return":{"pid":789}}`
+		expectedStatusCmd = `{"execute": "guest-exec-status", "arguments": { "pid": 789 } }`
+		exitedOK          = `{"return":{"exitcode":0,"out-data":"","exited":true}}`
+		exitedFailed      = `{"return":{"exitcode":6,"out-data":"","exited":true}}`
+	)
+
+	var mockLibvirt *testing.Libvirt
+	var manager *AccessCredentialManager
+	var cache *metadata.Cache
+	var lock sync.Mutex
+
+	BeforeEach(func() {
+		mockLibvirt = testing.NewLibvirt(gomock.NewController(GinkgoT()))
+		cache = metadata.NewCache()
+		manager = NewManager(mockLibvirt.VirtConnection, &lock, cache)
+	})
+
+	expectExec := func(cmd, status string) {
+		mockLibvirt.ConnectionEXPECT().QemuAgentCommand(cmd, domName).Return(expectedExecRes, nil)
+		mockLibvirt.ConnectionEXPECT().QemuAgentCommand(expectedStatusCmd, domName).Return(status, nil)
+	}
+
+	expectSetPassword := func() {
+		mockLibvirt.ConnectionEXPECT().LookupDomainByName(domName).Return(mockLibvirt.VirtDomain, nil)
+		mockLibvirt.DomainEXPECT().Free()
+		mockLibvirt.DomainEXPECT().SetUserPassword(user, password, libvirt.DomainSetUserPasswordFlags(0))
+	}
+
+	It("should set the password through the guest agent", func() {
+		expectSetPassword()
+
+		Expect(manager.ManageGuestUser(domName, "", v1.GuestUserSetPassword, user, password)).To(Succeed())
+	})
+
+	DescribeTable("should run the matching command in the guest", func(osID string, action v1.GuestUserAction, expectedCmd string) {
+		expectExec(expectedCmd, exitedOK)
+
+		Expect(manager.ManageGuestUser(domName, osID, action, user, "")).To(Succeed())
+	},
+		Entry("to create a Linux user", "fedora", v1.GuestUserCreate,
+			`{"execute": "guest-exec", "arguments": { "path": "useradd", "arg": [ "--create-home", "jdoe" ], "capture-output":true } }`),
+		Entry("to lock a Linux user", "fedora", v1.GuestUserLock,
+			`{"execute": "guest-exec", "arguments": { "path": "usermod", "arg": [ "--lock", "--expiredate", "1", "jdoe" ], "capture-output":true } }`),
+		Entry("to unlock a Linux user", "fedora", v1.GuestUserUnlock,
+			`{"execute": "guest-exec", "arguments": { "path": "usermod", "arg": [ "--unlock", "--expiredate", "", "jdoe" ], "capture-output":true } }`),
+		Entry("to lock a Windows user", windowsOSID, v1.GuestUserLock,
+			`{"execute": "guest-exec", "arguments": { "path": "net.exe", "arg": [ "user", "jdoe", "/active:no" ], "capture-output":true } }`),
+		Entry("to unlock a Windows user", windowsOSID, v1.GuestUserUnlock,
+			`{"execute": "guest-exec", "arguments": { "path": "net.exe", "arg": [ "user", "jdoe", "/active:yes" ], "capture-output":true } }`),
+	)
+
+	It("should set the password of a created user", func() {
+		expectExec(`{"execute": "guest-exec", "arguments": { "path": "useradd", "arg": [ "--create-home", "jdoe" ], "capture-output":true } }`, exitedOK)
+		expectSetPassword()
+
+		Expect(manager.ManageGuestUser(domName, "", v1.GuestUserCreate, user, password)).To(Succeed())
+	})
+
+	It("should create a Windows user with its password", func() {
+		expectExec(`{"execute": "guest-exec", "arguments": { "path": "net.exe", "arg": [ "user", "jdoe", "s3cr3t", "/add" ], "capture-output":true } }`, exitedOK)
+
+		Expect(manager.ManageGuestUser(domName, windowsOSID, v1.GuestUserCreate, user, password)).To(Succeed())
+	})
+
+	It("should reject Windows users without password", func() {
+		Expect(manager.ManageGuestUser(domName, windowsOSID, v1.GuestUserCreate, user, "")).To(MatchError("a password is required to create Windows user jdoe"))
+	})
+
+	It("should report a successful change in the metadata", func() {
+		expectExec(`{"execute": "guest-exec", "arguments": { "path": "usermod", "arg": [ "--lock", "--expiredate", "1", "jdoe" ], "capture-output":true } }`, exitedOK)
+
+		Expect(manager.ManageGuestUser(domName, "", v1.GuestUserLock, user, "")).To(Succeed())
+
+		result, exists := cache.GuestUser.Load()
+		Expect(exists).To(BeTrue())
+		Expect(result.Action).To(Equal(string(v1.GuestUserLock)))
+		Expect(result.Username).To(Equal(user))
+		Expect(result.Succeeded).To(BeTrue())
+		Expect(result.Message).To(BeEmpty())
+		Expect(result.Timestamp).ToNot(BeNil())
+	})
+
+	It("should report a failing command in the metadata", func() {
+		expectExec(`{"execute": "guest-exec", "arguments": { "path": "usermod", "arg": [ "--unlock", "--expiredate", "", "jdoe" ], "capture-output":true } }`, exitedFailed)
+
+		err := manager.ManageGuestUser(domName, "", v1.GuestUserUnlock, user, "")
+		Expect(err).To(MatchError(ContainSubstring("usermod failed for user jdoe")))
+
+		result, exists := cache.GuestUser.Load()
+		Expect(exists).To(BeTrue())
+		Expect(result.Succeeded).To(BeFalse())
+		Expect(result.Message).To(Equal(err.Error()))
+	})
+
+	It("should report a failing password change in the metadata", func() {
+		mockLibvirt.ConnectionEXPECT().LookupDomainByName(domName).Return(nil, errors.New("not found"))
+
+		Expect(manager.ManageGuestUser(domName, "", v1.GuestUserSetPassword, user, password)).To(MatchError(ContainSubstring("failed to set the password of user jdoe")))
+
+		result, exists := cache.GuestUser.Load()
+		Expect(exists).To(BeTrue())
+		Expect(result.Action).To(Equal(string(v1.GuestUserSetPassword)))
+		Expect(result.Succeeded).To(BeFalse())
+	})
+
+	It("should reject unknown actions", func() {
+		Expect(manager.ManageGuestUser(domName, "", "Delete", user, "")).To(MatchError(`unsupported guest user action "Delete"`))
+	})
+})
@@ -20,6 +20,7 @@ package agentpoller
 
 import (
 	"math"
+	"slices"
 	"sync"
 	"time"
 
@@ -47,6 +48,9 @@ const (
 
 	pollInitialInterval = 10 * time.Second
 
+	// learnedInterfacesKey stores the interfaces addresses learned without the guest agent
+	learnedInterfacesKey = "learned-interfaces"
+
 	repeatingLogLevel = 3
 )
 
@@ -82,7 +86,7 @@ func (s *AsyncAgentStore) Store(key, value any) {
 
 	domainInfo := api.DomainGuestInfo{}
 	switch key {
-	case libvirt.DOMAIN_GUEST_INFO_OS, libvirt.DOMAIN_GUEST_INFO_INTERFACES, GetFSFreezeStatus:
+	case libvirt.DOMAIN_GUEST_INFO_OS, libvirt.DOMAIN_GUEST_INFO_INTERFACES, GetFSFreezeStatus, learnedInterfacesKey:
 		updated := (oldData == nil) || !equality.Semantic.DeepEqual(oldData, value)
 		if !updated {
 			return
@@ -128,14 +132,25 @@ func (s *AsyncAgentStore) GetSysInfo() api.DomainSysInfo {
 	}
 }
 
-// GetInterfaceStatus returns the interfaces Guest Agent reported
+// StoreLearnedInterfaces saves the interfaces addresses learned without the guest agent
+func (s *AsyncAgentStore) StoreLearnedInterfaces(interfaces []api.InterfaceStatus) {
+	s.Store(learnedInterfacesKey, interfaces)
+}
+
+// GetInterfaceStatus returns the interfaces Guest Agent reported,
+// followed by the interfaces addresses learned without it
 func (s *AsyncAgentStore) GetInterfaceStatus() []api.InterfaceStatus {
+	var interfaces []api.InterfaceStatus
 	data, ok := s.store.Load(libvirt.DOMAIN_GUEST_INFO_INTERFACES)
 	if ok {
-		return data.([]api.InterfaceStatus)
+		interfaces = data.([]api.InterfaceStatus)
 	}
 
-	return nil
+	if learnedData, learned := s.store.Load(learnedInterfacesKey); learned && len(learnedData.([]api.InterfaceStatus)) > 0 {
+		interfaces = append(slices.Clone(interfaces), learnedData.([]api.InterfaceStatus)...)
+	}
+
+	return interfaces
 }
 
 // GetGuestOSInfo returns the Guest OS version and architecture
@@ -197,6 +197,25 @@ var _ = Describe("Qemu agent poller", func() {
 			Expect(interfacesStatus).To(Equal(fakeInterfaces))
 		})
 
+		It("should report the learned interfaces after the guest agent interfaces", func() {
+			learnedInterfaces := []api.InterfaceStatus{{Mac: "02:00:00:00:00:01", Ip: "10.0.0.10", IPs: []string{"10.0.0.10"}, InfoSource: "dhcp-lease"}}
+			agentStore.Store(libvirt.DOMAIN_GUEST_INFO_INTERFACES, fakeInterfaces)
+			agentStore.StoreLearnedInterfaces(learnedInterfaces)
+
+			Expect(agentStore.GetInterfaceStatus()).To(Equal(append(append([]api.InterfaceStatus{}, fakeInterfaces...), learnedInterfaces...)))
+		})
+
+		It("should fire an event for new learned interfaces", func() {
+			learnedInterfaces := []api.InterfaceStatus{{Mac: "02:00:00:00:00:01", Ip: "10.0.0.10", IPs: []string{"10.0.0.10"}, InfoSource: "arp-ndp"}}
+			agentStore.StoreLearnedInterfaces(learnedInterfaces)
+			Expect(agentStore.AgentUpdated).To(Receive(Equal(AgentUpdatedEvent{
+				DomainInfo: api.DomainGuestInfo{Interfaces: learnedInterfaces},
+			})))
+
+			agentStore.StoreLearnedInterfaces(learnedInterfaces)
+			Expect(agentStore.AgentUpdated).ToNot(Receive())
+		})
+
 		It("should report nil when no osInfo exists", func() {
 			osInfo := agentStore.GetGuestOSInfo()
 
@@ -0,0 +1,11 @@
+package agent_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestAgent(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
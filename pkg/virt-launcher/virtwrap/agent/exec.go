@@ -4,6 +4,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
@@ -35,19 +37,19 @@ func (e ExecExitCode) Error() string {
 }
 
 // GuestExec sends the provided command and args to the guest agent for execution and returns an error on an unsucessful exit code
-// The resulting stdout will be returned as a string
-func GuestExec(virConn cli.Connection, domName string, command string, args []string, timeoutSeconds int32) (string, error) {
+// The resulting stdout will be returned as a string, of which at most maxOutputBytes are read when it is positive
+func GuestExec(virConn cli.Connection, domName string, command string, args []string, timeoutSeconds int32, maxOutputBytes int64) (string, error) {
 	stdOut := ""
 	argsStr := ""
 	for _, arg := range args {
 		if argsStr == "" {
-			argsStr = fmt.Sprintf("\"%s\"", arg)
+			argsStr = jsonString(arg)
 		} else {
-			argsStr = argsStr + fmt.Sprintf(", \"%s\"", arg)
+			argsStr = argsStr + ", " + jsonString(arg)
 		}
 	}
 
-	cmdExec := fmt.Sprintf(`{"execute": "guest-exec", "arguments": { "path": "%s", "arg": [ %s ], "capture-output":true } }`, command, argsStr)
+	cmdExec := fmt.Sprintf(`{"execute": "guest-exec", "arguments": { "path": %s, "arg": [ %s ], "capture-output":true } }`, jsonString(command), argsStr)
 	output, err := virConn.QemuAgentCommand(cmdExec, domName)
 	if err != nil {
 		return "", err
@@ -81,11 +83,10 @@ func GuestExec(virConn cli.Connection, domName string, command string, args []st
 		}
 
 		if execStatusRes.Return.Exited {
-			stdOutBytes, err := base64.StdEncoding.DecodeString(execStatusRes.Return.OutData)
+			stdOut, err = decodeOutput(execStatusRes.Return.OutData, maxOutputBytes)
 			if err != nil {
 				return "", err
 			}
-			stdOut = string(stdOutBytes)
 			exitCode = execStatusRes.Return.ExitCode
 			exited = true
 			break
@@ -104,3 +105,23 @@ func GuestExec(virConn cli.Connection, domName string, command string, args []st
 
 	return stdOut, nil
 }
+
+// decodeOutput decodes the base64 encoded output of the command, stopping after maxBytes when it is positive
+func decodeOutput(outData string, maxBytes int64) (string, error) {
+	var decoder io.Reader = base64.NewDecoder(base64.StdEncoding, strings.NewReader(outData))
+	if maxBytes > 0 {
+		decoder = io.LimitReader(decoder, maxBytes)
+	}
+	output, err := io.ReadAll(decoder)
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+// jsonString quotes s as a JSON string, so that the command and the arguments
+// cannot alter the structure of the agent command
+func jsonString(s string) string {
+	quoted, _ := json.Marshal(s)
+	return string(quoted)
+}
@@ -0,0 +1,35 @@
+package agent_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Guest exec", func() {
+	const (
+		domName   = "some-domain"
+		execCmd   = `{"execute": "guest-exec", "arguments": { "path": "cat", "arg": [ "/etc/hostname" ], "capture-output":true } }`
+		statusCmd = `{"execute": "guest-exec-status", "arguments": { "pid": 42 } }`
+	)
+
+	DescribeTable("should read the output of the command", func(maxOutputBytes int64, expectedOutput string) {
+		virConn := cli.NewMockConnection(gomock.NewController(GinkgoT()))
+		gomock.InOrder(
+			virConn.EXPECT().QemuAgentCommand(execCmd, domName).Return(`{"return":{"pid":42}}`, nil),
+			virConn.EXPECT().QemuAgentCommand(statusCmd, domName).
+				Return(`{"return":{"exited":true,"exitcode":0,"out-data":"c29tZS1ob3N0bmFtZQo="}}`, nil),
+		)
+
+		output, err := agent.GuestExec(virConn, domName, "cat", []string{"/etc/hostname"}, 10, maxOutputBytes)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output).To(Equal(expectedOutput))
+	},
+		Entry("whole without a limit", int64(0), "some-hostname\n"),
+		Entry("whole within the limit", int64(14), "some-hostname\n"),
+		Entry("up to the limit", int64(4), "some"),
+	)
+})
@@ -0,0 +1,143 @@
+package agent
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+type fileOpenReturn struct {
+	Return int64 `json:"return"`
+}
+
+type fileSeekReturn struct {
+	Return fileSeekReturnData `json:"return"`
+}
+type fileSeekReturnData struct {
+	Position int64 `json:"position"`
+	EOF      bool  `json:"eof"`
+}
+
+type fileReadReturn struct {
+	Return fileReadReturnData `json:"return"`
+}
+type fileReadReturnData struct {
+	Count  int64  `json:"count"`
+	BufB64 string `json:"buf-b64"`
+	EOF    bool   `json:"eof"`
+}
+
+type fileWriteReturn struct {
+	Return fileWriteReturnData `json:"return"`
+}
+type fileWriteReturnData struct {
+	Count int64 `json:"count"`
+}
+
+// GuestFileOpen opens the guest file at path for reading, or for writing, in which case the file is
+// created or truncated. It returns the handle of the file, and the size of a file opened for reading.
+func GuestFileOpen(virConn cli.Connection, domName string, path string, write bool) (int64, int64, error) {
+	if write {
+		handle, err := guestFileOpen(virConn, domName, path, "wb")
+		return handle, 0, err
+	}
+
+	handle, err := guestFileOpen(virConn, domName, path, "rb")
+	if err != nil {
+		return 0, 0, err
+	}
+	size, err := guestFileSeek(virConn, domName, handle, 0, "end")
+	if err == nil {
+		_, err = guestFileSeek(virConn, domName, handle, 0, "set")
+	}
+	if err != nil {
+		guestFileCloseAndLog(virConn, domName, path, handle)
+		return 0, 0, err
+	}
+	return handle, size, nil
+}
+
+// GuestFileRead reads at most length bytes of the opened guest file, from its current position.
+// It returns the data read and whether the end of the file was reached.
+func GuestFileRead(virConn cli.Connection, domName string, handle, length int64) ([]byte, bool, error) {
+	data := []byte{}
+	eof := false
+	for int64(len(data)) < length && !eof {
+		cmdRead := fmt.Sprintf(`{"execute": "guest-file-read", "arguments": { "handle": %d, "count": %d } }`, handle, length-int64(len(data)))
+		output, err := virConn.QemuAgentCommand(cmdRead, domName)
+		if err != nil {
+			return nil, false, err
+		}
+		readRes := &fileReadReturn{}
+		if err := json.Unmarshal([]byte(output), readRes); err != nil {
+			return nil, false, err
+		}
+		chunk, err := base64.StdEncoding.DecodeString(readRes.Return.BufB64)
+		if err != nil {
+			return nil, false, err
+		}
+		data = append(data, chunk...)
+		eof = readRes.Return.EOF || len(chunk) == 0
+	}
+	return data, eof, nil
+}
+
+// GuestFileWrite writes data to the opened guest file, at its current position
+func GuestFileWrite(virConn cli.Connection, domName string, handle int64, data []byte) error {
+	cmdWrite := fmt.Sprintf(`{"execute": "guest-file-write", "arguments": { "handle": %d, "buf-b64": %q } }`, handle, base64.StdEncoding.EncodeToString(data))
+	output, err := virConn.QemuAgentCommand(cmdWrite, domName)
+	if err != nil {
+		return err
+	}
+	writeRes := &fileWriteReturn{}
+	if err := json.Unmarshal([]byte(output), writeRes); err != nil {
+		return err
+	}
+	if writeRes.Return.Count != int64(len(data)) {
+		return fmt.Errorf("only %d of %d bytes were written to the guest file", writeRes.Return.Count, len(data))
+	}
+	return nil
+}
+
+// GuestFileClose closes the opened guest file
+func GuestFileClose(virConn cli.Connection, domName string, handle int64) error {
+	cmdClose := fmt.Sprintf(`{"execute": "guest-file-close", "arguments": { "handle": %d } }`, handle)
+	_, err := virConn.QemuAgentCommand(cmdClose, domName)
+	return err
+}
+
+func guestFileOpen(virConn cli.Connection, domName string, path string, mode string) (int64, error) {
+	cmdOpen := fmt.Sprintf(`{"execute": "guest-file-open", "arguments": { "path": %s, "mode": %q } }`, jsonString(path), mode)
+	output, err := virConn.QemuAgentCommand(cmdOpen, domName)
+	if err != nil {
+		return 0, err
+	}
+	openRes := &fileOpenReturn{}
+	if err := json.Unmarshal([]byte(output), openRes); err != nil {
+		return 0, err
+	}
+	return openRes.Return, nil
+}
+
+func guestFileSeek(virConn cli.Connection, domName string, handle int64, offset int64, whence string) (int64, error) {
+	cmdSeek := fmt.Sprintf(`{"execute": "guest-file-seek", "arguments": { "handle": %d, "offset": %d, "whence": %q } }`, handle, offset, whence)
+	output, err := virConn.QemuAgentCommand(cmdSeek, domName)
+	if err != nil {
+		return 0, err
+	}
+	seekRes := &fileSeekReturn{}
+	if err := json.Unmarshal([]byte(output), seekRes); err != nil {
+		return 0, err
+	}
+	return seekRes.Return.Position, nil
+}
+
+func guestFileCloseAndLog(virConn cli.Connection, domName string, path string, handle int64) {
+	if err := GuestFileClose(virConn, domName, handle); err != nil {
+		log.Log.Reason(err).Warningf("Failed to close guest file %s of domain %s", path, domName)
+	}
+}
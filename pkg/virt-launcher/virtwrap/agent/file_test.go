@@ -0,0 +1,120 @@
+package agent_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Guest files", func() {
+	const (
+		domName = "some-domain"
+
+		openReadCmd  = `{"execute": "guest-file-open", "arguments": { "path": "/tmp/some\"file", "mode": "rb" } }`
+		openWriteCmd = `{"execute": "guest-file-open", "arguments": { "path": "/tmp/some\"file", "mode": "wb" } }`
+		seekEndCmd   = `{"execute": "guest-file-seek", "arguments": { "handle": 1000, "offset": 0, "whence": "end" } }`
+		seekSetCmd   = `{"execute": "guest-file-seek", "arguments": { "handle": 1000, "offset": 0, "whence": "set" } }`
+		closeCmd     = `{"execute": "guest-file-close", "arguments": { "handle": 1000 } }`
+		openReturn   = `{"return":1000}`
+	)
+	path := `/tmp/some"file`
+
+	var virConn *cli.MockConnection
+
+	BeforeEach(func() {
+		virConn = cli.NewMockConnection(gomock.NewController(GinkgoT()))
+	})
+
+	Context("open", func() {
+		It("should return the size of a file opened for reading", func() {
+			gomock.InOrder(
+				virConn.EXPECT().QemuAgentCommand(openReadCmd, domName).Return(openReturn, nil),
+				virConn.EXPECT().QemuAgentCommand(seekEndCmd, domName).Return(`{"return":{"position":11,"eof":true}}`, nil),
+				virConn.EXPECT().QemuAgentCommand(seekSetCmd, domName).Return(`{"return":{"position":0,"eof":false}}`, nil),
+			)
+
+			handle, size, err := agent.GuestFileOpen(virConn, domName, path, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handle).To(BeEquivalentTo(1000))
+			Expect(size).To(BeEquivalentTo(11))
+		})
+
+		It("should close the file when its size cannot be found", func() {
+			gomock.InOrder(
+				virConn.EXPECT().QemuAgentCommand(openReadCmd, domName).Return(openReturn, nil),
+				virConn.EXPECT().QemuAgentCommand(seekEndCmd, domName).Return("", errors.New("Illegal seek")),
+				virConn.EXPECT().QemuAgentCommand(closeCmd, domName).Return(`{"return":{}}`, nil),
+			)
+
+			_, _, err := agent.GuestFileOpen(virConn, domName, path, false)
+			Expect(err).To(MatchError("Illegal seek"))
+		})
+
+		It("should create the file opened for writing", func() {
+			virConn.EXPECT().QemuAgentCommand(openWriteCmd, domName).Return(openReturn, nil)
+
+			handle, _, err := agent.GuestFileOpen(virConn, domName, path, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handle).To(BeEquivalentTo(1000))
+		})
+
+		It("should fail when the file cannot be opened", func() {
+			virConn.EXPECT().QemuAgentCommand(openReadCmd, domName).Return("", errors.New("No such file or directory"))
+
+			_, _, err := agent.GuestFileOpen(virConn, domName, path, false)
+			Expect(err).To(MatchError("No such file or directory"))
+		})
+	})
+
+	Context("read", func() {
+		It("should read the requested chunk", func() {
+			gomock.InOrder(
+				virConn.EXPECT().QemuAgentCommand(`{"execute": "guest-file-read", "arguments": { "handle": 1000, "count": 4 } }`, domName).
+					Return(`{"return":{"count":2,"buf-b64":"bGw=","eof":false}}`, nil),
+				virConn.EXPECT().QemuAgentCommand(`{"execute": "guest-file-read", "arguments": { "handle": 1000, "count": 2 } }`, domName).
+					Return(`{"return":{"count":2,"buf-b64":"byA=","eof":false}}`, nil),
+			)
+
+			data, eof, err := agent.GuestFileRead(virConn, domName, 1000, 4)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("llo "))
+			Expect(eof).To(BeFalse())
+		})
+
+		It("should report the end of the file", func() {
+			virConn.EXPECT().QemuAgentCommand(`{"execute": "guest-file-read", "arguments": { "handle": 1000, "count": 16 } }`, domName).
+				Return(`{"return":{"count":5,"buf-b64":"aGVsbG8=","eof":true}}`, nil)
+
+			data, eof, err := agent.GuestFileRead(virConn, domName, 1000, 16)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("hello"))
+			Expect(eof).To(BeTrue())
+		})
+	})
+
+	Context("write", func() {
+		It("should write the chunk", func() {
+			virConn.EXPECT().QemuAgentCommand(`{"execute": "guest-file-write", "arguments": { "handle": 1000, "buf-b64": "aGVsbG8=" } }`, domName).
+				Return(`{"return":{"count":5,"eof":false}}`, nil)
+
+			Expect(agent.GuestFileWrite(virConn, domName, 1000, []byte("hello"))).To(Succeed())
+		})
+
+		It("should fail on a short write", func() {
+			virConn.EXPECT().QemuAgentCommand(gomock.Any(), domName).Return(`{"return":{"count":2,"eof":false}}`, nil)
+
+			Expect(agent.GuestFileWrite(virConn, domName, 1000, []byte("hello"))).To(MatchError(ContainSubstring("only 2 of 5 bytes were written")))
+		})
+	})
+
+	It("should close the file", func() {
+		virConn.EXPECT().QemuAgentCommand(closeCmd, domName).Return("", errors.New("No space left on device"))
+
+		Expect(agent.GuestFileClose(virConn, domName, 1000)).To(MatchError("No space left on device"))
+	})
+})
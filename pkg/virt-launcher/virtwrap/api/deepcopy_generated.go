@@ -290,6 +290,16 @@ func (in *BackupTarget) DeepCopy() *BackupTarget {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BandWidth) DeepCopyInto(out *BandWidth) {
 	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandWidthRate)
+		**out = **in
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandWidthRate)
+		**out = **in
+	}
 	return
 }
 
@@ -303,6 +313,22 @@ func (in *BandWidth) DeepCopy() *BandWidth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandWidthRate) DeepCopyInto(out *BandWidthRate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandWidthRate.
+func (in *BandWidthRate) DeepCopy() *BandWidthRate {
+	if in == nil {
+		return nil
+	}
+	out := new(BandWidthRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockIO) DeepCopyInto(out *BlockIO) {
 	*out = *in
@@ -667,6 +693,22 @@ func (in *Clock) DeepCopy() *Clock {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInitMetadata) DeepCopyInto(out *CloudInitMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInitMetadata.
+func (in *CloudInitMetadata) DeepCopy() *CloudInitMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInitMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Commandline) DeepCopyInto(out *Commandline) {
 	*out = *in
@@ -1122,6 +1164,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(Shareable)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1224,6 +1271,117 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	if in.TotalBytesSec != nil {
+		in, out := &in.TotalBytesSec, &out.TotalBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadBytesSec != nil {
+		in, out := &in.ReadBytesSec, &out.ReadBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteBytesSec != nil {
+		in, out := &in.WriteBytesSec, &out.WriteBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalIOPSSec != nil {
+		in, out := &in.TotalIOPSSec, &out.TotalIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadIOPSSec != nil {
+		in, out := &in.ReadIOPSSec, &out.ReadIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteIOPSSec != nil {
+		in, out := &in.WriteIOPSSec, &out.WriteIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalBytesSecMax != nil {
+		in, out := &in.TotalBytesSecMax, &out.TotalBytesSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadBytesSecMax != nil {
+		in, out := &in.ReadBytesSecMax, &out.ReadBytesSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteBytesSecMax != nil {
+		in, out := &in.WriteBytesSecMax, &out.WriteBytesSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalIOPSSecMax != nil {
+		in, out := &in.TotalIOPSSecMax, &out.TotalIOPSSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadIOPSSecMax != nil {
+		in, out := &in.ReadIOPSSecMax, &out.ReadIOPSSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteIOPSSecMax != nil {
+		in, out := &in.WriteIOPSSecMax, &out.WriteIOPSSecMax
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.SizeIOPSSec != nil {
+		in, out := &in.SizeIOPSSec, &out.SizeIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalBytesSecMaxLength != nil {
+		in, out := &in.TotalBytesSecMaxLength, &out.TotalBytesSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadBytesSecMaxLength != nil {
+		in, out := &in.ReadBytesSecMaxLength, &out.ReadBytesSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteBytesSecMaxLength != nil {
+		in, out := &in.WriteBytesSecMaxLength, &out.WriteBytesSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalIOPSSecMaxLength != nil {
+		in, out := &in.TotalIOPSSecMaxLength, &out.TotalIOPSSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadIOPSSecMaxLength != nil {
+		in, out := &in.ReadIOPSSecMaxLength, &out.ReadIOPSSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteIOPSSecMaxLength != nil {
+		in, out := &in.WriteIOPSSecMaxLength, &out.WriteIOPSSecMaxLength
+		*out = new(uint64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSecret) DeepCopyInto(out *DiskSecret) {
 	*out = *in
@@ -2207,6 +2365,26 @@ func (in *GuestPanicInfo) DeepCopy() *GuestPanicInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestUserMetadata) DeepCopyInto(out *GuestUserMetadata) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestUserMetadata.
+func (in *GuestUserMetadata) DeepCopy() *GuestUserMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestUserMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDevice) DeepCopyInto(out *HostDevice) {
 	*out = *in
@@ -2373,7 +2551,7 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 	if in.BandWidth != nil {
 		in, out := &in.BandWidth, &out.BandWidth
 		*out = new(BandWidth)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.BootOrder != nil {
 		in, out := &in.BootOrder, &out.BootOrder
@@ -2517,6 +2695,11 @@ func (in *InterfaceSource) DeepCopyInto(out *InterfaceSource) {
 		*out = new(Address)
 		**out = **in
 	}
+	if in.Reconnect != nil {
+		in, out := &in.Reconnect, &out.Reconnect
+		*out = new(InterfaceSourceReconnect)
+		**out = **in
+	}
 	return
 }
 
@@ -2530,6 +2713,22 @@ func (in *InterfaceSource) DeepCopy() *InterfaceSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSourceReconnect) DeepCopyInto(out *InterfaceSourceReconnect) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSourceReconnect.
+func (in *InterfaceSourceReconnect) DeepCopy() *InterfaceSourceReconnect {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceSourceReconnect)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceStatus) DeepCopyInto(out *InterfaceStatus) {
 	*out = *in
@@ -2595,6 +2794,16 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestUser != nil {
+		in, out := &in.GuestUser, &out.GuestUser
+		*out = new(GuestUserMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudInit != nil {
+		in, out := &in.CloudInit, &out.CloudInit
+		*out = new(CloudInitMetadata)
+		**out = **in
+	}
 	return
 }
 
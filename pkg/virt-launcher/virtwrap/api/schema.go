@@ -158,6 +158,9 @@ type InterfaceStatus struct {
 	Ip            string
 	IPs           []string
 	InterfaceName string
+	// InfoSource is set when the addresses were learned by virt-launcher
+	// and not reported by the guest agent.
+	InfoSource string
 }
 
 type SEVNodeParameters struct {
@@ -418,6 +421,8 @@ type KubeVirtMetadata struct {
 	Backup           *BackupMetadata           `xml:"backup,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	GuestUser        *GuestUserMetadata        `xml:"guestUser,omitempty"`
+	CloudInit        *CloudInitMetadata        `xml:"cloudInit,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -425,6 +430,25 @@ type AccessCredentialMetadata struct {
 	Message   string `xml:"message,omitempty"`
 }
 
+// CloudInitMetadata is the result of the last update of the cloud-init data of a running VMI
+type CloudInitMetadata struct {
+	// SourceRevision is the revision of the data in the secrets mounted in the pod, when they changed
+	SourceRevision string `xml:"sourceRevision,omitempty"`
+	// Revision is the revision of the data presented to the guest, when it was updated
+	Revision  string `xml:"revision,omitempty"`
+	Succeeded bool   `xml:"succeeded,omitempty"`
+	Message   string `xml:"message,omitempty"`
+}
+
+// GuestUserMetadata is the result of the last change made to a guest user
+type GuestUserMetadata struct {
+	Action    string       `xml:"action,omitempty"`
+	Username  string       `xml:"username,omitempty"`
+	Timestamp *metav1.Time `xml:"timestamp,omitempty"`
+	Succeeded bool         `xml:"succeeded,omitempty"`
+	Message   string       `xml:"message,omitempty"`
+}
+
 type MemoryDumpMetadata struct {
 	FileName       string       `xml:"fileName,omitempty"`
 	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
@@ -802,6 +826,7 @@ type Disk struct {
 	FilesystemOverhead *v1.Percent   `xml:"filesystemOverhead,omitempty"`
 	Capacity           *int64        `xml:"capacity,omitempty"`
 	Shareable          *Shareable    `xml:"shareable,omitempty"`
+	IOTune             *DiskIOTune   `xml:"iotune,omitempty"`
 }
 
 type DiskAuth struct {
@@ -887,6 +912,29 @@ type BlockIO struct {
 	DiscardGranularity *uint `xml:"discard_granularity,attr,omitempty"`
 }
 
+type DiskIOTune struct {
+	TotalBytesSec          *uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec           *uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec          *uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIOPSSec           *uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIOPSSec            *uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIOPSSec           *uint64 `xml:"write_iops_sec,omitempty"`
+	TotalBytesSecMax       *uint64 `xml:"total_bytes_sec_max,omitempty"`
+	ReadBytesSecMax        *uint64 `xml:"read_bytes_sec_max,omitempty"`
+	WriteBytesSecMax       *uint64 `xml:"write_bytes_sec_max,omitempty"`
+	TotalIOPSSecMax        *uint64 `xml:"total_iops_sec_max,omitempty"`
+	ReadIOPSSecMax         *uint64 `xml:"read_iops_sec_max,omitempty"`
+	WriteIOPSSecMax        *uint64 `xml:"write_iops_sec_max,omitempty"`
+	SizeIOPSSec            *uint64 `xml:"size_iops_sec,omitempty"`
+	GroupName              string  `xml:"group_name,omitempty"`
+	TotalBytesSecMaxLength *uint64 `xml:"total_bytes_sec_max_length,omitempty"`
+	ReadBytesSecMaxLength  *uint64 `xml:"read_bytes_sec_max_length,omitempty"`
+	WriteBytesSecMaxLength *uint64 `xml:"write_bytes_sec_max_length,omitempty"`
+	TotalIOPSSecMaxLength  *uint64 `xml:"total_iops_sec_max_length,omitempty"`
+	ReadIOPSSecMaxLength   *uint64 `xml:"read_iops_sec_max_length,omitempty"`
+	WriteIOPSSecMaxLength  *uint64 `xml:"write_iops_sec_max_length,omitempty"`
+}
+
 type Reservations struct {
 	Managed            string              `xml:"managed,attr,omitempty"`
 	SourceReservations *SourceReservations `xml:"source,omitempty"`
@@ -1005,6 +1053,14 @@ type LinkState struct {
 }
 
 type BandWidth struct {
+	Inbound  *BandWidthRate `xml:"inbound,omitempty"`
+	Outbound *BandWidthRate `xml:"outbound,omitempty"`
+}
+
+type BandWidthRate struct {
+	Average uint32 `xml:"average,attr"`
+	Peak    uint32 `xml:"peak,attr,omitempty"`
+	Burst   uint32 `xml:"burst,attr,omitempty"`
 }
 
 type BootOrder struct {
@@ -1024,11 +1080,19 @@ type FilterRef struct {
 }
 
 type InterfaceSource struct {
-	Network string   `xml:"network,attr,omitempty"`
-	Device  string   `xml:"dev,attr,omitempty"`
-	Bridge  string   `xml:"bridge,attr,omitempty"`
-	Mode    string   `xml:"mode,attr,omitempty"`
-	Address *Address `xml:"address,omitempty"`
+	Network   string                    `xml:"network,attr,omitempty"`
+	Device    string                    `xml:"dev,attr,omitempty"`
+	Bridge    string                    `xml:"bridge,attr,omitempty"`
+	Type      string                    `xml:"type,attr,omitempty"`
+	Path      string                    `xml:"path,attr,omitempty"`
+	Mode      string                    `xml:"mode,attr,omitempty"`
+	Address   *Address                  `xml:"address,omitempty"`
+	Reconnect *InterfaceSourceReconnect `xml:"reconnect,omitempty"`
+}
+
+type InterfaceSourceReconnect struct {
+	Enabled string `xml:"enabled,attr"`
+	Timeout uint   `xml:"timeout,attr,omitempty"`
 }
 
 type Model struct {
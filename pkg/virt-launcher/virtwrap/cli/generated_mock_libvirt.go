@@ -307,6 +307,21 @@ func (mr *MockConnectionMockRecorder) QemuAgentCommand(command, domainName any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QemuAgentCommand", reflect.TypeOf((*MockConnection)(nil).QemuAgentCommand), command, domainName)
 }
 
+// QemuAgentCommandWithTimeout mocks base method.
+func (m *MockConnection) QemuAgentCommandWithTimeout(command, domainName string, timeoutSeconds int32) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QemuAgentCommandWithTimeout", command, domainName, timeoutSeconds)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QemuAgentCommandWithTimeout indicates an expected call of QemuAgentCommandWithTimeout.
+func (mr *MockConnectionMockRecorder) QemuAgentCommandWithTimeout(command, domainName, timeoutSeconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QemuAgentCommandWithTimeout", reflect.TypeOf((*MockConnection)(nil).QemuAgentCommandWithTimeout), command, domainName, timeoutSeconds)
+}
+
 // SetReconnectChan mocks base method.
 func (m *MockConnection) SetReconnectChan(reconnect chan bool) {
 	m.ctrl.T.Helper()
@@ -917,6 +932,20 @@ func (mr *MockVirDomainMockRecorder) Screenshot(stream, screen, flags any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Screenshot", reflect.TypeOf((*MockVirDomain)(nil).Screenshot), stream, screen, flags)
 }
 
+// SetBlockIoTune mocks base method.
+func (m *MockVirDomain) SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlockIoTune", disk, params, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBlockIoTune indicates an expected call of SetBlockIoTune.
+func (mr *MockVirDomainMockRecorder) SetBlockIoTune(disk, params, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockIoTune", reflect.TypeOf((*MockVirDomain)(nil).SetBlockIoTune), disk, params, flags)
+}
+
 // SetLaunchSecurityState mocks base method.
 func (m *MockVirDomain) SetLaunchSecurityState(params *libvirt.DomainLaunchSecurityStateParameters, flags uint32) error {
 	m.ctrl.T.Helper()
@@ -60,6 +60,7 @@ type Connection interface {
 	NewStream(flags libvirt.StreamFlags) (Stream, error)
 	SetReconnectChan(reconnect chan bool)
 	QemuAgentCommand(command string, domainName string) (string, error)
+	QemuAgentCommandWithTimeout(command string, domainName string, timeoutSeconds int32) (string, error)
 	GetAllDomainStats(statsTypes libvirt.DomainStatsTypes, flags libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error)
 	// helper method, not found in libvirt
 	// We add this helper to
@@ -281,6 +282,19 @@ func (l *LibvirtConnection) QemuAgentCommand(command string, domainName string)
 	return result, err
 }
 
+// QemuAgentCommandWithTimeout executes a command on the Qemu guest agent, waiting at most timeoutSeconds for the reply
+func (l *LibvirtConnection) QemuAgentCommandWithTimeout(command string, domainName string, timeoutSeconds int32) (string, error) {
+	if err := l.reconnectIfNecessary(); err != nil {
+		return "", err
+	}
+	domain, err := l.Connect.LookupDomainByName(domainName)
+	if err != nil {
+		return "", err
+	}
+	defer domain.Free()
+	return domain.QemuAgentCommand(command, libvirt.DomainQemuAgentCommandTimeout(timeoutSeconds), uint32(0))
+}
+
 func (l *LibvirtConnection) GetAllDomainStats(statsTypes libvirt.DomainStatsTypes, flags libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error) {
 	if err := l.reconnectIfNecessary(); err != nil {
 		return nil, err
@@ -672,6 +686,7 @@ type VirDomain interface {
 	BackupBegin(backupXML string, checkpointXML string, flags libvirt.DomainBackupBeginFlags) error
 	CreateCheckpointXML(xmlConfig string, flags libvirt.DomainCheckpointCreateFlags) (*libvirt.DomainCheckpoint, error)
 	QemuMonitorCommand(command string, flags libvirt.DomainQemuMonitorCommandFlags) (string, error)
+	SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error
 }
 
 func NewConnection(uri string, user string, pass string, checkInterval time.Duration) (Connection, error) {
@@ -589,7 +589,7 @@ func (l *Launcher) Exec(ctx context.Context, request *cmdv1.ExecRequest) (*cmdv1
 		},
 	}
 
-	stdOut, err := l.domainManager.Exec(request.DomainName, request.Command, request.Args, request.TimeoutSeconds)
+	stdOut, err := l.domainManager.Exec(request.DomainName, request.Command, request.Args, request.TimeoutSeconds, request.MaxOutputBytes)
 	resp.StdOut = stdOut
 
 	exitCode := agent.ExecExitCode{}
@@ -618,6 +618,104 @@ func (l *Launcher) GuestPing(ctx context.Context, request *cmdv1.GuestPingReques
 	return resp, nil
 }
 
+// GuestFileOpen opens a guest file through the guest agent
+func (l *Launcher) GuestFileOpen(_ context.Context, request *cmdv1.GuestFileOpenRequest) (*cmdv1.GuestFileOpenResponse, error) {
+	resp := &cmdv1.GuestFileOpenResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+
+	handle, size, err := l.domainManager.GuestFileOpen(request.DomainName, request.Path, request.Write)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to open guest file %s", request.Path)
+		resp.Response.Success = false
+		resp.Response.Message = getErrorMessage(err)
+		return resp, nil
+	}
+	resp.Handle = handle
+	resp.Size = size
+	return resp, nil
+}
+
+// GuestFileRead reads a chunk of an opened guest file through the guest agent
+func (l *Launcher) GuestFileRead(_ context.Context, request *cmdv1.GuestFileReadRequest) (*cmdv1.GuestFileReadResponse, error) {
+	resp := &cmdv1.GuestFileReadResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+
+	data, eof, err := l.domainManager.GuestFileRead(request.DomainName, request.Handle, request.Length)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to read guest file handle %d", request.Handle)
+		resp.Response.Success = false
+		resp.Response.Message = getErrorMessage(err)
+		return resp, nil
+	}
+	resp.Data = data
+	resp.Eof = eof
+	return resp, nil
+}
+
+// GuestFileWrite writes a chunk of an opened guest file through the guest agent
+func (l *Launcher) GuestFileWrite(_ context.Context, request *cmdv1.GuestFileWriteRequest) (*cmdv1.Response, error) {
+	resp := &cmdv1.Response{
+		Success: true,
+	}
+
+	if err := l.domainManager.GuestFileWrite(request.DomainName, request.Handle, request.Data); err != nil {
+		log.Log.Reason(err).Errorf("Failed to write guest file handle %d", request.Handle)
+		resp.Success = false
+		resp.Message = getErrorMessage(err)
+	}
+	return resp, nil
+}
+
+// GuestFileClose closes an opened guest file through the guest agent
+func (l *Launcher) GuestFileClose(_ context.Context, request *cmdv1.GuestFileCloseRequest) (*cmdv1.Response, error) {
+	resp := &cmdv1.Response{
+		Success: true,
+	}
+
+	if err := l.domainManager.GuestFileClose(request.DomainName, request.Handle); err != nil {
+		log.Log.Reason(err).Errorf("Failed to close guest file handle %d", request.Handle)
+		resp.Success = false
+		resp.Message = getErrorMessage(err)
+	}
+	return resp, nil
+}
+
+// GuestUser creates, locks or unlocks a guest user, or sets its password, through the guest agent
+func (l *Launcher) GuestUser(_ context.Context, request *cmdv1.GuestUserRequest) (*cmdv1.Response, error) {
+	resp := &cmdv1.Response{
+		Success: true,
+	}
+
+	if err := l.domainManager.GuestUser(request.DomainName, v1.GuestUserAction(request.Action), request.Username, request.Password); err != nil {
+		log.Log.Reason(err).Errorf("Failed to apply %s to guest user %s", request.Action, request.Username)
+		resp.Success = false
+		resp.Message = getErrorMessage(err)
+	}
+	return resp, nil
+}
+
+func (l *Launcher) SyncGuestTime(_ context.Context, request *cmdv1.GuestTimeSyncRequest) (*cmdv1.GuestTimeSyncResponse, error) {
+	resp := &cmdv1.GuestTimeSyncResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+	drift, err := l.domainManager.SyncGuestTime(request.DomainName, request.TimeoutSeconds)
+	resp.DriftNanoseconds = drift.Nanoseconds()
+	if err != nil {
+		resp.Response.Success = false
+		resp.Response.Message = err.Error()
+		return resp, err
+	}
+	return resp, nil
+}
+
 func RunServer(socketPath string,
 	domainManager virtwrap.DomainManager,
 	stopChan chan struct{},
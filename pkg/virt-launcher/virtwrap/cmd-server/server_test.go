@@ -414,6 +414,7 @@ var _ = Describe("Virt remote commands", func() {
 				testGuestPingErr         = errors.New("guest ping error")
 				testStdOut               = "stdOut"
 				testTimeoutSeconds int32 = 10
+				testMaxOutputBytes int64 = 1024
 
 				expectExec = func() *gomock.Call {
 					return domainManager.EXPECT().Exec(
@@ -421,6 +422,7 @@ var _ = Describe("Virt remote commands", func() {
 						testCommand,
 						testArgs,
 						testTimeoutSeconds,
+						testMaxOutputBytes,
 					)
 				}
 				execRequest = func() *cmdv1.ExecRequest {
@@ -429,6 +431,7 @@ var _ = Describe("Virt remote commands", func() {
 						Command:        testCommand,
 						Args:           testArgs,
 						TimeoutSeconds: testTimeoutSeconds,
+						MaxOutputBytes: testMaxOutputBytes,
 					}
 				}
 				expectGuestPing = func() *gomock.Call {
@@ -510,6 +513,128 @@ var _ = Describe("Virt remote commands", func() {
 
 		})
 
+		Context("guest files", func() {
+			const (
+				testDomainName = "test"
+				testPath       = "/tmp/file"
+			)
+
+			var server cmdv1.CmdServer
+
+			BeforeEach(func() {
+				server = &Launcher{
+					domainManager: domainManager,
+				}
+			})
+
+			It("should return the handle and the size of the opened file", func() {
+				domainManager.EXPECT().GuestFileOpen(testDomainName, testPath, false).Return(int64(3), int64(8), nil)
+				resp, err := server.GuestFileOpen(context.TODO(), &cmdv1.GuestFileOpenRequest{
+					DomainName: testDomainName,
+					Path:       testPath,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Response.Success).To(BeTrue())
+				Expect(resp.Handle).To(BeEquivalentTo(3))
+				Expect(resp.Size).To(BeEquivalentTo(8))
+			})
+
+			It("should return open errors in the response", func() {
+				domainManager.EXPECT().GuestFileOpen(testDomainName, testPath, true).Return(int64(0), int64(0), errors.New("read-only file system"))
+				resp, err := server.GuestFileOpen(context.TODO(), &cmdv1.GuestFileOpenRequest{
+					DomainName: testDomainName,
+					Path:       testPath,
+					Write:      true,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Response.Success).To(BeFalse())
+				Expect(resp.Response.Message).To(Equal("read-only file system"))
+			})
+
+			It("should return the chunk read", func() {
+				domainManager.EXPECT().GuestFileRead(testDomainName, int64(3), int64(8)).Return([]byte("data"), true, nil)
+				resp, err := server.GuestFileRead(context.TODO(), &cmdv1.GuestFileReadRequest{
+					DomainName: testDomainName,
+					Handle:     3,
+					Length:     8,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Response.Success).To(BeTrue())
+				Expect(resp.Data).To(Equal([]byte("data")))
+				Expect(resp.Eof).To(BeTrue())
+			})
+
+			It("should return read errors in the response", func() {
+				domainManager.EXPECT().GuestFileRead(testDomainName, int64(3), int64(8)).Return(nil, false, errors.New("bad file descriptor"))
+				resp, err := server.GuestFileRead(context.TODO(), &cmdv1.GuestFileReadRequest{
+					DomainName: testDomainName,
+					Handle:     3,
+					Length:     8,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Response.Success).To(BeFalse())
+				Expect(resp.Response.Message).To(Equal("bad file descriptor"))
+			})
+
+			It("should write the chunk", func() {
+				domainManager.EXPECT().GuestFileWrite(testDomainName, int64(3), []byte("data")).Return(nil)
+				resp, err := server.GuestFileWrite(context.TODO(), &cmdv1.GuestFileWriteRequest{
+					DomainName: testDomainName,
+					Handle:     3,
+					Data:       []byte("data"),
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Success).To(BeTrue())
+			})
+
+			It("should return close errors in the response", func() {
+				domainManager.EXPECT().GuestFileClose(testDomainName, int64(3)).Return(errors.New("no space left on device"))
+				resp, err := server.GuestFileClose(context.TODO(), &cmdv1.GuestFileCloseRequest{
+					DomainName: testDomainName,
+					Handle:     3,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Success).To(BeFalse())
+				Expect(resp.Message).To(Equal("no space left on device"))
+			})
+		})
+
+		Context("guest users", func() {
+			const testDomainName = "test"
+
+			var server cmdv1.CmdServer
+
+			BeforeEach(func() {
+				server = &Launcher{
+					domainManager: domainManager,
+				}
+			})
+
+			It("should change the guest user", func() {
+				domainManager.EXPECT().GuestUser(testDomainName, v1.GuestUserSetPassword, "Administrator", "secret").Return(nil)
+				resp, err := server.GuestUser(context.TODO(), &cmdv1.GuestUserRequest{
+					DomainName: testDomainName,
+					Action:     string(v1.GuestUserSetPassword),
+					Username:   "Administrator",
+					Password:   "secret",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Success).To(BeTrue())
+			})
+
+			It("should return guest user errors in the response", func() {
+				domainManager.EXPECT().GuestUser(testDomainName, v1.GuestUserLock, "jdoe", "").Return(errors.New("user jdoe does not exist"))
+				resp, err := server.GuestUser(context.TODO(), &cmdv1.GuestUserRequest{
+					DomainName: testDomainName,
+					Action:     string(v1.GuestUserLock),
+					Username:   "jdoe",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Success).To(BeFalse())
+				Expect(resp.Message).To(Equal("user jdoe does not exist"))
+			})
+		})
+
 	})
 
 	Describe("Version mismatch", func() {
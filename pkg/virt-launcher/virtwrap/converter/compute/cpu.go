@@ -20,8 +20,11 @@
 package compute
 
 import (
+	"fmt"
+
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 )
@@ -61,6 +64,12 @@ func (c CPUDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain
 		if vmi.Spec.Domain.CPU.Model != "" {
 			if vmi.Spec.Domain.CPU.Model == v1.CPUModeHostModel || vmi.Spec.Domain.CPU.Model == v1.CPUModeHostPassthrough {
 				domain.Spec.CPU.Mode = vmi.Spec.Domain.CPU.Model
+			} else if vmi.Spec.Domain.CPU.Model == v1.CPUModeClusterBaseline {
+				if !topology.AreCPUBaselineTopologyHintsDefined(vmi) {
+					return fmt.Errorf("the baseline cpu model of the cluster is not known")
+				}
+				domain.Spec.CPU.Mode = "custom"
+				domain.Spec.CPU.Model = vmi.Status.TopologyHints.CPUBaseline.Model
 			} else {
 				domain.Spec.CPU.Mode = "custom"
 				domain.Spec.CPU.Model = vmi.Spec.Domain.CPU.Model
@@ -79,6 +88,20 @@ func (c CPUDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain
 			}
 		}
 
+		// Require the features common to the nodes of the CPU model group, unless the VMI sets them explicitly
+		if vmi.Spec.Domain.CPU.Model == v1.CPUModeClusterBaseline {
+			for _, feature := range vmi.Status.TopologyHints.CPUBaseline.Features {
+				if _, exists := existingFeatures[feature]; exists {
+					continue
+				}
+				existingFeatures[feature] = struct{}{}
+				domain.Spec.CPU.Features = append(domain.Spec.CPU.Features, api.CPUFeature{
+					Name:   feature,
+					Policy: "require",
+				})
+			}
+		}
+
 		/*
 						Libvirt validation fails when a CPU model is usable
 						by QEMU but lacks features listed in
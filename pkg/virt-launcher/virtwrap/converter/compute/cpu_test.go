@@ -0,0 +1,60 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
+)
+
+var _ = Describe("CPU Domain Configurator", func() {
+	Context("with the cluster-baseline CPU model", func() {
+		It("should render a custom CPU model with the features of the baseline", func() {
+			vmi := libvmi.New(libvmi.WithCPUModel(v1.CPUModeClusterBaseline), libvmi.WithCPUFeature("aes", "disable"))
+			vmi.Status.TopologyHints = &v1.TopologyHints{CPUBaseline: &v1.CPUBaseline{
+				Model:    "Skylake-Server",
+				Features: []string{"aes", "avx512f"},
+			}}
+
+			domain := api.Domain{Spec: api.DomainSpec{CPU: api.CPU{}}}
+			Expect(compute.NewCPUDomainConfigurator(false, false).Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.CPU.Mode).To(Equal("custom"))
+			Expect(domain.Spec.CPU.Model).To(Equal("Skylake-Server"))
+			Expect(domain.Spec.CPU.Features).To(ConsistOf(
+				api.CPUFeature{Name: "aes", Policy: "disable"},
+				api.CPUFeature{Name: "avx512f", Policy: "require"},
+			))
+		})
+
+		It("should fail when the baseline is not known", func() {
+			vmi := libvmi.New(libvmi.WithCPUModel(v1.CPUModeClusterBaseline))
+
+			domain := api.Domain{}
+			Expect(compute.NewCPUDomainConfigurator(false, false).Configure(vmi, &domain)).ToNot(Succeed())
+		})
+	})
+})
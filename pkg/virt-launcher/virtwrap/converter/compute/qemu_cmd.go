@@ -43,12 +43,14 @@ func NewQemuCmdDomainConfigurator(verboseLogEnabled bool) QemuCmdDomainConfigura
 }
 
 func (q QemuCmdDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
-	// Add Ignition Command Line if present
+	// Add Ignition Command Line if present, unless the Ignition volume is attached as a disk
 	ignitiondata := vmi.Annotations[v1.IgnitionAnnotation]
-	if ignitiondata != "" && strings.Contains(ignitiondata, "ignition") {
+	ignitionVolume := ignition.GetIgnitionVolume(vmi)
+	if (ignitionVolume != nil && !ignition.IsAttachedAsDisk(vmi, ignitionVolume.Name)) ||
+		(ignitionVolume == nil && ignitiondata != "" && strings.Contains(ignitiondata, "ignition")) {
 		initializeQEMUCmdAndQEMUArg(domain)
 		domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg, api.Arg{Value: "-fw_cfg"})
-		ignitionpath := fmt.Sprintf("%s/%s", ignition.GetDomainBasePath(vmi.Name, vmi.Namespace), ignition.IgnitionFile)
+		ignitionpath := ignition.GetIgnitionFilePath(vmi.Name, vmi.Namespace)
 		domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg, api.Arg{Value: fmt.Sprintf("name=opt/com.coreos/config,file=%s", ignitionpath)})
 	}
 
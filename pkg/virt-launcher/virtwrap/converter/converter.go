@@ -47,6 +47,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/emptydisk"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/hypervisor"
+	"kubevirt.io/kubevirt/pkg/ignition"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -56,6 +57,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/iothreads"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/storage"
 	convertertypes "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/types"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/virtio"
@@ -163,6 +165,7 @@ func Convert_v1_Disk_To_api_Disk(c *convertertypes.ConverterContext, diskDevice
 	if diskDevice.BootOrder != nil {
 		disk.BootOrder = &api.BootOrder{Order: *diskDevice.BootOrder}
 	}
+	disk.IOTune = storage.ConvertDiskIOTune(diskDevice.IOTune)
 	if (c.UseLaunchSecuritySEV || c.UseLaunchSecurityPV) && disk.Target.Bus == v1.DiskBusVirtio {
 		disk.Driver.IOMMU = "on"
 	}
@@ -555,6 +558,10 @@ func Convert_v1_Volume_To_api_Disk(source *v1.Volume, disk *api.Disk, c *convert
 		return Convert_v1_CloudInitSource_To_api_Disk(source.VolumeSource, disk, c)
 	}
 
+	if source.Ignition != nil {
+		return Convert_v1_IgnitionSource_To_api_Disk(disk, c)
+	}
+
 	if source.Sysprep != nil {
 		return Convert_v1_SysprepSource_To_api_Disk(source.Name, disk)
 	}
@@ -863,6 +870,22 @@ func Convert_v1_SysprepSource_To_api_Disk(volumeName string, disk *api.Disk) err
 	return nil
 }
 
+func Convert_v1_IgnitionSource_To_api_Disk(disk *api.Disk, c *convertertypes.ConverterContext) error {
+	if disk.Type == "lun" {
+		return fmt.Errorf(deviceTypeNotCompatibleFmt, disk.Alias.GetName())
+	}
+
+	disk.Source.File = ignition.GetIgnitionDiskPath(c.VirtualMachine.Name, c.VirtualMachine.Namespace)
+	disk.Type = "file"
+	disk.ReadOnly = toApiReadOnly(true)
+	setDiskDriver(disk, "raw", false)
+	// Ignition looks for the disk by its serial
+	if disk.Serial == "" {
+		disk.Serial = ignition.IgnitionDiskSerial
+	}
+	return nil
+}
+
 func Convert_v1_CloudInitSource_To_api_Disk(source v1.VolumeSource, disk *api.Disk, c *convertertypes.ConverterContext) error {
 	if disk.Type == "lun" {
 		return fmt.Errorf(deviceTypeNotCompatibleFmt, disk.Alias.GetName())
@@ -1012,8 +1035,9 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			isMemfdRequired = true
 		}
 	}
-	// virtiofs require shared access
-	if util.IsVMIVirtiofsEnabled(vmi) || netvmispec.HasPasstBinding(vmi) {
+	// virtiofs and vhost-user require shared access
+	if util.IsVMIVirtiofsEnabled(vmi) || netvmispec.HasPasstBinding(vmi) ||
+		hasVhostUserDomainAttachment(c.DomainAttachmentByInterfaceName) {
 		if domain.Spec.MemoryBacking == nil {
 			domain.Spec.MemoryBacking = &api.MemoryBacking{}
 		}
@@ -1259,3 +1283,12 @@ func GracePeriodSeconds(vmi *v1.VirtualMachineInstance) int64 {
 	}
 	return gracePeriodSeconds
 }
+
+func hasVhostUserDomainAttachment(domainAttachmentByInterfaceName map[string]string) bool {
+	for _, domainAttachment := range domainAttachmentByInterfaceName {
+		if domainAttachment == string(v1.VhostUser) {
+			return true
+		}
+	}
+	return false
+}
@@ -1788,6 +1788,45 @@ var _ = Describe("Converter", func() {
 			Entry("disabled - virtLauncherLogVerbosity variable is not defined", false, -1, false),
 		)
 
+		Context("with an Ignition volume", func() {
+			const ignitionFwCfgArg = "name=opt/com.coreos/config,file=/var/run/libvirt/ignition-dir/mynamespace/testvmi/data.ign"
+
+			BeforeEach(func() {
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "ignition",
+					VolumeSource: v1.VolumeSource{
+						Ignition: &v1.IgnitionSource{Config: `{"ignition":{"version":"3.4.0"}}`},
+					},
+				})
+			})
+
+			It("should pass the config through fw_cfg", func() {
+				domain := vmiToDomain(vmi, c)
+				Expect(domain.Spec.QEMUCmd).ToNot(BeNil())
+				Expect(domain.Spec.QEMUCmd.QEMUArg).To(ContainElements(api.Arg{Value: "-fw_cfg"}, api.Arg{Value: ignitionFwCfgArg}))
+			})
+
+			It("should attach the config as a raw disk when the volume is attached as a disk", func() {
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+					Name: "ignition",
+					DiskDevice: v1.DiskDevice{
+						Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio},
+					},
+				})
+
+				domain := vmiToDomain(vmi, c)
+				if domain.Spec.QEMUCmd != nil {
+					Expect(domain.Spec.QEMUCmd.QEMUArg).ToNot(ContainElement(api.Arg{Value: ignitionFwCfgArg}))
+				}
+				disk, err := getDiskByName(domain.Spec, "ignition")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(disk.Source.File).To(Equal("/var/run/libvirt/ignition-dir/mynamespace/testvmi/ignition.img"))
+				Expect(disk.Driver.Type).To(Equal("raw"))
+				Expect(disk.ReadOnly).ToNot(BeNil())
+				Expect(disk.Serial).To(Equal("ignition"))
+			})
+		})
+
 		DescribeTable("should add VSOCK section when present",
 			func(useVirtioTransitional bool) {
 				vmi.Status.VSOCKCID = pointer.P(uint32(100))
@@ -2218,6 +2257,28 @@ var _ = Describe("Converter", func() {
 			Expect(domain).ToNot(BeNil())
 			Expect(domain.Spec.Devices.Interfaces).To(BeEmpty())
 		})
+		It("Should create a vhost-user interface backed by shared memory for a vhost-user domain attachment", func() {
+			const bindingName = "vhostuser"
+			name1 := "Name"
+			c.DomainAttachmentByInterfaceName[name1] = string(v1.VhostUser)
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+
+			iface1 := v1.Interface{Name: name1, Binding: &v1.PluginBinding{Name: bindingName}}
+			net1 := v1.DefaultPodNetwork()
+			net1.Name = name1
+
+			vmi.Spec.Networks = []v1.Network{*net1}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface1}
+			vmi.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "2Mi"}}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("vhostuser"))
+			Expect(domain.Spec.Devices.Interfaces[0].Source.Mode).To(Equal("client"))
+			Expect(domain.Spec.MemoryBacking.HugePages).ToNot(BeNil())
+			Expect(domain.Spec.MemoryBacking.Access).To(Equal(&api.MemoryBackingAccess{Mode: "shared"}))
+		})
 		It("creates SRIOV hostdev", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			domain := &api.Domain{}
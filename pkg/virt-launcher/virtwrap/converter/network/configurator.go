@@ -24,6 +24,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
@@ -33,6 +34,10 @@ const (
 	//nolint:gosec //linter is confusing passt for password
 	passtLogFilePath  = "/var/run/kubevirt/passt.log"
 	passtBackendPasst = "passt"
+
+	// vhostUserReconnectTimeoutSeconds is the interval in which QEMU retries to connect
+	// to the vhost-user socket, e.g. after the CNI restarted or on a migration target.
+	vhostUserReconnectTimeoutSeconds = 5
 )
 
 type DomainConfigurator struct {
@@ -71,7 +76,9 @@ func (d DomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *ap
 			return fmt.Errorf("failed to find network %s", iface.Name)
 		}
 
-		if (iface.Binding != nil && d.domainAttachmentByInterfaceName[iface.Name] != string(v1.Tap)) || iface.SRIOV != nil {
+		domainAttachment := d.domainAttachmentByInterfaceName[iface.Name]
+		if (iface.Binding != nil && domainAttachment != string(v1.Tap) && domainAttachment != string(v1.VhostUser)) ||
+			iface.SRIOV != nil {
 			continue
 		}
 
@@ -120,6 +127,13 @@ func (d DomainConfigurator) configureInterface(iface *v1.Interface, vmi *v1.Virt
 	case d.domainAttachmentByInterfaceName[iface.Name] == string(v1.Tap):
 		builderOptions = append(builderOptions, d.tapBindingOptions(iface, useLaunchSecurity)...)
 
+	case d.domainAttachmentByInterfaceName[iface.Name] == string(v1.VhostUser):
+		vhostUserOpts, err := d.vhostUserBindingOptions(iface, vmi, useLaunchSecurity)
+		if err != nil {
+			return api.Interface{}, err
+		}
+		builderOptions = append(builderOptions, vhostUserOpts...)
+
 	case iface.PasstBinding != nil:
 		passtOpts, err := d.passtBindingOptions(iface, vmi)
 		if err != nil {
@@ -151,6 +165,47 @@ func (d DomainConfigurator) tapBindingOptions(iface *v1.Interface, useLaunchSecu
 	return opts
 }
 
+func (d DomainConfigurator) vhostUserBindingOptions(
+	iface *v1.Interface,
+	vmi *v1.VirtualMachineInstance,
+	useLaunchSecurity bool,
+) ([]builderOption, error) {
+	network := netvmispec.LookupNetworkByName(vmi.Spec.Networks, iface.Name)
+	if network == nil {
+		return nil, fmt.Errorf("failed to find network %s", iface.Name)
+	}
+
+	// QEMU acts as the client of the socket created by the CNI, reconnecting when the socket
+	// is re-created, e.g. on a migration target.
+	// https://libvirt.org/formatdomain.html#vhost-user-interface
+	opts := []builderOption{
+		withIfaceType("vhostuser"),
+		withSource(api.InterfaceSource{
+			Type: "unix",
+			Path: vhostuser.SocketPath(*network, vmi.Status.Interfaces),
+			Mode: "client",
+			Reconnect: &api.InterfaceSourceReconnect{
+				Enabled: "yes",
+				Timeout: vhostUserReconnectTimeoutSeconds,
+			},
+		}),
+	}
+
+	if iface.BootOrder != nil {
+		opts = append(opts, withBootOrder(*iface.BootOrder))
+	}
+
+	if d.isROMTuningSupported && (iface.BootOrder == nil || useLaunchSecurity) {
+		opts = append(opts, withROMDisabled())
+	}
+
+	if iface.State == v1.InterfaceStateLinkDown {
+		opts = append(opts, withLinkStateDown())
+	}
+
+	return opts, nil
+}
+
 func (d DomainConfigurator) passtBindingOptions(iface *v1.Interface, vmi *v1.VirtualMachineInstance) ([]builderOption, error) {
 	ifaceStatus := netvmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, iface.Name)
 	if ifaceStatus == nil || ifaceStatus.PodInterfaceName == "" {
@@ -26,6 +26,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/network"
@@ -182,6 +183,43 @@ var _ = Describe("Network Domain Configurator", func() {
 		),
 	)
 
+	It("should configure an interface with vhost-user binding", func() {
+		const vhostUserBindingPluginName = "vhostuser-binding"
+		multusNetwork := libvmi.MultusNetwork(network1Name, nad1Name)
+		vmi := libvmi.New(
+			libvmi.WithInterface(
+				libvmi.InterfaceWithBindingPlugin(network1Name, v1.PluginBinding{Name: vhostUserBindingPluginName}),
+			),
+			libvmi.WithNetwork(multusNetwork),
+		)
+
+		configurator := network.NewDomainConfigurator(
+			network.WithDomainAttachmentByInterfaceName(map[string]string{network1Name: string(v1.VhostUser)}),
+			network.WithUseLaunchSecuritySEV(false),
+			network.WithUseLaunchSecurityPV(false),
+			network.WithROMTuningSupport(true),
+			network.WithVirtioModel(virtioModel),
+		)
+
+		var domain api.Domain
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+		expectedIface := newDomainInterface(network1Name, virtioModel, func(iface *api.Interface) {
+			iface.Type = "vhostuser"
+			iface.Source = api.InterfaceSource{
+				Type: "unix",
+				Path: vhostuser.SocketPath(*multusNetwork, nil),
+				Mode: "client",
+				Reconnect: &api.InterfaceSourceReconnect{
+					Enabled: "yes",
+					Timeout: 5,
+				},
+			}
+			iface.Rom = &api.Rom{Enabled: "no"}
+		})
+		Expect(domain).To(Equal(newDomainWithIfaces([]api.Interface{expectedIface})))
+	})
+
 	DescribeTable("multi-queue", func(model string, expectedInterface api.Interface) {
 		ifaceWithModel := libvmi.InterfaceDeviceWithBridgeBinding(network1Name)
 		ifaceWithModel.Model = model
@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const defaultBurstLengthSeconds = 1
+
+// ConvertDiskIOTune translates the I/O limits of a disk into the libvirt iotune element.
+func ConvertDiskIOTune(ioTune *v1.DiskIOTune) *api.DiskIOTune {
+	if ioTune == nil {
+		return nil
+	}
+
+	domainIOTune := &api.DiskIOTune{
+		SizeIOPSSec: ioTune.SizeIOPS,
+		GroupName:   ioTune.GroupName,
+	}
+	if bytes := ioTune.Bytes; bytes != nil {
+		domainIOTune.TotalBytesSec = bytes.Total
+		domainIOTune.ReadBytesSec = bytes.Read
+		domainIOTune.WriteBytesSec = bytes.Write
+		if burst := bytes.Burst; burst != nil {
+			domainIOTune.TotalBytesSecMax = burst.Total
+			domainIOTune.ReadBytesSecMax = burst.Read
+			domainIOTune.WriteBytesSecMax = burst.Write
+			domainIOTune.TotalBytesSecMaxLength = burstLength(burst, burst.Total)
+			domainIOTune.ReadBytesSecMaxLength = burstLength(burst, burst.Read)
+			domainIOTune.WriteBytesSecMaxLength = burstLength(burst, burst.Write)
+		}
+	}
+	if iops := ioTune.IOPS; iops != nil {
+		domainIOTune.TotalIOPSSec = iops.Total
+		domainIOTune.ReadIOPSSec = iops.Read
+		domainIOTune.WriteIOPSSec = iops.Write
+		if burst := iops.Burst; burst != nil {
+			domainIOTune.TotalIOPSSecMax = burst.Total
+			domainIOTune.ReadIOPSSecMax = burst.Read
+			domainIOTune.WriteIOPSSecMax = burst.Write
+			domainIOTune.TotalIOPSSecMaxLength = burstLength(burst, burst.Total)
+			domainIOTune.ReadIOPSSecMaxLength = burstLength(burst, burst.Read)
+			domainIOTune.WriteIOPSSecMaxLength = burstLength(burst, burst.Write)
+		}
+	}
+
+	return domainIOTune
+}
+
+// burstLength returns the burst length for a burst value. Libvirt rejects a length
+// for a burst that is not set and reports the default length of one second otherwise.
+func burstLength(burst *v1.DiskIOBurst, value *uint64) *uint64 {
+	if value == nil {
+		return nil
+	}
+	length := uint64(defaultBurstLengthSeconds)
+	if burst.LengthSeconds != nil {
+		length = *burst.LengthSeconds
+	}
+	return &length
+}
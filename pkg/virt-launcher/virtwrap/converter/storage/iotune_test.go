@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/storage"
+)
+
+var _ = Describe("Disk IOTune converter", func() {
+	It("should not configure iotune when no limits are set", func() {
+		Expect(storage.ConvertDiskIOTune(nil)).To(BeNil())
+	})
+
+	It("should convert sustained limits", func() {
+		ioTune := &v1.DiskIOTune{
+			Bytes:     &v1.DiskIOLimits{Read: pointer.P(uint64(1000)), Write: pointer.P(uint64(2000))},
+			IOPS:      &v1.DiskIOLimits{Total: pointer.P(uint64(300))},
+			SizeIOPS:  pointer.P(uint64(4096)),
+			GroupName: "group1",
+		}
+
+		Expect(storage.ConvertDiskIOTune(ioTune)).To(Equal(&api.DiskIOTune{
+			ReadBytesSec:  pointer.P(uint64(1000)),
+			WriteBytesSec: pointer.P(uint64(2000)),
+			TotalIOPSSec:  pointer.P(uint64(300)),
+			SizeIOPSSec:   pointer.P(uint64(4096)),
+			GroupName:     "group1",
+		}))
+	})
+
+	It("should default the burst length to one second", func() {
+		ioTune := &v1.DiskIOTune{
+			IOPS: &v1.DiskIOLimits{
+				Total: pointer.P(uint64(100)),
+				Burst: &v1.DiskIOBurst{Total: pointer.P(uint64(200))},
+			},
+		}
+
+		Expect(storage.ConvertDiskIOTune(ioTune)).To(Equal(&api.DiskIOTune{
+			TotalIOPSSec:          pointer.P(uint64(100)),
+			TotalIOPSSecMax:       pointer.P(uint64(200)),
+			TotalIOPSSecMaxLength: pointer.P(uint64(1)),
+		}))
+	})
+
+	It("should set the burst length only for configured bursts", func() {
+		ioTune := &v1.DiskIOTune{
+			Bytes: &v1.DiskIOLimits{
+				Total: pointer.P(uint64(1000)),
+				Burst: &v1.DiskIOBurst{Total: pointer.P(uint64(5000)), LengthSeconds: pointer.P(uint64(10))},
+			},
+			IOPS: &v1.DiskIOLimits{
+				Read:  pointer.P(uint64(100)),
+				Write: pointer.P(uint64(100)),
+				Burst: &v1.DiskIOBurst{Write: pointer.P(uint64(500)), LengthSeconds: pointer.P(uint64(5))},
+			},
+		}
+
+		Expect(storage.ConvertDiskIOTune(ioTune)).To(Equal(&api.DiskIOTune{
+			TotalBytesSec:          pointer.P(uint64(1000)),
+			TotalBytesSecMax:       pointer.P(uint64(5000)),
+			TotalBytesSecMaxLength: pointer.P(uint64(10)),
+			ReadIOPSSec:            pointer.P(uint64(100)),
+			WriteIOPSSec:           pointer.P(uint64(100)),
+			WriteIOPSSecMax:        pointer.P(uint64(500)),
+			WriteIOPSSecMaxLength:  pointer.P(uint64(5)),
+		}))
+	})
+})
@@ -90,18 +90,18 @@ func (mr *MockDomainManagerMockRecorder) DeleteVMI(arg0 any) *gomock.Call {
 }
 
 // Exec mocks base method.
-func (m *MockDomainManager) Exec(arg0, arg1 string, arg2 []string, arg3 int32) (string, error) {
+func (m *MockDomainManager) Exec(arg0, arg1 string, arg2 []string, arg3 int32, arg4 int64) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Exec", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "Exec", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Exec indicates an expected call of Exec.
-func (mr *MockDomainManagerMockRecorder) Exec(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockDomainManagerMockRecorder) Exec(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockDomainManager)(nil).Exec), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockDomainManager)(nil).Exec), arg0, arg1, arg2, arg3, arg4)
 }
 
 // FinalizeVirtualMachineMigration mocks base method.
@@ -278,6 +278,80 @@ func (mr *MockDomainManagerMockRecorder) GetUsers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockDomainManager)(nil).GetUsers))
 }
 
+// GuestFileClose mocks base method.
+func (m *MockDomainManager) GuestFileClose(domainName string, handle int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileClose", domainName, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileClose indicates an expected call of GuestFileClose.
+func (mr *MockDomainManagerMockRecorder) GuestFileClose(domainName, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileClose", reflect.TypeOf((*MockDomainManager)(nil).GuestFileClose), domainName, handle)
+}
+
+// GuestFileOpen mocks base method.
+func (m *MockDomainManager) GuestFileOpen(domainName, path string, write bool) (int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileOpen", domainName, path, write)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GuestFileOpen indicates an expected call of GuestFileOpen.
+func (mr *MockDomainManagerMockRecorder) GuestFileOpen(domainName, path, write any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileOpen", reflect.TypeOf((*MockDomainManager)(nil).GuestFileOpen), domainName, path, write)
+}
+
+// GuestFileRead mocks base method.
+func (m *MockDomainManager) GuestFileRead(domainName string, handle, length int64) ([]byte, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", domainName, handle, length)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockDomainManagerMockRecorder) GuestFileRead(domainName, handle, length any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockDomainManager)(nil).GuestFileRead), domainName, handle, length)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockDomainManager) GuestFileWrite(domainName string, handle int64, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", domainName, handle, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockDomainManagerMockRecorder) GuestFileWrite(domainName, handle, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockDomainManager)(nil).GuestFileWrite), domainName, handle, data)
+}
+
+// GuestUser mocks base method.
+func (m *MockDomainManager) GuestUser(domainName string, action v1.GuestUserAction, username, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestUser", domainName, action, username, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestUser indicates an expected call of GuestUser.
+func (mr *MockDomainManagerMockRecorder) GuestUser(domainName, action, username, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestUser", reflect.TypeOf((*MockDomainManager)(nil).GuestUser), domainName, action, username, password)
+}
+
 // GuestPing mocks base method.
 func (m *MockDomainManager) GuestPing(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -488,6 +562,21 @@ func (mr *MockDomainManagerMockRecorder) SoftRebootVMI(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftRebootVMI", reflect.TypeOf((*MockDomainManager)(nil).SoftRebootVMI), arg0)
 }
 
+// SyncGuestTime mocks base method.
+func (m *MockDomainManager) SyncGuestTime(arg0 string, arg1 int32) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncGuestTime", arg0, arg1)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncGuestTime indicates an expected call of SyncGuestTime.
+func (mr *MockDomainManagerMockRecorder) SyncGuestTime(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncGuestTime", reflect.TypeOf((*MockDomainManager)(nil).SyncGuestTime), arg0, arg1)
+}
+
 // SyncVMI mocks base method.
 func (m *MockDomainManager) SyncVMI(arg0 *v1.VirtualMachineInstance, arg1 bool, arg2 *v10.VirtualMachineOptions) (*api.DomainSpec, error) {
 	m.ctrl.T.Helper()
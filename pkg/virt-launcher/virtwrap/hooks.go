@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"sync"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+)
+
+// hookNotificationsQueueSize bounds the notifications waiting for slow sidecars.
+// Notifications are dropped once it is full, they never block the domain operations.
+const hookNotificationsQueueSize = 100
+
+var getHookManager = hooks.GetManager
+
+type hookNotification struct {
+	manager       hooks.Manager
+	vmi           *v1.VirtualMachineInstance
+	hookPointName string
+	notify        func(hooks.Manager, *v1.VirtualMachineInstance) error
+}
+
+var (
+	hookNotifications     = make(chan hookNotification, hookNotificationsQueueSize)
+	startHookNotifierOnce sync.Once
+)
+
+// notifyHooks reports a lifecycle event, which the domain operation does not wait for, to the hook sidecars.
+// The sidecars cannot veto the event, so a failing hook is only logged.
+// The sidecars are called asynchronously, as the events are reported while the domain
+// is locked, in the order the events are reported. notify gets a copy of the VMI.
+func notifyHooks(vmi *v1.VirtualMachineInstance, hookPointName string, notify func(hooks.Manager, *v1.VirtualMachineInstance) error) {
+	startHookNotifierOnce.Do(func() {
+		go runHookNotifier(hookNotifications)
+	})
+
+	select {
+	case hookNotifications <- hookNotification{
+		manager:       getHookManager(),
+		vmi:           vmi.DeepCopy(),
+		hookPointName: hookPointName,
+		notify:        notify,
+	}:
+	default:
+		log.Log.Object(vmi).Warningf("%s hook notification dropped, too many notifications are pending", hookPointName)
+	}
+}
+
+func runHookNotifier(notifications <-chan hookNotification) {
+	for notification := range notifications {
+		if err := notification.notify(notification.manager, notification.vmi); err != nil {
+			log.Log.Object(notification.vmi).Reason(err).Warningf("%s hook sidecar failed", notification.hookPointName)
+		}
+	}
+}
+
+// runPreMigrationHooks calls the hook sidecars before the migration proceeds, each call is bounded by a timeout.
+// The sidecars cannot veto the migration, so a failing hook is only logged.
+func runPreMigrationHooks(vmi *v1.VirtualMachineInstance, role hooks.MigrationRole) {
+	if err := getHookManager().PreMigration(vmi, role); err != nil {
+		log.Log.Object(vmi).Reason(err).Warningf("%s hook sidecar failed", hooksInfo.PreMigrationHookPointName)
+	}
+}
+
+func notifyHotplugHooks(vmi *v1.VirtualMachineInstance) func(hooks.HotplugEvent) {
+	return func(event hooks.HotplugEvent) {
+		notifyHooks(vmi, hooksInfo.OnHotplugHookPointName, func(m hooks.Manager, vmi *v1.VirtualMachineInstance) error {
+			return m.OnHotplug(vmi, event)
+		})
+	}
+}
@@ -36,6 +36,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
@@ -247,7 +249,7 @@ func classifyVolumesForMigration(vmi *v1.VirtualMachineInstance) *migrationDisks
 			}
 		case volSrc.ConfigMap != nil || volSrc.Secret != nil || volSrc.DownwardAPI != nil ||
 			volSrc.ServiceAccount != nil || volSrc.CloudInitNoCloud != nil ||
-			volSrc.CloudInitConfigDrive != nil || volSrc.ContainerDisk != nil:
+			volSrc.CloudInitConfigDrive != nil || volSrc.Ignition != nil || volSrc.ContainerDisk != nil:
 			disks.generated[volume.Name] = true
 		}
 	}
@@ -1049,7 +1051,13 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 		dstURI = fmt.Sprintf("qemu+unix:///system?socket=%s", migrationproxy.SourceUnixFile(l.virtShareDir, string(vmi.UID)))
 	}
 
+	runPreMigrationHooks(vmi, hooks.MigrationRoleSource)
+
 	err = dom.MigrateToURI3(dstURI, params, migrateFlags)
+	migrated := err == nil
+	notifyHooks(vmi, hooksInfo.PostMigrationHookPointName, func(m hooks.Manager, vmi *v1.VirtualMachineInstance) error {
+		return m.PostMigration(vmi, hooks.MigrationRoleSource, migrated)
+	})
 	if err != nil {
 		l.setMigrationResult(true, err.Error(), "")
 		log.Log.Object(vmi).Errorf("migration failed with error: %v", err)
@@ -42,6 +42,7 @@ import (
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -67,6 +68,9 @@ func (l *LibvirtDomainManager) finalizeMigrationTarget(vmi *v1.VirtualMachineIns
 	}
 
 	l.setGuestTime(vmi)
+	notifyHooks(vmi, hooksInfo.PostMigrationHookPointName, func(m hooks.Manager, vmi *v1.VirtualMachineInstance) error {
+		return m.PostMigration(vmi, hooks.MigrationRoleTarget, true)
+	})
 	return nil
 }
 
@@ -78,6 +82,13 @@ func interfacesToReconnect(options *cmdv1.VirtualMachineOptions) map[string]stru
 			ifacesToRefresh[ifaceName] = struct{}{}
 		}
 	}
+	// vhost-user interfaces are reconnected to the socket of the target, refresh the guest link
+	// so it announces itself on the new backend
+	for ifaceName, domainAttachment := range options.InterfaceDomainAttachment {
+		if domainAttachment == string(v1.VhostUser) {
+			ifacesToRefresh[ifaceName] = struct{}{}
+		}
+	}
 	return ifacesToRefresh
 }
 
@@ -221,6 +232,7 @@ func (l *LibvirtDomainManager) prepareMigrationTarget(
 	if err != nil {
 		return fmt.Errorf("executing custom preStart hooks failed: %v", err)
 	}
+	runPreMigrationHooks(vmi, hooks.MigrationRoleTarget)
 
 	if shouldBlockMigrationTargetPreparation(vmi) {
 		return fmt.Errorf("Blocking preparation of migration target in order to satisfy a functional test condition")
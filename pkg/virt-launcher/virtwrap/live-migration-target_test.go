@@ -29,9 +29,11 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	"k8s.io/apimachinery/pkg/watch"
+	v1 "kubevirt.io/api/core/v1"
 	api2 "kubevirt.io/client-go/api"
 	"libvirt.org/go/libvirt"
 
+	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/testing"
@@ -113,3 +115,29 @@ var _ = Describe("client", func() {
 			libvirt.DOMAIN_JOB_OPERATION_UNKNOWN, libvirt.DOMAIN_JOB_OPERATION_BACKUP,
 			fmt.Errorf("error")))
 })
+
+var _ = Describe("interfaces to reconnect after migration", func() {
+	DescribeTable("should include", func(options *cmdv1.VirtualMachineOptions, expected map[string]struct{}) {
+		Expect(interfacesToReconnect(options)).To(Equal(expected))
+	},
+		Entry("no interface when no option is set", &cmdv1.VirtualMachineOptions{}, map[string]struct{}{}),
+		Entry("interfaces with a link refresh migration method",
+			&cmdv1.VirtualMachineOptions{
+				InterfaceMigration: map[string]*cmdv1.InterfaceBindingMigration{
+					"net1": {Method: string(v1.LinkRefresh)},
+				},
+				InterfaceDomainAttachment: map[string]string{"net1": string(v1.Tap)},
+			},
+			map[string]struct{}{"net1": {}},
+		),
+		Entry("interfaces with a vhost-user domain attachment",
+			&cmdv1.VirtualMachineOptions{
+				InterfaceDomainAttachment: map[string]string{
+					"net1": string(v1.Tap),
+					"net2": string(v1.VhostUser),
+				},
+			},
+			map[string]struct{}{"net2": {}},
+		),
+	)
+})
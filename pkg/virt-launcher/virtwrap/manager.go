@@ -28,10 +28,12 @@ package virtwrap
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/json"
 	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -69,10 +71,14 @@ import (
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	"kubevirt.io/kubevirt/pkg/ignition"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netsriov "kubevirt.io/kubevirt/pkg/network/deviceinfo"
+	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	netipam "kubevirt.io/kubevirt/pkg/network/ipam"
+	"kubevirt.io/kubevirt/pkg/network/link"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
@@ -154,8 +160,14 @@ type DomainManager interface {
 	HotplugHostDevices(vmi *v1.VirtualMachineInstance) error
 	InterfacesStatus() []api.InterfaceStatus
 	GetGuestOSInfo() *api.GuestOSInfo
-	Exec(string, string, []string, int32) (string, error)
+	Exec(string, string, []string, int32, int64) (string, error)
+	GuestFileOpen(domainName, path string, write bool) (int64, int64, error)
+	GuestFileRead(domainName string, handle, length int64) ([]byte, bool, error)
+	GuestFileWrite(domainName string, handle int64, data []byte) error
+	GuestFileClose(domainName string, handle int64) error
+	GuestUser(domainName string, action v1.GuestUserAction, username, password string) error
 	GuestPing(string) error
+	SyncGuestTime(string, int32) (time.Duration, error)
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	BackupVirtualMachine(*v1.VirtualMachineInstance, *backupv1.BackupOptions) error
 	RedefineCheckpoint(*v1.VirtualMachineInstance, *backupv1.BackupCheckpoint) (checkpointInvalid bool, err error)
@@ -187,6 +199,9 @@ type LibvirtDomainManager struct {
 	paused                 pausedVMIs
 	agentData              *agentpoller.AsyncAgentStore
 	cloudInitDataStore     *cloudinit.CloudInitData
+	cloudInitDataRevision  string
+	cloudInitSecretsWatch  bool
+	metadataService        *cloudinit.MetadataService
 	setGuestTimeContextPtr *contextStore
 	efiEnvironment         *efi.EFIEnvironment
 	ephemeralDiskCreator   ephemeraldisk.EphemeralDiskCreatorInterface
@@ -196,6 +211,7 @@ type LibvirtDomainManager struct {
 	diskMemoryLimitBytes   int64
 
 	metadataCache             *metadata.Cache
+	stopChan                  chan struct{}
 	domainStatsCache          *virtcache.TimeDefinedCache[*stats.DomainStats]
 	domainDirtyRateStatsCache *virtcache.TimeDefinedCache[*stats.DomainStatsDirtyRate]
 
@@ -273,6 +289,7 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 		domainInfoStats:      &stats.DomainJobInfo{},
 
 		metadataCache:                      metadataCache,
+		stopChan:                           stopChan,
 		cpuSetGetter:                       cpuSetGetter,
 		setTimeOnce:                        sync.Once{},
 		imageVolumeFeatureGateEnabled:      imageVolumeEnabled,
@@ -624,8 +641,32 @@ func (l *LibvirtDomainManager) hotPlugHostDevices(vmi *v1.VirtualMachineInstance
 	return nil
 }
 
-func (l *LibvirtDomainManager) Exec(domainName, command string, args []string, timeoutSeconds int32) (string, error) {
-	return agent.GuestExec(l.virConn, domainName, command, args, timeoutSeconds)
+func (l *LibvirtDomainManager) Exec(domainName, command string, args []string, timeoutSeconds int32, maxOutputBytes int64) (string, error) {
+	return agent.GuestExec(l.virConn, domainName, command, args, timeoutSeconds, maxOutputBytes)
+}
+
+func (l *LibvirtDomainManager) GuestFileOpen(domainName, path string, write bool) (int64, int64, error) {
+	return agent.GuestFileOpen(l.virConn, domainName, path, write)
+}
+
+func (l *LibvirtDomainManager) GuestFileRead(domainName string, handle, length int64) ([]byte, bool, error) {
+	return agent.GuestFileRead(l.virConn, domainName, handle, length)
+}
+
+func (l *LibvirtDomainManager) GuestFileWrite(domainName string, handle int64, data []byte) error {
+	return agent.GuestFileWrite(l.virConn, domainName, handle, data)
+}
+
+func (l *LibvirtDomainManager) GuestFileClose(domainName string, handle int64) error {
+	return agent.GuestFileClose(l.virConn, domainName, handle)
+}
+
+func (l *LibvirtDomainManager) GuestUser(domainName string, action v1.GuestUserAction, username, password string) error {
+	osID := ""
+	if osInfo := l.agentData.GetGuestOSInfo(); osInfo != nil {
+		osID = osInfo.Id
+	}
+	return l.credManager.ManageGuestUser(domainName, osID, action, username, password)
 }
 
 func (l *LibvirtDomainManager) GuestPing(domainName string) error {
@@ -634,6 +675,29 @@ func (l *LibvirtDomainManager) GuestPing(domainName string) error {
 	return err
 }
 
+// SyncGuestTime sets the guest clock to the host time through the guest agent. It returns
+// the drift of the guest clock, as reported by the guest agent before the update. Each guest
+// agent command waits at most timeoutSeconds for the reply.
+func (l *LibvirtDomainManager) SyncGuestTime(domainName string, timeoutSeconds int32) (time.Duration, error) {
+	result, err := l.virConn.QemuAgentCommandWithTimeout(`{"execute":"guest-get-time"}`, domainName, timeoutSeconds)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the guest time: %v", err)
+	}
+	guestTime := struct {
+		Return int64 `json:"return"`
+	}{}
+	if err := json.Unmarshal([]byte(result), &guestTime); err != nil {
+		return 0, fmt.Errorf("failed to parse the guest time %q: %v", result, err)
+	}
+	drift := time.Duration(guestTime.Return - time.Now().UnixNano())
+
+	setTimeCmd := fmt.Sprintf(`{"execute":"guest-set-time","arguments":{"time":%d}}`, time.Now().UnixNano())
+	if _, err := l.virConn.QemuAgentCommandWithTimeout(setTimeCmd, domainName, timeoutSeconds); err != nil {
+		return drift, fmt.Errorf("failed to set the guest time: %v", err)
+	}
+	return drift, nil
+}
+
 func getVMIEphemeralDisksTotalSize(ephemeralDiskDir string) *resource.Quantity {
 	totalSize := int64(0)
 	err := filepath.Walk(ephemeralDiskDir, func(path string, f os.FileInfo, err error) error {
@@ -707,14 +771,7 @@ func (l *LibvirtDomainManager) generateSomeCloudInitISO(vmi *v1.VirtualMachineIn
 		if size != 0 {
 			err = cloudinit.GenerateEmptyIso(vmi.Name, vmi.Namespace, cloudInitDataStore, size)
 		} else {
-			// ClusterInstancetype will take precedence over a namespaced Instancetype
-			// for setting instance_type in the metadata
-			instancetype := vmi.Annotations[v1.ClusterInstancetypeAnnotation]
-			if instancetype == "" {
-				instancetype = vmi.Annotations[v1.InstancetypeAnnotation]
-			}
-
-			err = cloudinit.GenerateLocalData(vmi, instancetype, cloudInitDataStore)
+			err = cloudinit.GenerateLocalData(vmi, instancetypeName(vmi), cloudInitDataStore)
 		}
 		if err != nil {
 			return fmt.Errorf("generating local cloud-init data failed: %v", err)
@@ -723,6 +780,15 @@ func (l *LibvirtDomainManager) generateSomeCloudInitISO(vmi *v1.VirtualMachineIn
 	return nil
 }
 
+// instancetypeName returns the instance type reported in the cloud-init metadata.
+// ClusterInstancetype will take precedence over a namespaced Instancetype.
+func instancetypeName(vmi *v1.VirtualMachineInstance) string {
+	if instancetype := vmi.Annotations[v1.ClusterInstancetypeAnnotation]; instancetype != "" {
+		return instancetype
+	}
+	return vmi.Annotations[v1.InstancetypeAnnotation]
+}
+
 func (l *LibvirtDomainManager) generateCloudInitISO(vmi *v1.VirtualMachineInstance, domPtr *cli.VirDomain) error {
 	return l.generateSomeCloudInitISO(vmi, domPtr, 0)
 }
@@ -739,6 +805,89 @@ func (l *LibvirtDomainManager) generateCloudInitEmptyISO(vmi *v1.VirtualMachineI
 	return fmt.Errorf("failed to find the status of volume %s", l.cloudInitDataStore.VolumeName)
 }
 
+// setIPAMCloudInitNetworkData configures the guest with the addresses allocated by KubeVirt IPAM,
+// for guests which do not use DHCP. Network data provided by the user takes precedence.
+func setIPAMCloudInitNetworkData(vmi *v1.VirtualMachineInstance, domain *api.Domain, cloudInitData *cloudinit.CloudInitData) error {
+	allocations, err := netipam.AllocationsFromVMI(vmi)
+	if err != nil || len(allocations) == 0 {
+		return err
+	}
+
+	var (
+		interfaces    []cloudinit.NetworkDataInterface
+		hasAllocation bool
+	)
+	for _, domainIface := range domain.Spec.Devices.Interfaces {
+		if domainIface.Alias == nil || domainIface.MAC == nil {
+			continue
+		}
+		iface := cloudinit.NetworkDataInterface{MAC: domainIface.MAC.MAC}
+		if allocation := netipam.LookupAllocationByNetwork(allocations, domainIface.Alias.GetName()); allocation != nil {
+			iface.Addresses = allocation.IPAddresses
+			iface.Gateway = allocation.Gateway
+			iface.Nameservers = allocation.Nameservers
+			hasAllocation = true
+		}
+		interfaces = append(interfaces, iface)
+	}
+	if !hasAllocation {
+		return nil
+	}
+
+	networkData, err := cloudinit.GenerateNetworkData(cloudInitData.DataSource, interfaces)
+	if err != nil {
+		return err
+	}
+	cloudInitData.NetworkData = networkData
+	return nil
+}
+
+// startCloudInitMetadataService serves the cloud-init data over HTTP on the masquerade gateways of the pod network,
+// one per IP family of the pod. virt-handler redirects the guest traffic to the metadata service addresses to them.
+func (l *LibvirtDomainManager) startCloudInitMetadataService(vmi *v1.VirtualMachineInstance) error {
+	podNetwork := netvmispec.LookupPodNetwork(vmi.Spec.Networks)
+	if podNetwork == nil {
+		return fmt.Errorf("the pod network is missing")
+	}
+	localAddrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return err
+	}
+
+	var addresses []string
+	for _, ipVersion := range []netdriver.IPVersion{netdriver.IPv4, netdriver.IPv6} {
+		gateway, _, err := link.GenerateMasqueradeGatewayAndVmIPAddrs(podNetwork, ipVersion)
+		if err != nil {
+			return err
+		}
+		if hasLocalAddress(localAddrs, gateway.IP) {
+			addresses = append(addresses, net.JoinHostPort(gateway.IP.String(), strconv.Itoa(cloudinit.MetadataServicePort)))
+		}
+	}
+	if len(addresses) == 0 {
+		return fmt.Errorf("no masquerade gateway address found for the pod network")
+	}
+
+	metadataService, err := cloudinit.NewMetadataService(vmi, instancetypeName(vmi), l.cloudInitDataStore)
+	if err != nil {
+		return err
+	}
+	if err := metadataService.Start(addresses...); err != nil {
+		return err
+	}
+	l.metadataService = metadataService
+	return nil
+}
+
+func hasLocalAddress(localAddrs []net.Addr, ip net.IP) bool {
+	for _, addr := range localAddrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // All local environment setup that needs to occur before VirtualMachineInstance starts
 // can be done in this function. This includes things like...
 //
@@ -759,6 +908,9 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 	if err != nil {
 		return domain, fmt.Errorf("ReadCloudInitVolumeDataSource failed: %v", err)
 	}
+	if cloudInitData != nil {
+		l.cloudInitDataRevision = cloudinit.DataRevision(cloudInitData)
+	}
 
 	// Pass cloud-init data to PreCloudInitIso hook
 	logger.Info("Starting PreCloudInitIso hook")
@@ -780,8 +932,7 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 	}
 
 	// generate ignition data
-	ignitionData := ignition.GetIgnitionSource(vmi)
-	if ignitionData != "" {
+	if ignition.HasIgnitionData(vmi) {
 
 		err := ignition.GenerateIgnitionLocalData(vmi, vmi.Namespace)
 		if err != nil {
@@ -802,6 +953,25 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("preparing the pod network failed: %v", err)
 	}
 
+	if l.cloudInitDataStore != nil && l.cloudInitDataStore.NetworkData == "" {
+		if err := setIPAMCloudInitNetworkData(vmi, domain, l.cloudInitDataStore); err != nil {
+			return domain, fmt.Errorf("generating the cloud-init network data failed: %v", err)
+		}
+	}
+
+	if l.cloudInitDataStore != nil && l.metadataService == nil && cloudinit.HasMetadataService(vmi) {
+		if err := l.startCloudInitMetadataService(vmi); err != nil {
+			return domain, fmt.Errorf("starting the cloud-init metadata service failed: %v", err)
+		}
+	}
+
+	if l.cloudInitDataStore != nil && !l.cloudInitSecretsWatch {
+		if err := cloudinit.WatchSecrets(vmi, config.SecretSourceDir, l.stopChan, func() { l.cloudInitSecretsChanged(vmi) }); err != nil {
+			return domain, fmt.Errorf("watching the cloud-init secrets failed: %v", err)
+		}
+		l.cloudInitSecretsWatch = true
+	}
+
 	// Create ephemeral disk for container disks
 	err = containerdisk.CreateEphemeralImages(vmi, l.ephemeralDiskCreator, l.disksInfo)
 	if err != nil {
@@ -1226,11 +1396,13 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		return nil, err
 	}
 
+	l.updateCloudInitData(vmi, oldSpec, dom)
+
 	var domainAttachments map[string]string
 	if options != nil {
 		domainAttachments = options.GetInterfaceDomainAttachment()
 	}
-	if err := network.Sync(domain, oldSpec, dom, vmi, domainAttachments); err != nil {
+	if err := network.Sync(domain, oldSpec, dom, vmi, domainAttachments, notifyHotplugHooks(vmi)); err != nil {
 		return nil, err
 	}
 
@@ -1266,6 +1438,11 @@ func (l *LibvirtDomainManager) syncDisks(
 			logger.Reason(err).Error("deleting CBT overlay")
 			return err
 		}
+		notifyHotplugHooks(vmi)(hooks.HotplugEvent{
+			DeviceType: hooks.HotplugDeviceDisk,
+			DeviceName: volumeName,
+			Action:     hooks.HotplugActionUnplug,
+		})
 	}
 	// Look up all the disks to attach
 	for _, attachDisk := range getAttachedDisks(spec.Devices.Disks, domain.Spec.Devices.Disks) {
@@ -1294,6 +1471,11 @@ func (l *LibvirtDomainManager) syncDisks(
 			logger.Reason(err).Error("attaching device")
 			return err
 		}
+		notifyHotplugHooks(vmi)(hooks.HotplugEvent{
+			DeviceType: hooks.HotplugDeviceDisk,
+			DeviceName: attachDisk.Alias.GetName(),
+			Action:     hooks.HotplugActionPlug,
+		})
 	}
 	// Look up all the disks to UPDATE
 	for _, updateDisk := range getUpdatedDisks(spec.Devices.Disks, domain.Spec.Devices.Disks) {
@@ -1322,6 +1504,15 @@ func (l *LibvirtDomainManager) syncDisks(
 			return err
 		}
 	}
+	// Look up all the disks with changed I/O limits
+	for _, ioTuneDisk := range getIOTuneUpdatedDisks(spec.Devices.Disks, domain.Spec.Devices.Disks) {
+		logger.V(1).Infof("Updating I/O limits of disk %s, target %s", ioTuneDisk.Alias.GetName(), ioTuneDisk.Target.Device)
+		err := dom.SetBlockIoTune(ioTuneDisk.Target.Device, toBlockIoTuneParameters(ioTuneDisk), affectDomainLiveAndConfigLibvirtFlags)
+		if err != nil {
+			logger.Reason(err).Error("updating I/O limits")
+			return err
+		}
+	}
 
 	// Resize and notify the VM about changed disks
 	for _, disk := range domain.Spec.Devices.Disks {
@@ -1345,6 +1536,106 @@ func (l *LibvirtDomainManager) syncDisks(
 	return nil
 }
 
+// cloudInitSecretsChanged records the revision of the data of the changed cloud-init secrets in the domain metadata.
+// The change of the metadata makes virt-handler sync the VMI, which updates the cloud-init data.
+func (l *LibvirtDomainManager) cloudInitSecretsChanged(vmi *v1.VirtualMachineInstance) {
+	cloudInitData, err := cloudinit.ReadCloudInitVolumeDataSource(vmi, config.SecretSourceDir)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to read the changed cloud-init secrets")
+		l.metadataCache.CloudInit.WithSafeBlock(func(cloudInitMetadata *api.CloudInitMetadata, _ bool) {
+			cloudInitMetadata.Succeeded = false
+			cloudInitMetadata.Message = fmt.Sprintf("reading the cloud-init data failed: %v", err)
+		})
+		return
+	}
+	revision := cloudinit.DataRevision(cloudInitData)
+	l.metadataCache.CloudInit.WithSafeBlock(func(cloudInitMetadata *api.CloudInitMetadata, _ bool) {
+		cloudInitMetadata.SourceRevision = revision
+	})
+}
+
+// updateCloudInitData syncs the cloud-init data and reports the result to virt-handler through the domain metadata.
+// A failure does not affect the guest, which keeps the previous data, and the update is retried on the next sync.
+func (l *LibvirtDomainManager) updateCloudInitData(vmi *v1.VirtualMachineInstance, spec *api.DomainSpec, dom cli.VirDomain) {
+	revision := l.cloudInitDataRevision
+	if err := l.syncCloudInitData(vmi, spec, dom); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to update the cloud-init data")
+		l.metadataCache.CloudInit.WithSafeBlock(func(cloudInitMetadata *api.CloudInitMetadata, _ bool) {
+			cloudInitMetadata.Succeeded = false
+			cloudInitMetadata.Message = err.Error()
+		})
+		return
+	}
+	if l.cloudInitDataRevision != revision {
+		l.metadataCache.CloudInit.WithSafeBlock(func(cloudInitMetadata *api.CloudInitMetadata, _ bool) {
+			cloudInitMetadata.Revision = l.cloudInitDataRevision
+			cloudInitMetadata.Succeeded = true
+			cloudInitMetadata.Message = ""
+		})
+	}
+}
+
+// syncCloudInitData regenerates the cloud-init data of a running VMI with the Live update policy when the
+// referenced secrets changed, and re-inserts the cloud-init cdrom so that the guest sees the new data.
+func (l *LibvirtDomainManager) syncCloudInitData(vmi *v1.VirtualMachineInstance, spec *api.DomainSpec, dom cli.VirDomain) error {
+	if l.cloudInitDataStore == nil || !cloudinit.IsLiveUpdatePolicy(vmi) {
+		return nil
+	}
+	logger := log.Log.Object(vmi)
+
+	cloudInitData, err := cloudinit.ReadCloudInitVolumeDataSource(vmi, config.SecretSourceDir)
+	if err != nil {
+		return fmt.Errorf("reading the cloud-init data failed: %v", err)
+	}
+	revision := cloudinit.DataRevision(cloudInitData)
+	if revision == l.cloudInitDataRevision {
+		return nil
+	}
+	logger.Infof("The cloud-init data changed, regenerating revision %s", revision)
+
+	cloudInitData, err = hooks.GetManager().PreCloudInitIso(vmi, cloudInitData)
+	if err != nil {
+		return fmt.Errorf("PreCloudInitIso hook failed: %v", err)
+	}
+	if cloudInitData.NetworkData == "" {
+		if err := setIPAMCloudInitNetworkData(vmi, &api.Domain{Spec: *spec}, cloudInitData); err != nil {
+			return fmt.Errorf("generating the cloud-init network data failed: %v", err)
+		}
+	}
+	l.cloudInitDataStore = cloudInitData
+	if err := l.generateCloudInitISO(vmi, &dom); err != nil {
+		return err
+	}
+
+	if l.metadataService != nil {
+		l.metadataService.Stop()
+		l.metadataService = nil
+		if err := l.startCloudInitMetadataService(vmi); err != nil {
+			return fmt.Errorf("restarting the cloud-init metadata service failed: %v", err)
+		}
+	}
+
+	for _, disk := range spec.Devices.Disks {
+		if disk.Alias == nil || disk.Alias.GetName() != cloudInitData.VolumeName || disk.Device != "cdrom" {
+			continue
+		}
+		// QEMU keeps the replaced iso open, ejecting and inserting the media makes it open the new one
+		ejected := disk.DeepCopy()
+		ejected.Source = api.DiskSource{}
+		for _, update := range []*api.Disk{ejected, &disk} {
+			updateBytes, err := xml.Marshal(update)
+			if err != nil {
+				return err
+			}
+			if err := dom.UpdateDeviceFlags(string(updateBytes), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+				return fmt.Errorf("re-inserting the cloud-init cdrom failed: %v", err)
+			}
+		}
+	}
+	l.cloudInitDataRevision = revision
+	return nil
+}
+
 func (l *LibvirtDomainManager) startDomain(
 	vmi *v1.VirtualMachineInstance,
 	dom cli.VirDomain,
@@ -1365,6 +1656,9 @@ func (l *LibvirtDomainManager) startDomain(
 	if vmi.ShouldStartPaused() {
 		l.paused.add(vmi.UID)
 	}
+	notifyHooks(vmi, hooksInfo.PostStartHookPointName, func(m hooks.Manager, vmi *v1.VirtualMachineInstance) error {
+		return m.PostStart(vmi)
+	})
 	return nil
 }
 
@@ -1576,6 +1870,99 @@ func getUpdatedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	return res
 }
 
+func getIOTuneUpdatedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
+	oldDiskMap := make(map[string]api.Disk)
+	for _, disk := range oldDisks {
+		oldDiskMap[disk.Target.Device] = disk
+	}
+	var res []api.Disk
+	for _, newDisk := range newDisks {
+		oldDisk, ok := oldDiskMap[newDisk.Target.Device]
+		if !ok {
+			// Attached disks already carry their limits
+			continue
+		}
+		if equality.Semantic.DeepEqual(oldDisk.IOTune, newDisk.IOTune) {
+			continue
+		}
+		res = append(res, newDisk)
+	}
+	return res
+}
+
+// ownIOTuneGroupPrefix prefixes the group a disk without a group name is put into. The API does not allow
+// slashes in group names, so that it cannot collide with the group of a user.
+const ownIOTuneGroupPrefix = "kubevirt/"
+
+// toBlockIoTuneParameters sets every limit, so that limits which got removed from the spec are reset to unlimited.
+func toBlockIoTuneParameters(disk api.Disk) *libvirt.DomainBlockIoTuneParameters {
+	ioTune := disk.IOTune
+	if ioTune == nil {
+		ioTune = &api.DiskIOTune{}
+	}
+	value := func(v *uint64) uint64 {
+		if v == nil {
+			return 0
+		}
+		return *v
+	}
+	params := &libvirt.DomainBlockIoTuneParameters{
+		TotalBytesSecSet:          true,
+		TotalBytesSec:             value(ioTune.TotalBytesSec),
+		ReadBytesSecSet:           true,
+		ReadBytesSec:              value(ioTune.ReadBytesSec),
+		WriteBytesSecSet:          true,
+		WriteBytesSec:             value(ioTune.WriteBytesSec),
+		TotalIopsSecSet:           true,
+		TotalIopsSec:              value(ioTune.TotalIOPSSec),
+		ReadIopsSecSet:            true,
+		ReadIopsSec:               value(ioTune.ReadIOPSSec),
+		WriteIopsSecSet:           true,
+		WriteIopsSec:              value(ioTune.WriteIOPSSec),
+		TotalBytesSecMaxSet:       true,
+		TotalBytesSecMax:          value(ioTune.TotalBytesSecMax),
+		ReadBytesSecMaxSet:        true,
+		ReadBytesSecMax:           value(ioTune.ReadBytesSecMax),
+		WriteBytesSecMaxSet:       true,
+		WriteBytesSecMax:          value(ioTune.WriteBytesSecMax),
+		TotalIopsSecMaxSet:        true,
+		TotalIopsSecMax:           value(ioTune.TotalIOPSSecMax),
+		ReadIopsSecMaxSet:         true,
+		ReadIopsSecMax:            value(ioTune.ReadIOPSSecMax),
+		WriteIopsSecMaxSet:        true,
+		WriteIopsSecMax:           value(ioTune.WriteIOPSSecMax),
+		TotalBytesSecMaxLengthSet: true,
+		TotalBytesSecMaxLength:    value(ioTune.TotalBytesSecMaxLength),
+		ReadBytesSecMaxLengthSet:  true,
+		ReadBytesSecMaxLength:     value(ioTune.ReadBytesSecMaxLength),
+		WriteBytesSecMaxLengthSet: true,
+		WriteBytesSecMaxLength:    value(ioTune.WriteBytesSecMaxLength),
+		TotalIopsSecMaxLengthSet:  true,
+		TotalIopsSecMaxLength:     value(ioTune.TotalIOPSSecMaxLength),
+		ReadIopsSecMaxLengthSet:   true,
+		ReadIopsSecMaxLength:      value(ioTune.ReadIOPSSecMaxLength),
+		WriteIopsSecMaxLengthSet:  true,
+		WriteIopsSecMaxLength:     value(ioTune.WriteIOPSSecMaxLength),
+		SizeIopsSecSet:            true,
+		SizeIopsSec:               value(ioTune.SizeIOPSSec),
+		GroupNameSet:              ioTune.GroupName != "",
+		GroupName:                 ioTune.GroupName,
+	}
+	if ioTune.GroupName == "" && hasIOLimits(ioTune) {
+		// libvirt keeps the current group when no group name is passed, a disk which left its group
+		// is put into a group of its own instead. Without limits, the disk leaves its group anyway.
+		params.GroupNameSet = true
+		params.GroupName = ownIOTuneGroupPrefix + disk.Target.Device
+	}
+	return params
+}
+
+func hasIOLimits(ioTune *api.DiskIOTune) bool {
+	limits := *ioTune
+	limits.GroupName = ""
+	return !equality.Semantic.DeepEqual(limits, api.DiskIOTune{})
+}
+
 var isHotplugBlockDeviceVolume = isHotplugBlockDeviceVolumeFunc
 
 func isHotplugBlockDeviceVolumeFunc(volumeName string) bool {
@@ -1690,6 +2077,9 @@ func (l *LibvirtDomainManager) PauseVMI(vmi *v1.VirtualMachineInstance) error {
 		}
 		logger.Infof("Signaled pause for %s", vmi.GetObjectMeta().GetName())
 		l.paused.add(vmi.UID)
+		notifyHooks(vmi, hooksInfo.OnPauseHookPointName, func(m hooks.Manager, vmi *v1.VirtualMachineInstance) error {
+			return m.OnPause(vmi)
+		})
 	} else {
 		logger.Infof("Domain is not running for %s", vmi.GetObjectMeta().GetName())
 	}
@@ -1733,6 +2123,9 @@ func (l *LibvirtDomainManager) UnpauseVMI(vmi *v1.VirtualMachineInstance) error
 		// Try to set guest time after this commands execution.
 		// This operation is not disruptive.
 		l.setGuestTime(vmi)
+		notifyHooks(vmi, hooksInfo.OnUnpauseHookPointName, func(m hooks.Manager, vmi *v1.VirtualMachineInstance) error {
+			return m.OnUnpause(vmi)
+		})
 	} else {
 		logger.Infof("Domain is not paused for %s", vmi.GetObjectMeta().GetName())
 	}
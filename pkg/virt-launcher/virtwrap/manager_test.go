@@ -52,7 +52,9 @@ import (
 	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/hooks"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	virtpointer "kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -609,6 +611,30 @@ var _ = Describe("Manager", func() {
 
 			Expect(manager.PauseVMI(vmi)).To(Succeed())
 		})
+		It("should notify the hook sidecars when pausing a VirtualMachineInstance", func() {
+			vmi := newVMI(testNamespace, testVmName)
+
+			mockHookManager := hooks.NewMockManager(ctrl)
+			getHookManager = func() hooks.Manager {
+				return mockHookManager
+			}
+			DeferCleanup(func() {
+				getHookManager = hooks.GetManager
+			})
+
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockLibvirt.DomainEXPECT().Suspend().Return(nil)
+			hookCalled := make(chan struct{})
+			mockHookManager.EXPECT().OnPause(vmi).DoAndReturn(func(*v1.VirtualMachineInstance) error {
+				close(hookCalled)
+				return fmt.Errorf("hook failure")
+			})
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			Expect(manager.PauseVMI(vmi)).To(Succeed(), "a failing hook should not fail the pause")
+			Eventually(hookCalled).Should(BeClosed())
+		})
 		It("should not try to pause a paused VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
 
@@ -667,6 +693,30 @@ var _ = Describe("Manager", func() {
 			// no call to unpause
 			Expect(manager.UnpauseVMI(vmi)).To(Succeed())
 		})
+		It("should synchronise the guest time and report the drift", func() {
+			drift := 90 * time.Second
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommandWithTimeout(`{"execute":"guest-get-time"}`, testDomainName, int32(5)).DoAndReturn(
+				func(string, string, int32) (string, error) {
+					return fmt.Sprintf(`{"return":%d}`, time.Now().Add(drift).UnixNano()), nil
+				})
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommandWithTimeout(gomock.Any(), testDomainName, int32(5)).DoAndReturn(
+				func(command string, _ string, _ int32) (string, error) {
+					Expect(command).To(HavePrefix(`{"execute":"guest-set-time","arguments":{"time":`))
+					return `{"return":{}}`, nil
+				})
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			observedDrift, err := manager.SyncGuestTime(testDomainName, 5)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(observedDrift).To(BeNumerically("~", drift, time.Second))
+		})
+		It("should not set the guest time if it can't be read", func() {
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommandWithTimeout(`{"execute":"guest-get-time"}`, testDomainName, int32(5)).Return("", fmt.Errorf("guest agent is not responding"))
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			_, err := manager.SyncGuestTime(testDomainName, 5)
+			Expect(err).To(MatchError(ContainSubstring("guest agent is not responding")))
+		})
 
 		It("should not add discard=unmap if a disk is preallocated", func() {
 			vmi := newVMI(testNamespace, testVmName)
@@ -3199,6 +3249,58 @@ var _ = Describe("getUpdatedDisks", func() {
 	)
 })
 
+var _ = Describe("getIOTuneUpdatedDisks", func() {
+	diskWithIOTune := func(device string, ioTune *api.DiskIOTune) api.Disk {
+		return api.Disk{
+			Device: "disk",
+			Target: api.DiskTarget{Device: device},
+			IOTune: ioTune,
+		}
+	}
+
+	DescribeTable("should return the disks with changed limits", func(oldDisks, newDisks, expected []api.Disk) {
+		Expect(getIOTuneUpdatedDisks(oldDisks, newDisks)).To(Equal(expected))
+	},
+		Entry("with unchanged limits",
+			[]api.Disk{diskWithIOTune("vda", &api.DiskIOTune{TotalIOPSSec: virtpointer.P(uint64(100))})},
+			[]api.Disk{diskWithIOTune("vda", &api.DiskIOTune{TotalIOPSSec: virtpointer.P(uint64(100))})},
+			nil),
+		Entry("with changed limits",
+			[]api.Disk{diskWithIOTune("vda", &api.DiskIOTune{TotalIOPSSec: virtpointer.P(uint64(100))})},
+			[]api.Disk{diskWithIOTune("vda", &api.DiskIOTune{TotalIOPSSec: virtpointer.P(uint64(200))})},
+			[]api.Disk{diskWithIOTune("vda", &api.DiskIOTune{TotalIOPSSec: virtpointer.P(uint64(200))})}),
+		Entry("with removed limits",
+			[]api.Disk{diskWithIOTune("vda", &api.DiskIOTune{TotalIOPSSec: virtpointer.P(uint64(100))})},
+			[]api.Disk{diskWithIOTune("vda", nil)},
+			[]api.Disk{diskWithIOTune("vda", nil)}),
+		Entry("with a newly attached disk",
+			[]api.Disk{},
+			[]api.Disk{diskWithIOTune("vda", &api.DiskIOTune{TotalIOPSSec: virtpointer.P(uint64(100))})},
+			nil),
+	)
+
+	It("should reset limits which are not set", func() {
+		params := toBlockIoTuneParameters(diskWithIOTune("vda", &api.DiskIOTune{ReadBytesSec: virtpointer.P(uint64(1024)), GroupName: "group"}))
+		Expect(params.ReadBytesSecSet).To(BeTrue())
+		Expect(params.ReadBytesSec).To(Equal(uint64(1024)))
+		Expect(params.TotalBytesSecSet).To(BeTrue())
+		Expect(params.TotalBytesSec).To(BeZero())
+		Expect(params.GroupNameSet).To(BeTrue())
+		Expect(params.GroupName).To(Equal("group"))
+	})
+
+	It("should move a disk with limits and without a group name into a group of its own", func() {
+		params := toBlockIoTuneParameters(diskWithIOTune("vda", &api.DiskIOTune{ReadBytesSec: virtpointer.P(uint64(1024))}))
+		Expect(params.GroupNameSet).To(BeTrue())
+		Expect(params.GroupName).To(Equal("kubevirt/vda"))
+	})
+
+	It("should not set a group name when all limits are removed", func() {
+		params := toBlockIoTuneParameters(diskWithIOTune("vda", nil))
+		Expect(params.GroupNameSet).To(BeFalse())
+	})
+})
+
 var _ = Describe("migratableDomXML", func() {
 	var ctrl *gomock.Controller
 	var mockLibvirt *testing.Libvirt
@@ -3703,6 +3805,130 @@ var _ = Describe("Manager helper functions", func() {
 	})
 })
 
+var _ = Describe("setIPAMCloudInitNetworkData", func() {
+	newDomain := func() *api.Domain {
+		domain := &api.Domain{}
+		domain.Spec.Devices.Interfaces = []api.Interface{
+			{Alias: api.NewUserDefinedAlias("default"), MAC: &api.MAC{MAC: "02:00:00:00:00:00"}},
+			{Alias: api.NewUserDefinedAlias("blue"), MAC: &api.MAC{MAC: "02:00:00:00:00:01"}},
+		}
+		return domain
+	}
+
+	It("should not set network data when there are no IP allocations", func() {
+		cloudInitData := &cloudinit.CloudInitData{DataSource: cloudinit.DataSourceNoCloud}
+		Expect(setIPAMCloudInitNetworkData(libvmi.New(), newDomain(), cloudInitData)).To(Succeed())
+		Expect(cloudInitData.NetworkData).To(BeEmpty())
+	})
+
+	It("should set network data configuring the allocated addresses", func() {
+		vmi := libvmi.New(libvmi.WithAnnotation(v1.IPAllocationsAnnotation,
+			`[{"network":"blue","networkAttachmentDefinition":"default/blue","ipAddresses":["10.10.0.2/24"]}]`))
+		cloudInitData := &cloudinit.CloudInitData{DataSource: cloudinit.DataSourceNoCloud}
+
+		Expect(setIPAMCloudInitNetworkData(vmi, newDomain(), cloudInitData)).To(Succeed())
+
+		expectedNetworkData, err := cloudinit.GenerateNetworkData(cloudinit.DataSourceNoCloud, []cloudinit.NetworkDataInterface{
+			{MAC: "02:00:00:00:00:00"},
+			{MAC: "02:00:00:00:00:01", Addresses: []string{"10.10.0.2/24"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cloudInitData.NetworkData).To(Equal(expectedNetworkData))
+	})
+})
+
+var _ = Describe("syncCloudInitData", func() {
+	var (
+		mockLibvirt *testing.Libvirt
+		manager     *LibvirtDomainManager
+		spec        *api.DomainSpec
+	)
+
+	newVMI := func(userData string) *v1.VirtualMachineInstance {
+		return libvmi.New(
+			libvmi.WithName("testvmi"),
+			libvmi.WithNamespace("default"),
+			libvmi.WithCDRomAndVolume(v1.DiskBusSATA, v1.Volume{
+				Name: "cloudinit",
+				VolumeSource: v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+					UserData:     userData,
+					UpdatePolicy: virtpointer.P(v1.CloudInitUpdatePolicyLive),
+				}},
+			}),
+		)
+	}
+
+	BeforeEach(func() {
+		mockLibvirt = testing.NewLibvirt(gomock.NewController(GinkgoT()))
+
+		vmi := newVMI("#cloud-config\n")
+		cloudInitData, err := cloudinit.ReadCloudInitVolumeDataSource(vmi, "")
+		Expect(err).ToNot(HaveOccurred())
+		manager = &LibvirtDomainManager{
+			cloudInitDataStore:    cloudInitData,
+			cloudInitDataRevision: cloudinit.DataRevision(cloudInitData),
+		}
+
+		spec = &api.DomainSpec{}
+		spec.Devices.Disks = []api.Disk{{
+			Device: "cdrom",
+			Type:   "file",
+			Source: api.DiskSource{File: cloudinit.GetIsoFilePath(cloudinit.DataSourceNoCloud, vmi.Name, vmi.Namespace)},
+			Target: api.DiskTarget{Bus: v1.DiskBusSATA, Device: "sda"},
+			Alias:  api.NewUserDefinedAlias("cloudinit"),
+		}}
+		Expect(cloudinit.PrepareLocalPath(vmi.Name, vmi.Namespace)).To(Succeed())
+	})
+
+	It("should do nothing when the data did not change", func() {
+		Expect(manager.syncCloudInitData(newVMI("#cloud-config\n"), spec, mockLibvirt.VirtDomain)).To(Succeed())
+	})
+
+	It("should regenerate the data and re-insert the cdrom when the data changed", func() {
+		domainXML, err := xml.Marshal(spec)
+		Expect(err).ToNot(HaveOccurred())
+		mockLibvirt.DomainEXPECT().GetXMLDesc(gomock.Any()).AnyTimes().Return(string(domainXML), nil)
+
+		ejected := spec.Devices.Disks[0].DeepCopy()
+		ejected.Source = api.DiskSource{}
+		ejectedXML, err := xml.Marshal(ejected)
+		Expect(err).ToNot(HaveOccurred())
+		insertedXML, err := xml.Marshal(spec.Devices.Disks[0])
+		Expect(err).ToNot(HaveOccurred())
+		gomock.InOrder(
+			mockLibvirt.DomainEXPECT().UpdateDeviceFlags(string(ejectedXML), affectDeviceLiveAndConfigLibvirtFlags).Return(nil),
+			mockLibvirt.DomainEXPECT().UpdateDeviceFlags(string(insertedXML), affectDeviceLiveAndConfigLibvirtFlags).Return(nil),
+		)
+
+		vmi := newVMI("#cloud-config\npassword: fedora\n")
+		Expect(manager.syncCloudInitData(vmi, spec, mockLibvirt.VirtDomain)).To(Succeed())
+		Expect(manager.cloudInitDataStore.UserData).To(Equal("#cloud-config\npassword: fedora\n"))
+		Expect(manager.cloudInitDataRevision).To(Equal(cloudinit.DataRevision(manager.cloudInitDataStore)))
+		Expect(cloudinit.GetIsoFilePath(cloudinit.DataSourceNoCloud, vmi.Name, vmi.Namespace)).To(BeAnExistingFile())
+	})
+
+	It("should ignore VMIs without the Live update policy", func() {
+		vmi := newVMI("#cloud-config\npassword: fedora\n")
+		vmi.Spec.Volumes[0].CloudInitNoCloud.UpdatePolicy = virtpointer.P(v1.CloudInitUpdatePolicyRestart)
+		Expect(manager.syncCloudInitData(vmi, spec, mockLibvirt.VirtDomain)).To(Succeed())
+	})
+
+	It("should report a failed update in the domain metadata and keep the previous data", func() {
+		manager.metadataCache = metadata.NewCache()
+		revision := manager.cloudInitDataRevision
+
+		vmi := newVMI("")
+		vmi.Spec.Volumes[0].CloudInitNoCloud.UserDataSecretRef = &k8sv1.LocalObjectReference{Name: "missing-secret"}
+		manager.updateCloudInitData(vmi, spec, mockLibvirt.VirtDomain)
+
+		cloudInitMetadata, exists := manager.metadataCache.CloudInit.Load()
+		Expect(exists).To(BeTrue())
+		Expect(cloudInitMetadata.Succeeded).To(BeFalse())
+		Expect(cloudInitMetadata.Message).To(ContainSubstring("reading the cloud-init data failed"))
+		Expect(manager.cloudInitDataRevision).To(Equal(revision))
+	})
+})
+
 var _ = Describe("calculateHotplugPortCount", func() {
 	const gb = 1024 * 1024 * 1024
 
@@ -0,0 +1,62 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"reflect"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// updateDomainBandwidth applies the bandwidth limits of the VMI tap-based interfaces on the running domain.
+func (vim *virtIOInterfaceManager) updateDomainBandwidth(
+	vmi *v1.VirtualMachineInstance,
+	currentDomain, desiredDomain *api.Domain,
+	domainAttachments map[string]string,
+) error {
+	currentDomainIfacesByAlias := indexedDomainInterfaces(currentDomain)
+	vmiIfacesByName := netvmispec.IndexInterfaceSpecByName(vmi.Spec.Domain.Devices.Interfaces)
+	for _, desiredIface := range desiredDomain.Spec.Devices.Interfaces {
+		ifaceName := desiredIface.Alias.GetName()
+		if domainAttachments[ifaceName] != string(v1.Tap) {
+			continue
+		}
+		curIface, existsInDomain := currentDomainIfacesByAlias[ifaceName]
+		vmiIface, existsInSpec := vmiIfacesByName[ifaceName]
+		if !existsInDomain || !existsInSpec {
+			continue
+		}
+
+		desiredBandWidth := domainspec.NewBandWidth(&vmiIface)
+		if !reflect.DeepEqual(curIface.BandWidth, desiredBandWidth) {
+			curIface.BandWidth = desiredBandWidth
+			// The link state is updated beforehand, keep the desired one.
+			curIface.LinkState = desiredIface.LinkState
+			if err := vim.updateIfaceInDomain(&curIface); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/testing"
+)
+
+var _ = Describe("interface bandwidth update", func() {
+	const (
+		bandwidthInboundXML = `<interface type=""><source></source>` +
+			`<bandwidth><inbound average="1000" burst="256"></inbound></bandwidth>` +
+			`<alias name="ua-default"></alias></interface>`
+		noBandwidthXML = `<interface type=""><source></source><alias name="ua-default"></alias></interface>`
+	)
+
+	var tapAttachment = map[string]string{defaultNet: string(v1.Tap)}
+
+	DescribeTable("should update the domain interface",
+		func(domainFrom *api.Domain, bandwidth *v1.InterfaceBandwidth, expectedXML string) {
+			mockClient := testing.NewLibvirt(gomock.NewController(GinkgoT()))
+			mockClient.DomainEXPECT().UpdateDeviceFlags(expectedXML, gomock.Any()).Times(1).Return(nil)
+
+			networkInterfaceManager := newVirtIOInterfaceManager(mockClient.VirtDomain, &fakeVMConfigurator{})
+			vmi := vmiWithBandwidth(bandwidth)
+			Expect(networkInterfaceManager.updateDomainBandwidth(vmi, domainFrom, dummyDomain(defaultNet), tapAttachment)).To(Succeed())
+		},
+		Entry("when a limit is set", dummyDomain(defaultNet),
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000, Burst: 256}},
+			bandwidthInboundXML,
+		),
+		Entry("when the limit is removed",
+			newDomain(api.Interface{
+				Alias:     api.NewUserDefinedAlias(defaultNet),
+				BandWidth: &api.BandWidth{Inbound: &api.BandWidthRate{Average: 1000, Burst: 256}},
+			}),
+			nil,
+			noBandwidthXML,
+		),
+	)
+
+	DescribeTable("should not update the domain interface",
+		func(domainFrom *api.Domain, domainAttachments map[string]string) {
+			mockClient := testing.NewLibvirt(gomock.NewController(GinkgoT()))
+			mockClient.DomainEXPECT().UpdateDeviceFlags(gomock.Any(), gomock.Any()).Times(0)
+
+			networkInterfaceManager := newVirtIOInterfaceManager(mockClient.VirtDomain, &fakeVMConfigurator{})
+			vmi := vmiWithBandwidth(&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000, Burst: 256}})
+			Expect(networkInterfaceManager.updateDomainBandwidth(vmi, domainFrom, dummyDomain(defaultNet), domainAttachments)).To(Succeed())
+		},
+		Entry("when the limit is unchanged",
+			newDomain(api.Interface{
+				Alias:     api.NewUserDefinedAlias(defaultNet),
+				BandWidth: &api.BandWidth{Inbound: &api.BandWidthRate{Average: 1000, Burst: 256}},
+			}),
+			tapAttachment,
+		),
+		Entry("when the interface is not tap-based", dummyDomain(defaultNet), map[string]string{}),
+	)
+})
+
+func vmiWithBandwidth(bandwidth *v1.InterfaceBandwidth) *v1.VirtualMachineInstance {
+	iface := *v1.DefaultMasqueradeNetworkInterface()
+	iface.Bandwidth = bandwidth
+	return &v1.VirtualMachineInstance{
+		Spec: v1.VirtualMachineInstanceSpec{
+			Networks: []v1.Network{*v1.DefaultPodNetwork()},
+			Domain: v1.DomainSpec{
+				Devices: v1.Devices{Interfaces: []v1.Interface{iface}},
+			},
+		},
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -42,6 +43,7 @@ func Sync(
 	dom domainClient,
 	vmi *v1.VirtualMachineInstance,
 	domainAttachments map[string]string,
+	onHotplug func(hooks.HotplugEvent),
 ) error {
 	if !vmi.IsRunning() {
 		return nil
@@ -49,6 +51,7 @@ func Sync(
 
 	networkConfigurator := netsetup.NewVMNetworkConfigurator(vmi, cache.CacheCreator{}, netsetup.WithDomainAttachments(domainAttachments))
 	networkInterfaceManager := newVirtIOInterfaceManager(dom, networkConfigurator)
+	networkInterfaceManager.onHotplug = onHotplug
 	if err := networkInterfaceManager.hotplugVirtioInterface(vmi, &api.Domain{Spec: *oldSpec}, domain); err != nil {
 		return err
 	}
@@ -58,6 +61,9 @@ func Sync(
 	if err := networkInterfaceManager.updateDomainLinkState(&api.Domain{Spec: *oldSpec}, domain); err != nil {
 		return err
 	}
+	if err := networkInterfaceManager.updateDomainBandwidth(vmi, &api.Domain{Spec: *oldSpec}, domain, domainAttachments); err != nil {
+		return err
+	}
 
 	return nil
 }
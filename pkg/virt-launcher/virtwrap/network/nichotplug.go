@@ -30,6 +30,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/hooks"
 	virtnetlink "kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
@@ -46,6 +47,8 @@ type vmConfigurator interface {
 type virtIOInterfaceManager struct {
 	dom          domainClient
 	configurator vmConfigurator
+	// onHotplug is called once an interface is attached to or detached from the domain
+	onHotplug func(hooks.HotplugEvent)
 }
 
 const (
@@ -90,6 +93,7 @@ func (vim *virtIOInterfaceManager) hotplugVirtioInterface(vmi *v1.VirtualMachine
 			log.Log.Reason(err).Errorf("libvirt failed to attach interface %s: %v", network.Name, err)
 			return err
 		}
+		vim.notifyHotplug(network.Name, hooks.HotplugActionPlug)
 	}
 	return nil
 }
@@ -113,14 +117,14 @@ func (vim *virtIOInterfaceManager) updateDomainLinkState(currentDomain, desiredD
 }
 
 func (vim *virtIOInterfaceManager) updateIfaceInDomain(domIfaceToUpdate *api.Interface) error {
-	log.Log.Infof("preparing to update link state to interface %q", domIfaceToUpdate.Alias.GetName())
+	log.Log.Infof("preparing to update interface %q", domIfaceToUpdate.Alias.GetName())
 	ifaceXML, err := xml.Marshal(domIfaceToUpdate)
 	if err != nil {
 		return err
 	}
 
 	if err = vim.dom.UpdateDeviceFlags(strings.ToLower(string(ifaceXML)), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
-		log.Log.Reason(err).Errorf("libvirt failed to set link state to interface %s , %v", domIfaceToUpdate.Alias.GetName(), err)
+		log.Log.Reason(err).Errorf("libvirt failed to update interface %s , %v", domIfaceToUpdate.Alias.GetName(), err)
 		return err
 	}
 	return nil
@@ -145,10 +149,22 @@ func (vim *virtIOInterfaceManager) hotUnplugVirtioInterface(vmi *v1.VirtualMachi
 			log.Log.Reason(derr).Errorf("libvirt failed to detach interface %s: %v", domainIface.Alias.GetName(), derr)
 			return derr
 		}
+		vim.notifyHotplug(domainIface.Alias.GetName(), hooks.HotplugActionUnplug)
 	}
 	return nil
 }
 
+func (vim *virtIOInterfaceManager) notifyHotplug(networkName string, action hooks.HotplugAction) {
+	if vim.onHotplug == nil {
+		return
+	}
+	vim.onHotplug(hooks.HotplugEvent{
+		DeviceType: hooks.HotplugDeviceInterface,
+		DeviceName: networkName,
+		Action:     action,
+	})
+}
+
 func interfacesToHotUnplug(
 	vmiSpecInterfaces []v1.Interface,
 	vmiSpecNets []v1.Network,
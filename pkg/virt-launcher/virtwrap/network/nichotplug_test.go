@@ -30,6 +30,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
 
@@ -202,6 +203,28 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 		),
 	)
 
+	It("hotplugVirtioInterface should notify the attached interface", func() {
+		networkInterfaceManager := newVirtIOInterfaceManager(
+			mockLibvirtClient(gomock.NewController(GinkgoT()), libvirtClientResult{expectedAttachedDevices: 1}).VirtDomain,
+			&fakeVMConfigurator{},
+		)
+		var events []hooks.HotplugEvent
+		networkInterfaceManager.onHotplug = func(event hooks.HotplugEvent) {
+			events = append(events, event)
+		}
+
+		Expect(networkInterfaceManager.hotplugVirtioInterface(
+			vmiWithSingleBridgeInterfaceWithPodInterfaceReady(networkName, nadName),
+			dummyDomain(),
+			dummyDomain(networkName),
+		)).To(Succeed())
+		Expect(events).To(Equal([]hooks.HotplugEvent{{
+			DeviceType: hooks.HotplugDeviceInterface,
+			DeviceName: networkName,
+			Action:     hooks.HotplugActionPlug,
+		}}))
+	})
+
 	DescribeTable(
 		"hotplugVirtioInterface FAILS when",
 		func(vmi *v1.VirtualMachineInstance, currentDomain, updatedDomain *api.Domain, configurator vmConfigurator, result libvirtClientResult) {
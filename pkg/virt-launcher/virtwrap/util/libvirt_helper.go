@@ -27,6 +27,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/hooks/domainpatch"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
@@ -144,6 +145,13 @@ func SetDomainSpecStrWithHooks(virConn cli.Connection, vmi *v1.VirtualMachineIns
 		return nil, err
 	}
 
+	// Cluster domain patches are applied last, on the raw XML, so that they
+	// can touch elements which are not modeled by the domain spec.
+	domainSpec, err = domainpatch.Apply(domainSpec, vmi.Status.DomainPatches)
+	if err != nil {
+		return nil, err
+	}
+
 	// update wantedSpec to reflect changes made to domain spec by hooks
 	domainSpecObj := &api.DomainSpec{}
 	if err = xml.Unmarshal([]byte(domainSpec), domainSpecObj); err != nil {
@@ -276,6 +276,42 @@ var _ = Describe("LibvirtHelper", func() {
 		Expect(wantedSpec.Devices.Disks).To(Equal(mutatedSpec.Devices.Disks))
 	})
 
+	It("should apply the VMI domain patches after the hooks", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockLibvirt := testing.NewLibvirt(ctrl)
+
+		vmi := api2.NewMinimalVMIWithNS("test-namespace", "test-vmi")
+		vmi.Status.DomainPatches = []v1.VirtualMachineInstanceDomainPatch{{
+			Name: "tune",
+			Operations: []v1.DomainPatchOperation{
+				{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/memballoon"},
+				{Op: v1.DomainPatchOperationAdd, Path: "/domain/devices", Value: `<watchdog model="itco" action="reset"/>`},
+			},
+		}}
+		wantedSpec := &api.DomainSpec{}
+
+		mockHookManager := hooks.NewMockManager(ctrl)
+		getHookManager = func() hooks.Manager {
+			return mockHookManager
+		}
+		defer func() {
+			getHookManager = hooks.GetManager
+		}()
+		mockHookManager.EXPECT().OnDefineDomain(wantedSpec, vmi).Return(
+			`<domain type="kvm"><name>test-vmi</name><devices><memballoon model="none"/></devices></domain>`, nil)
+		mockLibvirt.ConnectionEXPECT().DomainDefineXML(
+			`<domain type="kvm"><name>test-vmi</name><devices><watchdog model="itco" action="reset"/></devices></domain>`,
+		).Return(mockLibvirt.VirtDomain, nil)
+		mockLibvirt.DomainEXPECT().Free()
+
+		dom, err := SetDomainSpecStrWithHooks(mockLibvirt.VirtConnection, vmi, wantedSpec)
+		Expect(err).NotTo(HaveOccurred())
+		dom.Free()
+
+		Expect(wantedSpec.Devices.Watchdogs).To(HaveLen(1))
+		Expect(wantedSpec.Devices.Ballooning).To(BeNil())
+	})
+
 	Context("getLibvirtLogFilters()", func() {
 
 		DescribeTable("should return customLogFilters if defined and not empty with", func(libvirtLogVerbosityEnvVar *string, libvirtDebugLogsEnvVarDefined bool) {
@@ -1024,6 +1024,31 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
             cpuModel:
               type: string
+            cpuModelGroups:
+              description: |-
+                CPUModelGroups defines groups of nodes between which VMIs with the "cluster-baseline"
+                CPU model can migrate. The baseline of a group is the most capable CPU model, in the order
+                of the libvirt CPU map, which all its nodes support, with the host-model CPU features
+                which all its nodes report.
+              items:
+                description: CPUModelGroup selects the nodes whose common CPU model
+                  VMIs of the group run with
+                properties:
+                  name:
+                    description: Name of the group, referenced by spec.domain.cpu.modelGroup
+                    type: string
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: NodeSelector selects the nodes of the group
+                    type: object
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
             cpuRequest:
               anyOf:
               - type: integer
@@ -1140,6 +1165,155 @@ var CRDsValidation map[string]string = map[string]string{
                     in case hardware-assisted emulation is not available. Defaults to false
                   type: boolean
               type: object
+            domainPatches:
+              description: |-
+                DomainPatches are applied to the libvirt domain of the VMIs they select, after KubeVirt
+                generated it and the hook sidecars ran. They are selected when the VMI is created.
+              items:
+                description: DomainPatch edits the libvirt domain XML of the selected
+                  VMIs
+                properties:
+                  name:
+                    description: Name identifies the patch, it is reported in the
+                      status of the VMIs it is applied to
+                    type: string
+                  namespaceSelector:
+                    description: |-
+                      NamespaceSelector restricts the patch to the VMIs of the namespaces selected by their labels.
+                      VMI labels are set by the VMI owners, the namespace selector keeps the patch out of the other namespaces.
+                      An empty or missing namespace selector selects all namespaces.
+                    properties:
+                      matchExpressions:
+                        description: matchExpressions is a list of label selector
+                          requirements. The requirements are ANDed.
+                        items:
+                          description: |-
+                            A label selector requirement is a selector that contains values, a key, and an operator that
+                            relates the key and values.
+                          properties:
+                            key:
+                              description: key is the label key that the selector
+                                applies to.
+                              type: string
+                            operator:
+                              description: |-
+                                operator represents a key's relationship to a set of values.
+                                Valid operators are In, NotIn, Exists and DoesNotExist.
+                              type: string
+                            values:
+                              description: |-
+                                values is an array of string values. If the operator is In or NotIn,
+                                the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                the values array must be empty. This array is replaced during a strategic
+                                merge patch.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          required:
+                          - key
+                          - operator
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      matchLabels:
+                        additionalProperties:
+                          type: string
+                        description: |-
+                          matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                          map is equivalent to an element of matchExpressions, whose key field is "key", the
+                          operator is "In", and the values array contains only "value". The requirements are ANDed.
+                        type: object
+                    type: object
+                    x-kubernetes-map-type: atomic
+                  operations:
+                    description: Operations applied to the domain XML, in order
+                    items:
+                      description: DomainPatchOperation is a single edit of the domain
+                        XML
+                      properties:
+                        op:
+                          description: |-
+                            Op is the operation to perform: add, replace or remove.
+                            add sets an attribute, or appends the XML fragment in value to the selected elements.
+                            replace sets an existing attribute, or replaces the selected elements with the XML fragment in value.
+                            remove deletes the selected attribute or elements.
+                          enum:
+                          - add
+                          - replace
+                          - remove
+                          type: string
+                        path:
+                          description: |-
+                            Path selects the elements, or an attribute of them, with an XPath subset: an absolute
+                            location path of element names, filtered by [@attr='value'], [@attr] or [position]
+                            predicates, optionally ending with an @attr step.
+                            For example /domain/devices/interface[@type='ethernet'][1]/model/@type
+                          type: string
+                        value:
+                          description: Value is the attribute value, or the XML fragment,
+                            used by add and replace
+                          type: string
+                      required:
+                      - op
+                      - path
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  selector:
+                    description: |-
+                      Selector selects the VMIs the patch is applied to by their labels.
+                      An empty or missing selector selects all VMIs.
+                    properties:
+                      matchExpressions:
+                        description: matchExpressions is a list of label selector
+                          requirements. The requirements are ANDed.
+                        items:
+                          description: |-
+                            A label selector requirement is a selector that contains values, a key, and an operator that
+                            relates the key and values.
+                          properties:
+                            key:
+                              description: key is the label key that the selector
+                                applies to.
+                              type: string
+                            operator:
+                              description: |-
+                                operator represents a key's relationship to a set of values.
+                                Valid operators are In, NotIn, Exists and DoesNotExist.
+                              type: string
+                            values:
+                              description: |-
+                                values is an array of string values. If the operator is In or NotIn,
+                                the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                the values array must be empty. This array is replaced during a strategic
+                                merge patch.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          required:
+                          - key
+                          - operator
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      matchLabels:
+                        additionalProperties:
+                          type: string
+                        description: |-
+                          matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                          map is equivalent to an element of matchExpressions, whose key field is "key", the
+                          operator is "In", and the values array contains only "value". The requirements are ANDed.
+                        type: object
+                    type: object
+                    x-kubernetes-map-type: atomic
+                required:
+                - name
+                - operations
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
             emulatedMachines:
               description: Deprecated. Use architectureConfiguration instead.
               items:
@@ -1151,6 +1325,120 @@ var CRDsValidation map[string]string = map[string]string{
                 migrated instead of shut-off in case of a node drain. If the VirtualMachineInstance specific
                 field is set it overrides the cluster level one.
               type: string
+            guestExec:
+              description: |-
+                GuestExec restricts the commands which can be run in the guests through the guestexec
+                subresource. The subresource requires the GuestExec feature gate.
+              nullable: true
+              properties:
+                allowedCommands:
+                  description: |-
+                    AllowedCommands are the paths of the guest commands which can be run in the VMIs of all namespaces.
+                    Commands are matched exactly, as they are passed to the guest agent.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+                defaultTimeoutSeconds:
+                  description: |-
+                    DefaultTimeoutSeconds is the timeout of the commands which do not request one.
+                    Defaults to 10.
+                  format: int32
+                  type: integer
+                maxOutputBytes:
+                  description: |-
+                    MaxOutputBytes is the maximum size of the command output returned, the remainder is discarded.
+                    Defaults to 65536.
+                  format: int64
+                  type: integer
+                maxTimeoutSeconds:
+                  description: |-
+                    MaxTimeoutSeconds is the maximum timeout a command can request.
+                    Defaults to 60.
+                  format: int32
+                  type: integer
+                namespaceAllowedCommands:
+                  description: |-
+                    NamespaceAllowedCommands are the commands which can additionally be run in the VMIs of the
+                    namespaces selected by their labels
+                  items:
+                    description: GuestExecNamespaceAllowList allows commands in the
+                      namespaces selected by their labels
+                    properties:
+                      allowedCommands:
+                        description: AllowedCommands are the paths of the guest commands
+                          which can be run in the selected namespaces
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: set
+                      namespaceSelector:
+                        description: NamespaceSelector selects the namespaces by their
+                          labels
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                    required:
+                    - allowedCommands
+                    - namespaceSelector
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+            guestFileTransfer:
+              description: |-
+                GuestFileTransfer limits the files copied from and to the guests through the guestfile
+                subresource. The subresource requires the GuestFileTransfer feature gate.
+              nullable: true
+              properties:
+                maxFileSizeBytes:
+                  description: |-
+                    MaxFileSizeBytes is the maximum size of the files which can be copied from and to the guests.
+                    Defaults to 104857600 (100MiB).
+                  format: int64
+                  type: integer
+              type: object
             handlerConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -1501,7 +1789,7 @@ var CRDsValidation map[string]string = map[string]string{
                       domainAttachmentType:
                         description: |-
                           DomainAttachmentType is a standard domain network attachment method kubevirt supports.
-                          Supported values: "tap", "managedTap" (since v1.4).
+                          Supported values: "tap", "managedTap" (since v1.4), "vhostuser".
                           The standard domain attachment can be used instead or in addition to the sidecarImage.
                           version: 1alphav1
                         type: string
@@ -1539,6 +1827,70 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                 defaultNetworkInterface:
                   type: string
+                macPool:
+                  description: |-
+                    MACPool enables the allocation of MAC addresses to the VirtualMachine interfaces
+                    which do not specify one. The allocated address is persisted on the VirtualMachine template
+                    and is released when the VirtualMachine is deleted.
+                  properties:
+                    namespaceOverrides:
+                      description: |-
+                        NamespaceOverrides replace the ranges for the VirtualMachines of specific namespaces.
+                        A namespace override without ranges opts the namespace out of the allocation.
+                      items:
+                        properties:
+                          namespace:
+                            description: Namespace the ranges apply to.
+                            type: string
+                          ranges:
+                            description: Ranges of MAC addresses to allocate from
+                              for the VirtualMachines of the namespace.
+                            items:
+                              description: MACRange is an inclusive range of unicast
+                                MAC addresses.
+                              properties:
+                                end:
+                                  description: End is the last address of the range,
+                                    e.g. 02:00:00:ff:ff:ff
+                                  type: string
+                                start:
+                                  description: Start is the first address of the range,
+                                    e.g. 02:00:00:00:00:00
+                                  type: string
+                              required:
+                              - end
+                              - start
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        required:
+                        - namespace
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    ranges:
+                      description: Ranges of MAC addresses to allocate from.
+                      items:
+                        description: MACRange is an inclusive range of unicast MAC
+                          addresses.
+                        properties:
+                          end:
+                            description: End is the last address of the range, e.g.
+                              02:00:00:ff:ff:ff
+                            type: string
+                          start:
+                            description: Start is the first address of the range,
+                              e.g. 02:00:00:00:00:00
+                            type: string
+                        required:
+                        - end
+                        - start
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - ranges
+                  type: object
                 permitBridgeInterfaceOnPodNetwork:
                   type: boolean
                 permitSlirpInterface:
@@ -6008,6 +6360,13 @@ var CRDsValidation map[string]string = map[string]string{
                     clock:
                       description: Clock sets the clock and timers of the vmi.
                       properties:
+                        guestTimeSync:
+                          description: |-
+                            GuestTimeSync enables setting the guest clock through the guest agent after
+                            the guest was unpaused or migrated, and once the guest agent connects after
+                            boot, e.g. when starting from a restored snapshot. The drift observed before
+                            every synchronisation is reported as a metric.
+                          type: object
                         timer:
                           description: Timer specifies whih timers are attached to
                             the vmi.
@@ -6156,8 +6515,15 @@ var CRDsValidation map[string]string = map[string]string{
                             List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                             It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                             and "host-model" to get CPU closest to the node one.
+                            "cluster-baseline" gets the CPU model and features common to all nodes of the CPU model group,
+                            allowing the VMI to migrate to any node of the group.
                             Defaults to host-model.
                           type: string
+                        modelGroup:
+                          description: |-
+                            ModelGroup selects the CPU model group, as defined in the KubeVirt configuration, whose baseline
+                            is used when the model is "cluster-baseline". Defaults to all schedulable nodes.
+                          type: string
                         numa:
                           description: NUMA allows specifying settings for the guest
                             NUMA topology
@@ -6344,6 +6710,113 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: |-
+                                  IOTune specifies I/O throttling limits enforced by the hypervisor for this disk.
+                                  With the LiveUpdate rollout strategy the limits can be changed on a running VM.
+                                properties:
+                                  bytes:
+                                    description: Bytes limits the disk throughput
+                                      in bytes per second.
+                                    properties:
+                                      burst:
+                                        description: Burst allows exceeding the sustained
+                                          limits for a limited time.
+                                        properties:
+                                          lengthSeconds:
+                                            description: |-
+                                              LengthSeconds is the duration of a burst.
+                                              Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          read:
+                                            description: Read is the maximum read
+                                              rate during a burst.
+                                            format: int64
+                                            type: integer
+                                          total:
+                                            description: Total is the maximum rate
+                                              for reads and writes combined during
+                                              a burst.
+                                            format: int64
+                                            type: integer
+                                          write:
+                                            description: Write is the maximum write
+                                              rate during a burst.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      read:
+                                        description: Read limits reads.
+                                        format: int64
+                                        type: integer
+                                      total:
+                                        description: Total limits reads and writes
+                                          combined.
+                                        format: int64
+                                        type: integer
+                                      write:
+                                        description: Write limits writes.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  groupName:
+                                    description: |-
+                                      GroupName places the disk into a throttling group. All disks of a group share the
+                                      limits of the group.
+                                    type: string
+                                  iops:
+                                    description: IOPS limits the number of I/O operations
+                                      per second.
+                                    properties:
+                                      burst:
+                                        description: Burst allows exceeding the sustained
+                                          limits for a limited time.
+                                        properties:
+                                          lengthSeconds:
+                                            description: |-
+                                              LengthSeconds is the duration of a burst.
+                                              Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          read:
+                                            description: Read is the maximum read
+                                              rate during a burst.
+                                            format: int64
+                                            type: integer
+                                          total:
+                                            description: Total is the maximum rate
+                                              for reads and writes combined during
+                                              a burst.
+                                            format: int64
+                                            type: integer
+                                          write:
+                                            description: Write is the maximum write
+                                              rate during a burst.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      read:
+                                        description: Read limits reads.
+                                        format: int64
+                                        type: integer
+                                      total:
+                                        description: Total limits reads and writes
+                                          combined.
+                                        format: int64
+                                        type: integer
+                                      write:
+                                        description: Write limits writes.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  sizeIOPS:
+                                    description: |-
+                                      SizeIOPS is the size in bytes of a single I/O operation when IOPS limits are accounted.
+                                      Larger requests are counted as multiple operations.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -6521,6 +6994,64 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  Bandwidth limits the traffic rate of the interface.
+                                  Supported only by interfaces attached to the domain through a tap device
+                                  (bridge, masquerade and binding plugins with the tap domain attachment type).
+                                  Can be updated on a running VM.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in kibibytes per
+                                          second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kibibytes
+                                          that can be sent in a single burst at the
+                                          peak rate.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                          Must not be lower than the average.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in kibibytes per
+                                          second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kibibytes
+                                          that can be sent in a single burst at the
+                                          peak rate.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                          Must not be lower than the average.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -6554,12 +7085,76 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 67
                                       to interface's DHCP server
                                     type: string
-                                  ntpServers:
-                                    description: If specified will pass the configured
-                                      NTP server to the VM via DHCP option 042.
+                                  classlessRoutes:
+                                    description: If specified will pass the routes
+                                      via DHCP option 121, in addition to the routes
+                                      of the pod interface.
+                                    items:
+                                      description: DHCPClasslessRoute is a route passed
+                                        via DHCP option 121.
+                                      properties:
+                                        destination:
+                                          description: Destination network in CIDR
+                                            notation, e.g. 10.0.0.0/8.
+                                          type: string
+                                        gateway:
+                                          description: Gateway IPv4 address. An empty
+                                            value describes a route on the link.
+                                          type: string
+                                      required:
+                                      - destination
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  domainSearch:
+                                    description: If specified will pass the domain
+                                      search list via DHCP option 119, instead of
+                                      the one of the pod.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  leaseTimeSeconds:
+                                    description: |-
+                                      LeaseTimeSeconds is the lease time of the address offered to the guest.
+                                      Applies to the preferred and valid lifetimes of the DHCPv6 address as well.
+                                      Must be at least 60 seconds.
+                                      Defaults to an infinite lease.
+                                    format: int32
+                                    type: integer
+                                  ntpServers:
+                                    description: If specified will pass the configured
+                                      NTP server to the VM via DHCP option 042.
                                     items:
                                       type: string
                                     type: array
+                                  options:
+                                    description: |-
+                                      If specified will pass the listed DHCP options, overriding the values set by KubeVirt.
+                                      Options driving the DHCP protocol itself (e.g. lease time, message type, server identifier) cannot be set.
+                                    items:
+                                      description: DHCPOption is a numeric DHCP option
+                                        passed to the guest.
+                                      properties:
+                                        code:
+                                          description: 'Code of the option, range:
+                                            1-254.'
+                                          type: integer
+                                        type:
+                                          description: |-
+                                            Type of the value, one of: string, hex, ipv4, uint8, uint16, uint32.
+                                            Defaults to string.
+                                          type: string
+                                        value:
+                                          description: Value of the option, encoded
+                                            according to the type.
+                                          type: string
+                                      required:
+                                      - code
+                                      - value
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                   privateOptions:
                                     description: 'If specified will pass extra DHCP
                                       options for private use, range: 224-254'
@@ -6586,6 +7181,79 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 66
                                       to interface's DHCP server
                                     type: string
+                                  v6:
+                                    description: V6 specifies options passed by the
+                                      DHCPv6 server, used by the masquerade binding.
+                                    properties:
+                                      domainSearch:
+                                        description: If specified will pass the domain
+                                          search list via DHCPv6 option 24, instead
+                                          of the one of the pod.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      ntpServers:
+                                        description: If specified will pass the NTP
+                                          servers via DHCPv6 option 56.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    type: object
+                                type: object
+                              firewall:
+                                description: |-
+                                  Firewall filters the traffic bridged between the guest and the network.
+                                  Supported only by the bridge binding.
+                                  Requires the nf_conntrack_bridge kernel module to be loaded on the node.
+                                properties:
+                                  defaultAction:
+                                    description: |-
+                                      DefaultAction is applied to the traffic which does not match any rule.
+                                      Defaults to Allow.
+                                    type: string
+                                  rules:
+                                    description: Rules are evaluated in order, the
+                                      first matching rule decides the traffic fate.
+                                    items:
+                                      properties:
+                                        action:
+                                          description: 'Action to take on matching
+                                            traffic: Allow or Deny.'
+                                          type: string
+                                        cidr:
+                                          description: |-
+                                            CIDR of the remote peer: the source of ingress traffic or the destination of egress traffic.
+                                            Matches any peer when not specified.
+                                          type: string
+                                        direction:
+                                          description: 'Direction of the traffic the
+                                            rule applies to: Ingress or Egress.'
+                                          type: string
+                                        endPort:
+                                          description: EndPort indicates that the
+                                            range of ports from Port to EndPort, inclusive,
+                                            should match.
+                                          format: int32
+                                          type: integer
+                                        port:
+                                          description: |-
+                                            Port is the destination port of the matching traffic.
+                                            Requires the TCP, UDP or SCTP protocol.
+                                          format: int32
+                                          type: integer
+                                        protocol:
+                                          description: |-
+                                            Protocol of the matching traffic: TCP, UDP, SCTP or ICMP.
+                                            Matches any protocol when not specified.
+                                          type: string
+                                      required:
+                                      - action
+                                      - direction
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                 type: object
                               macAddress:
                                 description: 'Interface MAC address. For example:
@@ -6636,6 +7304,14 @@ var CRDsValidation map[string]string = map[string]string{
                                     Default protocol TCP.
                                     The port field is mandatory
                                   properties:
+                                    endPort:
+                                      description: |-
+                                        EndPort indicates that the range of ports from Port to EndPort, inclusive,
+                                        should be exposed. It must be greater than or equal to Port,
+                                        the range may span at most 1024 ports.
+                                        Supported only by the masquerade binding.
+                                      format: int32
+                                      type: integer
                                     name:
                                       description: |-
                                         If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -6650,7 +7326,9 @@ var CRDsValidation map[string]string = map[string]string{
                                       type: integer
                                     protocol:
                                       description: |-
-                                        Protocol for port. Must be UDP or TCP.
+                                        Protocol for port. Must be UDP, TCP, SCTP or ICMP.
+                                        ICMP forwards echo requests and is supported only by the masquerade binding,
+                                        in which case the port must not be set.
                                         Defaults to "TCP".
                                       type: string
                                   required:
@@ -7293,6 +7971,12 @@ var CRDsValidation map[string]string = map[string]string{
                       description: Resources describes the Compute Resources required
                         by this vmi.
                       properties:
+                        ioWeight:
+                          description: |-
+                            IOWeight sets the relative I/O weight (cgroup v2 io.weight) of the virt-launcher pod.
+                            Valid values are between 1 and 10000. Ignored on nodes using cgroup v1.
+                          format: int32
+                          type: integer
                         limits:
                           additionalProperties:
                             anyOf:
@@ -7334,6 +8018,30 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                guestServiceWatch:
+                  description: |-
+                    GuestServiceWatch lists services of the guest whose state is checked periodically
+                    through the guest agent. The state is reported by the GuestServicesUp condition
+                    and the kubevirt_vmi_guest_service_up metric.
+                  properties:
+                    periodSeconds:
+                      description: |-
+                        How often (in seconds) to check the services.
+                        Defaults to 60 seconds. Minimum value is 30.
+                      format: int32
+                      type: integer
+                    services:
+                      description: |-
+                        Services to watch, systemd units on Linux guests and service names on Windows guests.
+                        At most 10 services can be watched.
+                      items:
+                        type: string
+                      maxItems: 10
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - services
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
@@ -8017,6 +8725,33 @@ var CRDsValidation map[string]string = map[string]string{
                           The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                           More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
                         properties:
+                          metaData:
+                            description: MetaData adds user defined entries to the
+                              config drive instance metadata.
+                            properties:
+                              annotations:
+                                description: |-
+                                  Annotations lists the VMI annotations exposed in the instance metadata, under the
+                                  annotations key.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              keys:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  Keys are added to the instance metadata: at the top level with NoCloud, and under
+                                  the meta key with config drive. Keys generated by KubeVirt cannot be overridden.
+                                type: object
+                              labels:
+                                description: Labels lists the VMI labels exposed in
+                                  the instance metadata, under the labels key.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
                           networkData:
                             description: NetworkData contains config drive inline
                               cloud-init networkdata.
@@ -8055,6 +8790,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
+                          updatePolicy:
+                            description: |-
+                              UpdatePolicy defines how changes of the data sources are propagated to the guest.
+                              Defaults to None.
+                            type: string
                           userData:
                             description: UserData contains config drive inline cloud-init
                               userdata.
@@ -8063,6 +8803,29 @@ var CRDsValidation map[string]string = map[string]string{
                             description: UserDataBase64 contains config drive cloud-init
                               userdata as a base64 encoded string.
                             type: string
+                          vendorData:
+                            description: VendorData contains config drive inline cloud-init
+                              vendordata.
+                            type: string
+                          vendorDataBase64:
+                            description: VendorDataBase64 contains config drive cloud-init
+                              vendordata as a base64 encoded string.
+                            type: string
+                          vendorDataSecretRef:
+                            description: VendorDataSecretRef references a k8s secret
+                              that contains config drive vendordata.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
                         type: object
                       cloudInitNoCloud:
                         description: |-
@@ -8070,6 +8833,37 @@ var CRDsValidation map[string]string = map[string]string{
                           The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                           More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                         properties:
+                          metaData:
+                            description: MetaData adds user defined entries to the
+                              NoCloud instance metadata.
+                            properties:
+                              annotations:
+                                description: |-
+                                  Annotations lists the VMI annotations exposed in the instance metadata, under the
+                                  annotations key.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              keys:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  Keys are added to the instance metadata: at the top level with NoCloud, and under
+                                  the meta key with config drive. Keys generated by KubeVirt cannot be overridden.
+                                type: object
+                              labels:
+                                description: Labels lists the VMI labels exposed in
+                                  the instance metadata, under the labels key.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
+                          metadataService:
+                            description: MetadataService additionally serves the NoCloud
+                              data to the guest over HTTP.
+                            type: object
                           networkData:
                             description: NetworkData contains NoCloud inline cloud-init
                               networkdata.
@@ -8108,6 +8902,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
+                          updatePolicy:
+                            description: |-
+                              UpdatePolicy defines how changes of the data sources are propagated to the guest.
+                              Defaults to None.
+                            type: string
                           userData:
                             description: UserData contains NoCloud inline cloud-init
                               userdata.
@@ -8116,6 +8915,29 @@ var CRDsValidation map[string]string = map[string]string{
                             description: UserDataBase64 contains NoCloud cloud-init
                               userdata as a base64 encoded string.
                             type: string
+                          vendorData:
+                            description: VendorData contains NoCloud inline cloud-init
+                              vendordata.
+                            type: string
+                          vendorDataBase64:
+                            description: VendorDataBase64 contains NoCloud cloud-init
+                              vendordata as a base64 encoded string.
+                            type: string
+                          vendorDataSecretRef:
+                            description: VendorDataSecretRef references a k8s secret
+                              that contains NoCloud vendordata.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
                         type: object
                       configMap:
                         description: |-
@@ -8369,6 +9191,35 @@ var CRDsValidation map[string]string = map[string]string{
                         - path
                         - type
                         type: object
+                      ignition:
+                        description: |-
+                          Ignition represents an Ignition config source.
+                          The config is passed to the guest through QEMU fw_cfg, or as a raw disk when the volume is attached as a disk.
+                          More info: https://coreos.github.io/ignition/
+                        properties:
+                          config:
+                            description: Config contains the inline Ignition config.
+                            type: string
+                          configBase64:
+                            description: ConfigBase64 contains the Ignition config
+                              as a base64 encoded string.
+                            type: string
+                          configSecretRef:
+                            description: ConfigSecretRef references a k8s secret that
+                              contains the Ignition config under the "config" key.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
+                        type: object
                       memoryDump:
                         description: MemoryDump is attached to the virt launcher and
                           is populated with a memory dump of the vmi
@@ -8487,6 +9338,93 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
+                          unattend:
+                            description: |-
+                              Unattend describes a Sysprep answer file that is generated by KubeVirt and attached as disk of CDROM type,
+                              as an alternative to providing the answer file in a Secret or ConfigMap.
+                            properties:
+                              adminPasswordSecretRef:
+                                description: |-
+                                  AdminPasswordSecretRef references a k8s secret that contains the password of the local Administrator
+                                  account under the "password" key.
+                                properties:
+                                  name:
+                                    default: ""
+                                    description: |-
+                                      Name of the referent.
+                                      This field is effectively required, but due to backwards compatibility is
+                                      allowed to be empty. Instances of this type with an empty value here are
+                                      almost certainly wrong.
+                                      More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    type: string
+                                type: object
+                                x-kubernetes-map-type: atomic
+                              computerName:
+                                description: ComputerName is the name of the Windows
+                                  computer. Defaults to the hostname of the VMI, truncated
+                                  to 15 characters.
+                                type: string
+                              domainJoin:
+                                description: DomainJoin joins the computer to an Active
+                                  Directory domain.
+                                properties:
+                                  credentialsSecretRef:
+                                    description: |-
+                                      CredentialsSecretRef references a k8s secret that contains the "username" and "password" of an
+                                      account which is allowed to join computers to the domain.
+                                    properties:
+                                      name:
+                                        default: ""
+                                        description: |-
+                                          Name of the referent.
+                                          This field is effectively required, but due to backwards compatibility is
+                                          allowed to be empty. Instances of this type with an empty value here are
+                                          almost certainly wrong.
+                                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                        type: string
+                                    type: object
+                                    x-kubernetes-map-type: atomic
+                                  domain:
+                                    description: Domain is the name of the domain
+                                      to join.
+                                    type: string
+                                  organizationalUnit:
+                                    description: OrganizationalUnit is the distinguished
+                                      name of the organizational unit the computer
+                                      account is created in.
+                                    type: string
+                                required:
+                                - credentialsSecretRef
+                                - domain
+                                type: object
+                              driverPaths:
+                                description: |-
+                                  DriverPaths are searched for drivers during the setup, e.g. the directories of the virtio-win
+                                  drivers on a CDROM, like E:\amd64\w11.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              firstLogonCommands:
+                                description: |-
+                                  FirstLogonCommands are run in order when the Administrator logs on for the first time.
+                                  The Administrator is logged on automatically once to run them when AdminPasswordSecretRef is set.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              locale:
+                                description: Locale is the input, system, user interface
+                                  and user locale, e.g. en-US.
+                                type: string
+                              productKey:
+                                description: ProductKey is the Windows product key.
+                                type: string
+                              timeZone:
+                                description: TimeZone is the Windows time zone, e.g.
+                                  UTC or Pacific Standard Time.
+                                type: string
+                            type: object
                         type: object
                     required:
                     - name
@@ -8644,6 +9582,44 @@ var CRDsValidation map[string]string = map[string]string{
               description: Name is the name of resource
               type: string
           type: object
+        ipAllocations:
+          description: |-
+            IPAllocations holds the addresses allocated to the VirtualMachine secondary networks
+            from the KubeVirt IP pool of their NetworkAttachmentDefinition.
+            The allocations are kept across restarts and migrations of the VirtualMachine.
+          items:
+            properties:
+              gateway:
+                description: Gateway of the subnet.
+                type: string
+              ipAddresses:
+                description: IPAddresses allocated to the network, in CIDR notation
+                  with the subnet prefix length.
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+              nameservers:
+                description: Nameservers advertised to the guest.
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+              network:
+                description: Network is the name of the VirtualMachine network the
+                  addresses are allocated to.
+                type: string
+              networkAttachmentDefinition:
+                description: NetworkAttachmentDefinition holding the IP pool, in the
+                  <namespace>/<name> format.
+                type: string
+            required:
+            - ipAddresses
+            - network
+            - networkAttachmentDefinition
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         memoryDumpRequest:
           description: |-
             MemoryDumpRequest tracks memory dump request phase and info of getting a memory
@@ -8891,13 +9867,116 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
-                      lun:
-                        description: Attach a volume as a LUN to the vmi.
+                      ioTune:
+                        description: |-
+                          IOTune specifies I/O throttling limits enforced by the hypervisor for this disk.
+                          With the LiveUpdate rollout strategy the limits can be changed on a running VM.
                         properties:
-                          bus:
+                          bytes:
+                            description: Bytes limits the disk throughput in bytes
+                              per second.
+                            properties:
+                              burst:
+                                description: Burst allows exceeding the sustained
+                                  limits for a limited time.
+                                properties:
+                                  lengthSeconds:
+                                    description: |-
+                                      LengthSeconds is the duration of a burst.
+                                      Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  read:
+                                    description: Read is the maximum read rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                  total:
+                                    description: Total is the maximum rate for reads
+                                      and writes combined during a burst.
+                                    format: int64
+                                    type: integer
+                                  write:
+                                    description: Write is the maximum write rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                type: object
+                              read:
+                                description: Read limits reads.
+                                format: int64
+                                type: integer
+                              total:
+                                description: Total limits reads and writes combined.
+                                format: int64
+                                type: integer
+                              write:
+                                description: Write limits writes.
+                                format: int64
+                                type: integer
+                            type: object
+                          groupName:
                             description: |-
-                              Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi.
+                              GroupName places the disk into a throttling group. All disks of a group share the
+                              limits of the group.
+                            type: string
+                          iops:
+                            description: IOPS limits the number of I/O operations
+                              per second.
+                            properties:
+                              burst:
+                                description: Burst allows exceeding the sustained
+                                  limits for a limited time.
+                                properties:
+                                  lengthSeconds:
+                                    description: |-
+                                      LengthSeconds is the duration of a burst.
+                                      Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  read:
+                                    description: Read is the maximum read rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                  total:
+                                    description: Total is the maximum rate for reads
+                                      and writes combined during a burst.
+                                    format: int64
+                                    type: integer
+                                  write:
+                                    description: Write is the maximum write rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                type: object
+                              read:
+                                description: Read limits reads.
+                                format: int64
+                                type: integer
+                              total:
+                                description: Total limits reads and writes combined.
+                                format: int64
+                                type: integer
+                              write:
+                                description: Write limits writes.
+                                format: int64
+                                type: integer
+                            type: object
+                          sizeIOPS:
+                            description: |-
+                              SizeIOPS is the size in bytes of a single I/O operation when IOPS limits are accounted.
+                              Larger requests are counted as multiple operations.
+                            format: int64
+                            type: integer
+                        type: object
+                      lun:
+                        description: Attach a volume as a LUN to the vmi.
+                        properties:
+                          bus:
+                            description: |-
+                              Bus indicates the type of disk device to emulate.
+                              supported values: virtio, sata, scsi.
                             type: string
                           readonly:
                             description: |-
@@ -12091,6 +13170,13 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                guestTimeSync:
+                  description: |-
+                    GuestTimeSync enables setting the guest clock through the guest agent after
+                    the guest was unpaused or migrated, and once the guest agent connects after
+                    boot, e.g. when starting from a restored snapshot. The drift observed before
+                    every synchronisation is reported as a metric.
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -12236,8 +13322,15 @@ var CRDsValidation map[string]string = map[string]string{
                     List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                     It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                     and "host-model" to get CPU closest to the node one.
+                    "cluster-baseline" gets the CPU model and features common to all nodes of the CPU model group,
+                    allowing the VMI to migrate to any node of the group.
                     Defaults to host-model.
                   type: string
+                modelGroup:
+                  description: |-
+                    ModelGroup selects the CPU model group, as defined in the KubeVirt configuration, whose baseline
+                    is used when the model is "cluster-baseline". Defaults to all schedulable nodes.
+                  type: string
                 numa:
                   description: NUMA allows specifying settings for the guest NUMA
                     topology
@@ -12421,6 +13514,109 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune specifies I/O throttling limits enforced by the hypervisor for this disk.
+                          With the LiveUpdate rollout strategy the limits can be changed on a running VM.
+                        properties:
+                          bytes:
+                            description: Bytes limits the disk throughput in bytes
+                              per second.
+                            properties:
+                              burst:
+                                description: Burst allows exceeding the sustained
+                                  limits for a limited time.
+                                properties:
+                                  lengthSeconds:
+                                    description: |-
+                                      LengthSeconds is the duration of a burst.
+                                      Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  read:
+                                    description: Read is the maximum read rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                  total:
+                                    description: Total is the maximum rate for reads
+                                      and writes combined during a burst.
+                                    format: int64
+                                    type: integer
+                                  write:
+                                    description: Write is the maximum write rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                type: object
+                              read:
+                                description: Read limits reads.
+                                format: int64
+                                type: integer
+                              total:
+                                description: Total limits reads and writes combined.
+                                format: int64
+                                type: integer
+                              write:
+                                description: Write limits writes.
+                                format: int64
+                                type: integer
+                            type: object
+                          groupName:
+                            description: |-
+                              GroupName places the disk into a throttling group. All disks of a group share the
+                              limits of the group.
+                            type: string
+                          iops:
+                            description: IOPS limits the number of I/O operations
+                              per second.
+                            properties:
+                              burst:
+                                description: Burst allows exceeding the sustained
+                                  limits for a limited time.
+                                properties:
+                                  lengthSeconds:
+                                    description: |-
+                                      LengthSeconds is the duration of a burst.
+                                      Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  read:
+                                    description: Read is the maximum read rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                  total:
+                                    description: Total is the maximum rate for reads
+                                      and writes combined during a burst.
+                                    format: int64
+                                    type: integer
+                                  write:
+                                    description: Write is the maximum write rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                type: object
+                              read:
+                                description: Read limits reads.
+                                format: int64
+                                type: integer
+                              total:
+                                description: Total limits reads and writes combined.
+                                format: int64
+                                type: integer
+                              write:
+                                description: Write limits writes.
+                                format: int64
+                                type: integer
+                            type: object
+                          sizeIOPS:
+                            description: |-
+                              SizeIOPS is the size in bytes of a single I/O operation when IOPS limits are accounted.
+                              Larger requests are counted as multiple operations.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -12595,6 +13791,59 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic rate of the interface.
+                          Supported only by interfaces attached to the domain through a tap device
+                          (bridge, masquerade and binding plugins with the tap domain attachment type).
+                          Can be updated on a running VM.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in kibibytes per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kibibytes that
+                                  can be sent in a single burst at the peak rate.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                  Must not be lower than the average.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in kibibytes per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kibibytes that
+                                  can be sent in a single burst at the peak rate.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                  Must not be lower than the average.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -12628,12 +13877,74 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          classlessRoutes:
+                            description: If specified will pass the routes via DHCP
+                              option 121, in addition to the routes of the pod interface.
+                            items:
+                              description: DHCPClasslessRoute is a route passed via
+                                DHCP option 121.
+                              properties:
+                                destination:
+                                  description: Destination network in CIDR notation,
+                                    e.g. 10.0.0.0/8.
+                                  type: string
+                                gateway:
+                                  description: Gateway IPv4 address. An empty value
+                                    describes a route on the link.
+                                  type: string
+                              required:
+                              - destination
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          domainSearch:
+                            description: If specified will pass the domain search
+                              list via DHCP option 119, instead of the one of the
+                              pod.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          leaseTimeSeconds:
+                            description: |-
+                              LeaseTimeSeconds is the lease time of the address offered to the guest.
+                              Applies to the preferred and valid lifetimes of the DHCPv6 address as well.
+                              Must be at least 60 seconds.
+                              Defaults to an infinite lease.
+                            format: int32
+                            type: integer
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
                             items:
                               type: string
                             type: array
+                          options:
+                            description: |-
+                              If specified will pass the listed DHCP options, overriding the values set by KubeVirt.
+                              Options driving the DHCP protocol itself (e.g. lease time, message type, server identifier) cannot be set.
+                            items:
+                              description: DHCPOption is a numeric DHCP option passed
+                                to the guest.
+                              properties:
+                                code:
+                                  description: 'Code of the option, range: 1-254.'
+                                  type: integer
+                                type:
+                                  description: |-
+                                    Type of the value, one of: string, hex, ipv4, uint8, uint16, uint32.
+                                    Defaults to string.
+                                  type: string
+                                value:
+                                  description: Value of the option, encoded according
+                                    to the type.
+                                  type: string
+                              required:
+                              - code
+                              - value
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                           privateOptions:
                             description: 'If specified will pass extra DHCP options
                               for private use, range: 224-254'
@@ -12660,6 +13971,79 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 66 to interface's
                               DHCP server
                             type: string
+                          v6:
+                            description: V6 specifies options passed by the DHCPv6
+                              server, used by the masquerade binding.
+                            properties:
+                              domainSearch:
+                                description: If specified will pass the domain search
+                                  list via DHCPv6 option 24, instead of the one of
+                                  the pod.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              ntpServers:
+                                description: If specified will pass the NTP servers
+                                  via DHCPv6 option 56.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
+                        type: object
+                      firewall:
+                        description: |-
+                          Firewall filters the traffic bridged between the guest and the network.
+                          Supported only by the bridge binding.
+                          Requires the nf_conntrack_bridge kernel module to be loaded on the node.
+                        properties:
+                          defaultAction:
+                            description: |-
+                              DefaultAction is applied to the traffic which does not match any rule.
+                              Defaults to Allow.
+                            type: string
+                          rules:
+                            description: Rules are evaluated in order, the first matching
+                              rule decides the traffic fate.
+                            items:
+                              properties:
+                                action:
+                                  description: 'Action to take on matching traffic:
+                                    Allow or Deny.'
+                                  type: string
+                                cidr:
+                                  description: |-
+                                    CIDR of the remote peer: the source of ingress traffic or the destination of egress traffic.
+                                    Matches any peer when not specified.
+                                  type: string
+                                direction:
+                                  description: 'Direction of the traffic the rule
+                                    applies to: Ingress or Egress.'
+                                  type: string
+                                endPort:
+                                  description: EndPort indicates that the range of
+                                    ports from Port to EndPort, inclusive, should
+                                    match.
+                                  format: int32
+                                  type: integer
+                                port:
+                                  description: |-
+                                    Port is the destination port of the matching traffic.
+                                    Requires the TCP, UDP or SCTP protocol.
+                                  format: int32
+                                  type: integer
+                                protocol:
+                                  description: |-
+                                    Protocol of the matching traffic: TCP, UDP, SCTP or ICMP.
+                                    Matches any protocol when not specified.
+                                  type: string
+                              required:
+                              - action
+                              - direction
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
@@ -12710,6 +14094,14 @@ var CRDsValidation map[string]string = map[string]string{
                             Default protocol TCP.
                             The port field is mandatory
                           properties:
+                            endPort:
+                              description: |-
+                                EndPort indicates that the range of ports from Port to EndPort, inclusive,
+                                should be exposed. It must be greater than or equal to Port,
+                                the range may span at most 1024 ports.
+                                Supported only by the masquerade binding.
+                              format: int32
+                              type: integer
                             name:
                               description: |-
                                 If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -12724,7 +14116,9 @@ var CRDsValidation map[string]string = map[string]string{
                               type: integer
                             protocol:
                               description: |-
-                                Protocol for port. Must be UDP or TCP.
+                                Protocol for port. Must be UDP, TCP, SCTP or ICMP.
+                                ICMP forwards echo requests and is supported only by the masquerade binding,
+                                in which case the port must not be set.
                                 Defaults to "TCP".
                               type: string
                           required:
@@ -13356,6 +14750,12 @@ var CRDsValidation map[string]string = map[string]string{
               description: Resources describes the Compute Resources required by this
                 vmi.
               properties:
+                ioWeight:
+                  description: |-
+                    IOWeight sets the relative I/O weight (cgroup v2 io.weight) of the virt-launcher pod.
+                    Valid values are between 1 and 10000. Ignored on nodes using cgroup v1.
+                  format: int32
+                  type: integer
                 limits:
                   additionalProperties:
                     anyOf:
@@ -13397,6 +14797,30 @@ var CRDsValidation map[string]string = map[string]string{
             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
           type: string
+        guestServiceWatch:
+          description: |-
+            GuestServiceWatch lists services of the guest whose state is checked periodically
+            through the guest agent. The state is reported by the GuestServicesUp condition
+            and the kubevirt_vmi_guest_service_up metric.
+          properties:
+            periodSeconds:
+              description: |-
+                How often (in seconds) to check the services.
+                Defaults to 60 seconds. Minimum value is 30.
+              format: int32
+              type: integer
+            services:
+              description: |-
+                Services to watch, systemd units on Linux guests and service names on Windows guests.
+                At most 10 services can be watched.
+              items:
+                type: string
+              maxItems: 10
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - services
+          type: object
         hostname:
           description: |-
             Specifies the hostname of the vmi
@@ -14078,6 +15502,33 @@ var CRDsValidation map[string]string = map[string]string{
                   The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                   More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
                 properties:
+                  metaData:
+                    description: MetaData adds user defined entries to the config
+                      drive instance metadata.
+                    properties:
+                      annotations:
+                        description: |-
+                          Annotations lists the VMI annotations exposed in the instance metadata, under the
+                          annotations key.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      keys:
+                        additionalProperties:
+                          type: string
+                        description: |-
+                          Keys are added to the instance metadata: at the top level with NoCloud, and under
+                          the meta key with config drive. Keys generated by KubeVirt cannot be overridden.
+                        type: object
+                      labels:
+                        description: Labels lists the VMI labels exposed in the instance
+                          metadata, under the labels key.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    type: object
                   networkData:
                     description: NetworkData contains config drive inline cloud-init
                       networkdata.
@@ -14116,6 +15567,11 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                     type: object
                     x-kubernetes-map-type: atomic
+                  updatePolicy:
+                    description: |-
+                      UpdatePolicy defines how changes of the data sources are propagated to the guest.
+                      Defaults to None.
+                    type: string
                   userData:
                     description: UserData contains config drive inline cloud-init
                       userdata.
@@ -14124,6 +15580,29 @@ var CRDsValidation map[string]string = map[string]string{
                     description: UserDataBase64 contains config drive cloud-init userdata
                       as a base64 encoded string.
                     type: string
+                  vendorData:
+                    description: VendorData contains config drive inline cloud-init
+                      vendordata.
+                    type: string
+                  vendorDataBase64:
+                    description: VendorDataBase64 contains config drive cloud-init
+                      vendordata as a base64 encoded string.
+                    type: string
+                  vendorDataSecretRef:
+                    description: VendorDataSecretRef references a k8s secret that
+                      contains config drive vendordata.
+                    properties:
+                      name:
+                        default: ""
+                        description: |-
+                          Name of the referent.
+                          This field is effectively required, but due to backwards compatibility is
+                          allowed to be empty. Instances of this type with an empty value here are
+                          almost certainly wrong.
+                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                        type: string
+                    type: object
+                    x-kubernetes-map-type: atomic
                 type: object
               cloudInitNoCloud:
                 description: |-
@@ -14131,6 +15610,37 @@ var CRDsValidation map[string]string = map[string]string{
                   The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                   More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                 properties:
+                  metaData:
+                    description: MetaData adds user defined entries to the NoCloud
+                      instance metadata.
+                    properties:
+                      annotations:
+                        description: |-
+                          Annotations lists the VMI annotations exposed in the instance metadata, under the
+                          annotations key.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      keys:
+                        additionalProperties:
+                          type: string
+                        description: |-
+                          Keys are added to the instance metadata: at the top level with NoCloud, and under
+                          the meta key with config drive. Keys generated by KubeVirt cannot be overridden.
+                        type: object
+                      labels:
+                        description: Labels lists the VMI labels exposed in the instance
+                          metadata, under the labels key.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    type: object
+                  metadataService:
+                    description: MetadataService additionally serves the NoCloud data
+                      to the guest over HTTP.
+                    type: object
                   networkData:
                     description: NetworkData contains NoCloud inline cloud-init networkdata.
                     type: string
@@ -14168,6 +15678,11 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                     type: object
                     x-kubernetes-map-type: atomic
+                  updatePolicy:
+                    description: |-
+                      UpdatePolicy defines how changes of the data sources are propagated to the guest.
+                      Defaults to None.
+                    type: string
                   userData:
                     description: UserData contains NoCloud inline cloud-init userdata.
                     type: string
@@ -14175,6 +15690,28 @@ var CRDsValidation map[string]string = map[string]string{
                     description: UserDataBase64 contains NoCloud cloud-init userdata
                       as a base64 encoded string.
                     type: string
+                  vendorData:
+                    description: VendorData contains NoCloud inline cloud-init vendordata.
+                    type: string
+                  vendorDataBase64:
+                    description: VendorDataBase64 contains NoCloud cloud-init vendordata
+                      as a base64 encoded string.
+                    type: string
+                  vendorDataSecretRef:
+                    description: VendorDataSecretRef references a k8s secret that
+                      contains NoCloud vendordata.
+                    properties:
+                      name:
+                        default: ""
+                        description: |-
+                          Name of the referent.
+                          This field is effectively required, but due to backwards compatibility is
+                          allowed to be empty. Instances of this type with an empty value here are
+                          almost certainly wrong.
+                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                        type: string
+                    type: object
+                    x-kubernetes-map-type: atomic
                 type: object
               configMap:
                 description: |-
@@ -14420,6 +15957,35 @@ var CRDsValidation map[string]string = map[string]string{
                 - path
                 - type
                 type: object
+              ignition:
+                description: |-
+                  Ignition represents an Ignition config source.
+                  The config is passed to the guest through QEMU fw_cfg, or as a raw disk when the volume is attached as a disk.
+                  More info: https://coreos.github.io/ignition/
+                properties:
+                  config:
+                    description: Config contains the inline Ignition config.
+                    type: string
+                  configBase64:
+                    description: ConfigBase64 contains the Ignition config as a base64
+                      encoded string.
+                    type: string
+                  configSecretRef:
+                    description: ConfigSecretRef references a k8s secret that contains
+                      the Ignition config under the "config" key.
+                    properties:
+                      name:
+                        default: ""
+                        description: |-
+                          Name of the referent.
+                          This field is effectively required, but due to backwards compatibility is
+                          allowed to be empty. Instances of this type with an empty value here are
+                          almost certainly wrong.
+                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                        type: string
+                    type: object
+                    x-kubernetes-map-type: atomic
+                type: object
               memoryDump:
                 description: MemoryDump is attached to the virt launcher and is populated
                   with a memory dump of the vmi
@@ -14513,30 +16079,115 @@ var CRDsValidation map[string]string = map[string]string{
                       name:
                         default: ""
                         description: |-
-                          Name of the referent.
-                          This field is effectively required, but due to backwards compatibility is
-                          allowed to be empty. Instances of this type with an empty value here are
-                          almost certainly wrong.
-                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-                        type: string
-                    type: object
-                    x-kubernetes-map-type: atomic
-                  secret:
-                    description: Secret references a k8s Secret that contains Sysprep
-                      answer file named autounattend.xml that should be attached as
-                      disk of CDROM type.
-                    properties:
-                      name:
-                        default: ""
+                          Name of the referent.
+                          This field is effectively required, but due to backwards compatibility is
+                          allowed to be empty. Instances of this type with an empty value here are
+                          almost certainly wrong.
+                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                        type: string
+                    type: object
+                    x-kubernetes-map-type: atomic
+                  secret:
+                    description: Secret references a k8s Secret that contains Sysprep
+                      answer file named autounattend.xml that should be attached as
+                      disk of CDROM type.
+                    properties:
+                      name:
+                        default: ""
+                        description: |-
+                          Name of the referent.
+                          This field is effectively required, but due to backwards compatibility is
+                          allowed to be empty. Instances of this type with an empty value here are
+                          almost certainly wrong.
+                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                        type: string
+                    type: object
+                    x-kubernetes-map-type: atomic
+                  unattend:
+                    description: |-
+                      Unattend describes a Sysprep answer file that is generated by KubeVirt and attached as disk of CDROM type,
+                      as an alternative to providing the answer file in a Secret or ConfigMap.
+                    properties:
+                      adminPasswordSecretRef:
+                        description: |-
+                          AdminPasswordSecretRef references a k8s secret that contains the password of the local Administrator
+                          account under the "password" key.
+                        properties:
+                          name:
+                            default: ""
+                            description: |-
+                              Name of the referent.
+                              This field is effectively required, but due to backwards compatibility is
+                              allowed to be empty. Instances of this type with an empty value here are
+                              almost certainly wrong.
+                              More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                            type: string
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      computerName:
+                        description: ComputerName is the name of the Windows computer.
+                          Defaults to the hostname of the VMI, truncated to 15 characters.
+                        type: string
+                      domainJoin:
+                        description: DomainJoin joins the computer to an Active Directory
+                          domain.
+                        properties:
+                          credentialsSecretRef:
+                            description: |-
+                              CredentialsSecretRef references a k8s secret that contains the "username" and "password" of an
+                              account which is allowed to join computers to the domain.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          domain:
+                            description: Domain is the name of the domain to join.
+                            type: string
+                          organizationalUnit:
+                            description: OrganizationalUnit is the distinguished name
+                              of the organizational unit the computer account is created
+                              in.
+                            type: string
+                        required:
+                        - credentialsSecretRef
+                        - domain
+                        type: object
+                      driverPaths:
+                        description: |-
+                          DriverPaths are searched for drivers during the setup, e.g. the directories of the virtio-win
+                          drivers on a CDROM, like E:\amd64\w11.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      firstLogonCommands:
                         description: |-
-                          Name of the referent.
-                          This field is effectively required, but due to backwards compatibility is
-                          allowed to be empty. Instances of this type with an empty value here are
-                          almost certainly wrong.
-                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          FirstLogonCommands are run in order when the Administrator logs on for the first time.
+                          The Administrator is logged on automatically once to run them when AdminPasswordSecretRef is set.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      locale:
+                        description: Locale is the input, system, user interface and
+                          user locale, e.g. en-US.
+                        type: string
+                      productKey:
+                        description: ProductKey is the Windows product key.
+                        type: string
+                      timeZone:
+                        description: TimeZone is the Windows time zone, e.g. UTC or
+                          Pacific Standard Time.
                         type: string
                     type: object
-                    x-kubernetes-map-type: atomic
                 type: object
             required:
             - name
@@ -14680,6 +16331,57 @@ var CRDsValidation map[string]string = map[string]string{
               format: int32
               type: integer
           type: object
+        domainPatches:
+          description: |-
+            DomainPatches lists the cluster domain patches selected for the VMI when it was created.
+            They are applied to the libvirt domain when it is defined.
+          items:
+            description: VirtualMachineInstanceDomainPatch is a domain patch applied
+              to the libvirt domain of a VMI
+            properties:
+              name:
+                description: Name of the domain patch in the KubeVirt configuration
+                type: string
+              operations:
+                description: Operations applied to the domain XML, in order
+                items:
+                  description: DomainPatchOperation is a single edit of the domain
+                    XML
+                  properties:
+                    op:
+                      description: |-
+                        Op is the operation to perform: add, replace or remove.
+                        add sets an attribute, or appends the XML fragment in value to the selected elements.
+                        replace sets an existing attribute, or replaces the selected elements with the XML fragment in value.
+                        remove deletes the selected attribute or elements.
+                      enum:
+                      - add
+                      - replace
+                      - remove
+                      type: string
+                    path:
+                      description: |-
+                        Path selects the elements, or an attribute of them, with an XPath subset: an absolute
+                        location path of element names, filtered by [@attr='value'], [@attr] or [position]
+                        predicates, optionally ending with an @attr step.
+                        For example /domain/devices/interface[@type='ethernet'][1]/model/@type
+                      type: string
+                    value:
+                      description: Value is the attribute value, or the XML fragment,
+                        used by add and replace
+                      type: string
+                  required:
+                  - op
+                  - path
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+            required:
+            - name
+            - operations
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         evacuationNodeName:
           description: |-
             EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want
@@ -14725,7 +16427,8 @@ var CRDsValidation map[string]string = map[string]string{
             properties:
               infoSource:
                 description: 'Specifies the origin of the interface data collected.
-                  values: domain, guest-agent, multus-status.'
+                  values: domain, guest-agent, multus-status, dhcp-lease, arp-ndp,
+                  passt.'
                 type: string
               interfaceName:
                 description: The interface name inside the Virtual Machine
@@ -15315,6 +17018,27 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         topologyHints:
           properties:
+            cpuBaseline:
+              description: CPUBaseline is the CPU model and features common to the
+                nodes of the CPU model group of the VMI
+              properties:
+                features:
+                  description: Features lists the CPU features supported by all nodes
+                    of the group
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+                group:
+                  description: Group is the CPU model group the baseline was computed
+                    for
+                  type: string
+                model:
+                  description: Model is the CPU model usable on all nodes of the group
+                  type: string
+              required:
+              - model
+              type: object
             tscFrequency:
               format: int64
               type: integer
@@ -15948,6 +17672,13 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                guestTimeSync:
+                  description: |-
+                    GuestTimeSync enables setting the guest clock through the guest agent after
+                    the guest was unpaused or migrated, and once the guest agent connects after
+                    boot, e.g. when starting from a restored snapshot. The drift observed before
+                    every synchronisation is reported as a metric.
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -16093,8 +17824,15 @@ var CRDsValidation map[string]string = map[string]string{
                     List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                     It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                     and "host-model" to get CPU closest to the node one.
+                    "cluster-baseline" gets the CPU model and features common to all nodes of the CPU model group,
+                    allowing the VMI to migrate to any node of the group.
                     Defaults to host-model.
                   type: string
+                modelGroup:
+                  description: |-
+                    ModelGroup selects the CPU model group, as defined in the KubeVirt configuration, whose baseline
+                    is used when the model is "cluster-baseline". Defaults to all schedulable nodes.
+                  type: string
                 numa:
                   description: NUMA allows specifying settings for the guest NUMA
                     topology
@@ -16278,6 +18016,109 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune specifies I/O throttling limits enforced by the hypervisor for this disk.
+                          With the LiveUpdate rollout strategy the limits can be changed on a running VM.
+                        properties:
+                          bytes:
+                            description: Bytes limits the disk throughput in bytes
+                              per second.
+                            properties:
+                              burst:
+                                description: Burst allows exceeding the sustained
+                                  limits for a limited time.
+                                properties:
+                                  lengthSeconds:
+                                    description: |-
+                                      LengthSeconds is the duration of a burst.
+                                      Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  read:
+                                    description: Read is the maximum read rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                  total:
+                                    description: Total is the maximum rate for reads
+                                      and writes combined during a burst.
+                                    format: int64
+                                    type: integer
+                                  write:
+                                    description: Write is the maximum write rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                type: object
+                              read:
+                                description: Read limits reads.
+                                format: int64
+                                type: integer
+                              total:
+                                description: Total limits reads and writes combined.
+                                format: int64
+                                type: integer
+                              write:
+                                description: Write limits writes.
+                                format: int64
+                                type: integer
+                            type: object
+                          groupName:
+                            description: |-
+                              GroupName places the disk into a throttling group. All disks of a group share the
+                              limits of the group.
+                            type: string
+                          iops:
+                            description: IOPS limits the number of I/O operations
+                              per second.
+                            properties:
+                              burst:
+                                description: Burst allows exceeding the sustained
+                                  limits for a limited time.
+                                properties:
+                                  lengthSeconds:
+                                    description: |-
+                                      LengthSeconds is the duration of a burst.
+                                      Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  read:
+                                    description: Read is the maximum read rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                  total:
+                                    description: Total is the maximum rate for reads
+                                      and writes combined during a burst.
+                                    format: int64
+                                    type: integer
+                                  write:
+                                    description: Write is the maximum write rate during
+                                      a burst.
+                                    format: int64
+                                    type: integer
+                                type: object
+                              read:
+                                description: Read limits reads.
+                                format: int64
+                                type: integer
+                              total:
+                                description: Total limits reads and writes combined.
+                                format: int64
+                                type: integer
+                              write:
+                                description: Write limits writes.
+                                format: int64
+                                type: integer
+                            type: object
+                          sizeIOPS:
+                            description: |-
+                              SizeIOPS is the size in bytes of a single I/O operation when IOPS limits are accounted.
+                              Larger requests are counted as multiple operations.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -16452,6 +18293,59 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic rate of the interface.
+                          Supported only by interfaces attached to the domain through a tap device
+                          (bridge, masquerade and binding plugins with the tap domain attachment type).
+                          Can be updated on a running VM.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in kibibytes per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kibibytes that
+                                  can be sent in a single burst at the peak rate.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                  Must not be lower than the average.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in kibibytes per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kibibytes that
+                                  can be sent in a single burst at the peak rate.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                  Must not be lower than the average.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -16485,12 +18379,74 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          classlessRoutes:
+                            description: If specified will pass the routes via DHCP
+                              option 121, in addition to the routes of the pod interface.
+                            items:
+                              description: DHCPClasslessRoute is a route passed via
+                                DHCP option 121.
+                              properties:
+                                destination:
+                                  description: Destination network in CIDR notation,
+                                    e.g. 10.0.0.0/8.
+                                  type: string
+                                gateway:
+                                  description: Gateway IPv4 address. An empty value
+                                    describes a route on the link.
+                                  type: string
+                              required:
+                              - destination
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          domainSearch:
+                            description: If specified will pass the domain search
+                              list via DHCP option 119, instead of the one of the
+                              pod.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          leaseTimeSeconds:
+                            description: |-
+                              LeaseTimeSeconds is the lease time of the address offered to the guest.
+                              Applies to the preferred and valid lifetimes of the DHCPv6 address as well.
+                              Must be at least 60 seconds.
+                              Defaults to an infinite lease.
+                            format: int32
+                            type: integer
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
                             items:
                               type: string
                             type: array
+                          options:
+                            description: |-
+                              If specified will pass the listed DHCP options, overriding the values set by KubeVirt.
+                              Options driving the DHCP protocol itself (e.g. lease time, message type, server identifier) cannot be set.
+                            items:
+                              description: DHCPOption is a numeric DHCP option passed
+                                to the guest.
+                              properties:
+                                code:
+                                  description: 'Code of the option, range: 1-254.'
+                                  type: integer
+                                type:
+                                  description: |-
+                                    Type of the value, one of: string, hex, ipv4, uint8, uint16, uint32.
+                                    Defaults to string.
+                                  type: string
+                                value:
+                                  description: Value of the option, encoded according
+                                    to the type.
+                                  type: string
+                              required:
+                              - code
+                              - value
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                           privateOptions:
                             description: 'If specified will pass extra DHCP options
                               for private use, range: 224-254'
@@ -16517,6 +18473,79 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 66 to interface's
                               DHCP server
                             type: string
+                          v6:
+                            description: V6 specifies options passed by the DHCPv6
+                              server, used by the masquerade binding.
+                            properties:
+                              domainSearch:
+                                description: If specified will pass the domain search
+                                  list via DHCPv6 option 24, instead of the one of
+                                  the pod.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              ntpServers:
+                                description: If specified will pass the NTP servers
+                                  via DHCPv6 option 56.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
+                        type: object
+                      firewall:
+                        description: |-
+                          Firewall filters the traffic bridged between the guest and the network.
+                          Supported only by the bridge binding.
+                          Requires the nf_conntrack_bridge kernel module to be loaded on the node.
+                        properties:
+                          defaultAction:
+                            description: |-
+                              DefaultAction is applied to the traffic which does not match any rule.
+                              Defaults to Allow.
+                            type: string
+                          rules:
+                            description: Rules are evaluated in order, the first matching
+                              rule decides the traffic fate.
+                            items:
+                              properties:
+                                action:
+                                  description: 'Action to take on matching traffic:
+                                    Allow or Deny.'
+                                  type: string
+                                cidr:
+                                  description: |-
+                                    CIDR of the remote peer: the source of ingress traffic or the destination of egress traffic.
+                                    Matches any peer when not specified.
+                                  type: string
+                                direction:
+                                  description: 'Direction of the traffic the rule
+                                    applies to: Ingress or Egress.'
+                                  type: string
+                                endPort:
+                                  description: EndPort indicates that the range of
+                                    ports from Port to EndPort, inclusive, should
+                                    match.
+                                  format: int32
+                                  type: integer
+                                port:
+                                  description: |-
+                                    Port is the destination port of the matching traffic.
+                                    Requires the TCP, UDP or SCTP protocol.
+                                  format: int32
+                                  type: integer
+                                protocol:
+                                  description: |-
+                                    Protocol of the matching traffic: TCP, UDP, SCTP or ICMP.
+                                    Matches any protocol when not specified.
+                                  type: string
+                              required:
+                              - action
+                              - direction
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
@@ -16567,6 +18596,14 @@ var CRDsValidation map[string]string = map[string]string{
                             Default protocol TCP.
                             The port field is mandatory
                           properties:
+                            endPort:
+                              description: |-
+                                EndPort indicates that the range of ports from Port to EndPort, inclusive,
+                                should be exposed. It must be greater than or equal to Port,
+                                the range may span at most 1024 ports.
+                                Supported only by the masquerade binding.
+                              format: int32
+                              type: integer
                             name:
                               description: |-
                                 If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -16581,7 +18618,9 @@ var CRDsValidation map[string]string = map[string]string{
                               type: integer
                             protocol:
                               description: |-
-                                Protocol for port. Must be UDP or TCP.
+                                Protocol for port. Must be UDP, TCP, SCTP or ICMP.
+                                ICMP forwards echo requests and is supported only by the masquerade binding,
+                                in which case the port must not be set.
                                 Defaults to "TCP".
                               type: string
                           required:
@@ -17213,6 +19252,12 @@ var CRDsValidation map[string]string = map[string]string{
               description: Resources describes the Compute Resources required by this
                 vmi.
               properties:
+                ioWeight:
+                  description: |-
+                    IOWeight sets the relative I/O weight (cgroup v2 io.weight) of the virt-launcher pod.
+                    Valid values are between 1 and 10000. Ignored on nodes using cgroup v1.
+                  format: int32
+                  type: integer
                 limits:
                   additionalProperties:
                     anyOf:
@@ -18495,6 +20540,13 @@ var CRDsValidation map[string]string = map[string]string{
                     clock:
                       description: Clock sets the clock and timers of the vmi.
                       properties:
+                        guestTimeSync:
+                          description: |-
+                            GuestTimeSync enables setting the guest clock through the guest agent after
+                            the guest was unpaused or migrated, and once the guest agent connects after
+                            boot, e.g. when starting from a restored snapshot. The drift observed before
+                            every synchronisation is reported as a metric.
+                          type: object
                         timer:
                           description: Timer specifies whih timers are attached to
                             the vmi.
@@ -18643,8 +20695,15 @@ var CRDsValidation map[string]string = map[string]string{
                             List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                             It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                             and "host-model" to get CPU closest to the node one.
+                            "cluster-baseline" gets the CPU model and features common to all nodes of the CPU model group,
+                            allowing the VMI to migrate to any node of the group.
                             Defaults to host-model.
                           type: string
+                        modelGroup:
+                          description: |-
+                            ModelGroup selects the CPU model group, as defined in the KubeVirt configuration, whose baseline
+                            is used when the model is "cluster-baseline". Defaults to all schedulable nodes.
+                          type: string
                         numa:
                           description: NUMA allows specifying settings for the guest
                             NUMA topology
@@ -18831,6 +20890,113 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: |-
+                                  IOTune specifies I/O throttling limits enforced by the hypervisor for this disk.
+                                  With the LiveUpdate rollout strategy the limits can be changed on a running VM.
+                                properties:
+                                  bytes:
+                                    description: Bytes limits the disk throughput
+                                      in bytes per second.
+                                    properties:
+                                      burst:
+                                        description: Burst allows exceeding the sustained
+                                          limits for a limited time.
+                                        properties:
+                                          lengthSeconds:
+                                            description: |-
+                                              LengthSeconds is the duration of a burst.
+                                              Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          read:
+                                            description: Read is the maximum read
+                                              rate during a burst.
+                                            format: int64
+                                            type: integer
+                                          total:
+                                            description: Total is the maximum rate
+                                              for reads and writes combined during
+                                              a burst.
+                                            format: int64
+                                            type: integer
+                                          write:
+                                            description: Write is the maximum write
+                                              rate during a burst.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      read:
+                                        description: Read limits reads.
+                                        format: int64
+                                        type: integer
+                                      total:
+                                        description: Total limits reads and writes
+                                          combined.
+                                        format: int64
+                                        type: integer
+                                      write:
+                                        description: Write limits writes.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  groupName:
+                                    description: |-
+                                      GroupName places the disk into a throttling group. All disks of a group share the
+                                      limits of the group.
+                                    type: string
+                                  iops:
+                                    description: IOPS limits the number of I/O operations
+                                      per second.
+                                    properties:
+                                      burst:
+                                        description: Burst allows exceeding the sustained
+                                          limits for a limited time.
+                                        properties:
+                                          lengthSeconds:
+                                            description: |-
+                                              LengthSeconds is the duration of a burst.
+                                              Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          read:
+                                            description: Read is the maximum read
+                                              rate during a burst.
+                                            format: int64
+                                            type: integer
+                                          total:
+                                            description: Total is the maximum rate
+                                              for reads and writes combined during
+                                              a burst.
+                                            format: int64
+                                            type: integer
+                                          write:
+                                            description: Write is the maximum write
+                                              rate during a burst.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      read:
+                                        description: Read limits reads.
+                                        format: int64
+                                        type: integer
+                                      total:
+                                        description: Total limits reads and writes
+                                          combined.
+                                        format: int64
+                                        type: integer
+                                      write:
+                                        description: Write limits writes.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  sizeIOPS:
+                                    description: |-
+                                      SizeIOPS is the size in bytes of a single I/O operation when IOPS limits are accounted.
+                                      Larger requests are counted as multiple operations.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -19008,6 +21174,64 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  Bandwidth limits the traffic rate of the interface.
+                                  Supported only by interfaces attached to the domain through a tap device
+                                  (bridge, masquerade and binding plugins with the tap domain attachment type).
+                                  Can be updated on a running VM.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in kibibytes per
+                                          second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kibibytes
+                                          that can be sent in a single burst at the
+                                          peak rate.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                          Must not be lower than the average.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in kibibytes per
+                                          second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kibibytes
+                                          that can be sent in a single burst at the
+                                          peak rate.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                          Must not be lower than the average.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -19041,12 +21265,76 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 67
                                       to interface's DHCP server
                                     type: string
+                                  classlessRoutes:
+                                    description: If specified will pass the routes
+                                      via DHCP option 121, in addition to the routes
+                                      of the pod interface.
+                                    items:
+                                      description: DHCPClasslessRoute is a route passed
+                                        via DHCP option 121.
+                                      properties:
+                                        destination:
+                                          description: Destination network in CIDR
+                                            notation, e.g. 10.0.0.0/8.
+                                          type: string
+                                        gateway:
+                                          description: Gateway IPv4 address. An empty
+                                            value describes a route on the link.
+                                          type: string
+                                      required:
+                                      - destination
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  domainSearch:
+                                    description: If specified will pass the domain
+                                      search list via DHCP option 119, instead of
+                                      the one of the pod.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  leaseTimeSeconds:
+                                    description: |-
+                                      LeaseTimeSeconds is the lease time of the address offered to the guest.
+                                      Applies to the preferred and valid lifetimes of the DHCPv6 address as well.
+                                      Must be at least 60 seconds.
+                                      Defaults to an infinite lease.
+                                    format: int32
+                                    type: integer
                                   ntpServers:
                                     description: If specified will pass the configured
                                       NTP server to the VM via DHCP option 042.
                                     items:
                                       type: string
                                     type: array
+                                  options:
+                                    description: |-
+                                      If specified will pass the listed DHCP options, overriding the values set by KubeVirt.
+                                      Options driving the DHCP protocol itself (e.g. lease time, message type, server identifier) cannot be set.
+                                    items:
+                                      description: DHCPOption is a numeric DHCP option
+                                        passed to the guest.
+                                      properties:
+                                        code:
+                                          description: 'Code of the option, range:
+                                            1-254.'
+                                          type: integer
+                                        type:
+                                          description: |-
+                                            Type of the value, one of: string, hex, ipv4, uint8, uint16, uint32.
+                                            Defaults to string.
+                                          type: string
+                                        value:
+                                          description: Value of the option, encoded
+                                            according to the type.
+                                          type: string
+                                      required:
+                                      - code
+                                      - value
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                   privateOptions:
                                     description: 'If specified will pass extra DHCP
                                       options for private use, range: 224-254'
@@ -19073,6 +21361,79 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 66
                                       to interface's DHCP server
                                     type: string
+                                  v6:
+                                    description: V6 specifies options passed by the
+                                      DHCPv6 server, used by the masquerade binding.
+                                    properties:
+                                      domainSearch:
+                                        description: If specified will pass the domain
+                                          search list via DHCPv6 option 24, instead
+                                          of the one of the pod.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      ntpServers:
+                                        description: If specified will pass the NTP
+                                          servers via DHCPv6 option 56.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    type: object
+                                type: object
+                              firewall:
+                                description: |-
+                                  Firewall filters the traffic bridged between the guest and the network.
+                                  Supported only by the bridge binding.
+                                  Requires the nf_conntrack_bridge kernel module to be loaded on the node.
+                                properties:
+                                  defaultAction:
+                                    description: |-
+                                      DefaultAction is applied to the traffic which does not match any rule.
+                                      Defaults to Allow.
+                                    type: string
+                                  rules:
+                                    description: Rules are evaluated in order, the
+                                      first matching rule decides the traffic fate.
+                                    items:
+                                      properties:
+                                        action:
+                                          description: 'Action to take on matching
+                                            traffic: Allow or Deny.'
+                                          type: string
+                                        cidr:
+                                          description: |-
+                                            CIDR of the remote peer: the source of ingress traffic or the destination of egress traffic.
+                                            Matches any peer when not specified.
+                                          type: string
+                                        direction:
+                                          description: 'Direction of the traffic the
+                                            rule applies to: Ingress or Egress.'
+                                          type: string
+                                        endPort:
+                                          description: EndPort indicates that the
+                                            range of ports from Port to EndPort, inclusive,
+                                            should match.
+                                          format: int32
+                                          type: integer
+                                        port:
+                                          description: |-
+                                            Port is the destination port of the matching traffic.
+                                            Requires the TCP, UDP or SCTP protocol.
+                                          format: int32
+                                          type: integer
+                                        protocol:
+                                          description: |-
+                                            Protocol of the matching traffic: TCP, UDP, SCTP or ICMP.
+                                            Matches any protocol when not specified.
+                                          type: string
+                                      required:
+                                      - action
+                                      - direction
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                 type: object
                               macAddress:
                                 description: 'Interface MAC address. For example:
@@ -19123,6 +21484,14 @@ var CRDsValidation map[string]string = map[string]string{
                                     Default protocol TCP.
                                     The port field is mandatory
                                   properties:
+                                    endPort:
+                                      description: |-
+                                        EndPort indicates that the range of ports from Port to EndPort, inclusive,
+                                        should be exposed. It must be greater than or equal to Port,
+                                        the range may span at most 1024 ports.
+                                        Supported only by the masquerade binding.
+                                      format: int32
+                                      type: integer
                                     name:
                                       description: |-
                                         If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -19137,7 +21506,9 @@ var CRDsValidation map[string]string = map[string]string{
                                       type: integer
                                     protocol:
                                       description: |-
-                                        Protocol for port. Must be UDP or TCP.
+                                        Protocol for port. Must be UDP, TCP, SCTP or ICMP.
+                                        ICMP forwards echo requests and is supported only by the masquerade binding,
+                                        in which case the port must not be set.
                                         Defaults to "TCP".
                                       type: string
                                   required:
@@ -19780,6 +22151,12 @@ var CRDsValidation map[string]string = map[string]string{
                       description: Resources describes the Compute Resources required
                         by this vmi.
                       properties:
+                        ioWeight:
+                          description: |-
+                            IOWeight sets the relative I/O weight (cgroup v2 io.weight) of the virt-launcher pod.
+                            Valid values are between 1 and 10000. Ignored on nodes using cgroup v1.
+                          format: int32
+                          type: integer
                         limits:
                           additionalProperties:
                             anyOf:
@@ -19821,6 +22198,30 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                guestServiceWatch:
+                  description: |-
+                    GuestServiceWatch lists services of the guest whose state is checked periodically
+                    through the guest agent. The state is reported by the GuestServicesUp condition
+                    and the kubevirt_vmi_guest_service_up metric.
+                  properties:
+                    periodSeconds:
+                      description: |-
+                        How often (in seconds) to check the services.
+                        Defaults to 60 seconds. Minimum value is 30.
+                      format: int32
+                      type: integer
+                    services:
+                      description: |-
+                        Services to watch, systemd units on Linux guests and service names on Windows guests.
+                        At most 10 services can be watched.
+                      items:
+                        type: string
+                      maxItems: 10
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - services
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
@@ -20504,6 +22905,33 @@ var CRDsValidation map[string]string = map[string]string{
                           The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                           More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
                         properties:
+                          metaData:
+                            description: MetaData adds user defined entries to the
+                              config drive instance metadata.
+                            properties:
+                              annotations:
+                                description: |-
+                                  Annotations lists the VMI annotations exposed in the instance metadata, under the
+                                  annotations key.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              keys:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  Keys are added to the instance metadata: at the top level with NoCloud, and under
+                                  the meta key with config drive. Keys generated by KubeVirt cannot be overridden.
+                                type: object
+                              labels:
+                                description: Labels lists the VMI labels exposed in
+                                  the instance metadata, under the labels key.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
                           networkData:
                             description: NetworkData contains config drive inline
                               cloud-init networkdata.
@@ -20542,6 +22970,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
+                          updatePolicy:
+                            description: |-
+                              UpdatePolicy defines how changes of the data sources are propagated to the guest.
+                              Defaults to None.
+                            type: string
                           userData:
                             description: UserData contains config drive inline cloud-init
                               userdata.
@@ -20550,6 +22983,29 @@ var CRDsValidation map[string]string = map[string]string{
                             description: UserDataBase64 contains config drive cloud-init
                               userdata as a base64 encoded string.
                             type: string
+                          vendorData:
+                            description: VendorData contains config drive inline cloud-init
+                              vendordata.
+                            type: string
+                          vendorDataBase64:
+                            description: VendorDataBase64 contains config drive cloud-init
+                              vendordata as a base64 encoded string.
+                            type: string
+                          vendorDataSecretRef:
+                            description: VendorDataSecretRef references a k8s secret
+                              that contains config drive vendordata.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
                         type: object
                       cloudInitNoCloud:
                         description: |-
@@ -20557,6 +23013,37 @@ var CRDsValidation map[string]string = map[string]string{
                           The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                           More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                         properties:
+                          metaData:
+                            description: MetaData adds user defined entries to the
+                              NoCloud instance metadata.
+                            properties:
+                              annotations:
+                                description: |-
+                                  Annotations lists the VMI annotations exposed in the instance metadata, under the
+                                  annotations key.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              keys:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  Keys are added to the instance metadata: at the top level with NoCloud, and under
+                                  the meta key with config drive. Keys generated by KubeVirt cannot be overridden.
+                                type: object
+                              labels:
+                                description: Labels lists the VMI labels exposed in
+                                  the instance metadata, under the labels key.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
+                          metadataService:
+                            description: MetadataService additionally serves the NoCloud
+                              data to the guest over HTTP.
+                            type: object
                           networkData:
                             description: NetworkData contains NoCloud inline cloud-init
                               networkdata.
@@ -20595,6 +23082,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
+                          updatePolicy:
+                            description: |-
+                              UpdatePolicy defines how changes of the data sources are propagated to the guest.
+                              Defaults to None.
+                            type: string
                           userData:
                             description: UserData contains NoCloud inline cloud-init
                               userdata.
@@ -20603,6 +23095,29 @@ var CRDsValidation map[string]string = map[string]string{
                             description: UserDataBase64 contains NoCloud cloud-init
                               userdata as a base64 encoded string.
                             type: string
+                          vendorData:
+                            description: VendorData contains NoCloud inline cloud-init
+                              vendordata.
+                            type: string
+                          vendorDataBase64:
+                            description: VendorDataBase64 contains NoCloud cloud-init
+                              vendordata as a base64 encoded string.
+                            type: string
+                          vendorDataSecretRef:
+                            description: VendorDataSecretRef references a k8s secret
+                              that contains NoCloud vendordata.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
                         type: object
                       configMap:
                         description: |-
@@ -20856,6 +23371,35 @@ var CRDsValidation map[string]string = map[string]string{
                         - path
                         - type
                         type: object
+                      ignition:
+                        description: |-
+                          Ignition represents an Ignition config source.
+                          The config is passed to the guest through QEMU fw_cfg, or as a raw disk when the volume is attached as a disk.
+                          More info: https://coreos.github.io/ignition/
+                        properties:
+                          config:
+                            description: Config contains the inline Ignition config.
+                            type: string
+                          configBase64:
+                            description: ConfigBase64 contains the Ignition config
+                              as a base64 encoded string.
+                            type: string
+                          configSecretRef:
+                            description: ConfigSecretRef references a k8s secret that
+                              contains the Ignition config under the "config" key.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
+                        type: object
                       memoryDump:
                         description: MemoryDump is attached to the virt launcher and
                           is populated with a memory dump of the vmi
@@ -20974,6 +23518,93 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
+                          unattend:
+                            description: |-
+                              Unattend describes a Sysprep answer file that is generated by KubeVirt and attached as disk of CDROM type,
+                              as an alternative to providing the answer file in a Secret or ConfigMap.
+                            properties:
+                              adminPasswordSecretRef:
+                                description: |-
+                                  AdminPasswordSecretRef references a k8s secret that contains the password of the local Administrator
+                                  account under the "password" key.
+                                properties:
+                                  name:
+                                    default: ""
+                                    description: |-
+                                      Name of the referent.
+                                      This field is effectively required, but due to backwards compatibility is
+                                      allowed to be empty. Instances of this type with an empty value here are
+                                      almost certainly wrong.
+                                      More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    type: string
+                                type: object
+                                x-kubernetes-map-type: atomic
+                              computerName:
+                                description: ComputerName is the name of the Windows
+                                  computer. Defaults to the hostname of the VMI, truncated
+                                  to 15 characters.
+                                type: string
+                              domainJoin:
+                                description: DomainJoin joins the computer to an Active
+                                  Directory domain.
+                                properties:
+                                  credentialsSecretRef:
+                                    description: |-
+                                      CredentialsSecretRef references a k8s secret that contains the "username" and "password" of an
+                                      account which is allowed to join computers to the domain.
+                                    properties:
+                                      name:
+                                        default: ""
+                                        description: |-
+                                          Name of the referent.
+                                          This field is effectively required, but due to backwards compatibility is
+                                          allowed to be empty. Instances of this type with an empty value here are
+                                          almost certainly wrong.
+                                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                        type: string
+                                    type: object
+                                    x-kubernetes-map-type: atomic
+                                  domain:
+                                    description: Domain is the name of the domain
+                                      to join.
+                                    type: string
+                                  organizationalUnit:
+                                    description: OrganizationalUnit is the distinguished
+                                      name of the organizational unit the computer
+                                      account is created in.
+                                    type: string
+                                required:
+                                - credentialsSecretRef
+                                - domain
+                                type: object
+                              driverPaths:
+                                description: |-
+                                  DriverPaths are searched for drivers during the setup, e.g. the directories of the virtio-win
+                                  drivers on a CDROM, like E:\amd64\w11.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              firstLogonCommands:
+                                description: |-
+                                  FirstLogonCommands are run in order when the Administrator logs on for the first time.
+                                  The Administrator is logged on automatically once to run them when AdminPasswordSecretRef is set.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              locale:
+                                description: Locale is the input, system, user interface
+                                  and user locale, e.g. en-US.
+                                type: string
+                              productKey:
+                                description: ProductKey is the Windows product key.
+                                type: string
+                              timeZone:
+                                description: TimeZone is the Windows time zone, e.g.
+                                  UTC or Pacific Standard Time.
+                                type: string
+                            type: object
                         type: object
                     required:
                     - name
@@ -23578,6 +26209,13 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Clock sets the clock and timers of the
                                 vmi.
                               properties:
+                                guestTimeSync:
+                                  description: |-
+                                    GuestTimeSync enables setting the guest clock through the guest agent after
+                                    the guest was unpaused or migrated, and once the guest agent connects after
+                                    boot, e.g. when starting from a restored snapshot. The drift observed before
+                                    every synchronisation is reported as a metric.
+                                  type: object
                                 timer:
                                   description: Timer specifies whih timers are attached
                                     to the vmi.
@@ -23728,8 +26366,15 @@ var CRDsValidation map[string]string = map[string]string{
                                     List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                                     It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                                     and "host-model" to get CPU closest to the node one.
+                                    "cluster-baseline" gets the CPU model and features common to all nodes of the CPU model group,
+                                    allowing the VMI to migrate to any node of the group.
                                     Defaults to host-model.
                                   type: string
+                                modelGroup:
+                                  description: |-
+                                    ModelGroup selects the CPU model group, as defined in the KubeVirt configuration, whose baseline
+                                    is used when the model is "cluster-baseline". Defaults to all schedulable nodes.
+                                  type: string
                                 numa:
                                   description: NUMA allows specifying settings for
                                     the guest NUMA topology
@@ -23921,6 +26566,115 @@ var CRDsValidation map[string]string = map[string]string{
                                           IO specifies which QEMU disk IO mode should be used.
                                           Supported values are: native, default, threads.
                                         type: string
+                                      ioTune:
+                                        description: |-
+                                          IOTune specifies I/O throttling limits enforced by the hypervisor for this disk.
+                                          With the LiveUpdate rollout strategy the limits can be changed on a running VM.
+                                        properties:
+                                          bytes:
+                                            description: Bytes limits the disk throughput
+                                              in bytes per second.
+                                            properties:
+                                              burst:
+                                                description: Burst allows exceeding
+                                                  the sustained limits for a limited
+                                                  time.
+                                                properties:
+                                                  lengthSeconds:
+                                                    description: |-
+                                                      LengthSeconds is the duration of a burst.
+                                                      Defaults to 1.
+                                                    format: int64
+                                                    type: integer
+                                                  read:
+                                                    description: Read is the maximum
+                                                      read rate during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                  total:
+                                                    description: Total is the maximum
+                                                      rate for reads and writes combined
+                                                      during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                  write:
+                                                    description: Write is the maximum
+                                                      write rate during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                type: object
+                                              read:
+                                                description: Read limits reads.
+                                                format: int64
+                                                type: integer
+                                              total:
+                                                description: Total limits reads and
+                                                  writes combined.
+                                                format: int64
+                                                type: integer
+                                              write:
+                                                description: Write limits writes.
+                                                format: int64
+                                                type: integer
+                                            type: object
+                                          groupName:
+                                            description: |-
+                                              GroupName places the disk into a throttling group. All disks of a group share the
+                                              limits of the group.
+                                            type: string
+                                          iops:
+                                            description: IOPS limits the number of
+                                              I/O operations per second.
+                                            properties:
+                                              burst:
+                                                description: Burst allows exceeding
+                                                  the sustained limits for a limited
+                                                  time.
+                                                properties:
+                                                  lengthSeconds:
+                                                    description: |-
+                                                      LengthSeconds is the duration of a burst.
+                                                      Defaults to 1.
+                                                    format: int64
+                                                    type: integer
+                                                  read:
+                                                    description: Read is the maximum
+                                                      read rate during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                  total:
+                                                    description: Total is the maximum
+                                                      rate for reads and writes combined
+                                                      during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                  write:
+                                                    description: Write is the maximum
+                                                      write rate during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                type: object
+                                              read:
+                                                description: Read limits reads.
+                                                format: int64
+                                                type: integer
+                                              total:
+                                                description: Total limits reads and
+                                                  writes combined.
+                                                format: int64
+                                                type: integer
+                                              write:
+                                                description: Write limits writes.
+                                                format: int64
+                                                type: integer
+                                            type: object
+                                          sizeIOPS:
+                                            description: |-
+                                              SizeIOPS is the size in bytes of a single I/O operation when IOPS limits are accounted.
+                                              Larger requests are counted as multiple operations.
+                                            format: int64
+                                            type: integer
+                                        type: object
                                       lun:
                                         description: Attach a volume as a LUN to the
                                           vmi.
@@ -24103,6 +26857,64 @@ var CRDsValidation map[string]string = map[string]string{
                                           in PCI addresses assigned to the device.
                                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                         type: integer
+                                      bandwidth:
+                                        description: |-
+                                          Bandwidth limits the traffic rate of the interface.
+                                          Supported only by interfaces attached to the domain through a tap device
+                                          (bridge, masquerade and binding plugins with the tap domain attachment type).
+                                          Can be updated on a running VM.
+                                        properties:
+                                          inbound:
+                                            description: Inbound limits the traffic
+                                              received by the guest.
+                                            properties:
+                                              average:
+                                                description: Average is the average
+                                                  bit rate of the shaped traffic,
+                                                  in kibibytes per second.
+                                                format: int32
+                                                type: integer
+                                              burst:
+                                                description: Burst is the amount of
+                                                  kibibytes that can be sent in a
+                                                  single burst at the peak rate.
+                                                format: int32
+                                                type: integer
+                                              peak:
+                                                description: |-
+                                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                                  Must not be lower than the average.
+                                                format: int32
+                                                type: integer
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Outbound limits the traffic
+                                              sent by the guest.
+                                            properties:
+                                              average:
+                                                description: Average is the average
+                                                  bit rate of the shaped traffic,
+                                                  in kibibytes per second.
+                                                format: int32
+                                                type: integer
+                                              burst:
+                                                description: Burst is the amount of
+                                                  kibibytes that can be sent in a
+                                                  single burst at the peak rate.
+                                                format: int32
+                                                type: integer
+                                              peak:
+                                                description: |-
+                                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                                  Must not be lower than the average.
+                                                format: int32
+                                                type: integer
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
                                       binding:
                                         description: |-
                                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -24137,6 +26949,44 @@ var CRDsValidation map[string]string = map[string]string{
                                             description: If specified will pass option
                                               67 to interface's DHCP server
                                             type: string
+                                          classlessRoutes:
+                                            description: If specified will pass the
+                                              routes via DHCP option 121, in addition
+                                              to the routes of the pod interface.
+                                            items:
+                                              description: DHCPClasslessRoute is a
+                                                route passed via DHCP option 121.
+                                              properties:
+                                                destination:
+                                                  description: Destination network
+                                                    in CIDR notation, e.g. 10.0.0.0/8.
+                                                  type: string
+                                                gateway:
+                                                  description: Gateway IPv4 address.
+                                                    An empty value describes a route
+                                                    on the link.
+                                                  type: string
+                                              required:
+                                              - destination
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          domainSearch:
+                                            description: If specified will pass the
+                                              domain search list via DHCP option 119,
+                                              instead of the one of the pod.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          leaseTimeSeconds:
+                                            description: |-
+                                              LeaseTimeSeconds is the lease time of the address offered to the guest.
+                                              Applies to the preferred and valid lifetimes of the DHCPv6 address as well.
+                                              Must be at least 60 seconds.
+                                              Defaults to an infinite lease.
+                                            format: int32
+                                            type: integer
                                           ntpServers:
                                             description: If specified will pass the
                                               configured NTP server to the VM via
@@ -24144,6 +26994,33 @@ var CRDsValidation map[string]string = map[string]string{
                                             items:
                                               type: string
                                             type: array
+                                          options:
+                                            description: |-
+                                              If specified will pass the listed DHCP options, overriding the values set by KubeVirt.
+                                              Options driving the DHCP protocol itself (e.g. lease time, message type, server identifier) cannot be set.
+                                            items:
+                                              description: DHCPOption is a numeric
+                                                DHCP option passed to the guest.
+                                              properties:
+                                                code:
+                                                  description: 'Code of the option,
+                                                    range: 1-254.'
+                                                  type: integer
+                                                type:
+                                                  description: |-
+                                                    Type of the value, one of: string, hex, ipv4, uint8, uint16, uint32.
+                                                    Defaults to string.
+                                                  type: string
+                                                value:
+                                                  description: Value of the option,
+                                                    encoded according to the type.
+                                                  type: string
+                                              required:
+                                              - code
+                                              - value
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
                                           privateOptions:
                                             description: 'If specified will pass extra
                                               DHCP options for private use, range:
@@ -24171,6 +27048,84 @@ var CRDsValidation map[string]string = map[string]string{
                                             description: If specified will pass option
                                               66 to interface's DHCP server
                                             type: string
+                                          v6:
+                                            description: V6 specifies options passed
+                                              by the DHCPv6 server, used by the masquerade
+                                              binding.
+                                            properties:
+                                              domainSearch:
+                                                description: If specified will pass
+                                                  the domain search list via DHCPv6
+                                                  option 24, instead of the one of
+                                                  the pod.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              ntpServers:
+                                                description: If specified will pass
+                                                  the NTP servers via DHCPv6 option
+                                                  56.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            type: object
+                                        type: object
+                                      firewall:
+                                        description: |-
+                                          Firewall filters the traffic bridged between the guest and the network.
+                                          Supported only by the bridge binding.
+                                          Requires the nf_conntrack_bridge kernel module to be loaded on the node.
+                                        properties:
+                                          defaultAction:
+                                            description: |-
+                                              DefaultAction is applied to the traffic which does not match any rule.
+                                              Defaults to Allow.
+                                            type: string
+                                          rules:
+                                            description: Rules are evaluated in order,
+                                              the first matching rule decides the
+                                              traffic fate.
+                                            items:
+                                              properties:
+                                                action:
+                                                  description: 'Action to take on
+                                                    matching traffic: Allow or Deny.'
+                                                  type: string
+                                                cidr:
+                                                  description: |-
+                                                    CIDR of the remote peer: the source of ingress traffic or the destination of egress traffic.
+                                                    Matches any peer when not specified.
+                                                  type: string
+                                                direction:
+                                                  description: 'Direction of the traffic
+                                                    the rule applies to: Ingress or
+                                                    Egress.'
+                                                  type: string
+                                                endPort:
+                                                  description: EndPort indicates that
+                                                    the range of ports from Port to
+                                                    EndPort, inclusive, should match.
+                                                  format: int32
+                                                  type: integer
+                                                port:
+                                                  description: |-
+                                                    Port is the destination port of the matching traffic.
+                                                    Requires the TCP, UDP or SCTP protocol.
+                                                  format: int32
+                                                  type: integer
+                                                protocol:
+                                                  description: |-
+                                                    Protocol of the matching traffic: TCP, UDP, SCTP or ICMP.
+                                                    Matches any protocol when not specified.
+                                                  type: string
+                                              required:
+                                              - action
+                                              - direction
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
                                         type: object
                                       macAddress:
                                         description: 'Interface MAC address. For example:
@@ -24224,6 +27179,14 @@ var CRDsValidation map[string]string = map[string]string{
                                             Default protocol TCP.
                                             The port field is mandatory
                                           properties:
+                                            endPort:
+                                              description: |-
+                                                EndPort indicates that the range of ports from Port to EndPort, inclusive,
+                                                should be exposed. It must be greater than or equal to Port,
+                                                the range may span at most 1024 ports.
+                                                Supported only by the masquerade binding.
+                                              format: int32
+                                              type: integer
                                             name:
                                               description: |-
                                                 If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -24238,7 +27201,9 @@ var CRDsValidation map[string]string = map[string]string{
                                               type: integer
                                             protocol:
                                               description: |-
-                                                Protocol for port. Must be UDP or TCP.
+                                                Protocol for port. Must be UDP, TCP, SCTP or ICMP.
+                                                ICMP forwards echo requests and is supported only by the masquerade binding,
+                                                in which case the port must not be set.
                                                 Defaults to "TCP".
                                               type: string
                                           required:
@@ -24894,6 +27859,12 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Resources describes the Compute Resources
                                 required by this vmi.
                               properties:
+                                ioWeight:
+                                  description: |-
+                                    IOWeight sets the relative I/O weight (cgroup v2 io.weight) of the virt-launcher pod.
+                                    Valid values are between 1 and 10000. Ignored on nodes using cgroup v1.
+                                  format: int32
+                                  type: integer
                                 limits:
                                   additionalProperties:
                                     anyOf:
@@ -24935,6 +27906,30 @@ var CRDsValidation map[string]string = map[string]string{
                             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                           type: string
+                        guestServiceWatch:
+                          description: |-
+                            GuestServiceWatch lists services of the guest whose state is checked periodically
+                            through the guest agent. The state is reported by the GuestServicesUp condition
+                            and the kubevirt_vmi_guest_service_up metric.
+                          properties:
+                            periodSeconds:
+                              description: |-
+                                How often (in seconds) to check the services.
+                                Defaults to 60 seconds. Minimum value is 30.
+                              format: int32
+                              type: integer
+                            services:
+                              description: |-
+                                Services to watch, systemd units on Linux guests and service names on Windows guests.
+                                At most 10 services can be watched.
+                              items:
+                                type: string
+                              maxItems: 10
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          required:
+                          - services
+                          type: object
                         hostname:
                           description: |-
                             Specifies the hostname of the vmi
@@ -25621,6 +28616,34 @@ var CRDsValidation map[string]string = map[string]string{
                                   The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                                   More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
                                 properties:
+                                  metaData:
+                                    description: MetaData adds user defined entries
+                                      to the config drive instance metadata.
+                                    properties:
+                                      annotations:
+                                        description: |-
+                                          Annotations lists the VMI annotations exposed in the instance metadata, under the
+                                          annotations key.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      keys:
+                                        additionalProperties:
+                                          type: string
+                                        description: |-
+                                          Keys are added to the instance metadata: at the top level with NoCloud, and under
+                                          the meta key with config drive. Keys generated by KubeVirt cannot be overridden.
+                                        type: object
+                                      labels:
+                                        description: Labels lists the VMI labels exposed
+                                          in the instance metadata, under the labels
+                                          key.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    type: object
                                   networkData:
                                     description: NetworkData contains config drive
                                       inline cloud-init networkdata.
@@ -25660,6 +28683,11 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                     type: object
                                     x-kubernetes-map-type: atomic
+                                  updatePolicy:
+                                    description: |-
+                                      UpdatePolicy defines how changes of the data sources are propagated to the guest.
+                                      Defaults to None.
+                                    type: string
                                   userData:
                                     description: UserData contains config drive inline
                                       cloud-init userdata.
@@ -25668,6 +28696,30 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: UserDataBase64 contains config drive
                                       cloud-init userdata as a base64 encoded string.
                                     type: string
+                                  vendorData:
+                                    description: VendorData contains config drive
+                                      inline cloud-init vendordata.
+                                    type: string
+                                  vendorDataBase64:
+                                    description: VendorDataBase64 contains config
+                                      drive cloud-init vendordata as a base64 encoded
+                                      string.
+                                    type: string
+                                  vendorDataSecretRef:
+                                    description: VendorDataSecretRef references a
+                                      k8s secret that contains config drive vendordata.
+                                    properties:
+                                      name:
+                                        default: ""
+                                        description: |-
+                                          Name of the referent.
+                                          This field is effectively required, but due to backwards compatibility is
+                                          allowed to be empty. Instances of this type with an empty value here are
+                                          almost certainly wrong.
+                                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                        type: string
+                                    type: object
+                                    x-kubernetes-map-type: atomic
                                 type: object
                               cloudInitNoCloud:
                                 description: |-
@@ -25675,6 +28727,38 @@ var CRDsValidation map[string]string = map[string]string{
                                   The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                                   More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                                 properties:
+                                  metaData:
+                                    description: MetaData adds user defined entries
+                                      to the NoCloud instance metadata.
+                                    properties:
+                                      annotations:
+                                        description: |-
+                                          Annotations lists the VMI annotations exposed in the instance metadata, under the
+                                          annotations key.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      keys:
+                                        additionalProperties:
+                                          type: string
+                                        description: |-
+                                          Keys are added to the instance metadata: at the top level with NoCloud, and under
+                                          the meta key with config drive. Keys generated by KubeVirt cannot be overridden.
+                                        type: object
+                                      labels:
+                                        description: Labels lists the VMI labels exposed
+                                          in the instance metadata, under the labels
+                                          key.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    type: object
+                                  metadataService:
+                                    description: MetadataService additionally serves
+                                      the NoCloud data to the guest over HTTP.
+                                    type: object
                                   networkData:
                                     description: NetworkData contains NoCloud inline
                                       cloud-init networkdata.
@@ -25713,6 +28797,11 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                     type: object
                                     x-kubernetes-map-type: atomic
+                                  updatePolicy:
+                                    description: |-
+                                      UpdatePolicy defines how changes of the data sources are propagated to the guest.
+                                      Defaults to None.
+                                    type: string
                                   userData:
                                     description: UserData contains NoCloud inline
                                       cloud-init userdata.
@@ -25721,6 +28810,29 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: UserDataBase64 contains NoCloud cloud-init
                                       userdata as a base64 encoded string.
                                     type: string
+                                  vendorData:
+                                    description: VendorData contains NoCloud inline
+                                      cloud-init vendordata.
+                                    type: string
+                                  vendorDataBase64:
+                                    description: VendorDataBase64 contains NoCloud
+                                      cloud-init vendordata as a base64 encoded string.
+                                    type: string
+                                  vendorDataSecretRef:
+                                    description: VendorDataSecretRef references a
+                                      k8s secret that contains NoCloud vendordata.
+                                    properties:
+                                      name:
+                                        default: ""
+                                        description: |-
+                                          Name of the referent.
+                                          This field is effectively required, but due to backwards compatibility is
+                                          allowed to be empty. Instances of this type with an empty value here are
+                                          almost certainly wrong.
+                                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                        type: string
+                                    type: object
+                                    x-kubernetes-map-type: atomic
                                 type: object
                               configMap:
                                 description: |-
@@ -25980,6 +29092,37 @@ var CRDsValidation map[string]string = map[string]string{
                                 - path
                                 - type
                                 type: object
+                              ignition:
+                                description: |-
+                                  Ignition represents an Ignition config source.
+                                  The config is passed to the guest through QEMU fw_cfg, or as a raw disk when the volume is attached as a disk.
+                                  More info: https://coreos.github.io/ignition/
+                                properties:
+                                  config:
+                                    description: Config contains the inline Ignition
+                                      config.
+                                    type: string
+                                  configBase64:
+                                    description: ConfigBase64 contains the Ignition
+                                      config as a base64 encoded string.
+                                    type: string
+                                  configSecretRef:
+                                    description: ConfigSecretRef references a k8s
+                                      secret that contains the Ignition config under
+                                      the "config" key.
+                                    properties:
+                                      name:
+                                        default: ""
+                                        description: |-
+                                          Name of the referent.
+                                          This field is effectively required, but due to backwards compatibility is
+                                          allowed to be empty. Instances of this type with an empty value here are
+                                          almost certainly wrong.
+                                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                        type: string
+                                    type: object
+                                    x-kubernetes-map-type: atomic
+                                type: object
                               memoryDump:
                                 description: MemoryDump is attached to the virt launcher
                                   and is populated with a memory dump of the vmi
@@ -26098,6 +29241,95 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                     type: object
                                     x-kubernetes-map-type: atomic
+                                  unattend:
+                                    description: |-
+                                      Unattend describes a Sysprep answer file that is generated by KubeVirt and attached as disk of CDROM type,
+                                      as an alternative to providing the answer file in a Secret or ConfigMap.
+                                    properties:
+                                      adminPasswordSecretRef:
+                                        description: |-
+                                          AdminPasswordSecretRef references a k8s secret that contains the password of the local Administrator
+                                          account under the "password" key.
+                                        properties:
+                                          name:
+                                            default: ""
+                                            description: |-
+                                              Name of the referent.
+                                              This field is effectively required, but due to backwards compatibility is
+                                              allowed to be empty. Instances of this type with an empty value here are
+                                              almost certainly wrong.
+                                              More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            type: string
+                                        type: object
+                                        x-kubernetes-map-type: atomic
+                                      computerName:
+                                        description: ComputerName is the name of the
+                                          Windows computer. Defaults to the hostname
+                                          of the VMI, truncated to 15 characters.
+                                        type: string
+                                      domainJoin:
+                                        description: DomainJoin joins the computer
+                                          to an Active Directory domain.
+                                        properties:
+                                          credentialsSecretRef:
+                                            description: |-
+                                              CredentialsSecretRef references a k8s secret that contains the "username" and "password" of an
+                                              account which is allowed to join computers to the domain.
+                                            properties:
+                                              name:
+                                                default: ""
+                                                description: |-
+                                                  Name of the referent.
+                                                  This field is effectively required, but due to backwards compatibility is
+                                                  allowed to be empty. Instances of this type with an empty value here are
+                                                  almost certainly wrong.
+                                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                type: string
+                                            type: object
+                                            x-kubernetes-map-type: atomic
+                                          domain:
+                                            description: Domain is the name of the
+                                              domain to join.
+                                            type: string
+                                          organizationalUnit:
+                                            description: OrganizationalUnit is the
+                                              distinguished name of the organizational
+                                              unit the computer account is created
+                                              in.
+                                            type: string
+                                        required:
+                                        - credentialsSecretRef
+                                        - domain
+                                        type: object
+                                      driverPaths:
+                                        description: |-
+                                          DriverPaths are searched for drivers during the setup, e.g. the directories of the virtio-win
+                                          drivers on a CDROM, like E:\amd64\w11.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      firstLogonCommands:
+                                        description: |-
+                                          FirstLogonCommands are run in order when the Administrator logs on for the first time.
+                                          The Administrator is logged on automatically once to run them when AdminPasswordSecretRef is set.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      locale:
+                                        description: Locale is the input, system,
+                                          user interface and user locale, e.g. en-US.
+                                        type: string
+                                      productKey:
+                                        description: ProductKey is the Windows product
+                                          key.
+                                        type: string
+                                      timeZone:
+                                        description: TimeZone is the Windows time
+                                          zone, e.g. UTC or Pacific Standard Time.
+                                        type: string
+                                    type: object
                                 type: object
                             required:
                             - name
@@ -29140,6 +32372,13 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Clock sets the clock and timers of
                                     the vmi.
                                   properties:
+                                    guestTimeSync:
+                                      description: |-
+                                        GuestTimeSync enables setting the guest clock through the guest agent after
+                                        the guest was unpaused or migrated, and once the guest agent connects after
+                                        boot, e.g. when starting from a restored snapshot. The drift observed before
+                                        every synchronisation is reported as a metric.
+                                      type: object
                                     timer:
                                       description: Timer specifies whih timers are
                                         attached to the vmi.
@@ -29291,8 +32530,15 @@ var CRDsValidation map[string]string = map[string]string{
                                         List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                                         It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                                         and "host-model" to get CPU closest to the node one.
+                                        "cluster-baseline" gets the CPU model and features common to all nodes of the CPU model group,
+                                        allowing the VMI to migrate to any node of the group.
                                         Defaults to host-model.
                                       type: string
+                                    modelGroup:
+                                      description: |-
+                                        ModelGroup selects the CPU model group, as defined in the KubeVirt configuration, whose baseline
+                                        is used when the model is "cluster-baseline". Defaults to all schedulable nodes.
+                                      type: string
                                     numa:
                                       description: NUMA allows specifying settings
                                         for the guest NUMA topology
@@ -29486,6 +32732,119 @@ var CRDsValidation map[string]string = map[string]string{
                                               IO specifies which QEMU disk IO mode should be used.
                                               Supported values are: native, default, threads.
                                             type: string
+                                          ioTune:
+                                            description: |-
+                                              IOTune specifies I/O throttling limits enforced by the hypervisor for this disk.
+                                              With the LiveUpdate rollout strategy the limits can be changed on a running VM.
+                                            properties:
+                                              bytes:
+                                                description: Bytes limits the disk
+                                                  throughput in bytes per second.
+                                                properties:
+                                                  burst:
+                                                    description: Burst allows exceeding
+                                                      the sustained limits for a limited
+                                                      time.
+                                                    properties:
+                                                      lengthSeconds:
+                                                        description: |-
+                                                          LengthSeconds is the duration of a burst.
+                                                          Defaults to 1.
+                                                        format: int64
+                                                        type: integer
+                                                      read:
+                                                        description: Read is the maximum
+                                                          read rate during a burst.
+                                                        format: int64
+                                                        type: integer
+                                                      total:
+                                                        description: Total is the
+                                                          maximum rate for reads and
+                                                          writes combined during a
+                                                          burst.
+                                                        format: int64
+                                                        type: integer
+                                                      write:
+                                                        description: Write is the
+                                                          maximum write rate during
+                                                          a burst.
+                                                        format: int64
+                                                        type: integer
+                                                    type: object
+                                                  read:
+                                                    description: Read limits reads.
+                                                    format: int64
+                                                    type: integer
+                                                  total:
+                                                    description: Total limits reads
+                                                      and writes combined.
+                                                    format: int64
+                                                    type: integer
+                                                  write:
+                                                    description: Write limits writes.
+                                                    format: int64
+                                                    type: integer
+                                                type: object
+                                              groupName:
+                                                description: |-
+                                                  GroupName places the disk into a throttling group. All disks of a group share the
+                                                  limits of the group.
+                                                type: string
+                                              iops:
+                                                description: IOPS limits the number
+                                                  of I/O operations per second.
+                                                properties:
+                                                  burst:
+                                                    description: Burst allows exceeding
+                                                      the sustained limits for a limited
+                                                      time.
+                                                    properties:
+                                                      lengthSeconds:
+                                                        description: |-
+                                                          LengthSeconds is the duration of a burst.
+                                                          Defaults to 1.
+                                                        format: int64
+                                                        type: integer
+                                                      read:
+                                                        description: Read is the maximum
+                                                          read rate during a burst.
+                                                        format: int64
+                                                        type: integer
+                                                      total:
+                                                        description: Total is the
+                                                          maximum rate for reads and
+                                                          writes combined during a
+                                                          burst.
+                                                        format: int64
+                                                        type: integer
+                                                      write:
+                                                        description: Write is the
+                                                          maximum write rate during
+                                                          a burst.
+                                                        format: int64
+                                                        type: integer
+                                                    type: object
+                                                  read:
+                                                    description: Read limits reads.
+                                                    format: int64
+                                                    type: integer
+                                                  total:
+                                                    description: Total limits reads
+                                                      and writes combined.
+                                                    format: int64
+                                                    type: integer
+                                                  write:
+                                                    description: Write limits writes.
+                                                    format: int64
+                                                    type: integer
+                                                type: object
+                                              sizeIOPS:
+                                                description: |-
+                                                  SizeIOPS is the size in bytes of a single I/O operation when IOPS limits are accounted.
+                                                  Larger requests are counted as multiple operations.
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN
                                               to the vmi.
@@ -29673,6 +33032,66 @@ var CRDsValidation map[string]string = map[string]string{
                                               in PCI addresses assigned to the device.
                                               This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                             type: integer
+                                          bandwidth:
+                                            description: |-
+                                              Bandwidth limits the traffic rate of the interface.
+                                              Supported only by interfaces attached to the domain through a tap device
+                                              (bridge, masquerade and binding plugins with the tap domain attachment type).
+                                              Can be updated on a running VM.
+                                            properties:
+                                              inbound:
+                                                description: Inbound limits the traffic
+                                                  received by the guest.
+                                                properties:
+                                                  average:
+                                                    description: Average is the average
+                                                      bit rate of the shaped traffic,
+                                                      in kibibytes per second.
+                                                    format: int32
+                                                    type: integer
+                                                  burst:
+                                                    description: Burst is the amount
+                                                      of kibibytes that can be sent
+                                                      in a single burst at the peak
+                                                      rate.
+                                                    format: int32
+                                                    type: integer
+                                                  peak:
+                                                    description: |-
+                                                      Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                                      Must not be lower than the average.
+                                                    format: int32
+                                                    type: integer
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Outbound limits the traffic
+                                                  sent by the guest.
+                                                properties:
+                                                  average:
+                                                    description: Average is the average
+                                                      bit rate of the shaped traffic,
+                                                      in kibibytes per second.
+                                                    format: int32
+                                                    type: integer
+                                                  burst:
+                                                    description: Burst is the amount
+                                                      of kibibytes that can be sent
+                                                      in a single burst at the peak
+                                                      rate.
+                                                    format: int32
+                                                    type: integer
+                                                  peak:
+                                                    description: |-
+                                                      Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                                      Must not be lower than the average.
+                                                    format: int32
+                                                    type: integer
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
                                           binding:
                                             description: |-
                                               Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -29707,6 +33126,47 @@ var CRDsValidation map[string]string = map[string]string{
                                                 description: If specified will pass
                                                   option 67 to interface's DHCP server
                                                 type: string
+                                              classlessRoutes:
+                                                description: If specified will pass
+                                                  the routes via DHCP option 121,
+                                                  in addition to the routes of the
+                                                  pod interface.
+                                                items:
+                                                  description: DHCPClasslessRoute
+                                                    is a route passed via DHCP option
+                                                    121.
+                                                  properties:
+                                                    destination:
+                                                      description: Destination network
+                                                        in CIDR notation, e.g. 10.0.0.0/8.
+                                                      type: string
+                                                    gateway:
+                                                      description: Gateway IPv4 address.
+                                                        An empty value describes a
+                                                        route on the link.
+                                                      type: string
+                                                  required:
+                                                  - destination
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              domainSearch:
+                                                description: If specified will pass
+                                                  the domain search list via DHCP
+                                                  option 119, instead of the one of
+                                                  the pod.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              leaseTimeSeconds:
+                                                description: |-
+                                                  LeaseTimeSeconds is the lease time of the address offered to the guest.
+                                                  Applies to the preferred and valid lifetimes of the DHCPv6 address as well.
+                                                  Must be at least 60 seconds.
+                                                  Defaults to an infinite lease.
+                                                format: int32
+                                                type: integer
                                               ntpServers:
                                                 description: If specified will pass
                                                   the configured NTP server to the
@@ -29714,6 +33174,33 @@ var CRDsValidation map[string]string = map[string]string{
                                                 items:
                                                   type: string
                                                 type: array
+                                              options:
+                                                description: |-
+                                                  If specified will pass the listed DHCP options, overriding the values set by KubeVirt.
+                                                  Options driving the DHCP protocol itself (e.g. lease time, message type, server identifier) cannot be set.
+                                                items:
+                                                  description: DHCPOption is a numeric
+                                                    DHCP option passed to the guest.
+                                                  properties:
+                                                    code:
+                                                      description: 'Code of the option,
+                                                        range: 1-254.'
+                                                      type: integer
+                                                    type:
+                                                      description: |-
+                                                        Type of the value, one of: string, hex, ipv4, uint8, uint16, uint32.
+                                                        Defaults to string.
+                                                      type: string
+                                                    value:
+                                                      description: Value of the option,
+                                                        encoded according to the type.
+                                                      type: string
+                                                  required:
+                                                  - code
+                                                  - value
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
                                               privateOptions:
                                                 description: 'If specified will pass
                                                   extra DHCP options for private use,
@@ -29741,6 +33228,86 @@ var CRDsValidation map[string]string = map[string]string{
                                                 description: If specified will pass
                                                   option 66 to interface's DHCP server
                                                 type: string
+                                              v6:
+                                                description: V6 specifies options
+                                                  passed by the DHCPv6 server, used
+                                                  by the masquerade binding.
+                                                properties:
+                                                  domainSearch:
+                                                    description: If specified will
+                                                      pass the domain search list
+                                                      via DHCPv6 option 24, instead
+                                                      of the one of the pod.
+                                                    items:
+                                                      type: string
+                                                    type: array
+                                                    x-kubernetes-list-type: atomic
+                                                  ntpServers:
+                                                    description: If specified will
+                                                      pass the NTP servers via DHCPv6
+                                                      option 56.
+                                                    items:
+                                                      type: string
+                                                    type: array
+                                                    x-kubernetes-list-type: atomic
+                                                type: object
+                                            type: object
+                                          firewall:
+                                            description: |-
+                                              Firewall filters the traffic bridged between the guest and the network.
+                                              Supported only by the bridge binding.
+                                              Requires the nf_conntrack_bridge kernel module to be loaded on the node.
+                                            properties:
+                                              defaultAction:
+                                                description: |-
+                                                  DefaultAction is applied to the traffic which does not match any rule.
+                                                  Defaults to Allow.
+                                                type: string
+                                              rules:
+                                                description: Rules are evaluated in
+                                                  order, the first matching rule decides
+                                                  the traffic fate.
+                                                items:
+                                                  properties:
+                                                    action:
+                                                      description: 'Action to take
+                                                        on matching traffic: Allow
+                                                        or Deny.'
+                                                      type: string
+                                                    cidr:
+                                                      description: |-
+                                                        CIDR of the remote peer: the source of ingress traffic or the destination of egress traffic.
+                                                        Matches any peer when not specified.
+                                                      type: string
+                                                    direction:
+                                                      description: 'Direction of the
+                                                        traffic the rule applies to:
+                                                        Ingress or Egress.'
+                                                      type: string
+                                                    endPort:
+                                                      description: EndPort indicates
+                                                        that the range of ports from
+                                                        Port to EndPort, inclusive,
+                                                        should match.
+                                                      format: int32
+                                                      type: integer
+                                                    port:
+                                                      description: |-
+                                                        Port is the destination port of the matching traffic.
+                                                        Requires the TCP, UDP or SCTP protocol.
+                                                      format: int32
+                                                      type: integer
+                                                    protocol:
+                                                      description: |-
+                                                        Protocol of the matching traffic: TCP, UDP, SCTP or ICMP.
+                                                        Matches any protocol when not specified.
+                                                      type: string
+                                                  required:
+                                                  - action
+                                                  - direction
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
                                             type: object
                                           macAddress:
                                             description: 'Interface MAC address. For
@@ -29794,6 +33361,14 @@ var CRDsValidation map[string]string = map[string]string{
                                                 Default protocol TCP.
                                                 The port field is mandatory
                                               properties:
+                                                endPort:
+                                                  description: |-
+                                                    EndPort indicates that the range of ports from Port to EndPort, inclusive,
+                                                    should be exposed. It must be greater than or equal to Port,
+                                                    the range may span at most 1024 ports.
+                                                    Supported only by the masquerade binding.
+                                                  format: int32
+                                                  type: integer
                                                 name:
                                                   description: |-
                                                     If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -29808,7 +33383,9 @@ var CRDsValidation map[string]string = map[string]string{
                                                   type: integer
                                                 protocol:
                                                   description: |-
-                                                    Protocol for port. Must be UDP or TCP.
+                                                    Protocol for port. Must be UDP, TCP, SCTP or ICMP.
+                                                    ICMP forwards echo requests and is supported only by the masquerade binding,
+                                                    in which case the port must not be set.
                                                     Defaults to "TCP".
                                                   type: string
                                               required:
@@ -30467,6 +34044,12 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Resources describes the Compute Resources
                                     required by this vmi.
                                   properties:
+                                    ioWeight:
+                                      description: |-
+                                        IOWeight sets the relative I/O weight (cgroup v2 io.weight) of the virt-launcher pod.
+                                        Valid values are between 1 and 10000. Ignored on nodes using cgroup v1.
+                                      format: int32
+                                      type: integer
                                     limits:
                                       additionalProperties:
                                         anyOf:
@@ -30508,6 +34091,30 @@ var CRDsValidation map[string]string = map[string]string{
                                 - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                                 - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                               type: string
+                            guestServiceWatch:
+                              description: |-
+                                GuestServiceWatch lists services of the guest whose state is checked periodically
+                                through the guest agent. The state is reported by the GuestServicesUp condition
+                                and the kubevirt_vmi_guest_service_up metric.
+                              properties:
+                                periodSeconds:
+                                  description: |-
+                                    How often (in seconds) to check the services.
+                                    Defaults to 60 seconds. Minimum value is 30.
+                                  format: int32
+                                  type: integer
+                                services:
+                                  description: |-
+                                    Services to watch, systemd units on Linux guests and service names on Windows guests.
+                                    At most 10 services can be watched.
+                                  items:
+                                    type: string
+                                  maxItems: 10
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - services
+                              type: object
                             hostname:
                               description: |-
                                 Specifies the hostname of the vmi
@@ -31198,6 +34805,34 @@ var CRDsValidation map[string]string = map[string]string{
                                       The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                                       More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
                                     properties:
+                                      metaData:
+                                        description: MetaData adds user defined entries
+                                          to the config drive instance metadata.
+                                        properties:
+                                          annotations:
+                                            description: |-
+                                              Annotations lists the VMI annotations exposed in the instance metadata, under the
+                                              annotations key.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          keys:
+                                            additionalProperties:
+                                              type: string
+                                            description: |-
+                                              Keys are added to the instance metadata: at the top level with NoCloud, and under
+                                              the meta key with config drive. Keys generated by KubeVirt cannot be overridden.
+                                            type: object
+                                          labels:
+                                            description: Labels lists the VMI labels
+                                              exposed in the instance metadata, under
+                                              the labels key.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        type: object
                                       networkData:
                                         description: NetworkData contains config drive
                                           inline cloud-init networkdata.
@@ -31239,6 +34874,11 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                         type: object
                                         x-kubernetes-map-type: atomic
+                                      updatePolicy:
+                                        description: |-
+                                          UpdatePolicy defines how changes of the data sources are propagated to the guest.
+                                          Defaults to None.
+                                        type: string
                                       userData:
                                         description: UserData contains config drive
                                           inline cloud-init userdata.
@@ -31248,6 +34888,31 @@ var CRDsValidation map[string]string = map[string]string{
                                           drive cloud-init userdata as a base64 encoded
                                           string.
                                         type: string
+                                      vendorData:
+                                        description: VendorData contains config drive
+                                          inline cloud-init vendordata.
+                                        type: string
+                                      vendorDataBase64:
+                                        description: VendorDataBase64 contains config
+                                          drive cloud-init vendordata as a base64
+                                          encoded string.
+                                        type: string
+                                      vendorDataSecretRef:
+                                        description: VendorDataSecretRef references
+                                          a k8s secret that contains config drive
+                                          vendordata.
+                                        properties:
+                                          name:
+                                            default: ""
+                                            description: |-
+                                              Name of the referent.
+                                              This field is effectively required, but due to backwards compatibility is
+                                              allowed to be empty. Instances of this type with an empty value here are
+                                              almost certainly wrong.
+                                              More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            type: string
+                                        type: object
+                                        x-kubernetes-map-type: atomic
                                     type: object
                                   cloudInitNoCloud:
                                     description: |-
@@ -31255,6 +34920,39 @@ var CRDsValidation map[string]string = map[string]string{
                                       The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                                       More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                                     properties:
+                                      metaData:
+                                        description: MetaData adds user defined entries
+                                          to the NoCloud instance metadata.
+                                        properties:
+                                          annotations:
+                                            description: |-
+                                              Annotations lists the VMI annotations exposed in the instance metadata, under the
+                                              annotations key.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          keys:
+                                            additionalProperties:
+                                              type: string
+                                            description: |-
+                                              Keys are added to the instance metadata: at the top level with NoCloud, and under
+                                              the meta key with config drive. Keys generated by KubeVirt cannot be overridden.
+                                            type: object
+                                          labels:
+                                            description: Labels lists the VMI labels
+                                              exposed in the instance metadata, under
+                                              the labels key.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        type: object
+                                      metadataService:
+                                        description: MetadataService additionally
+                                          serves the NoCloud data to the guest over
+                                          HTTP.
+                                        type: object
                                       networkData:
                                         description: NetworkData contains NoCloud
                                           inline cloud-init networkdata.
@@ -31294,6 +34992,11 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                         type: object
                                         x-kubernetes-map-type: atomic
+                                      updatePolicy:
+                                        description: |-
+                                          UpdatePolicy defines how changes of the data sources are propagated to the guest.
+                                          Defaults to None.
+                                        type: string
                                       userData:
                                         description: UserData contains NoCloud inline
                                           cloud-init userdata.
@@ -31303,6 +35006,30 @@ var CRDsValidation map[string]string = map[string]string{
                                           cloud-init userdata as a base64 encoded
                                           string.
                                         type: string
+                                      vendorData:
+                                        description: VendorData contains NoCloud inline
+                                          cloud-init vendordata.
+                                        type: string
+                                      vendorDataBase64:
+                                        description: VendorDataBase64 contains NoCloud
+                                          cloud-init vendordata as a base64 encoded
+                                          string.
+                                        type: string
+                                      vendorDataSecretRef:
+                                        description: VendorDataSecretRef references
+                                          a k8s secret that contains NoCloud vendordata.
+                                        properties:
+                                          name:
+                                            default: ""
+                                            description: |-
+                                              Name of the referent.
+                                              This field is effectively required, but due to backwards compatibility is
+                                              allowed to be empty. Instances of this type with an empty value here are
+                                              almost certainly wrong.
+                                              More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            type: string
+                                        type: object
+                                        x-kubernetes-map-type: atomic
                                     type: object
                                   configMap:
                                     description: |-
@@ -31564,6 +35291,37 @@ var CRDsValidation map[string]string = map[string]string{
                                     - path
                                     - type
                                     type: object
+                                  ignition:
+                                    description: |-
+                                      Ignition represents an Ignition config source.
+                                      The config is passed to the guest through QEMU fw_cfg, or as a raw disk when the volume is attached as a disk.
+                                      More info: https://coreos.github.io/ignition/
+                                    properties:
+                                      config:
+                                        description: Config contains the inline Ignition
+                                          config.
+                                        type: string
+                                      configBase64:
+                                        description: ConfigBase64 contains the Ignition
+                                          config as a base64 encoded string.
+                                        type: string
+                                      configSecretRef:
+                                        description: ConfigSecretRef references a
+                                          k8s secret that contains the Ignition config
+                                          under the "config" key.
+                                        properties:
+                                          name:
+                                            default: ""
+                                            description: |-
+                                              Name of the referent.
+                                              This field is effectively required, but due to backwards compatibility is
+                                              allowed to be empty. Instances of this type with an empty value here are
+                                              almost certainly wrong.
+                                              More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            type: string
+                                        type: object
+                                        x-kubernetes-map-type: atomic
+                                    type: object
                                   memoryDump:
                                     description: MemoryDump is attached to the virt
                                       launcher and is populated with a memory dump
@@ -31685,6 +35443,97 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                         type: object
                                         x-kubernetes-map-type: atomic
+                                      unattend:
+                                        description: |-
+                                          Unattend describes a Sysprep answer file that is generated by KubeVirt and attached as disk of CDROM type,
+                                          as an alternative to providing the answer file in a Secret or ConfigMap.
+                                        properties:
+                                          adminPasswordSecretRef:
+                                            description: |-
+                                              AdminPasswordSecretRef references a k8s secret that contains the password of the local Administrator
+                                              account under the "password" key.
+                                            properties:
+                                              name:
+                                                default: ""
+                                                description: |-
+                                                  Name of the referent.
+                                                  This field is effectively required, but due to backwards compatibility is
+                                                  allowed to be empty. Instances of this type with an empty value here are
+                                                  almost certainly wrong.
+                                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                type: string
+                                            type: object
+                                            x-kubernetes-map-type: atomic
+                                          computerName:
+                                            description: ComputerName is the name
+                                              of the Windows computer. Defaults to
+                                              the hostname of the VMI, truncated to
+                                              15 characters.
+                                            type: string
+                                          domainJoin:
+                                            description: DomainJoin joins the computer
+                                              to an Active Directory domain.
+                                            properties:
+                                              credentialsSecretRef:
+                                                description: |-
+                                                  CredentialsSecretRef references a k8s secret that contains the "username" and "password" of an
+                                                  account which is allowed to join computers to the domain.
+                                                properties:
+                                                  name:
+                                                    default: ""
+                                                    description: |-
+                                                      Name of the referent.
+                                                      This field is effectively required, but due to backwards compatibility is
+                                                      allowed to be empty. Instances of this type with an empty value here are
+                                                      almost certainly wrong.
+                                                      More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                    type: string
+                                                type: object
+                                                x-kubernetes-map-type: atomic
+                                              domain:
+                                                description: Domain is the name of
+                                                  the domain to join.
+                                                type: string
+                                              organizationalUnit:
+                                                description: OrganizationalUnit is
+                                                  the distinguished name of the organizational
+                                                  unit the computer account is created
+                                                  in.
+                                                type: string
+                                            required:
+                                            - credentialsSecretRef
+                                            - domain
+                                            type: object
+                                          driverPaths:
+                                            description: |-
+                                              DriverPaths are searched for drivers during the setup, e.g. the directories of the virtio-win
+                                              drivers on a CDROM, like E:\amd64\w11.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          firstLogonCommands:
+                                            description: |-
+                                              FirstLogonCommands are run in order when the Administrator logs on for the first time.
+                                              The Administrator is logged on automatically once to run them when AdminPasswordSecretRef is set.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          locale:
+                                            description: Locale is the input, system,
+                                              user interface and user locale, e.g.
+                                              en-US.
+                                            type: string
+                                          productKey:
+                                            description: ProductKey is the Windows
+                                              product key.
+                                            type: string
+                                          timeZone:
+                                            description: TimeZone is the Windows time
+                                              zone, e.g. UTC or Pacific Standard Time.
+                                            type: string
+                                        type: object
                                     type: object
                                 required:
                                 - name
@@ -31851,6 +35700,44 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Name is the name of resource
                           type: string
                       type: object
+                    ipAllocations:
+                      description: |-
+                        IPAllocations holds the addresses allocated to the VirtualMachine secondary networks
+                        from the KubeVirt IP pool of their NetworkAttachmentDefinition.
+                        The allocations are kept across restarts and migrations of the VirtualMachine.
+                      items:
+                        properties:
+                          gateway:
+                            description: Gateway of the subnet.
+                            type: string
+                          ipAddresses:
+                            description: IPAddresses allocated to the network, in
+                              CIDR notation with the subnet prefix length.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          nameservers:
+                            description: Nameservers advertised to the guest.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          network:
+                            description: Network is the name of the VirtualMachine
+                              network the addresses are allocated to.
+                            type: string
+                          networkAttachmentDefinition:
+                            description: NetworkAttachmentDefinition holding the IP
+                              pool, in the <namespace>/<name> format.
+                            type: string
+                        required:
+                        - ipAddresses
+                        - network
+                        - networkAttachmentDefinition
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
                     memoryDumpRequest:
                       description: |-
                         MemoryDumpRequest tracks memory dump request phase and info of getting a memory
@@ -32109,6 +35996,113 @@ var CRDsValidation map[string]string = map[string]string{
                                       IO specifies which QEMU disk IO mode should be used.
                                       Supported values are: native, default, threads.
                                     type: string
+                                  ioTune:
+                                    description: |-
+                                      IOTune specifies I/O throttling limits enforced by the hypervisor for this disk.
+                                      With the LiveUpdate rollout strategy the limits can be changed on a running VM.
+                                    properties:
+                                      bytes:
+                                        description: Bytes limits the disk throughput
+                                          in bytes per second.
+                                        properties:
+                                          burst:
+                                            description: Burst allows exceeding the
+                                              sustained limits for a limited time.
+                                            properties:
+                                              lengthSeconds:
+                                                description: |-
+                                                  LengthSeconds is the duration of a burst.
+                                                  Defaults to 1.
+                                                format: int64
+                                                type: integer
+                                              read:
+                                                description: Read is the maximum read
+                                                  rate during a burst.
+                                                format: int64
+                                                type: integer
+                                              total:
+                                                description: Total is the maximum
+                                                  rate for reads and writes combined
+                                                  during a burst.
+                                                format: int64
+                                                type: integer
+                                              write:
+                                                description: Write is the maximum
+                                                  write rate during a burst.
+                                                format: int64
+                                                type: integer
+                                            type: object
+                                          read:
+                                            description: Read limits reads.
+                                            format: int64
+                                            type: integer
+                                          total:
+                                            description: Total limits reads and writes
+                                              combined.
+                                            format: int64
+                                            type: integer
+                                          write:
+                                            description: Write limits writes.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      groupName:
+                                        description: |-
+                                          GroupName places the disk into a throttling group. All disks of a group share the
+                                          limits of the group.
+                                        type: string
+                                      iops:
+                                        description: IOPS limits the number of I/O
+                                          operations per second.
+                                        properties:
+                                          burst:
+                                            description: Burst allows exceeding the
+                                              sustained limits for a limited time.
+                                            properties:
+                                              lengthSeconds:
+                                                description: |-
+                                                  LengthSeconds is the duration of a burst.
+                                                  Defaults to 1.
+                                                format: int64
+                                                type: integer
+                                              read:
+                                                description: Read is the maximum read
+                                                  rate during a burst.
+                                                format: int64
+                                                type: integer
+                                              total:
+                                                description: Total is the maximum
+                                                  rate for reads and writes combined
+                                                  during a burst.
+                                                format: int64
+                                                type: integer
+                                              write:
+                                                description: Write is the maximum
+                                                  write rate during a burst.
+                                                format: int64
+                                                type: integer
+                                            type: object
+                                          read:
+                                            description: Read limits reads.
+                                            format: int64
+                                            type: integer
+                                          total:
+                                            description: Total limits reads and writes
+                                              combined.
+                                            format: int64
+                                            type: integer
+                                          write:
+                                            description: Write limits writes.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      sizeIOPS:
+                                        description: |-
+                                          SizeIOPS is the size in bytes of a single I/O operation when IOPS limits are accounted.
+                                          Larger requests are counted as multiple operations.
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   lun:
                                     description: Attach a volume as a LUN to the vmi.
                                     properties:
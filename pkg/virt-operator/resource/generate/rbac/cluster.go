@@ -92,6 +92,11 @@ const (
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
+	apiVMInstancesGuestExec                 = "virtualmachineinstances/guestexec"
+	apiVMInstancesGuestFile                 = "virtualmachineinstances/guestfile"
+	apiVMInstancesGuestUser                 = "virtualmachineinstances/guestuser"
+	apiVMInstancesNetStat                   = "virtualmachineinstances/netstat"
+	apiVMInstancesPcap                      = "virtualmachineinstances/pcap"
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	apiVMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
@@ -214,11 +219,14 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesNetStat,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
+					apiVMInstancesPcap,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
+					apiVMInstancesGuestFile,
 				},
 				Verbs: []string{
 					"get",
@@ -240,6 +248,9 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
 					apiVMInstancesEvacuateCancel,
+					apiVMInstancesGuestExec,
+					apiVMInstancesGuestFile,
+					apiVMInstancesGuestUser,
 				},
 				Verbs: []string{
 					"update",
@@ -423,9 +434,11 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesNetStat,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
+					apiVMInstancesPcap,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
 				},
@@ -690,6 +703,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesNetStat,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMObjectGraph,
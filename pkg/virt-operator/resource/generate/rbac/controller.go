@@ -611,7 +611,7 @@ func newControllerClusterRole(includeNADRules bool) *rbacv1.ClusterRole {
 			Resources: []string{
 				"network-attachment-definitions",
 			},
-			Verbs: []string{"get"},
+			Verbs: []string{"get", "list", "watch"},
 		})
 	}
 	return cr
@@ -221,7 +221,7 @@ func newHandlerRole(namespace string) *rbacv1.Role {
 					"configmaps",
 				},
 				Verbs: []string{
-					"get", "list", "watch",
+					"get", "list", "watch", "create", "update", "delete",
 				},
 			},
 		},
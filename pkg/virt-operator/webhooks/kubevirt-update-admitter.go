@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"strings"
 
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -44,6 +45,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/hooks/domainpatch"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
@@ -82,6 +85,10 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 	results = append(results, validateGuestToRequestHeadroom(newKV.Spec.Configuration.AdditionalGuestMemoryOverheadRatio)...)
 	results = append(results, validateVirtTemplateDeployment(&newKV.Spec.Configuration)...)
 	results = append(results, validateRoleAggregationStrategy(&newKV.Spec.Configuration)...)
+	results = append(results, validateMACPool(newKV.Spec.Configuration.NetworkConfiguration)...)
+	results = append(results, validateDomainPatches(newKV.Spec.Configuration.DomainPatches)...)
+	results = append(results, validateGuestExec(newKV.Spec.Configuration.GuestExec)...)
+	results = append(results, validateGuestFileTransfer(newKV.Spec.Configuration.GuestFileTransfer)...)
 
 	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.TLSConfiguration, newKV.Spec.Configuration.TLSConfiguration) {
 		if newKV.Spec.Configuration.TLSConfiguration != nil {
@@ -563,3 +570,177 @@ func validateRoleAggregationStrategy(config *v1.KubeVirtConfiguration) []metav1.
 		Message: fmt.Sprintf("RoleAggregationStrategy cannot be set to Manual without enabling the %s feature gate", featuregate.OptOutRoleAggregation),
 	}}
 }
+
+func validateMACPool(networkConfig *v1.NetworkConfiguration) []metav1.StatusCause {
+	if networkConfig == nil || networkConfig.MACPool == nil {
+		return nil
+	}
+	macPoolField := field.NewPath("spec", "configuration", "network", "macPool")
+
+	causes := validateMACRanges(macPoolField.Child("ranges"), networkConfig.MACPool.Ranges)
+	namespaces := map[string]struct{}{}
+	for idx, override := range networkConfig.MACPool.NamespaceOverrides {
+		overrideField := macPoolField.Child("namespaceOverrides").Index(idx)
+		if _, exists := namespaces[override.Namespace]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Field:   overrideField.Child("namespace").String(),
+				Message: fmt.Sprintf("MAC pool namespace %q is overridden more than once", override.Namespace),
+			})
+		}
+		namespaces[override.Namespace] = struct{}{}
+		causes = append(causes, validateMACRanges(overrideField.Child("ranges"), override.Ranges)...)
+	}
+	return causes
+}
+
+func validateMACRanges(fieldPath *field.Path, ranges []v1.MACRange) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, macRange := range ranges {
+		if err := macpool.ValidateRange(macRange); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fieldPath.Index(idx).String(),
+				Message: fmt.Sprintf("invalid MAC pool range: %v", err),
+			})
+		}
+	}
+	return causes
+}
+
+func validateDomainPatches(patches []v1.DomainPatch) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	patchesField := field.NewPath("spec", "configuration", "domainPatches")
+	names := map[string]struct{}{}
+	for idx, patch := range patches {
+		patchField := patchesField.Index(idx)
+		if patch.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   patchField.Child("name").String(),
+				Message: "domain patch name must not be empty",
+			})
+		} else if _, exists := names[patch.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Field:   patchField.Child("name").String(),
+				Message: fmt.Sprintf("domain patch %q is defined more than once", patch.Name),
+			})
+		}
+		names[patch.Name] = struct{}{}
+
+		if patch.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(patch.Selector); err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   patchField.Child("selector").String(),
+					Message: fmt.Sprintf("invalid domain patch selector: %v", err),
+				})
+			}
+		}
+		if patch.NamespaceSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(patch.NamespaceSelector); err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   patchField.Child("namespaceSelector").String(),
+					Message: fmt.Sprintf("invalid domain patch namespace selector: %v", err),
+				})
+			}
+		}
+
+		if len(patch.Operations) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   patchField.Child("operations").String(),
+				Message: fmt.Sprintf("domain patch %q must define at least one operation", patch.Name),
+			})
+		}
+		for opIdx, operation := range patch.Operations {
+			if err := domainpatch.ValidateOperation(operation); err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   patchField.Child("operations").Index(opIdx).String(),
+					Message: fmt.Sprintf("invalid domain patch operation: %v", err),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+func validateGuestExec(guestExec *v1.GuestExecConfiguration) []metav1.StatusCause {
+	if guestExec == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	guestExecField := field.NewPath("spec", "configuration", "guestExec")
+	causes = append(causes, validateGuestExecCommands(guestExecField.Child("allowedCommands"), guestExec.AllowedCommands)...)
+	for idx, allowList := range guestExec.NamespaceAllowedCommands {
+		allowListField := guestExecField.Child("namespaceAllowedCommands").Index(idx)
+		if _, err := metav1.LabelSelectorAsSelector(&allowList.NamespaceSelector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   allowListField.Child("namespaceSelector").String(),
+				Message: fmt.Sprintf("invalid namespace selector: %v", err),
+			})
+		}
+		causes = append(causes, validateGuestExecCommands(allowListField.Child("allowedCommands"), allowList.AllowedCommands)...)
+	}
+
+	if guestExec.MaxOutputBytes != nil && *guestExec.MaxOutputBytes <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   guestExecField.Child("maxOutputBytes").String(),
+			Message: "maxOutputBytes must be positive",
+		})
+	}
+	if guestExec.DefaultTimeoutSeconds != nil && *guestExec.DefaultTimeoutSeconds <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   guestExecField.Child("defaultTimeoutSeconds").String(),
+			Message: "defaultTimeoutSeconds must be positive",
+		})
+	}
+	if guestExec.MaxTimeoutSeconds != nil && *guestExec.MaxTimeoutSeconds <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   guestExecField.Child("maxTimeoutSeconds").String(),
+			Message: "maxTimeoutSeconds must be positive",
+		})
+	}
+	if guestExec.DefaultTimeoutSeconds != nil && guestExec.MaxTimeoutSeconds != nil &&
+		*guestExec.MaxTimeoutSeconds > 0 && *guestExec.DefaultTimeoutSeconds > *guestExec.MaxTimeoutSeconds {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   guestExecField.Child("defaultTimeoutSeconds").String(),
+			Message: "defaultTimeoutSeconds must not be greater than maxTimeoutSeconds",
+		})
+	}
+	return causes
+}
+
+func validateGuestFileTransfer(guestFileTransfer *v1.GuestFileTransferConfiguration) []metav1.StatusCause {
+	if guestFileTransfer == nil || guestFileTransfer.MaxFileSizeBytes == nil || *guestFileTransfer.MaxFileSizeBytes > 0 {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Field:   field.NewPath("spec", "configuration", "guestFileTransfer", "maxFileSizeBytes").String(),
+		Message: "maxFileSizeBytes must be positive",
+	}}
+}
+
+func validateGuestExecCommands(fieldPath *field.Path, commands []string) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, command := range commands {
+		if strings.TrimSpace(command) == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fieldPath.Index(idx).String(),
+				Message: "allowed guest command must not be empty",
+			})
+		}
+	}
+	return causes
+}
@@ -149,6 +149,202 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		),
 	)
 
+	DescribeTable("validateMACPool", func(networkConfig *v1.NetworkConfiguration, expectedFields []string) {
+		causes := validateMACPool(networkConfig)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("should allow a nil network configuration", nil, nil),
+		Entry("should allow a network configuration without a MAC pool", &v1.NetworkConfiguration{}, nil),
+		Entry("should allow valid ranges and overrides",
+			&v1.NetworkConfiguration{MACPool: &v1.MACPoolConfiguration{
+				Ranges: []v1.MACRange{{Start: "02:00:00:00:00:00", End: "02:00:00:ff:ff:ff"}},
+				NamespaceOverrides: []v1.NamespaceMACPool{
+					{Namespace: "ns1", Ranges: []v1.MACRange{{Start: "02:10:00:00:00:00", End: "02:10:00:00:00:ff"}}},
+					{Namespace: "ns2"},
+				},
+			}},
+			nil,
+		),
+		Entry("should reject invalid ranges",
+			&v1.NetworkConfiguration{MACPool: &v1.MACPoolConfiguration{
+				Ranges: []v1.MACRange{
+					{Start: "02:00:00:00:00:00", End: "02:00:00:ff:ff:ff"},
+					{Start: "01:00:00:00:00:00", End: "01:00:00:00:00:ff"},
+				},
+				NamespaceOverrides: []v1.NamespaceMACPool{
+					{Namespace: "ns1", Ranges: []v1.MACRange{{Start: "02:10:00:00:00:ff", End: "02:10:00:00:00:00"}}},
+				},
+			}},
+			[]string{
+				"spec.configuration.network.macPool.ranges[1]",
+				"spec.configuration.network.macPool.namespaceOverrides[0].ranges[0]",
+			},
+		),
+		Entry("should reject a namespace overridden more than once",
+			&v1.NetworkConfiguration{MACPool: &v1.MACPoolConfiguration{
+				NamespaceOverrides: []v1.NamespaceMACPool{{Namespace: "ns1"}, {Namespace: "ns1"}},
+			}},
+			[]string{"spec.configuration.network.macPool.namespaceOverrides[1].namespace"},
+		),
+	)
+
+	DescribeTable("validateDomainPatches", func(patches []v1.DomainPatch, expectedFields []string) {
+		causes := validateDomainPatches(patches)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("should allow no patches", nil, nil),
+		Entry("should allow valid patches",
+			[]v1.DomainPatch{
+				{
+					Name:       "no-memballoon",
+					Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/memballoon"}},
+				},
+				{
+					Name:     "hide-kvm",
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"workload": "gpu"}},
+					Operations: []v1.DomainPatchOperation{
+						{Op: v1.DomainPatchOperationAdd, Path: "/domain/features", Value: `<kvm><hidden state="on"/></kvm>`},
+					},
+				},
+			},
+			nil,
+		),
+		Entry("should reject missing and duplicate names",
+			[]v1.DomainPatch{
+				{Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/rng"}}},
+				{Name: "patch", Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/rng"}}},
+				{Name: "patch", Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/rng"}}},
+			},
+			[]string{
+				"spec.configuration.domainPatches[0].name",
+				"spec.configuration.domainPatches[2].name",
+			},
+		),
+		Entry("should reject an invalid selector",
+			[]v1.DomainPatch{{
+				Name: "patch",
+				Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "workload", Operator: "Bogus"},
+				}},
+				Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/rng"}},
+			}},
+			[]string{"spec.configuration.domainPatches[0].selector"},
+		),
+		Entry("should reject an invalid namespace selector",
+			[]v1.DomainPatch{{
+				Name: "patch",
+				NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tenant", Operator: "Bogus"},
+				}},
+				Operations: []v1.DomainPatchOperation{{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/rng"}},
+			}},
+			[]string{"spec.configuration.domainPatches[0].namespaceSelector"},
+		),
+		Entry("should reject a patch without operations",
+			[]v1.DomainPatch{{Name: "patch"}},
+			[]string{"spec.configuration.domainPatches[0].operations"},
+		),
+		Entry("should reject invalid operations",
+			[]v1.DomainPatch{{
+				Name: "patch",
+				Operations: []v1.DomainPatchOperation{
+					{Op: v1.DomainPatchOperationRemove, Path: "/domain/devices/rng"},
+					{Op: v1.DomainPatchOperationRemove, Path: "/domain"},
+					{Op: v1.DomainPatchOperationAdd, Path: "/domain/devices", Value: "<watchdog>"},
+				},
+			}},
+			[]string{
+				"spec.configuration.domainPatches[0].operations[1]",
+				"spec.configuration.domainPatches[0].operations[2]",
+			},
+		),
+	)
+
+	DescribeTable("validateGuestExec", func(guestExec *v1.GuestExecConfiguration, expectedFields []string) {
+		causes := validateGuestExec(guestExec)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("should allow no configuration", nil, nil),
+		Entry("should allow a valid configuration",
+			&v1.GuestExecConfiguration{
+				AllowedCommands: []string{"/usr/bin/uptime"},
+				NamespaceAllowedCommands: []v1.GuestExecNamespaceAllowList{{
+					NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "ops"}},
+					AllowedCommands:   []string{"/usr/bin/journalctl"},
+				}},
+				MaxOutputBytes:        pointer.P(int64(1024)),
+				DefaultTimeoutSeconds: pointer.P(int32(5)),
+				MaxTimeoutSeconds:     pointer.P(int32(30)),
+			},
+			nil,
+		),
+		Entry("should reject empty commands",
+			&v1.GuestExecConfiguration{
+				AllowedCommands: []string{"/usr/bin/uptime", " "},
+				NamespaceAllowedCommands: []v1.GuestExecNamespaceAllowList{{
+					AllowedCommands: []string{""},
+				}},
+			},
+			[]string{
+				"spec.configuration.guestExec.allowedCommands[1]",
+				"spec.configuration.guestExec.namespaceAllowedCommands[0].allowedCommands[0]",
+			},
+		),
+		Entry("should reject an invalid namespace selector",
+			&v1.GuestExecConfiguration{
+				NamespaceAllowedCommands: []v1.GuestExecNamespaceAllowList{{
+					NamespaceSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "team", Operator: "Bogus"},
+					}},
+					AllowedCommands: []string{"/usr/bin/uptime"},
+				}},
+			},
+			[]string{"spec.configuration.guestExec.namespaceAllowedCommands[0].namespaceSelector"},
+		),
+		Entry("should reject non positive limits",
+			&v1.GuestExecConfiguration{
+				MaxOutputBytes:        pointer.P(int64(0)),
+				DefaultTimeoutSeconds: pointer.P(int32(0)),
+				MaxTimeoutSeconds:     pointer.P(int32(-1)),
+			},
+			[]string{
+				"spec.configuration.guestExec.maxOutputBytes",
+				"spec.configuration.guestExec.defaultTimeoutSeconds",
+				"spec.configuration.guestExec.maxTimeoutSeconds",
+			},
+		),
+		Entry("should reject a default timeout greater than the maximum",
+			&v1.GuestExecConfiguration{
+				DefaultTimeoutSeconds: pointer.P(int32(30)),
+				MaxTimeoutSeconds:     pointer.P(int32(10)),
+			},
+			[]string{"spec.configuration.guestExec.defaultTimeoutSeconds"},
+		),
+	)
+
+	DescribeTable("validateGuestFileTransfer", func(guestFileTransfer *v1.GuestFileTransferConfiguration, expectedCauses int) {
+		causes := validateGuestFileTransfer(guestFileTransfer)
+		Expect(causes).To(HaveLen(expectedCauses))
+		for _, cause := range causes {
+			Expect(cause.Field).To(Equal("spec.configuration.guestFileTransfer.maxFileSizeBytes"))
+		}
+	},
+		Entry("should allow no configuration", nil, 0),
+		Entry("should allow no size limit", &v1.GuestFileTransferConfiguration{}, 0),
+		Entry("should allow a positive size limit", &v1.GuestFileTransferConfiguration{MaxFileSizeBytes: pointer.P(int64(1024))}, 0),
+		Entry("should reject a zero size limit", &v1.GuestFileTransferConfiguration{MaxFileSizeBytes: pointer.P(int64(0))}, 1),
+		Entry("should reject a negative size limit", &v1.GuestFileTransferConfiguration{MaxFileSizeBytes: pointer.P(int64(-1))}, 1),
+	)
+
 	DescribeTable("validateSeccompConfiguration", func(seccompConfiguration *v1.SeccompConfiguration, expectedFields []string) {
 		causes := validateSeccompConfiguration(test, seccompConfiguration)
 		Expect(causes).To(HaveLen(len(expectedFields)))
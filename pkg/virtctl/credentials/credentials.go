@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"kubevirt.io/kubevirt/pkg/virtctl/credentials/addkey"
+	"kubevirt.io/kubevirt/pkg/virtctl/credentials/guestuser"
 	"kubevirt.io/kubevirt/pkg/virtctl/credentials/password"
 	"kubevirt.io/kubevirt/pkg/virtctl/credentials/removekey"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
@@ -22,6 +23,9 @@ func NewCommand() *cobra.Command {
 		addkey.NewCommand(),
 		removekey.NewCommand(),
 		password.SetPasswordCommand(),
+		guestuser.CreateUserCommand(),
+		guestuser.LockUserCommand(),
+		guestuser.UnlockUserCommand(),
 	)
 
 	cmd.SetUsageTemplate(templates.UsageTemplate())
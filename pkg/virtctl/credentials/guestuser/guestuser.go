@@ -0,0 +1,106 @@
+package guestuser
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	userFlag     = "user"
+	passwordFlag = "password"
+)
+
+func CreateUserCommand() *cobra.Command {
+	c := &guestUserCommand{action: v1.GuestUserCreate}
+	cmd := &cobra.Command{
+		Use:   "create-user",
+		Short: "Create a user in the guest of a running virtual machine through the guest agent",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Create a user without password in the guest of a virtual machine.
+  {{ProgramName}} credentials create-user --user <username> <vm-name>
+
+  # Create a user and set its password.
+  {{ProgramName}} credentials create-user --user <username> --password <password> <vm-name>
+`,
+		RunE: c.run,
+	}
+	c.addUserFlag(cmd)
+	cmd.Flags().StringVarP(&c.password, passwordFlag, "p", "", "Password for the new user")
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func LockUserCommand() *cobra.Command {
+	c := &guestUserCommand{action: v1.GuestUserLock}
+	cmd := &cobra.Command{
+		Use:   "lock-user",
+		Short: "Lock a user in the guest of a running virtual machine through the guest agent",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Prevent a user from logging into the guest of a virtual machine.
+  {{ProgramName}} credentials lock-user --user <username> <vm-name>
+`,
+		RunE: c.run,
+	}
+	c.addUserFlag(cmd)
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func UnlockUserCommand() *cobra.Command {
+	c := &guestUserCommand{action: v1.GuestUserUnlock}
+	cmd := &cobra.Command{
+		Use:   "unlock-user",
+		Short: "Unlock a user in the guest of a running virtual machine through the guest agent",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Allow a locked user to log into the guest of a virtual machine again.
+  {{ProgramName}} credentials unlock-user --user <username> <vm-name>
+`,
+		RunE: c.run,
+	}
+	c.addUserFlag(cmd)
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+type guestUserCommand struct {
+	action   v1.GuestUserAction
+	user     string
+	password string
+}
+
+func (c *guestUserCommand) addUserFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&c.user, userFlag, "u", "", "Name of the user.")
+	if err := cmd.MarkFlagRequired(userFlag); err != nil {
+		panic(err)
+	}
+}
+
+func (c *guestUserCommand) run(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	cli, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("error getting kubevirt client or namespace: %w", err)
+	}
+
+	err = cli.VirtualMachineInstance(namespace).GuestUser(cmd.Context(), vmName, &v1.VirtualMachineInstanceGuestUserOptions{
+		Action:   c.action,
+		Username: c.user,
+		Password: c.password,
+	})
+	if err != nil {
+		return fmt.Errorf("error applying %s to user %s in the guest: %w", c.action, c.user, err)
+	}
+
+	cmd.Printf("Successfully applied %s to user %s in the guest", c.action, c.user)
+	return nil
+}
@@ -0,0 +1,13 @@
+package guestuser_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGuestUser(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GuestUser Suite")
+}
@@ -0,0 +1,76 @@
+package guestuser_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Credentials guest users", func() {
+	const (
+		vmName   = "test-vm"
+		userName = "test-user"
+		testPass = "test-pass"
+	)
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(vmiInterface).AnyTimes()
+	})
+
+	DescribeTable("should fail if no user is specified", func(command string) {
+		err := testing.NewRepeatableVirtctlCommand("credentials", command, vmName)()
+		Expect(err).To(MatchError(ContainSubstring("required flag(s) \"user\" not set")))
+	},
+		Entry("to create a user", "create-user"),
+		Entry("to lock a user", "lock-user"),
+		Entry("to unlock a user", "unlock-user"),
+	)
+
+	DescribeTable("should apply the action to the guest user", func(command string, action v1.GuestUserAction) {
+		vmiInterface.EXPECT().GuestUser(gomock.Any(), vmName, &v1.VirtualMachineInstanceGuestUserOptions{
+			Action:   action,
+			Username: userName,
+		}).Return(nil)
+
+		err := testing.NewRepeatableVirtctlCommand("credentials", command, "--user", userName, vmName)()
+		Expect(err).ToNot(HaveOccurred())
+	},
+		Entry("to create a user", "create-user", v1.GuestUserCreate),
+		Entry("to lock a user", "lock-user", v1.GuestUserLock),
+		Entry("to unlock a user", "unlock-user", v1.GuestUserUnlock),
+	)
+
+	It("should create a user with a password", func() {
+		vmiInterface.EXPECT().GuestUser(gomock.Any(), vmName, &v1.VirtualMachineInstanceGuestUserOptions{
+			Action:   v1.GuestUserCreate,
+			Username: userName,
+			Password: testPass,
+		}).Return(nil)
+
+		err := testing.NewRepeatableVirtctlCommand("credentials", "create-user", "--user", userName, "--password", testPass, vmName)()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should fail when the guest agent fails", func() {
+		vmiInterface.EXPECT().GuestUser(gomock.Any(), vmName, gomock.Any()).Return(errors.New("guest agent not connected"))
+
+		err := testing.NewRepeatableVirtctlCommand("credentials", "lock-user", "--user", userName, vmName)()
+		Expect(err).To(MatchError("error applying Lock to user test-user in the guest: guest agent not connected"))
+	})
+})
@@ -35,6 +35,9 @@ const exampleUsage = `  # Set a user password for a virtual machine.
 
   # Set a user password in a secret that is not owned by the virtual machine.
   {{ProgramName}} credentials set-password --user <username> --password <password> --force <vm-name>
+
+  # Set a user password directly in the guest of a running virtual machine, without changing any secret.
+  {{ProgramName}} credentials set-password --user <username> --password <password> --guest-agent <vm-name>
 `
 
 type passwordCommandFlags struct {
@@ -43,6 +46,8 @@ type passwordCommandFlags struct {
 	Password string
 
 	Force bool
+
+	GuestAgent bool
 }
 
 func (p *passwordCommandFlags) AddToCommand(cmd *cobra.Command) {
@@ -56,6 +61,12 @@ func (p *passwordCommandFlags) AddToCommand(cmd *cobra.Command) {
 	}
 
 	cmd.Flags().BoolVar(&p.Force, "force", false, "Force update of secret, even if it's not owned by the VM.")
+
+	const guestAgentFlag = "guest-agent"
+	cmd.Flags().BoolVar(&p.GuestAgent, guestAgentFlag, false,
+		"Set the password once in the guest of the running VM through the guest agent, instead of storing it in the secret.")
+	cmd.MarkFlagsMutuallyExclusive(guestAgentFlag, "secret")
+	cmd.MarkFlagsMutuallyExclusive(guestAgentFlag, "force")
 }
 
 func (p *passwordCommandFlags) runSetPasswordCommand(cmd *cobra.Command, args []string) error {
@@ -66,6 +77,19 @@ func (p *passwordCommandFlags) runSetPasswordCommand(cmd *cobra.Command, args []
 		return fmt.Errorf("error getting kubevirt client or namespace: %w", err)
 	}
 
+	if p.GuestAgent {
+		err = cli.VirtualMachineInstance(vmNamespace).GuestUser(cmd.Context(), vmName, &v1.VirtualMachineInstanceGuestUserOptions{
+			Action:   v1.GuestUserSetPassword,
+			Username: p.User,
+			Password: p.Password,
+		})
+		if err != nil {
+			return fmt.Errorf("error setting the password of user %s in the guest: %w", p.User, err)
+		}
+		cmd.Printf("Successfully set the password of user %s in the guest", p.User)
+		return nil
+	}
+
 	vm, err := cli.VirtualMachine(vmNamespace).Get(cmd.Context(), vmName, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("error getting virtual machine: %w", err)
@@ -2,6 +2,7 @@ package password_test
 
 import (
 	"context"
+	"errors"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -190,6 +191,59 @@ var _ = Describe("Credentials set-password", func() {
 
 		expectSecretToContainUserWithPassword(kubeClient, secondSecretName, userName, testPass)
 	})
+
+	Context("through the guest agent", func() {
+		var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+		BeforeEach(func() {
+			vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(gomock.NewController(GinkgoT()))
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).
+				Return(vmiInterface).AnyTimes()
+		})
+
+		It("should set the password in the guest without patching the secret", func() {
+			vmiInterface.EXPECT().GuestUser(gomock.Any(), vmName, &v1.VirtualMachineInstanceGuestUserOptions{
+				Action:   v1.GuestUserSetPassword,
+				Username: userName,
+				Password: testPass,
+			}).Return(nil)
+
+			err := runSetPasswordCommand(
+				"--user", userName,
+				"--password", testPass,
+				"--guest-agent",
+				vmName,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), secretName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret.Data).To(BeEmpty())
+		})
+
+		It("should fail when the guest agent fails", func() {
+			vmiInterface.EXPECT().GuestUser(gomock.Any(), vmName, gomock.Any()).Return(errors.New("guest agent not connected"))
+
+			err := runSetPasswordCommand(
+				"--user", userName,
+				"--password", testPass,
+				"--guest-agent",
+				vmName,
+			)
+			Expect(err).To(MatchError("error setting the password of user test-user in the guest: guest agent not connected"))
+		})
+
+		It("should not allow to select a secret", func() {
+			err := runSetPasswordCommand(
+				"--user", userName,
+				"--password", testPass,
+				"--guest-agent",
+				"--secret", secretName,
+				vmName,
+			)
+			Expect(err).To(MatchError(ContainSubstring("none of the others can be")))
+		})
+	})
 })
 
 func appendToAccessCredentials(virtClient *kubevirtfake.Clientset, vm *v1.VirtualMachine, accessCredential v1.AccessCredential) {
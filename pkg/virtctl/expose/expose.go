@@ -329,6 +329,10 @@ func podNetworkPorts(vmiSpec *v1.VirtualMachineInstanceSpec) []k8sv1.ServicePort
 			if device.Name == podNetworkName {
 				ports := []k8sv1.ServicePort{}
 				for i, port := range device.Ports {
+					// ICMP is not a service protocol and has no port to expose
+					if port.Protocol == "ICMP" {
+						continue
+					}
 					ports = append(ports, k8sv1.ServicePort{Name: fmt.Sprintf("port-%d", i+1), Protocol: k8sv1.Protocol(port.Protocol), Port: port.Port})
 				}
 				return ports
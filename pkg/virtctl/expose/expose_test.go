@@ -223,7 +223,7 @@ var _ = Describe("Expose", func() {
 		Context("with missing port but existing pod network ports", func() {
 			BeforeEach(func() {
 				addPodNetworkWithPorts := func(spec *v1.VirtualMachineInstanceSpec) {
-					ports := []v1.Port{{Name: "a", Protocol: "TCP", Port: 80}, {Name: "b", Protocol: "UDP", Port: 81}}
+					ports := []v1.Port{{Name: "a", Protocol: "TCP", Port: 80}, {Name: "b", Protocol: "UDP", Port: 81}, {Name: "c", Protocol: "ICMP"}}
 					spec.Networks = append(spec.Networks, v1.Network{Name: "pod", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}})
 					spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{Name: "pod", Ports: ports})
 				}
@@ -40,6 +40,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/version"
 	"kubevirt.io/kubevirt/pkg/virtctl/vm"
 	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
+	"kubevirt.io/kubevirt/pkg/virtctl/vmi"
 	"kubevirt.io/kubevirt/pkg/virtctl/vnc"
 )
 
@@ -118,6 +119,8 @@ func NewVirtctlCommandFn() *cobra.Command {
 		vm.NewGuestOsInfoCommand(),
 		vm.NewUserListCommand(),
 		vm.NewFSListCommand(),
+		vm.NewGuestExecCommand(),
+		vm.NewGuestCopyCommand(),
 		vm.NewAddVolumeCommand(),
 		vm.NewRemoveVolumeCommand(),
 		vm.NewExpandCommand(),
@@ -137,6 +140,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		adm.NewCommand(),
 		objectgraph.NewCommand(),
 		template.NewCommand(),
+		vmi.NewCommand(),
 		optionsCmd,
 	)
 
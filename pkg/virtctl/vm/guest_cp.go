@@ -0,0 +1,176 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	guestfile "kubevirt.io/kubevirt/pkg/guest-file"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/scp"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const COMMAND_GUESTCP = "guest-cp"
+
+func NewGuestCopyCommand() *cobra.Command {
+	c := &guestCopyCommand{}
+
+	cmd := &cobra.Command{
+		Use:     "guest-cp (SOURCE) (DESTINATION)",
+		Short:   "Copy a file from or to the guest of a virtual machine instance through the guest agent.",
+		Example: usageGuestCopy(),
+		Args:    cobra.ExactArgs(2),
+		RunE:    c.run,
+	}
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageGuestCopy() string {
+	return `  # Copy the file '/etc/hosts' of the guest of the virtual machine instance 'myvmi' to the working directory:
+  {{ProgramName}} guest-cp vmi/myvmi:/etc/hosts .
+
+  # Copy the local file 'setup.ps1' to 'C:\setup.ps1' in the guest of the virtual machine instance 'myvmi' in namespace 'mynamespace':
+  {{ProgramName}} guest-cp setup.ps1 vmi/myvmi/mynamespace:C:\setup.ps1`
+}
+
+type guestCopyCommand struct{}
+
+func (c *guestCopyCommand) run(cmd *cobra.Command, args []string) error {
+	local, remote, toRemote, err := scp.ParseTarget(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	if remote.Username != "" {
+		return fmt.Errorf("a username cannot be specified, files are copied as the user running the guest agent")
+	}
+	if remote.Path == "" {
+		return fmt.Errorf("the path of the file in the guest cannot be empty")
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if remote.Namespace != "" {
+		namespace = remote.Namespace
+	}
+	vmiClient := virtClient.VirtualMachineInstance(namespace)
+
+	if toRemote {
+		err = copyToGuest(cmd.Context(), vmiClient, remote.Name, local.Path, remote.Path)
+	} else {
+		err = copyFromGuest(cmd.Context(), vmiClient, remote.Name, remote.Path, local.Path)
+	}
+	if err != nil {
+		return fmt.Errorf("error copying %s to %s: %v", args[0], args[1], err)
+	}
+	return nil
+}
+
+// copyToGuest writes the local file to the guest, one chunk at a time.
+// The copy ends with a chunk shorter than the maximum chunk size, which is empty for empty files
+// and for files of whole chunks.
+func copyToGuest(ctx context.Context, vmiClient kubecli.VirtualMachineInstanceInterface, vmiName, localPath, guestPath string) error {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	buf := make([]byte, guestfile.MaxChunkBytes)
+	var offset int64
+	for {
+		n, err := io.ReadFull(file, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return err
+		}
+		if err := vmiClient.GuestFileWrite(ctx, vmiName, &v1.VirtualMachineInstanceGuestFileWriteOptions{
+			Path:   guestPath,
+			Offset: offset,
+			Data:   buf[:n],
+		}); err != nil {
+			return err
+		}
+		offset += int64(n)
+		if n < len(buf) {
+			return nil
+		}
+	}
+}
+
+// copyFromGuest reads the guest file, one chunk at a time, and writes it to the local path.
+// When the local path is a directory, the file keeps its guest name.
+func copyFromGuest(ctx context.Context, vmiClient kubecli.VirtualMachineInstanceInterface, vmiName, guestPath, localPath string) (err error) {
+	if info, statErr := os.Stat(localPath); statErr == nil && info.IsDir() {
+		localPath = filepath.Join(localPath, guestFileName(guestPath))
+	}
+
+	file, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	var offset int64
+	for {
+		chunk, err := vmiClient.GuestFileRead(ctx, vmiName, &v1.VirtualMachineInstanceGuestFileReadOptions{
+			Path:   guestPath,
+			Offset: offset,
+			Length: guestfile.MaxChunkBytes,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := file.Write(chunk.Data); err != nil {
+			return err
+		}
+		offset += int64(len(chunk.Data))
+		if chunk.EOF {
+			return nil
+		}
+		if len(chunk.Data) == 0 {
+			return fmt.Errorf("no data was read at offset %d of %d bytes", offset, chunk.Size)
+		}
+	}
+}
+
+// guestFileName returns the last element of the guest path, which may be a Windows path
+func guestFileName(guestPath string) string {
+	guestPath = strings.TrimRight(strings.ReplaceAll(guestPath, `\`, "/"), "/")
+	return guestPath[strings.LastIndex(guestPath, "/")+1:]
+}
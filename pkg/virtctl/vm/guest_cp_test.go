@@ -0,0 +1,176 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	guestfile "kubevirt.io/kubevirt/pkg/guest-file"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Guest copy command", func() {
+	const (
+		vmiName   = "testvmi"
+		guestPath = "/etc/hosts"
+	)
+
+	var (
+		ctrl         *gomock.Controller
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		tmpDir       string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().
+			VirtualMachineInstance(k8smetav1.NamespaceDefault).
+			Return(vmiInterface).
+			AnyTimes()
+		tmpDir = GinkgoT().TempDir()
+	})
+
+	DescribeTable("should reject invalid arguments", func(src, dst, expectedErr string) {
+		cmd := testing.NewRepeatableVirtctlCommand("guest-cp", src, dst)
+		Expect(cmd()).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("with two local paths", "hosts", "hosts.bak", "none of the two provided locations seems to be a remote location"),
+		Entry("with a username", "root@vmi/testvmi:/etc/hosts", "hosts", "a username cannot be specified"),
+		Entry("without guest path", "vmi/testvmi:", "hosts", "the path of the file in the guest cannot be empty"),
+	)
+
+	Context("from the guest", func() {
+		It("should read the file chunk by chunk", func() {
+			localPath := filepath.Join(tmpDir, "hosts")
+			gomock.InOrder(
+				vmiInterface.EXPECT().GuestFileRead(gomock.Any(), vmiName, &v1.VirtualMachineInstanceGuestFileReadOptions{
+					Path: guestPath, Offset: 0, Length: guestfile.MaxChunkBytes,
+				}).Return(v1.VirtualMachineInstanceGuestFileChunk{Data: []byte("127.0.0.1 "), Size: 19}, nil),
+				vmiInterface.EXPECT().GuestFileRead(gomock.Any(), vmiName, &v1.VirtualMachineInstanceGuestFileReadOptions{
+					Path: guestPath, Offset: 10, Length: guestfile.MaxChunkBytes,
+				}).Return(v1.VirtualMachineInstanceGuestFileChunk{Data: []byte("localhost"), Size: 19, EOF: true}, nil),
+			)
+
+			cmd := testing.NewRepeatableVirtctlCommand("guest-cp", "vmi/"+vmiName+":"+guestPath, localPath)
+			Expect(cmd()).To(Succeed())
+			Expect(os.ReadFile(localPath)).To(BeEquivalentTo("127.0.0.1 localhost"))
+		})
+
+		It("should keep the name of the file when copying to a directory", func() {
+			vmiInterface.EXPECT().GuestFileRead(gomock.Any(), vmiName, gomock.Any()).
+				Return(v1.VirtualMachineInstanceGuestFileChunk{Data: []byte("@echo off"), Size: 9, EOF: true}, nil)
+
+			cmd := testing.NewRepeatableVirtctlCommand("guest-cp", "vmi/"+vmiName+`:C:\setup.bat`, tmpDir)
+			Expect(cmd()).To(Succeed())
+			Expect(os.ReadFile(filepath.Join(tmpDir, "setup.bat"))).To(BeEquivalentTo("@echo off"))
+		})
+
+		It("should use the namespace of the target", func() {
+			otherVMIInterface := kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance("other").Return(otherVMIInterface)
+			otherVMIInterface.EXPECT().GuestFileRead(gomock.Any(), vmiName, gomock.Any()).
+				Return(v1.VirtualMachineInstanceGuestFileChunk{EOF: true}, nil)
+
+			cmd := testing.NewRepeatableVirtctlCommand("guest-cp", "vmi/"+vmiName+"/other:"+guestPath, filepath.Join(tmpDir, "hosts"))
+			Expect(cmd()).To(Succeed())
+		})
+
+		It("should fail when the file cannot be read", func() {
+			vmiInterface.EXPECT().GuestFileRead(gomock.Any(), vmiName, gomock.Any()).
+				Return(v1.VirtualMachineInstanceGuestFileChunk{}, errors.New("No such file or directory"))
+
+			cmd := testing.NewRepeatableVirtctlCommand("guest-cp", "vmi/"+vmiName+":"+guestPath, filepath.Join(tmpDir, "hosts"))
+			Expect(cmd()).To(MatchError(ContainSubstring("No such file or directory")))
+		})
+	})
+
+	Context("to the guest", func() {
+		writeLocalFile := func(data []byte) string {
+			localPath := filepath.Join(tmpDir, "hosts")
+			Expect(os.WriteFile(localPath, data, 0o600)).To(Succeed())
+			return localPath
+		}
+
+		It("should write the file chunk by chunk", func() {
+			data := bytes.Repeat([]byte("a"), int(guestfile.MaxChunkBytes)+3)
+			localPath := writeLocalFile(data)
+			gomock.InOrder(
+				vmiInterface.EXPECT().GuestFileWrite(gomock.Any(), vmiName, &v1.VirtualMachineInstanceGuestFileWriteOptions{
+					Path: guestPath, Offset: 0, Data: data[:guestfile.MaxChunkBytes],
+				}).Return(nil),
+				vmiInterface.EXPECT().GuestFileWrite(gomock.Any(), vmiName, &v1.VirtualMachineInstanceGuestFileWriteOptions{
+					Path: guestPath, Offset: guestfile.MaxChunkBytes, Data: data[guestfile.MaxChunkBytes:],
+				}).Return(nil),
+			)
+
+			cmd := testing.NewRepeatableVirtctlCommand("guest-cp", localPath, "vmi/"+vmiName+":"+guestPath)
+			Expect(cmd()).To(Succeed())
+		})
+
+		It("should end files of whole chunks with an empty chunk", func() {
+			data := bytes.Repeat([]byte("a"), int(guestfile.MaxChunkBytes))
+			localPath := writeLocalFile(data)
+			gomock.InOrder(
+				vmiInterface.EXPECT().GuestFileWrite(gomock.Any(), vmiName, &v1.VirtualMachineInstanceGuestFileWriteOptions{
+					Path: guestPath, Offset: 0, Data: data,
+				}).Return(nil),
+				vmiInterface.EXPECT().GuestFileWrite(gomock.Any(), vmiName, &v1.VirtualMachineInstanceGuestFileWriteOptions{
+					Path: guestPath, Offset: guestfile.MaxChunkBytes, Data: []byte{},
+				}).Return(nil),
+			)
+
+			cmd := testing.NewRepeatableVirtctlCommand("guest-cp", localPath, "vmi/"+vmiName+":"+guestPath)
+			Expect(cmd()).To(Succeed())
+		})
+
+		It("should create empty files", func() {
+			localPath := writeLocalFile([]byte{})
+			vmiInterface.EXPECT().GuestFileWrite(gomock.Any(), vmiName, &v1.VirtualMachineInstanceGuestFileWriteOptions{
+				Path: guestPath, Offset: 0, Data: []byte{},
+			}).Return(nil)
+
+			cmd := testing.NewRepeatableVirtctlCommand("guest-cp", localPath, "vmi/"+vmiName+":"+guestPath)
+			Expect(cmd()).To(Succeed())
+		})
+
+		It("should fail when the file cannot be written", func() {
+			localPath := writeLocalFile([]byte("127.0.0.1 localhost"))
+			vmiInterface.EXPECT().GuestFileWrite(gomock.Any(), vmiName, gomock.Any()).
+				Return(errors.New("guest files larger than 10 bytes cannot be copied"))
+
+			cmd := testing.NewRepeatableVirtctlCommand("guest-cp", localPath, "vmi/"+vmiName+":"+guestPath)
+			Expect(cmd()).To(MatchError(ContainSubstring("guest files larger than 10 bytes cannot be copied")))
+		})
+	})
+})
@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_GUESTEXEC = "guest-exec"
+
+	timeoutFlag = "timeout"
+)
+
+func NewGuestExecCommand() *cobra.Command {
+	c := &guestExecCommand{}
+
+	cmd := &cobra.Command{
+		Use:     "guest-exec (VMI) -- (COMMAND) [ARGS...]",
+		Short:   "Run a command in the guest of a virtual machine instance through the guest agent.",
+		Example: usageGuestExec(),
+		Args:    cobra.MinimumNArgs(2),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().Int32Var(&c.timeoutSeconds, timeoutFlag, 0, "Seconds to wait for the command to finish, the cluster default is used when not set.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageGuestExec() string {
+	return `  # Run 'systemctl is-active sshd' in the guest of the virtual machine instance 'myvmi':
+  {{ProgramName}} guest-exec myvmi -- systemctl is-active sshd
+
+  # Run a command and wait at most 30 seconds for it to finish:
+  {{ProgramName}} guest-exec myvmi --timeout 30 -- /usr/bin/df -h`
+}
+
+type guestExecCommand struct {
+	timeoutSeconds int32
+}
+
+func (c *guestExecCommand) run(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	opts := &v1.VirtualMachineInstanceGuestExecOptions{
+		Command: args[1],
+		Args:    args[2:],
+	}
+	if cmd.Flags().Changed(timeoutFlag) {
+		opts.TimeoutSeconds = pointer.P(c.timeoutSeconds)
+	}
+
+	result, err := virtClient.VirtualMachineInstance(namespace).GuestExec(cmd.Context(), vmiName, opts)
+	if err != nil {
+		return fmt.Errorf("error running %q in the guest of VirtualMachineInstance %s: %v", opts.Command, vmiName, err)
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), result.Stdout)
+	if result.Truncated {
+		fmt.Fprintln(cmd.ErrOrStderr(), "warning: the output of the command was truncated")
+	}
+	if result.ExitCode != 0 {
+		return fmt.Errorf("command %q exited with code %d", opts.Command, result.ExitCode)
+	}
+	return nil
+}
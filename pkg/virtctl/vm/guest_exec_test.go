@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Guest exec command", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().
+			VirtualMachineInstance(k8smetav1.NamespaceDefault).
+			Return(vmiInterface).
+			AnyTimes()
+	})
+
+	It("should fail without a command", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("guest-exec", vmiName)
+		Expect(cmd()).To(MatchError("requires at least 2 arg(s), only received 1"))
+	})
+
+	It("should print the output of the command", func() {
+		vmiInterface.EXPECT().GuestExec(gomock.Any(), vmiName, &v1.VirtualMachineInstanceGuestExecOptions{
+			Command: "systemctl",
+			Args:    []string{"is-active", "sshd"},
+		}).Return(v1.VirtualMachineInstanceGuestExecResult{Stdout: "active\n"}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommandWithOut("guest-exec", vmiName, "--", "systemctl", "is-active", "sshd")
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("active\n"))
+	})
+
+	It("should pass the timeout when it is set", func() {
+		vmiInterface.EXPECT().GuestExec(gomock.Any(), vmiName, &v1.VirtualMachineInstanceGuestExecOptions{
+			Command:        "uptime",
+			Args:           []string{},
+			TimeoutSeconds: pointer.P(int32(30)),
+		}).Return(v1.VirtualMachineInstanceGuestExecResult{}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand("guest-exec", vmiName, "--timeout", "30", "--", "uptime")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should warn when the output was truncated", func() {
+		vmiInterface.EXPECT().GuestExec(gomock.Any(), vmiName, gomock.Any()).
+			Return(v1.VirtualMachineInstanceGuestExecResult{Stdout: "partial", Truncated: true}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommandWithOutAndErr("guest-exec", vmiName, "--", "cat", "/var/log/messages")
+		out, errOut, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("partial"))
+		Expect(string(errOut)).To(Equal("warning: the output of the command was truncated\n"))
+	})
+
+	It("should fail when the command exits with a non zero code", func() {
+		vmiInterface.EXPECT().GuestExec(gomock.Any(), vmiName, gomock.Any()).
+			Return(v1.VirtualMachineInstanceGuestExecResult{ExitCode: 3}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand("guest-exec", vmiName, "--", "systemctl", "is-active", "sshd")
+		Expect(cmd()).To(MatchError(`command "systemctl" exited with code 3`))
+	})
+
+	It("should fail when the command cannot be run", func() {
+		vmiInterface.EXPECT().GuestExec(gomock.Any(), vmiName, gomock.Any()).
+			Return(v1.VirtualMachineInstanceGuestExecResult{}, errors.New("command uptime is not allowed in namespace default"))
+
+		cmd := testing.NewRepeatableVirtctlCommand("guest-exec", vmiName, "--", "uptime")
+		Expect(cmd()).To(MatchError(`error running "uptime" in the guest of VirtualMachineInstance testvmi: command uptime is not allowed in namespace default`))
+	})
+})
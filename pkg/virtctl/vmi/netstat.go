@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmi
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+func NewNetstatCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "netstat (VMI)",
+		Short:   "Show the traffic statistics of the interfaces of a virtual machine instance.",
+		Example: usageNetstat(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    netstatRun,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageNetstat() string {
+	return `  # Show the traffic statistics of the interfaces of the virtual machine instance 'myvmi':
+  {{ProgramName}} vmi netstat myvmi`
+}
+
+func netstatRun(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	netStats, err := virtClient.VirtualMachineInstance(namespace).NetworkStatistics(cmd.Context(), vmiName)
+	if err != nil {
+		return fmt.Errorf("error getting the network statistics of VirtualMachineInstance %s: %v", vmiName, err)
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "INTERFACE\tDEVICE\tRX BYTES\tRX PACKETS\tRX ERRORS\tRX DROPPED\tTX BYTES\tTX PACKETS\tTX ERRORS\tTX DROPPED")
+	for _, iface := range netStats.Interfaces {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\n",
+			iface.Name, iface.Device,
+			iface.RxBytes, iface.RxPackets, iface.RxErrors, iface.RxDropped,
+			iface.TxBytes, iface.TxPackets, iface.TxErrors, iface.TxDropped,
+		)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,87 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmi_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("netstat command", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().
+			VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(vmiInterface).
+			AnyTimes()
+	})
+
+	It("should fail with missing input parameters", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("vmi", "netstat")
+		Expect(cmd()).To(MatchError("accepts 1 arg(s), received 0"))
+	})
+
+	It("should fail when the statistics cannot be fetched", func() {
+		vmiInterface.EXPECT().NetworkStatistics(gomock.Any(), vmiName).
+			Return(v1.VirtualMachineInstanceNetworkStatistics{}, errors.New("VMI is not running"))
+
+		cmd := testing.NewRepeatableVirtctlCommand("vmi", "netstat", vmiName)
+		Expect(cmd()).To(MatchError("error getting the network statistics of VirtualMachineInstance testvmi: VMI is not running"))
+	})
+
+	It("should print the statistics of each interface", func() {
+		vmiInterface.EXPECT().NetworkStatistics(gomock.Any(), vmiName).Return(v1.VirtualMachineInstanceNetworkStatistics{
+			Interfaces: []v1.VirtualMachineInstanceInterfaceStatistics{{
+				Name:      "default",
+				Device:    "tap0",
+				RxBytes:   1000,
+				RxPackets: 10,
+				RxDropped: 1,
+				TxBytes:   2000,
+				TxPackets: 20,
+				TxErrors:  2,
+			}},
+		}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommandWithOut("vmi", "netstat", vmiName)
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal(
+			"INTERFACE  DEVICE  RX BYTES  RX PACKETS  RX ERRORS  RX DROPPED  TX BYTES  TX PACKETS  TX ERRORS  TX DROPPED\n" +
+				"default    tap0    1000      10          0          1           2000      20          2          0\n",
+		))
+	})
+})
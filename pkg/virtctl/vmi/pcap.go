@@ -0,0 +1,129 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmi
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	interfaceFlag   = "interface"
+	filterFlag      = "filter"
+	durationFlag    = "duration"
+	packetCountFlag = "packet-count"
+	outputFlag      = "output"
+)
+
+type pcapCommand struct {
+	iface       string
+	filter      string
+	duration    time.Duration
+	packetCount uint32
+	output      string
+}
+
+func NewPcapCommand() *cobra.Command {
+	c := &pcapCommand{}
+
+	cmd := &cobra.Command{
+		Use:     "pcap (VMI)",
+		Short:   "Capture the traffic of a virtual machine instance interface in the pcap format.",
+		Example: usagePcap(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVar(&c.iface, interfaceFlag, "", "Name of the interface to capture the traffic of, as set in the VMI spec.")
+	cmd.Flags().StringVar(&c.filter, filterFlag, "", "pcap-filter expression the packets are filtered with, e.g. 'tcp port 22'.")
+	cmd.Flags().DurationVar(&c.duration, durationFlag, 0, "Duration after which the capture ends, at most 30 minutes. Defaults to one minute when not set.")
+	cmd.Flags().Uint32Var(&c.packetCount, packetCountFlag, 0, "Number of packets after which the capture ends, at most 1000000. Defaults to 10000 when not set.")
+	cmd.Flags().StringVarP(&c.output, outputFlag, "o", "", "File the capture is written to. Written to stdout when not set.")
+	if err := cmd.MarkFlagRequired(interfaceFlag); err != nil {
+		panic(err)
+	}
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usagePcap() string {
+	return `  # Capture the traffic of the 'default' interface of the virtual machine instance 'myvmi' with wireshark:
+  {{ProgramName}} vmi pcap myvmi --interface default | wireshark -k -i -
+
+  # Capture 100 packets of the 'secondary' interface to a file:
+  {{ProgramName}} vmi pcap myvmi --interface secondary --packet-count 100 -o capture.pcap
+
+  # Capture the ICMP traffic of the 'default' interface for one minute:
+  {{ProgramName}} vmi pcap myvmi --interface default --duration 1m --filter icmp`
+}
+
+func (c *pcapCommand) run(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	opts := &v1.PcapOptions{
+		Interface:   c.iface,
+		Filter:      c.filter,
+		PacketCount: c.packetCount,
+	}
+	if c.duration > 0 {
+		opts.Duration = &metav1.Duration{Duration: c.duration}
+	}
+
+	out := cmd.OutOrStdout()
+	if c.output != "" {
+		f, err := os.Create(c.output)
+		if err != nil {
+			return fmt.Errorf("can't create the capture file: %v", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	stream, err := virtClient.VirtualMachineInstance(namespace).Pcap(vmiName, opts)
+	if err != nil {
+		return fmt.Errorf("error capturing the traffic of VirtualMachineInstance %s: %v", vmiName, err)
+	}
+
+	// Nothing is sent to the capture, the input is only closed once it ends.
+	in, inWriter := io.Pipe()
+	defer inWriter.Close()
+
+	return stream.Stream(kvcorev1.StreamOptions{
+		In:  in,
+		Out: out,
+	})
+}
@@ -0,0 +1,114 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmi_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("pcap command", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	expectPcap := func(expectedOptions *v1.PcapOptions, stream kvcorev1.StreamInterface, err error) {
+		kubecli.MockKubevirtClientInstance.EXPECT().
+			VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(vmiInterface)
+		vmiInterface.EXPECT().Pcap(vmiName, expectedOptions).Return(stream, err)
+	}
+
+	It("should fail without the interface flag", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("vmi", "pcap", vmiName)
+		Expect(cmd()).To(MatchError(ContainSubstring(`required flag(s) "interface" not set`)))
+	})
+
+	It("should fail when the capture cannot be started", func() {
+		expectPcap(&v1.PcapOptions{Interface: "default"}, nil, errors.New("interface default does not exist"))
+
+		cmd := testing.NewRepeatableVirtctlCommand("vmi", "pcap", vmiName, "--interface", "default")
+		Expect(cmd()).To(MatchError("error capturing the traffic of VirtualMachineInstance testvmi: interface default does not exist"))
+	})
+
+	It("should write the capture to stdout", func() {
+		expectPcap(&v1.PcapOptions{Interface: "default"}, &fakeStream{data: "pcap data"}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommandWithOut("vmi", "pcap", vmiName, "--interface", "default")
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("pcap data"))
+	})
+
+	It("should pass the capture options and write the capture to the output file", func() {
+		expectPcap(&v1.PcapOptions{
+			Interface:   "secondary",
+			Filter:      "6 0 0 262144",
+			Duration:    &metav1.Duration{Duration: time.Minute},
+			PacketCount: 10,
+		}, &fakeStream{data: "pcap data"}, nil)
+
+		output := filepath.Join(GinkgoT().TempDir(), "capture.pcap")
+		cmd := testing.NewRepeatableVirtctlCommand("vmi", "pcap", vmiName,
+			"--interface", "secondary",
+			"--filter", "6 0 0 262144",
+			"--duration", "1m",
+			"--packet-count", "10",
+			"-o", output,
+		)
+		Expect(cmd()).To(Succeed())
+		Expect(os.ReadFile(output)).To(BeEquivalentTo("pcap data"))
+	})
+})
+
+type fakeStream struct {
+	data string
+}
+
+func (s *fakeStream) Stream(options kvcorev1.StreamOptions) error {
+	_, err := io.WriteString(options.Out, s.data)
+	return err
+}
+
+func (s *fakeStream) AsConn() net.Conn {
+	return nil
+}
@@ -214,6 +214,22 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSize) DeepCopyInto(out *BlockSize) {
 	*out = *in
@@ -318,6 +334,27 @@ func (in *CPU) DeepCopy() *CPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUBaseline) DeepCopyInto(out *CPUBaseline) {
+	*out = *in
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUBaseline.
+func (in *CPUBaseline) DeepCopy() *CPUBaseline {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUBaseline)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUFeature) DeepCopyInto(out *CPUFeature) {
 	*out = *in
@@ -334,6 +371,29 @@ func (in *CPUFeature) DeepCopy() *CPUFeature {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUModelGroup) DeepCopyInto(out *CPUModelGroup) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUModelGroup.
+func (in *CPUModelGroup) DeepCopy() *CPUModelGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUModelGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUTopology) DeepCopyInto(out *CPUTopology) {
 	*out = *in
@@ -490,6 +550,11 @@ func (in *Clock) DeepCopyInto(out *Clock) {
 		*out = new(Timer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestTimeSync != nil {
+		in, out := &in.GuestTimeSync, &out.GuestTimeSync
+		*out = new(GuestTimeSync)
+		**out = **in
+	}
 	return
 }
 
@@ -563,6 +628,21 @@ func (in *CloudInitConfigDriveSource) DeepCopyInto(out *CloudInitConfigDriveSour
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.VendorDataSecretRef != nil {
+		in, out := &in.VendorDataSecretRef, &out.VendorDataSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.MetaData != nil {
+		in, out := &in.MetaData, &out.MetaData
+		*out = new(CloudInitMetaData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdatePolicy != nil {
+		in, out := &in.UpdatePolicy, &out.UpdatePolicy
+		*out = new(CloudInitUpdatePolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -576,6 +656,55 @@ func (in *CloudInitConfigDriveSource) DeepCopy() *CloudInitConfigDriveSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInitMetaData) DeepCopyInto(out *CloudInitMetaData) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInitMetaData.
+func (in *CloudInitMetaData) DeepCopy() *CloudInitMetaData {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInitMetaData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInitMetadataService) DeepCopyInto(out *CloudInitMetadataService) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInitMetadataService.
+func (in *CloudInitMetadataService) DeepCopy() *CloudInitMetadataService {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInitMetadataService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudInitNoCloudSource) DeepCopyInto(out *CloudInitNoCloudSource) {
 	*out = *in
@@ -589,6 +718,26 @@ func (in *CloudInitNoCloudSource) DeepCopyInto(out *CloudInitNoCloudSource) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.VendorDataSecretRef != nil {
+		in, out := &in.VendorDataSecretRef, &out.VendorDataSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.MetaData != nil {
+		in, out := &in.MetaData, &out.MetaData
+		*out = new(CloudInitMetaData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetadataService != nil {
+		in, out := &in.MetadataService, &out.MetadataService
+		*out = new(CloudInitMetadataService)
+		**out = **in
+	}
+	if in.UpdatePolicy != nil {
+		in, out := &in.UpdatePolicy, &out.UpdatePolicy
+		*out = new(CloudInitUpdatePolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -900,6 +1049,38 @@ func (in *CustomizeComponentsPatch) DeepCopy() *CustomizeComponentsPatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPClasslessRoute) DeepCopyInto(out *DHCPClasslessRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPClasslessRoute.
+func (in *DHCPClasslessRoute) DeepCopy() *DHCPClasslessRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPClasslessRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOption) DeepCopyInto(out *DHCPOption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOption.
+func (in *DHCPOption) DeepCopy() *DHCPOption {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 	*out = *in
@@ -913,6 +1094,31 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = make([]DHCPPrivateOptions, len(*in))
 		copy(*out, *in)
 	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]DHCPOption, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClasslessRoutes != nil {
+		in, out := &in.ClasslessRoutes, &out.ClasslessRoutes
+		*out = make([]DHCPClasslessRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.DomainSearch != nil {
+		in, out := &in.DomainSearch, &out.DomainSearch
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LeaseTimeSeconds != nil {
+		in, out := &in.LeaseTimeSeconds, &out.LeaseTimeSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.V6 != nil {
+		in, out := &in.V6, &out.V6
+		*out = new(DHCPv6Options)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -942,6 +1148,32 @@ func (in *DHCPPrivateOptions) DeepCopy() *DHCPPrivateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPv6Options) DeepCopyInto(out *DHCPv6Options) {
+	*out = *in
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DomainSearch != nil {
+		in, out := &in.DomainSearch, &out.DomainSearch
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPv6Options.
+func (in *DHCPv6Options) DeepCopy() *DHCPv6Options {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPv6Options)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolumeSource) DeepCopyInto(out *DataVolumeSource) {
 	*out = *in
@@ -1324,6 +1556,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1368,6 +1605,78 @@ func (in *DiskDevice) DeepCopy() *DiskDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOBurst) DeepCopyInto(out *DiskIOBurst) {
+	*out = *in
+	if in.Total != nil {
+		in, out := &in.Total, &out.Total
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Read != nil {
+		in, out := &in.Read, &out.Read
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Write != nil {
+		in, out := &in.Write, &out.Write
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LengthSeconds != nil {
+		in, out := &in.LengthSeconds, &out.LengthSeconds
+		*out = new(uint64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOBurst.
+func (in *DiskIOBurst) DeepCopy() *DiskIOBurst {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOBurst)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOLimits) DeepCopyInto(out *DiskIOLimits) {
+	*out = *in
+	if in.Total != nil {
+		in, out := &in.Total, &out.Total
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Read != nil {
+		in, out := &in.Read, &out.Read
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Write != nil {
+		in, out := &in.Write, &out.Write
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(DiskIOBurst)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOLimits.
+func (in *DiskIOLimits) DeepCopy() *DiskIOLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskIOThreads) DeepCopyInto(out *DiskIOThreads) {
 	*out = *in
@@ -1389,6 +1698,37 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	if in.Bytes != nil {
+		in, out := &in.Bytes, &out.Bytes
+		*out = new(DiskIOLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(DiskIOLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SizeIOPS != nil {
+		in, out := &in.SizeIOPS, &out.SizeIOPS
+		*out = new(uint64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -1450,6 +1790,53 @@ func (in *DomainMemoryDumpInfo) DeepCopy() *DomainMemoryDumpInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainPatch) DeepCopyInto(out *DomainPatch) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]DomainPatchOperation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainPatch.
+func (in *DomainPatch) DeepCopy() *DomainPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainPatchOperation) DeepCopyInto(out *DomainPatchOperation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainPatchOperation.
+func (in *DomainPatchOperation) DeepCopy() *DomainPatchOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainPatchOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
@@ -1927,6 +2314,22 @@ func (in *FilesystemVirtiofs) DeepCopy() *FilesystemVirtiofs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRule.
+func (in *FirewallRule) DeepCopy() *FirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Firmware) DeepCopyInto(out *Firmware) {
 	*out = *in
@@ -2090,6 +2493,129 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecConfiguration) DeepCopyInto(out *GuestExecConfiguration) {
+	*out = *in
+	if in.AllowedCommands != nil {
+		in, out := &in.AllowedCommands, &out.AllowedCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceAllowedCommands != nil {
+		in, out := &in.NamespaceAllowedCommands, &out.NamespaceAllowedCommands
+		*out = make([]GuestExecNamespaceAllowList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxOutputBytes != nil {
+		in, out := &in.MaxOutputBytes, &out.MaxOutputBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DefaultTimeoutSeconds != nil {
+		in, out := &in.DefaultTimeoutSeconds, &out.DefaultTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxTimeoutSeconds != nil {
+		in, out := &in.MaxTimeoutSeconds, &out.MaxTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecConfiguration.
+func (in *GuestExecConfiguration) DeepCopy() *GuestExecConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecNamespaceAllowList) DeepCopyInto(out *GuestExecNamespaceAllowList) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.AllowedCommands != nil {
+		in, out := &in.AllowedCommands, &out.AllowedCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecNamespaceAllowList.
+func (in *GuestExecNamespaceAllowList) DeepCopy() *GuestExecNamespaceAllowList {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecNamespaceAllowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestFileTransferConfiguration) DeepCopyInto(out *GuestFileTransferConfiguration) {
+	*out = *in
+	if in.MaxFileSizeBytes != nil {
+		in, out := &in.MaxFileSizeBytes, &out.MaxFileSizeBytes
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestFileTransferConfiguration.
+func (in *GuestFileTransferConfiguration) DeepCopy() *GuestFileTransferConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestFileTransferConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestServiceWatch) DeepCopyInto(out *GuestServiceWatch) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestServiceWatch.
+func (in *GuestServiceWatch) DeepCopy() *GuestServiceWatch {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestServiceWatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestTimeSync) DeepCopyInto(out *GuestTimeSync) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestTimeSync.
+func (in *GuestTimeSync) DeepCopy() *GuestTimeSync {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestTimeSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -2322,6 +2848,27 @@ func (in *I6300ESBWatchdog) DeepCopy() *I6300ESBWatchdog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnitionSource) DeepCopyInto(out *IgnitionSource) {
+	*out = *in
+	if in.ConfigSecretRef != nil {
+		in, out := &in.ConfigSecretRef, &out.ConfigSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionSource.
+func (in *IgnitionSource) DeepCopy() *IgnitionSource {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnitionSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitrdInfo) DeepCopyInto(out *InitrdInfo) {
 	*out = *in
@@ -2446,6 +2993,16 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(InterfaceFirewall)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2459,6 +3016,32 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidth) DeepCopyInto(out *InterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandwidthLimit)
+		**out = **in
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandwidthLimit)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidth.
+func (in *InterfaceBandwidth) DeepCopy() *InterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	*out = *in
@@ -2568,6 +3151,27 @@ func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceFirewall) DeepCopyInto(out *InterfaceFirewall) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]FirewallRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceFirewall.
+func (in *InterfaceFirewall) DeepCopy() *InterfaceFirewall {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceFirewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
 	*out = *in
@@ -2807,6 +3411,13 @@ func (in *KubeVirtCondition) DeepCopy() *KubeVirtCondition {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 	*out = *in
+	if in.CPUModelGroups != nil {
+		in, out := &in.CPUModelGroups, &out.CPUModelGroups
+		*out = make([]CPUModelGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CPURequest != nil {
 		in, out := &in.CPURequest, &out.CPURequest
 		x := (*in).DeepCopy()
@@ -2981,6 +3592,23 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(RoleAggregationStrategy)
 		**out = **in
 	}
+	if in.DomainPatches != nil {
+		in, out := &in.DomainPatches, &out.DomainPatches
+		*out = make([]DomainPatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GuestExec != nil {
+		in, out := &in.GuestExec, &out.GuestExec
+		*out = new(GuestExecConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestFileTransfer != nil {
+		in, out := &in.GuestFileTransfer, &out.GuestFileTransfer
+		*out = new(GuestFileTransferConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3273,6 +3901,50 @@ func (in *LunTarget) DeepCopy() *LunTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MACPoolConfiguration) DeepCopyInto(out *MACPoolConfiguration) {
+	*out = *in
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]MACRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceOverrides != nil {
+		in, out := &in.NamespaceOverrides, &out.NamespaceOverrides
+		*out = make([]NamespaceMACPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACPoolConfiguration.
+func (in *MACPoolConfiguration) DeepCopy() *MACPoolConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MACPoolConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MACRange) DeepCopyInto(out *MACRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACRange.
+func (in *MACRange) DeepCopy() *MACRange {
+	if in == nil {
+		return nil
+	}
+	out := new(MACRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Machine) DeepCopyInto(out *Machine) {
 	*out = *in
@@ -3579,23 +4251,44 @@ func (in *NUMA) DeepCopy() *NUMA {
 	if in == nil {
 		return nil
 	}
-	out := new(NUMA)
+	out := new(NUMA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMAGuestMappingPassthrough) DeepCopyInto(out *NUMAGuestMappingPassthrough) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMAGuestMappingPassthrough.
+func (in *NUMAGuestMappingPassthrough) DeepCopy() *NUMAGuestMappingPassthrough {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMAGuestMappingPassthrough)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NUMAGuestMappingPassthrough) DeepCopyInto(out *NUMAGuestMappingPassthrough) {
+func (in *NamespaceMACPool) DeepCopyInto(out *NamespaceMACPool) {
 	*out = *in
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]MACRange, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMAGuestMappingPassthrough.
-func (in *NUMAGuestMappingPassthrough) DeepCopy() *NUMAGuestMappingPassthrough {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceMACPool.
+func (in *NamespaceMACPool) DeepCopy() *NamespaceMACPool {
 	if in == nil {
 		return nil
 	}
-	out := new(NUMAGuestMappingPassthrough)
+	out := new(NamespaceMACPool)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3637,6 +4330,11 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.MACPool != nil {
+		in, out := &in.MACPool, &out.MACPool
+		*out = new(MACPoolConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3895,6 +4593,27 @@ func (in *PauseOptions) DeepCopy() *PauseOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PcapOptions) DeepCopyInto(out *PcapOptions) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PcapOptions.
+func (in *PcapOptions) DeepCopy() *PcapOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(PcapOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PciHostDevice) DeepCopyInto(out *PciHostDevice) {
 	*out = *in
@@ -4324,6 +5043,11 @@ func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.IOWeight != nil {
+		in, out := &in.IOWeight, &out.IOWeight
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -4861,6 +5585,27 @@ func (in *SyNICTimer) DeepCopy() *SyNICTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SysprepDomainJoin) DeepCopyInto(out *SysprepDomainJoin) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysprepDomainJoin.
+func (in *SysprepDomainJoin) DeepCopy() *SysprepDomainJoin {
+	if in == nil {
+		return nil
+	}
+	out := new(SysprepDomainJoin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SysprepSource) DeepCopyInto(out *SysprepSource) {
 	*out = *in
@@ -4874,6 +5619,11 @@ func (in *SysprepSource) DeepCopyInto(out *SysprepSource) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.Unattend != nil {
+		in, out := &in.Unattend, &out.Unattend
+		*out = new(SysprepUnattend)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4887,6 +5637,42 @@ func (in *SysprepSource) DeepCopy() *SysprepSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SysprepUnattend) DeepCopyInto(out *SysprepUnattend) {
+	*out = *in
+	if in.AdminPasswordSecretRef != nil {
+		in, out := &in.AdminPasswordSecretRef, &out.AdminPasswordSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.DomainJoin != nil {
+		in, out := &in.DomainJoin, &out.DomainJoin
+		*out = new(SysprepDomainJoin)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FirstLogonCommands != nil {
+		in, out := &in.FirstLogonCommands, &out.FirstLogonCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DriverPaths != nil {
+		in, out := &in.DriverPaths, &out.DriverPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysprepUnattend.
+func (in *SysprepUnattend) DeepCopy() *SysprepUnattend {
+	if in == nil {
+		return nil
+	}
+	out := new(SysprepUnattend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TDX) DeepCopyInto(out *TDX) {
 	*out = *in
@@ -5089,6 +5875,11 @@ func (in *TopologyHints) DeepCopyInto(out *TopologyHints) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.CPUBaseline != nil {
+		in, out := &in.CPUBaseline, &out.CPUBaseline
+		*out = new(CPUBaseline)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5410,6 +6201,32 @@ func (in *VirtualMachineCondition) DeepCopy() *VirtualMachineCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineIPAllocation) DeepCopyInto(out *VirtualMachineIPAllocation) {
+	*out = *in
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPAllocation.
+func (in *VirtualMachineIPAllocation) DeepCopy() *VirtualMachineIPAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineIPAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstance) DeepCopyInto(out *VirtualMachineInstance) {
 	*out = *in
@@ -5536,6 +6353,27 @@ func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceDomainPatch) DeepCopyInto(out *VirtualMachineInstanceDomainPatch) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]DomainPatchOperation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceDomainPatch.
+func (in *VirtualMachineInstanceDomainPatch) DeepCopy() *VirtualMachineInstanceDomainPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceDomainPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceFileSystem) DeepCopyInto(out *VirtualMachineInstanceFileSystem) {
 	*out = *in
@@ -5666,6 +6504,124 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestExecOptions) DeepCopyInto(out *VirtualMachineInstanceGuestExecOptions) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestExecOptions.
+func (in *VirtualMachineInstanceGuestExecOptions) DeepCopy() *VirtualMachineInstanceGuestExecOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestExecOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestExecResult) DeepCopyInto(out *VirtualMachineInstanceGuestExecResult) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestExecResult.
+func (in *VirtualMachineInstanceGuestExecResult) DeepCopy() *VirtualMachineInstanceGuestExecResult {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestExecResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceGuestExecResult) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestFileChunk) DeepCopyInto(out *VirtualMachineInstanceGuestFileChunk) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestFileChunk.
+func (in *VirtualMachineInstanceGuestFileChunk) DeepCopy() *VirtualMachineInstanceGuestFileChunk {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestFileChunk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceGuestFileChunk) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestFileReadOptions) DeepCopyInto(out *VirtualMachineInstanceGuestFileReadOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestFileReadOptions.
+func (in *VirtualMachineInstanceGuestFileReadOptions) DeepCopy() *VirtualMachineInstanceGuestFileReadOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestFileReadOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestFileWriteOptions) DeepCopyInto(out *VirtualMachineInstanceGuestFileWriteOptions) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestFileWriteOptions.
+func (in *VirtualMachineInstanceGuestFileWriteOptions) DeepCopy() *VirtualMachineInstanceGuestFileWriteOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestFileWriteOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -5729,6 +6685,38 @@ func (in *VirtualMachineInstanceGuestOSUserList) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestUserOptions) DeepCopyInto(out *VirtualMachineInstanceGuestUserOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestUserOptions.
+func (in *VirtualMachineInstanceGuestUserOptions) DeepCopy() *VirtualMachineInstanceGuestUserOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestUserOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceInterfaceStatistics) DeepCopyInto(out *VirtualMachineInstanceInterfaceStatistics) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceInterfaceStatistics.
+func (in *VirtualMachineInstanceInterfaceStatistics) DeepCopy() *VirtualMachineInstanceInterfaceStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceInterfaceStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceList) DeepCopyInto(out *VirtualMachineInstanceList) {
 	*out = *in
@@ -6126,6 +7114,36 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopy() *VirtualMachineInst
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceNetworkStatistics) DeepCopyInto(out *VirtualMachineInstanceNetworkStatistics) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]VirtualMachineInstanceInterfaceStatistics, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceNetworkStatistics.
+func (in *VirtualMachineInstanceNetworkStatistics) DeepCopy() *VirtualMachineInstanceNetworkStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceNetworkStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceNetworkStatistics) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstancePhaseTransitionTimestamp) DeepCopyInto(out *VirtualMachineInstancePhaseTransitionTimestamp) {
 	*out = *in
@@ -6441,6 +7459,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 		*out = new(Probe)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestServiceWatch != nil {
+		in, out := &in.GuestServiceWatch, &out.GuestServiceWatch
+		*out = new(GuestServiceWatch)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Networks != nil {
 		in, out := &in.Networks, &out.Networks
 		*out = make([]Network, len(*in))
@@ -6578,6 +7601,13 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(ChangedBlockTrackingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DomainPatches != nil {
+		in, out := &in.DomainPatches, &out.DomainPatches
+		*out = make([]VirtualMachineInstanceDomainPatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6867,6 +7897,13 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(InstancetypeStatusRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IPAllocations != nil {
+		in, out := &in.IPAllocations, &out.IPAllocations
+		*out = make([]VirtualMachineIPAllocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6985,6 +8022,11 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(CloudInitConfigDriveSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Ignition != nil {
+		in, out := &in.Ignition, &out.Ignition
+		*out = new(IgnitionSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Sysprep != nil {
 		in, out := &in.Sysprep, &out.Sysprep
 		*out = new(SysprepSource)
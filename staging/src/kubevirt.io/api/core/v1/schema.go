@@ -35,6 +35,7 @@ const (
 	IOThreadsPolicySupplementalPool IOThreadsPolicy = "supplementalPool"
 	CPUModeHostPassthrough                          = "host-passthrough"
 	CPUModeHostModel                                = "host-model"
+	CPUModeClusterBaseline                          = "cluster-baseline"
 	DefaultCPUModel                                 = CPUModeHostModel
 )
 
@@ -166,6 +167,55 @@ type SysprepSource struct {
 	// ConfigMap references a ConfigMap that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.
 	// + optional
 	ConfigMap *v1.LocalObjectReference `json:"configMap,omitempty"`
+	// Unattend describes a Sysprep answer file that is generated by KubeVirt and attached as disk of CDROM type,
+	// as an alternative to providing the answer file in a Secret or ConfigMap.
+	// +optional
+	Unattend *SysprepUnattend `json:"unattend,omitempty"`
+}
+
+// SysprepUnattend describes the settings of a generated Sysprep answer file.
+type SysprepUnattend struct {
+	// ComputerName is the name of the Windows computer. Defaults to the hostname of the VMI, truncated to 15 characters.
+	// +optional
+	ComputerName string `json:"computerName,omitempty"`
+	// AdminPasswordSecretRef references a k8s secret that contains the password of the local Administrator
+	// account under the "password" key.
+	// +optional
+	AdminPasswordSecretRef *v1.LocalObjectReference `json:"adminPasswordSecretRef,omitempty"`
+	// ProductKey is the Windows product key.
+	// +optional
+	ProductKey string `json:"productKey,omitempty"`
+	// Locale is the input, system, user interface and user locale, e.g. en-US.
+	// +optional
+	Locale string `json:"locale,omitempty"`
+	// TimeZone is the Windows time zone, e.g. UTC or Pacific Standard Time.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// DomainJoin joins the computer to an Active Directory domain.
+	// +optional
+	DomainJoin *SysprepDomainJoin `json:"domainJoin,omitempty"`
+	// FirstLogonCommands are run in order when the Administrator logs on for the first time.
+	// The Administrator is logged on automatically once to run them when AdminPasswordSecretRef is set.
+	// +optional
+	// +listType=atomic
+	FirstLogonCommands []string `json:"firstLogonCommands,omitempty"`
+	// DriverPaths are searched for drivers during the setup, e.g. the directories of the virtio-win
+	// drivers on a CDROM, like E:\amd64\w11.
+	// +optional
+	// +listType=atomic
+	DriverPaths []string `json:"driverPaths,omitempty"`
+}
+
+// SysprepDomainJoin describes how the computer joins an Active Directory domain.
+type SysprepDomainJoin struct {
+	// Domain is the name of the domain to join.
+	Domain string `json:"domain"`
+	// CredentialsSecretRef references a k8s secret that contains the "username" and "password" of an
+	// account which is allowed to join computers to the domain.
+	CredentialsSecretRef *v1.LocalObjectReference `json:"credentialsSecretRef"`
+	// OrganizationalUnit is the distinguished name of the organizational unit the computer account is created in.
+	// +optional
+	OrganizationalUnit string `json:"organizationalUnit,omitempty"`
 }
 
 // Represents a cloud-init nocloud user data source.
@@ -189,8 +239,67 @@ type CloudInitNoCloudSource struct {
 	// NetworkData contains NoCloud inline cloud-init networkdata.
 	// + optional
 	NetworkData string `json:"networkData,omitempty"`
+	// VendorDataSecretRef references a k8s secret that contains NoCloud vendordata.
+	// + optional
+	VendorDataSecretRef *v1.LocalObjectReference `json:"vendorDataSecretRef,omitempty"`
+	// VendorDataBase64 contains NoCloud cloud-init vendordata as a base64 encoded string.
+	// + optional
+	VendorDataBase64 string `json:"vendorDataBase64,omitempty"`
+	// VendorData contains NoCloud inline cloud-init vendordata.
+	// + optional
+	VendorData string `json:"vendorData,omitempty"`
+	// MetaData adds user defined entries to the NoCloud instance metadata.
+	// + optional
+	MetaData *CloudInitMetaData `json:"metaData,omitempty"`
+	// MetadataService additionally serves the NoCloud data to the guest over HTTP.
+	// + optional
+	MetadataService *CloudInitMetadataService `json:"metadataService,omitempty"`
+	// UpdatePolicy defines how changes of the data sources are propagated to the guest.
+	// Defaults to None.
+	// + optional
+	UpdatePolicy *CloudInitUpdatePolicy `json:"updatePolicy,omitempty"`
+}
+
+// CloudInitMetaData holds the user defined entries of the cloud-init instance metadata.
+type CloudInitMetaData struct {
+	// Keys are added to the instance metadata: at the top level with NoCloud, and under
+	// the meta key with config drive. Keys generated by KubeVirt cannot be overridden.
+	// +optional
+	Keys map[string]string `json:"keys,omitempty"`
+	// Labels lists the VMI labels exposed in the instance metadata, under the labels key.
+	// +listType=atomic
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+	// Annotations lists the VMI annotations exposed in the instance metadata, under the
+	// annotations key.
+	// +listType=atomic
+	// +optional
+	Annotations []string `json:"annotations,omitempty"`
 }
 
+// CloudInitMetadataService serves the cloud-init data from virt-launcher on
+// http://169.254.169.254, in both the NoCloud-net and the EC2 layouts, for guests
+// which do not look for the data on a disk.
+// Over IPv6, it is served on http://[fd00:ec2::254].
+// It requires the pod network to use the masquerade binding.
+type CloudInitMetadataService struct{}
+
+// CloudInitUpdatePolicy defines how changes of the cloud-init data sources, e.g. of the
+// referenced secrets, are propagated to the guest.
+type CloudInitUpdatePolicy string
+
+const (
+	// CloudInitUpdatePolicyNone generates the data when the VMI starts, with a fixed instance-id.
+	// The guest does not run the per-instance cloud-init modules again when the data changes.
+	CloudInitUpdatePolicyNone CloudInitUpdatePolicy = "None"
+	// CloudInitUpdatePolicyRestart derives the instance-id from the data, so that the guest
+	// runs cloud-init as a new instance when the VM restarts with changed data.
+	CloudInitUpdatePolicyRestart CloudInitUpdatePolicy = "Restart"
+	// CloudInitUpdatePolicyLive additionally regenerates the data of a running VMI when the
+	// referenced secrets change, and re-inserts it into the cloud-init disk, which must be a cdrom.
+	CloudInitUpdatePolicyLive CloudInitUpdatePolicy = "Live"
+)
+
 // Represents a cloud-init config drive user data source.
 // More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
 type CloudInitConfigDriveSource struct {
@@ -212,6 +321,36 @@ type CloudInitConfigDriveSource struct {
 	// NetworkData contains config drive inline cloud-init networkdata.
 	// + optional
 	NetworkData string `json:"networkData,omitempty"`
+	// VendorDataSecretRef references a k8s secret that contains config drive vendordata.
+	// + optional
+	VendorDataSecretRef *v1.LocalObjectReference `json:"vendorDataSecretRef,omitempty"`
+	// VendorDataBase64 contains config drive cloud-init vendordata as a base64 encoded string.
+	// + optional
+	VendorDataBase64 string `json:"vendorDataBase64,omitempty"`
+	// VendorData contains config drive inline cloud-init vendordata.
+	// + optional
+	VendorData string `json:"vendorData,omitempty"`
+	// MetaData adds user defined entries to the config drive instance metadata.
+	// + optional
+	MetaData *CloudInitMetaData `json:"metaData,omitempty"`
+	// UpdatePolicy defines how changes of the data sources are propagated to the guest.
+	// Defaults to None.
+	// + optional
+	UpdatePolicy *CloudInitUpdatePolicy `json:"updatePolicy,omitempty"`
+}
+
+// Represents an Ignition config source.
+// More info: https://coreos.github.io/ignition/
+type IgnitionSource struct {
+	// ConfigSecretRef references a k8s secret that contains the Ignition config under the "config" key.
+	// + optional
+	ConfigSecretRef *v1.LocalObjectReference `json:"configSecretRef,omitempty"`
+	// ConfigBase64 contains the Ignition config as a base64 encoded string.
+	// + optional
+	ConfigBase64 string `json:"configBase64,omitempty"`
+	// Config contains the inline Ignition config.
+	// + optional
+	Config string `json:"config,omitempty"`
 }
 
 type DomainSpec struct {
@@ -345,6 +484,10 @@ type ResourceRequirements struct {
 	// put the overhead only into the container's memory limit. This can lead to crashes if
 	// all memory is in use on a node. Defaults to false.
 	OvercommitGuestOverhead bool `json:"overcommitGuestOverhead,omitempty"`
+	// IOWeight sets the relative I/O weight (cgroup v2 io.weight) of the virt-launcher pod.
+	// Valid values are between 1 and 10000. Ignored on nodes using cgroup v1.
+	// +optional
+	IOWeight *uint32 `json:"ioWeight,omitempty"`
 }
 
 // CPU allows specifying the CPU topology.
@@ -365,9 +508,15 @@ type CPU struct {
 	// List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
 	// It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
 	// and "host-model" to get CPU closest to the node one.
+	// "cluster-baseline" gets the CPU model and features common to all nodes of the CPU model group,
+	// allowing the VMI to migrate to any node of the group.
 	// Defaults to host-model.
 	// +optional
 	Model string `json:"model,omitempty"`
+	// ModelGroup selects the CPU model group, as defined in the KubeVirt configuration, whose baseline
+	// is used when the model is "cluster-baseline". Defaults to all schedulable nodes.
+	// +optional
+	ModelGroup string `json:"modelGroup,omitempty"`
 	// Features specifies the CPU features list inside the VMI.
 	// +optional
 	Features []CPUFeature `json:"features,omitempty"`
@@ -804,6 +953,63 @@ type Disk struct {
 	// Defaults to false.
 	// +optional
 	ChangedBlockTracking *bool `json:"changedBlockTracking,omitempty"`
+	// IOTune specifies I/O throttling limits enforced by the hypervisor for this disk.
+	// With the LiveUpdate rollout strategy the limits can be changed on a running VM.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
+}
+
+// DiskIOTune limits the throughput and the number of I/O operations of a disk.
+type DiskIOTune struct {
+	// Bytes limits the disk throughput in bytes per second.
+	// +optional
+	Bytes *DiskIOLimits `json:"bytes,omitempty"`
+	// IOPS limits the number of I/O operations per second.
+	// +optional
+	IOPS *DiskIOLimits `json:"iops,omitempty"`
+	// SizeIOPS is the size in bytes of a single I/O operation when IOPS limits are accounted.
+	// Larger requests are counted as multiple operations.
+	// +optional
+	SizeIOPS *uint64 `json:"sizeIOPS,omitempty"`
+	// GroupName places the disk into a throttling group. All disks of a group share the
+	// limits of the group.
+	// +optional
+	GroupName string `json:"groupName,omitempty"`
+}
+
+// DiskIOLimits describes sustained limits and optional bursts.
+// Total can not be combined with Read or Write.
+type DiskIOLimits struct {
+	// Total limits reads and writes combined.
+	// +optional
+	Total *uint64 `json:"total,omitempty"`
+	// Read limits reads.
+	// +optional
+	Read *uint64 `json:"read,omitempty"`
+	// Write limits writes.
+	// +optional
+	Write *uint64 `json:"write,omitempty"`
+	// Burst allows exceeding the sustained limits for a limited time.
+	// +optional
+	Burst *DiskIOBurst `json:"burst,omitempty"`
+}
+
+// DiskIOBurst describes the maximum rates allowed during a burst.
+// Every burst value requires the matching sustained limit to be set.
+type DiskIOBurst struct {
+	// Total is the maximum rate for reads and writes combined during a burst.
+	// +optional
+	Total *uint64 `json:"total,omitempty"`
+	// Read is the maximum read rate during a burst.
+	// +optional
+	Read *uint64 `json:"read,omitempty"`
+	// Write is the maximum write rate during a burst.
+	// +optional
+	Write *uint64 `json:"write,omitempty"`
+	// LengthSeconds is the duration of a burst.
+	// Defaults to 1.
+	// +optional
+	LengthSeconds *uint64 `json:"lengthSeconds,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -958,6 +1164,11 @@ type VolumeSource struct {
 	// More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
 	// +optional
 	CloudInitConfigDrive *CloudInitConfigDriveSource `json:"cloudInitConfigDrive,omitempty"`
+	// Ignition represents an Ignition config source.
+	// The config is passed to the guest through QEMU fw_cfg, or as a raw disk when the volume is attached as a disk.
+	// More info: https://coreos.github.io/ignition/
+	// +optional
+	Ignition *IgnitionSource `json:"ignition,omitempty"`
 	// Represents a Sysprep volume source.
 	// +optional
 	Sysprep *SysprepSource `json:"sysprep,omitempty"`
@@ -1125,6 +1336,16 @@ type Clock struct {
 	// Timer specifies whih timers are attached to the vmi.
 	// +optional
 	Timer *Timer `json:"timer,omitempty"`
+	// GuestTimeSync enables setting the guest clock through the guest agent after
+	// the guest was unpaused or migrated, and once the guest agent connects after
+	// boot, e.g. when starting from a restored snapshot. The drift observed before
+	// every synchronisation is reported as a metric.
+	// +optional
+	GuestTimeSync *GuestTimeSync `json:"guestTimeSync,omitempty"`
+}
+
+// GuestTimeSync represents the synchronisation of the guest clock with the host clock.
+type GuestTimeSync struct {
 }
 
 // Represents all available timers in a vmi.
@@ -1486,6 +1707,17 @@ type Interface struct {
 	// Empty value functions as `up`.
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// Bandwidth limits the traffic rate of the interface.
+	// Supported only by interfaces attached to the domain through a tap device
+	// (bridge, masquerade and binding plugins with the tap domain attachment type).
+	// Can be updated on a running VM.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
+	// Firewall filters the traffic bridged between the guest and the network.
+	// Supported only by the bridge binding.
+	// Requires the nf_conntrack_bridge kernel module to be loaded on the node.
+	// +optional
+	Firewall *InterfaceFirewall `json:"firewall,omitempty"`
 }
 
 type InterfaceState string
@@ -1496,6 +1728,81 @@ const (
 	InterfaceStateLinkDown InterfaceState = "down"
 )
 
+// InterfaceBandwidth shapes the traffic of an interface.
+// Directions are from the guest point of view.
+type InterfaceBandwidth struct {
+	// Inbound limits the traffic received by the guest.
+	// +optional
+	Inbound *BandwidthLimit `json:"inbound,omitempty"`
+	// Outbound limits the traffic sent by the guest.
+	// +optional
+	Outbound *BandwidthLimit `json:"outbound,omitempty"`
+}
+
+// BandwidthLimit describes the rate of a traffic direction.
+type BandwidthLimit struct {
+	// Average is the average bit rate of the shaped traffic, in kibibytes per second.
+	Average uint32 `json:"average"`
+	// Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+	// Must not be lower than the average.
+	// +optional
+	Peak uint32 `json:"peak,omitempty"`
+	// Burst is the amount of kibibytes that can be sent in a single burst at the peak rate.
+	// +optional
+	Burst uint32 `json:"burst,omitempty"`
+}
+
+type FirewallAction string
+
+const (
+	FirewallActionAllow FirewallAction = "Allow"
+	FirewallActionDeny  FirewallAction = "Deny"
+)
+
+type FirewallDirection string
+
+const (
+	// FirewallDirectionIngress is the traffic entering the guest.
+	FirewallDirectionIngress FirewallDirection = "Ingress"
+	// FirewallDirectionEgress is the traffic leaving the guest.
+	FirewallDirectionEgress FirewallDirection = "Egress"
+)
+
+// InterfaceFirewall is a stateful filter of the interface traffic.
+// Replies to allowed connections are always allowed.
+type InterfaceFirewall struct {
+	// DefaultAction is applied to the traffic which does not match any rule.
+	// Defaults to Allow.
+	// +optional
+	DefaultAction FirewallAction `json:"defaultAction,omitempty"`
+	// Rules are evaluated in order, the first matching rule decides the traffic fate.
+	// +optional
+	// +listType=atomic
+	Rules []FirewallRule `json:"rules,omitempty"`
+}
+
+type FirewallRule struct {
+	// Direction of the traffic the rule applies to: Ingress or Egress.
+	Direction FirewallDirection `json:"direction"`
+	// Action to take on matching traffic: Allow or Deny.
+	Action FirewallAction `json:"action"`
+	// CIDR of the remote peer: the source of ingress traffic or the destination of egress traffic.
+	// Matches any peer when not specified.
+	// +optional
+	CIDR string `json:"cidr,omitempty"`
+	// Protocol of the matching traffic: TCP, UDP, SCTP or ICMP.
+	// Matches any protocol when not specified.
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+	// Port is the destination port of the matching traffic.
+	// Requires the TCP, UDP or SCTP protocol.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// EndPort indicates that the range of ports from Port to EndPort, inclusive, should match.
+	// +optional
+	EndPort int32 `json:"endPort,omitempty"`
+}
+
 // Extra DHCP options to use in the interface.
 type DHCPOptions struct {
 	// If specified will pass option 67 to interface's DHCP server
@@ -1510,6 +1817,81 @@ type DHCPOptions struct {
 	// If specified will pass extra DHCP options for private use, range: 224-254
 	// +optional
 	PrivateOptions []DHCPPrivateOptions `json:"privateOptions,omitempty"`
+	// If specified will pass the listed DHCP options, overriding the values set by KubeVirt.
+	// Options driving the DHCP protocol itself (e.g. lease time, message type, server identifier) cannot be set.
+	// +optional
+	// +listType=atomic
+	Options []DHCPOption `json:"options,omitempty"`
+	// If specified will pass the routes via DHCP option 121, in addition to the routes of the pod interface.
+	// +optional
+	// +listType=atomic
+	ClasslessRoutes []DHCPClasslessRoute `json:"classlessRoutes,omitempty"`
+	// If specified will pass the domain search list via DHCP option 119, instead of the one of the pod.
+	// +optional
+	// +listType=atomic
+	DomainSearch []string `json:"domainSearch,omitempty"`
+	// LeaseTimeSeconds is the lease time of the address offered to the guest.
+	// Applies to the preferred and valid lifetimes of the DHCPv6 address as well.
+	// Must be at least 60 seconds.
+	// Defaults to an infinite lease.
+	// +optional
+	LeaseTimeSeconds *uint32 `json:"leaseTimeSeconds,omitempty"`
+	// V6 specifies options passed by the DHCPv6 server, used by the masquerade binding.
+	// +optional
+	V6 *DHCPv6Options `json:"v6,omitempty"`
+}
+
+// DHCPOptionType describes how the value of a DHCP option is encoded.
+type DHCPOptionType string
+
+const (
+	// DHCPOptionTypeString passes the value as is.
+	DHCPOptionTypeString DHCPOptionType = "string"
+	// DHCPOptionTypeHex passes the bytes of the hexadecimal value, e.g. "0a0b0c".
+	DHCPOptionTypeHex DHCPOptionType = "hex"
+	// DHCPOptionTypeIPv4 passes a comma separated list of IPv4 addresses.
+	DHCPOptionTypeIPv4 DHCPOptionType = "ipv4"
+	// DHCPOptionTypeUint8 passes the value as a single byte.
+	DHCPOptionTypeUint8 DHCPOptionType = "uint8"
+	// DHCPOptionTypeUint16 passes the value as a 16 bits big endian integer.
+	DHCPOptionTypeUint16 DHCPOptionType = "uint16"
+	// DHCPOptionTypeUint32 passes the value as a 32 bits big endian integer.
+	DHCPOptionTypeUint32 DHCPOptionType = "uint32"
+)
+
+// DHCPOption is a numeric DHCP option passed to the guest.
+type DHCPOption struct {
+	// Code of the option, range: 1-254.
+	Code int `json:"code"`
+	// Value of the option, encoded according to the type.
+	Value string `json:"value"`
+	// Type of the value, one of: string, hex, ipv4, uint8, uint16, uint32.
+	// Defaults to string.
+	// +optional
+	Type DHCPOptionType `json:"type,omitempty"`
+}
+
+// DHCPClasslessRoute is a route passed via DHCP option 121.
+type DHCPClasslessRoute struct {
+	// Destination network in CIDR notation, e.g. 10.0.0.0/8.
+	Destination string `json:"destination"`
+	// Gateway IPv4 address. An empty value describes a route on the link.
+	// +optional
+	Gateway string `json:"gateway,omitempty"`
+}
+
+// DHCPv6Options are the options passed by the DHCPv6 server.
+// The server offers the guest address of the masquerade binding as a static lease, to the client
+// sending from the MAC address of the interface. Prefix delegation (IA_PD) is not supported.
+type DHCPv6Options struct {
+	// If specified will pass the NTP servers via DHCPv6 option 56.
+	// +optional
+	// +listType=atomic
+	NTPServers []string `json:"ntpServers,omitempty"`
+	// If specified will pass the domain search list via DHCPv6 option 24, instead of the one of the pod.
+	// +optional
+	// +listType=atomic
+	DomainSearch []string `json:"domainSearch,omitempty"`
 }
 
 func (d *DHCPOptions) UnmarshalJSON(data []byte) error {
@@ -1604,13 +1986,21 @@ type Port struct {
 	// referred to by services.
 	// +optional
 	Name string `json:"name,omitempty"`
-	// Protocol for port. Must be UDP or TCP.
+	// Protocol for port. Must be UDP, TCP, SCTP or ICMP.
+	// ICMP forwards echo requests and is supported only by the masquerade binding,
+	// in which case the port must not be set.
 	// Defaults to "TCP".
 	// +optional
 	Protocol string `json:"protocol,omitempty"`
 	// Number of port to expose for the virtual machine.
 	// This must be a valid port number, 0 < x < 65536.
 	Port int32 `json:"port"`
+	// EndPort indicates that the range of ports from Port to EndPort, inclusive,
+	// should be exposed. It must be greater than or equal to Port,
+	// the range may span at most 1024 ports.
+	// Supported only by the masquerade binding.
+	// +optional
+	EndPort int32 `json:"endPort,omitempty"`
 }
 
 type AccessCredentialSecretSource struct {
@@ -63,6 +63,30 @@ func (SysprepSource) SwaggerDoc() map[string]string {
 		"":          "Represents a Sysprep volume source.",
 		"secret":    "Secret references a k8s Secret that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.\n+ optional",
 		"configMap": "ConfigMap references a ConfigMap that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.\n+ optional",
+		"unattend":  "Unattend describes a Sysprep answer file that is generated by KubeVirt and attached as disk of CDROM type,\nas an alternative to providing the answer file in a Secret or ConfigMap.\n+optional",
+	}
+}
+
+func (SysprepUnattend) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "SysprepUnattend describes the settings of a generated Sysprep answer file.",
+		"computerName":           "ComputerName is the name of the Windows computer. Defaults to the hostname of the VMI, truncated to 15 characters.\n+optional",
+		"adminPasswordSecretRef": "AdminPasswordSecretRef references a k8s secret that contains the password of the local Administrator\naccount under the \"password\" key.\n+optional",
+		"productKey":             "ProductKey is the Windows product key.\n+optional",
+		"locale":                 "Locale is the input, system, user interface and user locale, e.g. en-US.\n+optional",
+		"timeZone":               "TimeZone is the Windows time zone, e.g. UTC or Pacific Standard Time.\n+optional",
+		"domainJoin":             "DomainJoin joins the computer to an Active Directory domain.\n+optional",
+		"firstLogonCommands":     "FirstLogonCommands are run in order when the Administrator logs on for the first time.\nThe Administrator is logged on automatically once to run them when AdminPasswordSecretRef is set.\n+optional\n+listType=atomic",
+		"driverPaths":            "DriverPaths are searched for drivers during the setup, e.g. the directories of the virtio-win\ndrivers on a CDROM, like E:\\amd64\\w11.\n+optional\n+listType=atomic",
+	}
+}
+
+func (SysprepDomainJoin) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "SysprepDomainJoin describes how the computer joins an Active Directory domain.",
+		"domain":               "Domain is the name of the domain to join.",
+		"credentialsSecretRef": "CredentialsSecretRef references a k8s secret that contains the \"username\" and \"password\" of an\naccount which is allowed to join computers to the domain.",
+		"organizationalUnit":   "OrganizationalUnit is the distinguished name of the organizational unit the computer account is created in.\n+optional",
 	}
 }
 
@@ -75,6 +99,27 @@ func (CloudInitNoCloudSource) SwaggerDoc() map[string]string {
 		"networkDataSecretRef": "NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.\n+ optional",
 		"networkDataBase64":    "NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.\n+ optional",
 		"networkData":          "NetworkData contains NoCloud inline cloud-init networkdata.\n+ optional",
+		"vendorDataSecretRef":  "VendorDataSecretRef references a k8s secret that contains NoCloud vendordata.\n+ optional",
+		"vendorDataBase64":     "VendorDataBase64 contains NoCloud cloud-init vendordata as a base64 encoded string.\n+ optional",
+		"vendorData":           "VendorData contains NoCloud inline cloud-init vendordata.\n+ optional",
+		"metaData":             "MetaData adds user defined entries to the NoCloud instance metadata.\n+ optional",
+		"metadataService":      "MetadataService additionally serves the NoCloud data to the guest over HTTP.\n+ optional",
+		"updatePolicy":         "UpdatePolicy defines how changes of the data sources are propagated to the guest.\nDefaults to None.\n+ optional",
+	}
+}
+
+func (CloudInitMetaData) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "CloudInitMetaData holds the user defined entries of the cloud-init instance metadata.",
+		"keys":        "Keys are added to the instance metadata: at the top level with NoCloud, and under\nthe meta key with config drive. Keys generated by KubeVirt cannot be overridden.\n+optional",
+		"labels":      "Labels lists the VMI labels exposed in the instance metadata, under the labels key.\n+listType=atomic\n+optional",
+		"annotations": "Annotations lists the VMI annotations exposed in the instance metadata, under the\nannotations key.\n+listType=atomic\n+optional",
+	}
+}
+
+func (CloudInitMetadataService) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "CloudInitMetadataService serves the cloud-init data from virt-launcher on\nhttp://169.254.169.254, in both the NoCloud-net and the EC2 layouts, for guests\nwhich do not look for the data on a disk.\nOver IPv6, it is served on http://[fd00:ec2::254].\nIt requires the pod network to use the masquerade binding.",
 	}
 }
 
@@ -87,6 +132,20 @@ func (CloudInitConfigDriveSource) SwaggerDoc() map[string]string {
 		"networkDataSecretRef": "NetworkDataSecretRef references a k8s secret that contains config drive networkdata.\n+ optional",
 		"networkDataBase64":    "NetworkDataBase64 contains config drive cloud-init networkdata as a base64 encoded string.\n+ optional",
 		"networkData":          "NetworkData contains config drive inline cloud-init networkdata.\n+ optional",
+		"vendorDataSecretRef":  "VendorDataSecretRef references a k8s secret that contains config drive vendordata.\n+ optional",
+		"vendorDataBase64":     "VendorDataBase64 contains config drive cloud-init vendordata as a base64 encoded string.\n+ optional",
+		"vendorData":           "VendorData contains config drive inline cloud-init vendordata.\n+ optional",
+		"metaData":             "MetaData adds user defined entries to the config drive instance metadata.\n+ optional",
+		"updatePolicy":         "UpdatePolicy defines how changes of the data sources are propagated to the guest.\nDefaults to None.\n+ optional",
+	}
+}
+
+func (IgnitionSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "Represents an Ignition config source.\nMore info: https://coreos.github.io/ignition/",
+		"configSecretRef": "ConfigSecretRef references a k8s secret that contains the Ignition config under the \"config\" key.\n+ optional",
+		"configBase64":    "ConfigBase64 contains the Ignition config as a base64 encoded string.\n+ optional",
+		"config":          "Config contains the inline Ignition config.\n+ optional",
 	}
 }
 
@@ -161,6 +220,7 @@ func (ResourceRequirements) SwaggerDoc() map[string]string {
 		"requests":                "Requests is a description of the initial vmi resources.\nValid resource keys are \"memory\" and \"cpu\".\n+optional",
 		"limits":                  "Limits describes the maximum amount of compute resources allowed.\nValid resource keys are \"memory\" and \"cpu\".\n+optional",
 		"overcommitGuestOverhead": "Don't ask the scheduler to take the guest-management overhead into account. Instead\nput the overhead only into the container's memory limit. This can lead to crashes if\nall memory is in use on a node. Defaults to false.",
+		"ioWeight":                "IOWeight sets the relative I/O weight (cgroup v2 io.weight) of the virt-launcher pod.\nValid values are between 1 and 10000. Ignored on nodes using cgroup v1.\n+optional",
 	}
 }
 
@@ -171,7 +231,8 @@ func (CPU) SwaggerDoc() map[string]string {
 		"sockets":               "Sockets specifies the number of sockets inside the vmi.\nMust be a value greater or equal 1.",
 		"maxSockets":            "MaxSockets specifies the maximum amount of sockets that can\nbe hotplugged",
 		"threads":               "Threads specifies the number of threads inside the vmi.\nMust be a value greater or equal 1.",
-		"model":                 "Model specifies the CPU model inside the VMI.\nList of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.\nIt is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node\nand \"host-model\" to get CPU closest to the node one.\nDefaults to host-model.\n+optional",
+		"model":                 "Model specifies the CPU model inside the VMI.\nList of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.\nIt is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node\nand \"host-model\" to get CPU closest to the node one.\n\"cluster-baseline\" gets the CPU model and features common to all nodes of the CPU model group,\nallowing the VMI to migrate to any node of the group.\nDefaults to host-model.\n+optional",
+		"modelGroup":            "ModelGroup selects the CPU model group, as defined in the KubeVirt configuration, whose baseline\nis used when the model is \"cluster-baseline\". Defaults to all schedulable nodes.\n+optional",
 		"features":              "Features specifies the CPU features list inside the VMI.\n+optional",
 		"dedicatedCpuPlacement": "DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node\nwith enough dedicated pCPUs and pin the vCPUs to it.\n+optional",
 		"numa":                  "NUMA allows specifying settings for the guest NUMA topology\n+optional",
@@ -395,6 +456,37 @@ func (Disk) SwaggerDoc() map[string]string {
 		"shareable":            "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":          "If specified, it can change the default error policy (stop) for the disk\n+optional",
 		"changedBlockTracking": "ChangedBlockTracking indicates this disk should have CBT option\nDefaults to false.\n+optional",
+		"ioTune":               "IOTune specifies I/O throttling limits enforced by the hypervisor for this disk.\nWith the LiveUpdate rollout strategy the limits can be changed on a running VM.\n+optional",
+	}
+}
+
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "DiskIOTune limits the throughput and the number of I/O operations of a disk.",
+		"bytes":     "Bytes limits the disk throughput in bytes per second.\n+optional",
+		"iops":      "IOPS limits the number of I/O operations per second.\n+optional",
+		"sizeIOPS":  "SizeIOPS is the size in bytes of a single I/O operation when IOPS limits are accounted.\nLarger requests are counted as multiple operations.\n+optional",
+		"groupName": "GroupName places the disk into a throttling group. All disks of a group share the\nlimits of the group.\n+optional",
+	}
+}
+
+func (DiskIOLimits) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "DiskIOLimits describes sustained limits and optional bursts.\nTotal can not be combined with Read or Write.",
+		"total": "Total limits reads and writes combined.\n+optional",
+		"read":  "Read limits reads.\n+optional",
+		"write": "Write limits writes.\n+optional",
+		"burst": "Burst allows exceeding the sustained limits for a limited time.\n+optional",
+	}
+}
+
+func (DiskIOBurst) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "DiskIOBurst describes the maximum rates allowed during a burst.\nEvery burst value requires the matching sustained limit to be set.",
+		"total":         "Total is the maximum rate for reads and writes combined during a burst.\n+optional",
+		"read":          "Read is the maximum read rate during a burst.\n+optional",
+		"write":         "Write is the maximum write rate during a burst.\n+optional",
+		"lengthSeconds": "LengthSeconds is the duration of a burst.\nDefaults to 1.\n+optional",
 	}
 }
 
@@ -492,6 +584,7 @@ func (VolumeSource) SwaggerDoc() map[string]string {
 		"persistentVolumeClaim": "PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.\nDirectly attached to the vmi via qemu.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims\n+optional",
 		"cloudInitNoCloud":      "CloudInitNoCloud represents a cloud-init NoCloud user-data source.\nThe NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.\nMore info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html\n+optional",
 		"cloudInitConfigDrive":  "CloudInitConfigDrive represents a cloud-init Config Drive user-data source.\nThe Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.\nMore info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html\n+optional",
+		"ignition":              "Ignition represents an Ignition config source.\nThe config is passed to the guest through QEMU fw_cfg, or as a raw disk when the volume is attached as a disk.\nMore info: https://coreos.github.io/ignition/\n+optional",
 		"sysprep":               "Represents a Sysprep volume source.\n+optional",
 		"containerDisk":         "ContainerDisk references a docker image, embedding a qcow or raw disk.\nMore info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html\n+optional",
 		"ephemeral":             "Ephemeral is a special volume source that \"wraps\" specified source and provides copy-on-write image on top of it.\n+optional",
@@ -580,8 +673,15 @@ func (ClockOffsetUTC) SwaggerDoc() map[string]string {
 
 func (Clock) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":      "Represents the clock and timers of a vmi.\n+kubebuilder:pruning:PreserveUnknownFields",
-		"timer": "Timer specifies whih timers are attached to the vmi.\n+optional",
+		"":              "Represents the clock and timers of a vmi.\n+kubebuilder:pruning:PreserveUnknownFields",
+		"timer":         "Timer specifies whih timers are attached to the vmi.\n+optional",
+		"guestTimeSync": "GuestTimeSync enables setting the guest clock through the guest agent after\nthe guest was unpaused or migrated, and once the guest agent connects after\nboot, e.g. when starting from a restored snapshot. The drift observed before\nevery synchronisation is reported as a metric.\n+optional",
+	}
+}
+
+func (GuestTimeSync) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "GuestTimeSync represents the synchronisation of the guest clock with the host clock.",
 	}
 }
 
@@ -754,16 +854,84 @@ func (Interface) SwaggerDoc() map[string]string {
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
 		"state":       "State represents the requested operational state of the interface.\nThe supported values are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link down.\n`up`, expressing a request to set the link up.\nEmpty value functions as `up`.\n+optional",
+		"bandwidth":   "Bandwidth limits the traffic rate of the interface.\nSupported only by interfaces attached to the domain through a tap device\n(bridge, masquerade and binding plugins with the tap domain attachment type).\nCan be updated on a running VM.\n+optional",
+		"firewall":    "Firewall filters the traffic bridged between the guest and the network.\nSupported only by the bridge binding.\nRequires the nf_conntrack_bridge kernel module to be loaded on the node.\n+optional",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth shapes the traffic of an interface.\nDirections are from the guest point of view.",
+		"inbound":  "Inbound limits the traffic received by the guest.\n+optional",
+		"outbound": "Outbound limits the traffic sent by the guest.\n+optional",
+	}
+}
+
+func (BandwidthLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "BandwidthLimit describes the rate of a traffic direction.",
+		"average": "Average is the average bit rate of the shaped traffic, in kibibytes per second.",
+		"peak":    "Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.\nMust not be lower than the average.\n+optional",
+		"burst":   "Burst is the amount of kibibytes that can be sent in a single burst at the peak rate.\n+optional",
+	}
+}
+
+func (InterfaceFirewall) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "InterfaceFirewall is a stateful filter of the interface traffic.\nReplies to allowed connections are always allowed.",
+		"defaultAction": "DefaultAction is applied to the traffic which does not match any rule.\nDefaults to Allow.\n+optional",
+		"rules":         "Rules are evaluated in order, the first matching rule decides the traffic fate.\n+optional\n+listType=atomic",
+	}
+}
+
+func (FirewallRule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"direction": "Direction of the traffic the rule applies to: Ingress or Egress.",
+		"action":    "Action to take on matching traffic: Allow or Deny.",
+		"cidr":      "CIDR of the remote peer: the source of ingress traffic or the destination of egress traffic.\nMatches any peer when not specified.\n+optional",
+		"protocol":  "Protocol of the matching traffic: TCP, UDP, SCTP or ICMP.\nMatches any protocol when not specified.\n+optional",
+		"port":      "Port is the destination port of the matching traffic.\nRequires the TCP, UDP or SCTP protocol.\n+optional",
+		"endPort":   "EndPort indicates that the range of ports from Port to EndPort, inclusive, should match.\n+optional",
 	}
 }
 
 func (DHCPOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":               "Extra DHCP options to use in the interface.",
-		"bootFileName":   "If specified will pass option 67 to interface's DHCP server\n+optional",
-		"tftpServerName": "If specified will pass option 66 to interface's DHCP server\n+optional",
-		"ntpServers":     "If specified will pass the configured NTP server to the VM via DHCP option 042.\n+optional",
-		"privateOptions": "If specified will pass extra DHCP options for private use, range: 224-254\n+optional",
+		"":                 "Extra DHCP options to use in the interface.",
+		"bootFileName":     "If specified will pass option 67 to interface's DHCP server\n+optional",
+		"tftpServerName":   "If specified will pass option 66 to interface's DHCP server\n+optional",
+		"ntpServers":       "If specified will pass the configured NTP server to the VM via DHCP option 042.\n+optional",
+		"privateOptions":   "If specified will pass extra DHCP options for private use, range: 224-254\n+optional",
+		"options":          "If specified will pass the listed DHCP options, overriding the values set by KubeVirt.\nOptions driving the DHCP protocol itself (e.g. lease time, message type, server identifier) cannot be set.\n+optional\n+listType=atomic",
+		"classlessRoutes":  "If specified will pass the routes via DHCP option 121, in addition to the routes of the pod interface.\n+optional\n+listType=atomic",
+		"domainSearch":     "If specified will pass the domain search list via DHCP option 119, instead of the one of the pod.\n+optional\n+listType=atomic",
+		"leaseTimeSeconds": "LeaseTimeSeconds is the lease time of the address offered to the guest.\nApplies to the preferred and valid lifetimes of the DHCPv6 address as well.\nMust be at least 60 seconds.\nDefaults to an infinite lease.\n+optional",
+		"v6":               "V6 specifies options passed by the DHCPv6 server, used by the masquerade binding.\n+optional",
+	}
+}
+
+func (DHCPOption) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "DHCPOption is a numeric DHCP option passed to the guest.",
+		"code":  "Code of the option, range: 1-254.",
+		"value": "Value of the option, encoded according to the type.",
+		"type":  "Type of the value, one of: string, hex, ipv4, uint8, uint16, uint32.\nDefaults to string.\n+optional",
+	}
+}
+
+func (DHCPClasslessRoute) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "DHCPClasslessRoute is a route passed via DHCP option 121.",
+		"destination": "Destination network in CIDR notation, e.g. 10.0.0.0/8.",
+		"gateway":     "Gateway IPv4 address. An empty value describes a route on the link.\n+optional",
+	}
+}
+
+func (DHCPv6Options) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "DHCPv6Options are the options passed by the DHCPv6 server.\nThe server offers the guest address of the masquerade binding as a static lease, to the client\nsending from the MAC address of the interface. Prefix delegation (IA_PD) is not supported.",
+		"ntpServers":   "If specified will pass the NTP servers via DHCPv6 option 56.\n+optional\n+listType=atomic",
+		"domainSearch": "If specified will pass the domain search list via DHCPv6 option 24, instead of the one of the pod.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -837,8 +1005,9 @@ func (Port) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "Port represents a port to expose from the virtual machine.\nDefault protocol TCP.\nThe port field is mandatory",
 		"name":     "If specified, this must be an IANA_SVC_NAME and unique within the pod. Each\nnamed port in a pod must have a unique name. Name for the port that can be\nreferred to by services.\n+optional",
-		"protocol": "Protocol for port. Must be UDP or TCP.\nDefaults to \"TCP\".\n+optional",
+		"protocol": "Protocol for port. Must be UDP, TCP, SCTP or ICMP.\nICMP forwards echo requests and is supported only by the masquerade binding,\nin which case the port must not be set.\nDefaults to \"TCP\".\n+optional",
 		"port":     "Number of port to expose for the virtual machine.\nThis must be a valid port number, 0 < x < 65536.",
+		"endPort":  "EndPort indicates that the range of ports from Port to EndPort, inclusive,\nshould be exposed. It must be greater than or equal to Port,\nthe range may span at most 1024 ports.\nSupported only by the masquerade binding.\n+optional",
 	}
 }
 
@@ -140,6 +140,11 @@ type VirtualMachineInstanceSpec struct {
 	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
 	// +optional
 	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+	// GuestServiceWatch lists services of the guest whose state is checked periodically
+	// through the guest agent. The state is reported by the GuestServicesUp condition
+	// and the kubevirt_vmi_guest_service_up metric.
+	// +optional
+	GuestServiceWatch *GuestServiceWatch `json:"guestServiceWatch,omitempty"`
 	// Specifies the hostname of the vmi
 	// If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
 	// +optional
@@ -227,6 +232,19 @@ type VirtualMachineInstancePhaseTransitionTimestamp struct {
 
 type TopologyHints struct {
 	TSCFrequency *int64 `json:"tscFrequency,omitempty"`
+	// CPUBaseline is the CPU model and features common to the nodes of the CPU model group of the VMI
+	CPUBaseline *CPUBaseline `json:"cpuBaseline,omitempty"`
+}
+
+// CPUBaseline is the CPU model a VMI with the "cluster-baseline" CPU model runs with
+type CPUBaseline struct {
+	// Group is the CPU model group the baseline was computed for
+	Group string `json:"group,omitempty"`
+	// Model is the CPU model usable on all nodes of the group
+	Model string `json:"model"`
+	// Features lists the CPU features supported by all nodes of the group
+	// +listType=atomic
+	Features []string `json:"features,omitempty"`
 }
 
 // VirtualMachineInstanceStatus represents information about the status of a VirtualMachineInstance. Status may trail the actual
@@ -330,6 +348,21 @@ type VirtualMachineInstanceStatus struct {
 	// +nullable
 	// +optional
 	ChangedBlockTracking *ChangedBlockTrackingStatus `json:"changedBlockTracking,omitempty" optional:"true"`
+
+	// DomainPatches lists the cluster domain patches selected for the VMI when it was created.
+	// They are applied to the libvirt domain when it is defined.
+	// +listType=atomic
+	// +optional
+	DomainPatches []VirtualMachineInstanceDomainPatch `json:"domainPatches,omitempty"`
+}
+
+// VirtualMachineInstanceDomainPatch is a domain patch applied to the libvirt domain of a VMI
+type VirtualMachineInstanceDomainPatch struct {
+	// Name of the domain patch in the KubeVirt configuration
+	Name string `json:"name"`
+	// Operations applied to the domain XML, in order
+	// +listType=atomic
+	Operations []DomainPatchOperation `json:"operations"`
 }
 
 // StorageMigratedVolumeInfo tracks the information about the source and destination volumes during the volume migration
@@ -662,6 +695,15 @@ const (
 	// Reflects whether the QEMU guest agent updated access credentials successfully
 	VirtualMachineInstanceAccessCredentialsSynchronized VirtualMachineInstanceConditionType = "AccessCredentialsSynchronized"
 
+	// Reflects whether the last change made to a guest user through the guestuser subresource succeeded
+	VirtualMachineInstanceGuestUserUpdated VirtualMachineInstanceConditionType = "GuestUserUpdated"
+
+	// Reflects whether the cloud-init data of a running VMI with the Live update policy was updated successfully
+	VirtualMachineInstanceCloudInitSynchronized VirtualMachineInstanceConditionType = "CloudInitSynchronized"
+
+	// Reflects whether all the services watched through the guest agent are running
+	VirtualMachineInstanceGuestServicesUp VirtualMachineInstanceConditionType = "GuestServicesUp"
+
 	// Reflects whether the QEMU guest agent is connected through the channel
 	VirtualMachineInstanceUnsupportedAgent VirtualMachineInstanceConditionType = "AgentVersionNotSupported"
 
@@ -845,7 +887,7 @@ type VirtualMachineInstanceNetworkInterface struct {
 	PodInterfaceName string `json:"podInterfaceName,omitempty"`
 	// The interface name inside the Virtual Machine
 	InterfaceName string `json:"interfaceName,omitempty"`
-	// Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.
+	// Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status, dhcp-lease, arp-ndp, passt.
 	InfoSource string `json:"infoSource,omitempty"`
 	// Specifies how many queues are allocated by MultiQueue
 	QueueCount int32 `json:"queueCount,omitempty"`
@@ -1216,6 +1258,11 @@ const (
 	CPUModelVendorLabel = "cpu-vendor.node.kubevirt.io/"
 	// This label represents supported machine type on the node
 	SupportedMachineTypeLabel = "machine-type.node.kubevirt.io/"
+	// This label represents the NUMA topology of the node
+	NUMALabel = "numa.node.kubevirt.io/"
+	// This label marks the config maps in which the node labeller publishes the NUMA topology of a node,
+	// its value is the node name
+	NUMATopologyNodeLabel = "kubevirt.io/numa-topology-node"
 
 	VirtIO = "virtio"
 
@@ -1298,6 +1345,10 @@ const (
 	KSMSleepMsBaselineOverride string = "kubevirt.io/ksm-sleep-ms-baseline-override"
 	KSMFreePercentOverride     string = "kubevirt.io/ksm-free-percent-override"
 
+	// IPAllocationsAnnotation carries the IP allocations of the VirtualMachine to its VirtualMachineInstance.
+	// The value is a JSON encoded list of VirtualMachineIPAllocation.
+	IPAllocationsAnnotation string = "kubevirt.io/ip-allocations"
+
 	// InstancetypeAnnotation is the name of a VirtualMachineInstancetype
 	InstancetypeAnnotation string = "kubevirt.io/instancetype-name"
 
@@ -1471,6 +1522,8 @@ const (
 	Resumed                      SyncEvent = "Resumed"
 	AccessCredentialsSyncFailed  SyncEvent = "AccessCredentialsSyncFailed"
 	AccessCredentialsSyncSuccess SyncEvent = "AccessCredentialsSyncSuccess"
+	CloudInitSyncFailed          SyncEvent = "CloudInitSyncFailed"
+	CloudInitSyncSuccess         SyncEvent = "CloudInitSyncSuccess"
 )
 
 func (s SyncEvent) String() string {
@@ -2104,6 +2157,30 @@ type VirtualMachineStatus struct {
 	//+nullable
 	//+optional
 	PreferenceRef *InstancetypeStatusRef `json:"preferenceRef,omitempty"`
+
+	// IPAllocations holds the addresses allocated to the VirtualMachine secondary networks
+	// from the KubeVirt IP pool of their NetworkAttachmentDefinition.
+	// The allocations are kept across restarts and migrations of the VirtualMachine.
+	// +optional
+	// +listType=atomic
+	IPAllocations []VirtualMachineIPAllocation `json:"ipAllocations,omitempty" optional:"true"`
+}
+
+type VirtualMachineIPAllocation struct {
+	// Network is the name of the VirtualMachine network the addresses are allocated to.
+	Network string `json:"network"`
+	// NetworkAttachmentDefinition holding the IP pool, in the <namespace>/<name> format.
+	NetworkAttachmentDefinition string `json:"networkAttachmentDefinition"`
+	// IPAddresses allocated to the network, in CIDR notation with the subnet prefix length.
+	// +listType=atomic
+	IPAddresses []string `json:"ipAddresses"`
+	// Gateway of the subnet.
+	// +optional
+	Gateway string `json:"gateway,omitempty"`
+	// Nameservers advertised to the guest.
+	// +optional
+	// +listType=atomic
+	Nameservers []string `json:"nameservers,omitempty"`
 }
 
 type ControllerRevisionRef struct {
@@ -2361,6 +2438,19 @@ type Probe struct {
 	FailureThreshold int32 `json:"failureThreshold,omitempty"`
 }
 
+// GuestServiceWatch describes the services of the guest to watch through the guest agent.
+type GuestServiceWatch struct {
+	// Services to watch, systemd units on Linux guests and service names on Windows guests.
+	// At most 10 services can be watched.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=10
+	Services []string `json:"services"`
+	// How often (in seconds) to check the services.
+	// Defaults to 60 seconds. Minimum value is 30.
+	// +optional
+	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+}
+
 // KubeVirt represents the object deploying all KubeVirt resources
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -2834,6 +2924,128 @@ type VirtualMachineInstanceGuestOSUserList struct {
 	Items           []VirtualMachineInstanceGuestOSUser `json:"items"`
 }
 
+// VirtualMachineInstanceNetworkStatistics holds the traffic counters of the VMI network interfaces
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceNetworkStatistics struct {
+	metav1.TypeMeta `json:",inline"`
+	// +listType=atomic
+	Interfaces []VirtualMachineInstanceInterfaceStatistics `json:"interfaces"`
+}
+
+// VirtualMachineInstanceInterfaceStatistics holds the traffic counters of a VMI network interface,
+// as seen from the host side of the interface
+type VirtualMachineInstanceInterfaceStatistics struct {
+	// Name of the VMI interface, or of the host device when it does not match a VMI interface
+	Name string `json:"name"`
+	// Device is the host device backing the interface, e.g. the tap device
+	Device    string `json:"device,omitempty"`
+	RxBytes   uint64 `json:"rxBytes"`
+	RxPackets uint64 `json:"rxPackets"`
+	RxErrors  uint64 `json:"rxErrors"`
+	RxDropped uint64 `json:"rxDropped"`
+	TxBytes   uint64 `json:"txBytes"`
+	TxPackets uint64 `json:"txPackets"`
+	TxErrors  uint64 `json:"txErrors"`
+	TxDropped uint64 `json:"txDropped"`
+}
+
+// VirtualMachineInstanceGuestExecOptions is the command run in the guest by the guestexec subresource
+type VirtualMachineInstanceGuestExecOptions struct {
+	// Command is the path of the command in the guest, it must be allowed by the cluster configuration
+	Command string `json:"command"`
+	// Args are the arguments passed to the command
+	// +listType=atomic
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the time the command is given to exit.
+	// Defaults to, and is limited by, the cluster configuration.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// VirtualMachineInstanceGuestExecResult is the result of a command run in the guest
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceGuestExecResult struct {
+	metav1.TypeMeta `json:",inline"`
+	// ExitCode is the exit code of the command
+	ExitCode int32 `json:"exitCode"`
+	// Stdout is the standard output of the command
+	// +optional
+	Stdout string `json:"stdout,omitempty"`
+	// Truncated is set when the output exceeded the size limit and was truncated
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// VirtualMachineInstanceGuestFileReadOptions selects the chunk of a guest file read by the guestfile subresource
+type VirtualMachineInstanceGuestFileReadOptions struct {
+	// Path is the absolute path of the file in the guest
+	Path string `json:"path"`
+	// Offset is the position in the file the chunk starts at
+	// +optional
+	Offset int64 `json:"offset,omitempty"`
+	// Length is the maximum size of the chunk.
+	// Defaults to, and is limited by, the maximum chunk size of the subresource.
+	// +optional
+	Length int64 `json:"length,omitempty"`
+}
+
+// VirtualMachineInstanceGuestFileChunk is a chunk of a guest file
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceGuestFileChunk struct {
+	metav1.TypeMeta `json:",inline"`
+	// Data is the content of the chunk
+	// +optional
+	Data []byte `json:"data,omitempty"`
+	// Size is the size of the whole file
+	Size int64 `json:"size"`
+	// EOF is set when the chunk ends at the end of the file
+	// +optional
+	EOF bool `json:"eof,omitempty"`
+}
+
+// VirtualMachineInstanceGuestFileWriteOptions is a chunk written to a guest file by the guestfile subresource
+type VirtualMachineInstanceGuestFileWriteOptions struct {
+	// Path is the absolute path of the file in the guest
+	Path string `json:"path"`
+	// Offset is the position in the file the chunk is written at.
+	// The file is created, or truncated, when the offset is zero; it must exist otherwise.
+	// +optional
+	Offset int64 `json:"offset,omitempty"`
+	// Data is the content of the chunk
+	// +optional
+	Data []byte `json:"data,omitempty"`
+}
+
+// GuestUserAction is the change made to a guest user by the guestuser subresource
+type GuestUserAction string
+
+const (
+	// GuestUserSetPassword sets the password of an existing guest user
+	GuestUserSetPassword GuestUserAction = "SetPassword"
+	// GuestUserCreate creates a guest user, and sets its password when one is given
+	GuestUserCreate GuestUserAction = "Create"
+	// GuestUserLock locks a guest user, so that it cannot log in anymore
+	GuestUserLock GuestUserAction = "Lock"
+	// GuestUserUnlock unlocks a locked guest user
+	GuestUserUnlock GuestUserAction = "Unlock"
+)
+
+// VirtualMachineInstanceGuestUserOptions is the change made to a guest user by the guestuser subresource
+type VirtualMachineInstanceGuestUserOptions struct {
+	// Action is the change made to the user
+	Action GuestUserAction `json:"action"`
+	// Username is the name of the user in the guest
+	Username string `json:"username"`
+	// Password is the new password of the user.
+	// It is required to set the password, and optional when creating the user.
+	// +optional
+	Password string `json:"password,omitempty"`
+}
+
 // VirtualMachineGuestOSUser is the single user of the guest os
 type VirtualMachineInstanceGuestOSUser struct {
 	UserName string `json:"userName"`
@@ -2947,6 +3159,24 @@ type VSOCKOptions struct {
 	UseTLS     *bool  `json:"useTLS,omitempty"`
 }
 
+// PcapOptions are provided when capturing the traffic of a VMI network interface
+type PcapOptions struct {
+	// Interface is the name of the VMI interface whose traffic is captured
+	Interface string `json:"interface"`
+	// Filter is a pcap-filter expression the packets are matched against, e.g. "tcp port 22".
+	// Protocols, hosts, networks, ports and port ranges are supported, host names are not resolved.
+	// +optional
+	Filter string `json:"filter,omitempty"`
+	// Duration after which the capture stops, at most 30 minutes.
+	// Defaults to one minute.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// PacketCount is the number of packets after which the capture stops, at most 1000000.
+	// Defaults to 10000.
+	// +optional
+	PacketCount uint32 `json:"packetCount,omitempty"`
+}
+
 // RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk
 type RemoveVolumeOptions struct {
 	// Name represents the name that maps to both the disk and volume that
@@ -2991,7 +3221,15 @@ type ReloadableComponentConfiguration struct {
 
 // KubeVirtConfiguration holds all kubevirt configurations
 type KubeVirtConfiguration struct {
-	CPUModel               string                  `json:"cpuModel,omitempty"`
+	CPUModel string `json:"cpuModel,omitempty"`
+	// CPUModelGroups defines groups of nodes between which VMIs with the "cluster-baseline"
+	// CPU model can migrate. The baseline of a group is the most capable CPU model, in the order
+	// of the libvirt CPU map, which all its nodes support, with the host-model CPU features
+	// which all its nodes report.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	CPUModelGroups         []CPUModelGroup         `json:"cpuModelGroups,omitempty"`
 	CPURequest             *resource.Quantity      `json:"cpuRequest,omitempty"`
 	DeveloperConfiguration *DeveloperConfiguration `json:"developerConfiguration,omitempty"`
 	// Deprecated. Use architectureConfiguration instead.
@@ -3097,6 +3335,120 @@ type KubeVirtConfiguration struct {
 	// +optional
 	// +kubebuilder:validation:Enum=AggregateToDefault;Manual
 	RoleAggregationStrategy *RoleAggregationStrategy `json:"roleAggregationStrategy,omitempty"`
+
+	// DomainPatches are applied to the libvirt domain of the VMIs they select, after KubeVirt
+	// generated it and the hook sidecars ran. They are selected when the VMI is created.
+	// +listType=atomic
+	// +optional
+	DomainPatches []DomainPatch `json:"domainPatches,omitempty"`
+
+	// GuestExec restricts the commands which can be run in the guests through the guestexec
+	// subresource. The subresource requires the GuestExec feature gate.
+	// +nullable
+	// +optional
+	GuestExec *GuestExecConfiguration `json:"guestExec,omitempty"`
+
+	// GuestFileTransfer limits the files copied from and to the guests through the guestfile
+	// subresource. The subresource requires the GuestFileTransfer feature gate.
+	// +nullable
+	// +optional
+	GuestFileTransfer *GuestFileTransferConfiguration `json:"guestFileTransfer,omitempty"`
+}
+
+// GuestExecConfiguration holds the allow-list and the limits of the guestexec subresource
+type GuestExecConfiguration struct {
+	// AllowedCommands are the paths of the guest commands which can be run in the VMIs of all namespaces.
+	// Commands are matched exactly, as they are passed to the guest agent.
+	// +listType=set
+	// +optional
+	AllowedCommands []string `json:"allowedCommands,omitempty"`
+	// NamespaceAllowedCommands are the commands which can additionally be run in the VMIs of the
+	// namespaces selected by their labels
+	// +listType=atomic
+	// +optional
+	NamespaceAllowedCommands []GuestExecNamespaceAllowList `json:"namespaceAllowedCommands,omitempty"`
+	// MaxOutputBytes is the maximum size of the command output returned, the remainder is discarded.
+	// Defaults to 65536.
+	// +optional
+	MaxOutputBytes *int64 `json:"maxOutputBytes,omitempty"`
+	// DefaultTimeoutSeconds is the timeout of the commands which do not request one.
+	// Defaults to 10.
+	// +optional
+	DefaultTimeoutSeconds *int32 `json:"defaultTimeoutSeconds,omitempty"`
+	// MaxTimeoutSeconds is the maximum timeout a command can request.
+	// Defaults to 60.
+	// +optional
+	MaxTimeoutSeconds *int32 `json:"maxTimeoutSeconds,omitempty"`
+}
+
+// GuestExecNamespaceAllowList allows commands in the namespaces selected by their labels
+type GuestExecNamespaceAllowList struct {
+	// NamespaceSelector selects the namespaces by their labels
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// AllowedCommands are the paths of the guest commands which can be run in the selected namespaces
+	// +listType=set
+	AllowedCommands []string `json:"allowedCommands"`
+}
+
+// GuestFileTransferConfiguration holds the limits of the guestfile subresource
+type GuestFileTransferConfiguration struct {
+	// MaxFileSizeBytes is the maximum size of the files which can be copied from and to the guests.
+	// Defaults to 104857600 (100MiB).
+	// +optional
+	MaxFileSizeBytes *int64 `json:"maxFileSizeBytes,omitempty"`
+}
+
+// DomainPatch edits the libvirt domain XML of the selected VMIs
+type DomainPatch struct {
+	// Name identifies the patch, it is reported in the status of the VMIs it is applied to
+	Name string `json:"name"`
+	// Selector selects the VMIs the patch is applied to by their labels.
+	// An empty or missing selector selects all VMIs.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// NamespaceSelector restricts the patch to the VMIs of the namespaces selected by their labels.
+	// VMI labels are set by the VMI owners, the namespace selector keeps the patch out of the other namespaces.
+	// An empty or missing namespace selector selects all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Operations applied to the domain XML, in order
+	// +listType=atomic
+	Operations []DomainPatchOperation `json:"operations"`
+}
+
+type DomainPatchOperationType string
+
+const (
+	DomainPatchOperationAdd     DomainPatchOperationType = "add"
+	DomainPatchOperationReplace DomainPatchOperationType = "replace"
+	DomainPatchOperationRemove  DomainPatchOperationType = "remove"
+)
+
+// DomainPatchOperation is a single edit of the domain XML
+type DomainPatchOperation struct {
+	// Op is the operation to perform: add, replace or remove.
+	// add sets an attribute, or appends the XML fragment in value to the selected elements.
+	// replace sets an existing attribute, or replaces the selected elements with the XML fragment in value.
+	// remove deletes the selected attribute or elements.
+	// +kubebuilder:validation:Enum=add;replace;remove
+	Op DomainPatchOperationType `json:"op"`
+	// Path selects the elements, or an attribute of them, with an XPath subset: an absolute
+	// location path of element names, filtered by [@attr='value'], [@attr] or [position]
+	// predicates, optionally ending with an @attr step.
+	// For example /domain/devices/interface[@type='ethernet'][1]/model/@type
+	Path string `json:"path"`
+	// Value is the attribute value, or the XML fragment, used by add and replace
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// CPUModelGroup selects the nodes whose common CPU model VMIs of the group run with
+type CPUModelGroup struct {
+	// Name of the group, referenced by spec.domain.cpu.modelGroup
+	Name string `json:"name"`
+	// NodeSelector selects the nodes of the group
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 }
 
 // QGSConfiguration holds QGS configuration
@@ -3535,6 +3887,40 @@ type NetworkConfiguration struct {
 	DeprecatedPermitSlirpInterface    *bool                             `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool                             `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
 	Binding                           map[string]InterfaceBindingPlugin `json:"binding,omitempty"`
+	// MACPool enables the allocation of MAC addresses to the VirtualMachine interfaces
+	// which do not specify one. The allocated address is persisted on the VirtualMachine template
+	// and is released when the VirtualMachine is deleted.
+	// +optional
+	MACPool *MACPoolConfiguration `json:"macPool,omitempty"`
+}
+
+// MACPoolConfiguration holds the MAC address ranges to allocate from.
+type MACPoolConfiguration struct {
+	// Ranges of MAC addresses to allocate from.
+	// +listType=atomic
+	Ranges []MACRange `json:"ranges"`
+	// NamespaceOverrides replace the ranges for the VirtualMachines of specific namespaces.
+	// A namespace override without ranges opts the namespace out of the allocation.
+	// +optional
+	// +listType=atomic
+	NamespaceOverrides []NamespaceMACPool `json:"namespaceOverrides,omitempty"`
+}
+
+// MACRange is an inclusive range of unicast MAC addresses.
+type MACRange struct {
+	// Start is the first address of the range, e.g. 02:00:00:00:00:00
+	Start string `json:"start"`
+	// End is the last address of the range, e.g. 02:00:00:ff:ff:ff
+	End string `json:"end"`
+}
+
+type NamespaceMACPool struct {
+	// Namespace the ranges apply to.
+	Namespace string `json:"namespace"`
+	// Ranges of MAC addresses to allocate from for the VirtualMachines of the namespace.
+	// +optional
+	// +listType=atomic
+	Ranges []MACRange `json:"ranges,omitempty"`
 }
 
 type InterfaceBindingPlugin struct {
@@ -3548,7 +3934,7 @@ type InterfaceBindingPlugin struct {
 	// version: 1alphav1
 	NetworkAttachmentDefinition string `json:"networkAttachmentDefinition,omitempty"`
 	// DomainAttachmentType is a standard domain network attachment method kubevirt supports.
-	// Supported values: "tap", "managedTap" (since v1.4).
+	// Supported values: "tap", "managedTap" (since v1.4), "vhostuser".
 	// The standard domain attachment can be used instead or in addition to the sidecarImage.
 	// version: 1alphav1
 	DomainAttachmentType DomainAttachmentType `json:"domainAttachmentType,omitempty"`
@@ -3591,6 +3977,11 @@ const (
 	// ManagedTap domain attachment type is binding an ethernet connection into guests using a tap device.
 	// The tap device is created (unless already present) on the network pod interface with a Linux bridge.
 	ManagedTap DomainAttachmentType = "managedTap"
+	// VhostUser domain attachment type is binding the guest interface to a userspace datapath through a vhost-user socket.
+	// The socket is expected to be provided by the CNI in the vhost-user sockets directory of the virt-launcher pod,
+	// named after the pod interface. It requires the guest memory to be backed by huge pages.
+	// https://libvirt.org/formatdomain.html#vhost-user-interface
+	VhostUser DomainAttachmentType = "vhostuser"
 )
 
 type NetworkBindingDownwardAPIType string
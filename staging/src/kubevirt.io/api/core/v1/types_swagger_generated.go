@@ -32,6 +32,7 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"volumes":                       "List of volumes that can be mounted by disks belonging to the vmi.\n+kubebuilder:validation:MaxItems:=256",
 		"livenessProbe":                 "Periodic probe of VirtualMachineInstance liveness.\nVirtualmachineInstances will be stopped if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
 		"readinessProbe":                "Periodic probe of VirtualMachineInstance service readiness.\nVirtualmachineInstances will be removed from service endpoints if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
+		"guestServiceWatch":             "GuestServiceWatch lists services of the guest whose state is checked periodically\nthrough the guest agent. The state is reported by the GuestServicesUp condition\nand the kubevirt_vmi_guest_service_up metric.\n+optional",
 		"hostname":                      "Specifies the hostname of the vmi\nIf not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.\n+optional",
 		"subdomain":                     "If specified, the fully qualified vmi hostname will be \"<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>\".\nIf not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi,\nno matter if the vmi itself can pick up a hostname.\n+optional",
 		"networks":                      "List of networks that can be attached to a vm's virtual interface.\n+kubebuilder:validation:MaxItems:=256",
@@ -53,7 +54,18 @@ func (VirtualMachineInstancePhaseTransitionTimestamp) SwaggerDoc() map[string]st
 }
 
 func (TopologyHints) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"cpuBaseline": "CPUBaseline is the CPU model and features common to the nodes of the CPU model group of the VMI",
+	}
+}
+
+func (CPUBaseline) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "CPUBaseline is the CPU model a VMI with the \"cluster-baseline\" CPU model runs with",
+		"group":    "Group is the CPU model group the baseline was computed for",
+		"model":    "Model is the CPU model usable on all nodes of the group",
+		"features": "Features lists the CPU features supported by all nodes of the group\n+listType=atomic",
+	}
 }
 
 func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
@@ -86,6 +98,15 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"memory":                        "Memory shows various informations about the VirtualMachine memory.\n+optional",
 		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
 		"changedBlockTracking":          "ChangedBlockTracking represents the status of the changedBlockTracking\n+nullable\n+optional",
+		"domainPatches":                 "DomainPatches lists the cluster domain patches selected for the VMI when it was created.\nThey are applied to the libvirt domain when it is defined.\n+listType=atomic\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceDomainPatch) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineInstanceDomainPatch is a domain patch applied to the libvirt domain of a VMI",
+		"name":       "Name of the domain patch in the KubeVirt configuration",
+		"operations": "Operations applied to the domain XML, in order\n+listType=atomic",
 	}
 }
 
@@ -196,7 +217,7 @@ func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 		"ipAddresses":      "List of all IP addresses of a Virtual Machine interface",
 		"podInterfaceName": "PodInterfaceName represents the name of the pod network interface",
 		"interfaceName":    "The interface name inside the Virtual Machine",
-		"infoSource":       "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
+		"infoSource":       "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status, dhcp-lease, arp-ndp, passt.",
 		"queueCount":       "Specifies how many queues are allocated by MultiQueue",
 		"linkState":        "LinkState Reports the current operational link state`. values: up, down.",
 	}
@@ -470,6 +491,17 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"changedBlockTracking":   "ChangedBlockTracking represents the status of the changedBlockTracking\n+nullable\n+optional",
 		"instancetypeRef":        "InstancetypeRef captures the state of any referenced instance type from the VirtualMachine\n+nullable\n+optional",
 		"preferenceRef":          "PreferenceRef captures the state of any referenced preference from the VirtualMachine\n+nullable\n+optional",
+		"ipAllocations":          "IPAllocations holds the addresses allocated to the VirtualMachine secondary networks\nfrom the KubeVirt IP pool of their NetworkAttachmentDefinition.\nThe allocations are kept across restarts and migrations of the VirtualMachine.\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineIPAllocation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"network":                     "Network is the name of the VirtualMachine network the addresses are allocated to.",
+		"networkAttachmentDefinition": "NetworkAttachmentDefinition holding the IP pool, in the <namespace>/<name> format.",
+		"ipAddresses":                 "IPAddresses allocated to the network, in CIDR notation with the subnet prefix length.\n+listType=atomic",
+		"gateway":                     "Gateway of the subnet.\n+optional",
+		"nameservers":                 "Nameservers advertised to the guest.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -575,6 +607,14 @@ func (Probe) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GuestServiceWatch) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "GuestServiceWatch describes the services of the guest to watch through the guest agent.",
+		"services":      "Services to watch, systemd units on Linux guests and service names on Windows guests.\nAt most 10 services can be watched.\n+listType=atomic\n+kubebuilder:validation:MaxItems:=10",
+		"periodSeconds": "How often (in seconds) to check the services.\nDefaults to 60 seconds. Minimum value is 30.\n+optional",
+	}
+}
+
 func (KubeVirt) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "KubeVirt represents the object deploying all KubeVirt resources\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+genclient",
@@ -766,6 +806,75 @@ func (VirtualMachineInstanceGuestOSUserList) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceNetworkStatistics) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineInstanceNetworkStatistics holds the traffic counters of the VMI network interfaces\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"interfaces": "+listType=atomic",
+	}
+}
+
+func (VirtualMachineInstanceInterfaceStatistics) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineInstanceInterfaceStatistics holds the traffic counters of a VMI network interface,\nas seen from the host side of the interface",
+		"name":   "Name of the VMI interface, or of the host device when it does not match a VMI interface",
+		"device": "Device is the host device backing the interface, e.g. the tap device",
+	}
+}
+
+func (VirtualMachineInstanceGuestExecOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceGuestExecOptions is the command run in the guest by the guestexec subresource",
+		"command":        "Command is the path of the command in the guest, it must be allowed by the cluster configuration",
+		"args":           "Args are the arguments passed to the command\n+listType=atomic\n+optional",
+		"timeoutSeconds": "TimeoutSeconds is the time the command is given to exit.\nDefaults to, and is limited by, the cluster configuration.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestExecResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VirtualMachineInstanceGuestExecResult is the result of a command run in the guest\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"exitCode":  "ExitCode is the exit code of the command",
+		"stdout":    "Stdout is the standard output of the command\n+optional",
+		"truncated": "Truncated is set when the output exceeded the size limit and was truncated\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestFileReadOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineInstanceGuestFileReadOptions selects the chunk of a guest file read by the guestfile subresource",
+		"path":   "Path is the absolute path of the file in the guest",
+		"offset": "Offset is the position in the file the chunk starts at\n+optional",
+		"length": "Length is the maximum size of the chunk.\nDefaults to, and is limited by, the maximum chunk size of the subresource.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestFileChunk) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachineInstanceGuestFileChunk is a chunk of a guest file\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"data": "Data is the content of the chunk\n+optional",
+		"size": "Size is the size of the whole file",
+		"eof":  "EOF is set when the chunk ends at the end of the file\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestFileWriteOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineInstanceGuestFileWriteOptions is a chunk written to a guest file by the guestfile subresource",
+		"path":   "Path is the absolute path of the file in the guest",
+		"offset": "Offset is the position in the file the chunk is written at.\nThe file is created, or truncated, when the offset is zero; it must exist otherwise.\n+optional",
+		"data":   "Data is the content of the chunk\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestUserOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VirtualMachineInstanceGuestUserOptions is the change made to a guest user by the guestuser subresource",
+		"action":   "Action is the change made to the user",
+		"username": "Username is the name of the user in the guest",
+		"password": "Password is the new password of the user.\nIt is required to set the password, and optional when creating the user.\n+optional",
+	}
+}
+
 func (VirtualMachineInstanceGuestOSUser) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":          "VirtualMachineGuestOSUser is the single user of the guest os",
@@ -834,6 +943,16 @@ func (VSOCKOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (PcapOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "PcapOptions are provided when capturing the traffic of a VMI network interface",
+		"interface":   "Interface is the name of the VMI interface whose traffic is captured",
+		"filter":      "Filter is a pcap-filter expression the packets are matched against, e.g. \"tcp port 22\".\nProtocols, hosts, networks, ports and port ranges are supported, host names are not resolved.\n+optional",
+		"duration":    "Duration after which the capture stops, at most 30 minutes.\nDefaults to one minute.\n+optional",
+		"packetCount": "PacketCount is the number of packets after which the capture stops, at most 1000000.\nDefaults to 10000.\n+optional",
+	}
+}
+
 func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk",
@@ -870,6 +989,7 @@ func (ReloadableComponentConfiguration) SwaggerDoc() map[string]string {
 func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                                   "KubeVirtConfiguration holds all kubevirt configurations",
+		"cpuModelGroups":                     "CPUModelGroups defines groups of nodes between which VMIs with the \"cluster-baseline\"\nCPU model can migrate. The baseline of a group is the most capable CPU model, in the order\nof the libvirt CPU map, which all its nodes support, with the host-model CPU features\nwhich all its nodes report.\n+listType=map\n+listMapKey=name\n+optional",
 		"emulatedMachines":                   "Deprecated. Use architectureConfiguration instead.",
 		"machineType":                        "Deprecated. Use architectureConfiguration instead.",
 		"ovmfPath":                           "Deprecated. Use architectureConfiguration instead.",
@@ -890,6 +1010,62 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"changedBlockTrackingLabelSelectors": "ChangedBlockTrackingLabelSelectors defines label selectors. VMs matching these selectors will have changed block tracking enabled.\nEnabling changedBlockTracking is mandatory for performing storage-agnostic backups and incremental backups.\n+nullable",
 		"confidentialCompute":                "QGS configuration for attestation on the Intel TDX Platform\n+nullable",
 		"roleAggregationStrategy":            "RoleAggregationStrategy controls whether RBAC cluster roles should be aggregated\nto the default Kubernetes roles (admin, edit, view).\nWhen set to \"AggregateToDefault\" (default) or not specified, the aggregate-to-* labels are added to the cluster roles.\nWhen set to \"Manual\", the labels are not added, and roles will not be aggregated to the default roles.\nSetting this field to \"Manual\" requires the OptOutRoleAggregation feature gate to be enabled.\nThis is an Alpha feature and subject to change.\n+optional\n+kubebuilder:validation:Enum=AggregateToDefault;Manual",
+		"domainPatches":                      "DomainPatches are applied to the libvirt domain of the VMIs they select, after KubeVirt\ngenerated it and the hook sidecars ran. They are selected when the VMI is created.\n+listType=atomic\n+optional",
+		"guestExec":                          "GuestExec restricts the commands which can be run in the guests through the guestexec\nsubresource. The subresource requires the GuestExec feature gate.\n+nullable\n+optional",
+		"guestFileTransfer":                  "GuestFileTransfer limits the files copied from and to the guests through the guestfile\nsubresource. The subresource requires the GuestFileTransfer feature gate.\n+nullable\n+optional",
+	}
+}
+
+func (GuestExecConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "GuestExecConfiguration holds the allow-list and the limits of the guestexec subresource",
+		"allowedCommands":          "AllowedCommands are the paths of the guest commands which can be run in the VMIs of all namespaces.\nCommands are matched exactly, as they are passed to the guest agent.\n+listType=set\n+optional",
+		"namespaceAllowedCommands": "NamespaceAllowedCommands are the commands which can additionally be run in the VMIs of the\nnamespaces selected by their labels\n+listType=atomic\n+optional",
+		"maxOutputBytes":           "MaxOutputBytes is the maximum size of the command output returned, the remainder is discarded.\nDefaults to 65536.\n+optional",
+		"defaultTimeoutSeconds":    "DefaultTimeoutSeconds is the timeout of the commands which do not request one.\nDefaults to 10.\n+optional",
+		"maxTimeoutSeconds":        "MaxTimeoutSeconds is the maximum timeout a command can request.\nDefaults to 60.\n+optional",
+	}
+}
+
+func (GuestExecNamespaceAllowList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "GuestExecNamespaceAllowList allows commands in the namespaces selected by their labels",
+		"namespaceSelector": "NamespaceSelector selects the namespaces by their labels",
+		"allowedCommands":   "AllowedCommands are the paths of the guest commands which can be run in the selected namespaces\n+listType=set",
+	}
+}
+
+func (GuestFileTransferConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "GuestFileTransferConfiguration holds the limits of the guestfile subresource",
+		"maxFileSizeBytes": "MaxFileSizeBytes is the maximum size of the files which can be copied from and to the guests.\nDefaults to 104857600 (100MiB).\n+optional",
+	}
+}
+
+func (DomainPatch) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "DomainPatch edits the libvirt domain XML of the selected VMIs",
+		"name":              "Name identifies the patch, it is reported in the status of the VMIs it is applied to",
+		"selector":          "Selector selects the VMIs the patch is applied to by their labels.\nAn empty or missing selector selects all VMIs.\n+optional",
+		"namespaceSelector": "NamespaceSelector restricts the patch to the VMIs of the namespaces selected by their labels.\nVMI labels are set by the VMI owners, the namespace selector keeps the patch out of the other namespaces.\nAn empty or missing namespace selector selects all namespaces.\n+optional",
+		"operations":        "Operations applied to the domain XML, in order\n+listType=atomic",
+	}
+}
+
+func (DomainPatchOperation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "DomainPatchOperation is a single edit of the domain XML",
+		"op":    "Op is the operation to perform: add, replace or remove.\nadd sets an attribute, or appends the XML fragment in value to the selected elements.\nreplace sets an existing attribute, or replaces the selected elements with the XML fragment in value.\nremove deletes the selected attribute or elements.\n+kubebuilder:validation:Enum=add;replace;remove",
+		"path":  "Path selects the elements, or an attribute of them, with an XPath subset: an absolute\nlocation path of element names, filtered by [@attr='value'], [@attr] or [position]\npredicates, optionally ending with an @attr step.\nFor example /domain/devices/interface[@type='ethernet'][1]/model/@type",
+		"value": "Value is the attribute value, or the XML fragment, used by add and replace\n+optional",
+	}
+}
+
+func (CPUModelGroup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "CPUModelGroup selects the nodes whose common CPU model VMIs of the group run with",
+		"name":         "Name of the group, referenced by spec.domain.cpu.modelGroup",
+		"nodeSelector": "NodeSelector selects the nodes of the group\n+optional",
 	}
 }
 
@@ -1121,6 +1297,30 @@ func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                     "NetworkConfiguration holds network options",
 		"permitSlirpInterface": "DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.\nDeprecated: Removed in v1.3.",
+		"macPool":              "MACPool enables the allocation of MAC addresses to the VirtualMachine interfaces\nwhich do not specify one. The allocated address is persisted on the VirtualMachine template\nand is released when the VirtualMachine is deleted.\n+optional",
+	}
+}
+
+func (MACPoolConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "MACPoolConfiguration holds the MAC address ranges to allocate from.",
+		"ranges":             "Ranges of MAC addresses to allocate from.\n+listType=atomic",
+		"namespaceOverrides": "NamespaceOverrides replace the ranges for the VirtualMachines of specific namespaces.\nA namespace override without ranges opts the namespace out of the allocation.\n+optional\n+listType=atomic",
+	}
+}
+
+func (MACRange) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "MACRange is an inclusive range of unicast MAC addresses.",
+		"start": "Start is the first address of the range, e.g. 02:00:00:00:00:00",
+		"end":   "End is the last address of the range, e.g. 02:00:00:ff:ff:ff",
+	}
+}
+
+func (NamespaceMACPool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"namespace": "Namespace the ranges apply to.",
+		"ranges":    "Ranges of MAC addresses to allocate from for the VirtualMachines of the namespace.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -1128,7 +1328,7 @@ func (InterfaceBindingPlugin) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"sidecarImage":                "SidecarImage references a container image that runs in the virt-launcher pod.\nThe sidecar handles (libvirt) domain configuration and optional services.\nversion: 1alphav1",
 		"networkAttachmentDefinition": "NetworkAttachmentDefinition references to a NetworkAttachmentDefinition CR object.\nFormat: <name>, <namespace>/<name>.\nIf namespace is not specified, VMI namespace is assumed.\nversion: 1alphav1",
-		"domainAttachmentType":        "DomainAttachmentType is a standard domain network attachment method kubevirt supports.\nSupported values: \"tap\", \"managedTap\" (since v1.4).\nThe standard domain attachment can be used instead or in addition to the sidecarImage.\nversion: 1alphav1",
+		"domainAttachmentType":        "DomainAttachmentType is a standard domain network attachment method kubevirt supports.\nSupported values: \"tap\", \"managedTap\" (since v1.4), \"vhostuser\".\nThe standard domain attachment can be used instead or in addition to the sidecarImage.\nversion: 1alphav1",
 		"migration":                   "Migration means the VM using the plugin can be safely migrated\nversion: 1alphav1",
 		"downwardAPI":                 "DownwardAPI specifies what kind of data should be exposed to the binding plugin sidecar.\nSupported values: \"device-info\"\nversion: v1alphav1\n+optional",
 		"computeResourceOverhead":     "ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.\nversion: v1alphav1\n+optional",
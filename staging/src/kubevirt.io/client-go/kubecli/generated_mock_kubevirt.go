@@ -1578,6 +1578,64 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Get(ctx, name, opts a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Get), ctx, name, opts)
 }
 
+// GuestExec mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestExec(ctx context.Context, name string, guestExecOptions *v122.VirtualMachineInstanceGuestExecOptions) (v122.VirtualMachineInstanceGuestExecResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExec", ctx, name, guestExecOptions)
+	ret0, _ := ret[0].(v122.VirtualMachineInstanceGuestExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExec indicates an expected call of GuestExec.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestExec(ctx, name, guestExecOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExec", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestExec), ctx, name, guestExecOptions)
+}
+
+// GuestFileRead mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestFileRead(ctx context.Context, name string, readOptions *v122.VirtualMachineInstanceGuestFileReadOptions) (v122.VirtualMachineInstanceGuestFileChunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", ctx, name, readOptions)
+	ret0, _ := ret[0].(v122.VirtualMachineInstanceGuestFileChunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestFileRead(ctx, name, readOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestFileRead), ctx, name, readOptions)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestFileWrite(ctx context.Context, name string, writeOptions *v122.VirtualMachineInstanceGuestFileWriteOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", ctx, name, writeOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestFileWrite(ctx, name, writeOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestFileWrite), ctx, name, writeOptions)
+}
+
+// GuestUser mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestUser(ctx context.Context, name string, guestUserOptions *v122.VirtualMachineInstanceGuestUserOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestUser", ctx, name, guestUserOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestUser indicates an expected call of GuestUser.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestUser(ctx, name, guestUserOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestUser", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestUser), ctx, name, guestUserOptions)
+}
+
 // GuestOsInfo mocks base method.
 func (m *MockVirtualMachineInstanceInterface) GuestOsInfo(ctx context.Context, name string) (v122.VirtualMachineInstanceGuestAgentInfo, error) {
 	m.ctrl.T.Helper()
@@ -1608,6 +1666,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) List(ctx, opts any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).List), ctx, opts)
 }
 
+// NetworkStatistics mocks base method.
+func (m *MockVirtualMachineInstanceInterface) NetworkStatistics(ctx context.Context, name string) (v122.VirtualMachineInstanceNetworkStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkStatistics", ctx, name)
+	ret0, _ := ret[0].(v122.VirtualMachineInstanceNetworkStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkStatistics indicates an expected call of NetworkStatistics.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) NetworkStatistics(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkStatistics", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).NetworkStatistics), ctx, name)
+}
+
 // ObjectGraph mocks base method.
 func (m *MockVirtualMachineInstanceInterface) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v122.ObjectGraphOptions) (v122.ObjectGraphNode, error) {
 	m.ctrl.T.Helper()
@@ -1657,6 +1730,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Pause(ctx, name, paus
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Pause), ctx, name, pauseOptions)
 }
 
+// Pcap mocks base method.
+func (m *MockVirtualMachineInstanceInterface) Pcap(name string, options *v122.PcapOptions) (v123.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pcap", name, options)
+	ret0, _ := ret[0].(v123.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pcap indicates an expected call of Pcap.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Pcap(name, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pcap", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Pcap), name, options)
+}
+
 // PortForward mocks base method.
 func (m *MockVirtualMachineInstanceInterface) PortForward(name string, port int, protocol string) (v123.StreamInterface, error) {
 	m.ctrl.T.Helper()
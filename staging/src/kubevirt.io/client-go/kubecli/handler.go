@@ -24,6 +24,7 @@ const (
 	usbredirTemplateURI           = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/usbredir"
 	vncTemplateURI                = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc"
 	vsockTemplateURI              = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vsock"
+	pcapTemplateURI               = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pcap"
 	pauseTemplateURI              = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
 	unpauseTemplateURI            = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unpause"
 	backupTemplateURI             = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/backup"
@@ -35,6 +36,10 @@ const (
 	guestInfoTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
 	userListTemplateURI           = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
+	netstatTemplateURI            = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/netstat"
+	guestExecTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestexec"
+	guestFileTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestfile"
+	guestUserTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestuser"
 	screenshotTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc/screenshot"
 
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
@@ -64,6 +69,7 @@ type VirtHandlerConn interface {
 	VNCURI(vmi *virtv1.VirtualMachineInstance, preserveSession bool) (string, error)
 	ScreenshotURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error)
+	PcapURI(vmi *virtv1.VirtualMachineInstance, query url.Values) (string, error)
 	PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnpauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
@@ -75,10 +81,15 @@ type VirtHandlerConn interface {
 	SEVInjectLaunchSecretURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	Pod() (pod *v1.Pod, err error)
 	Put(url string, body io.ReadCloser) error
+	PutWithResponse(url string, body io.ReadCloser) (string, error)
 	Get(url, contentType string) (string, error)
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	NetworkStatisticsURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestExecURI(vmi *virtv1.VirtualMachineInstance, query url.Values) (string, error)
+	GuestFileURI(vmi *virtv1.VirtualMachineInstance, query url.Values) (string, error)
+	GuestUserURI(vmi *virtv1.VirtualMachineInstance, query url.Values) (string, error)
 	BackupURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	RedefineCheckpointURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
@@ -218,6 +229,19 @@ func (v *virtHandlerConn) VSOCKURI(vmi *virtv1.VirtualMachineInstance, port stri
 	return fmt.Sprintf("%s?port=%s&tls=%s", baseURI, port, tls), nil
 }
 
+func (v *virtHandlerConn) PcapURI(vmi *virtv1.VirtualMachineInstance, query url.Values) (string, error) {
+	baseURI, err := v.formatURI(pcapTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(baseURI)
+	if err != nil {
+		return "", err
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
 func (v *virtHandlerConn) BackupURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(backupTemplateURI, vmi)
 }
@@ -295,6 +319,15 @@ func (v *virtHandlerConn) Put(url string, body io.ReadCloser) error {
 	return nil
 }
 
+func (v *virtHandlerConn) PutWithResponse(url string, body io.ReadCloser) (string, error) {
+	req, err := http.NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return "", err
+	}
+
+	return v.doRequest(req)
+}
+
 func (v *virtHandlerConn) Get(url, contentType string) (string, error) {
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
@@ -331,6 +364,49 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) NetworkStatisticsURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(netstatTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) GuestExecURI(vmi *virtv1.VirtualMachineInstance, query url.Values) (string, error) {
+	baseURI, err := v.formatURI(guestExecTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(baseURI)
+	if err != nil {
+		return "", err
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+func (v *virtHandlerConn) GuestFileURI(vmi *virtv1.VirtualMachineInstance, query url.Values) (string, error) {
+	baseURI, err := v.formatURI(guestFileTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(baseURI)
+	if err != nil {
+		return "", err
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+func (v *virtHandlerConn) GuestUserURI(vmi *virtv1.VirtualMachineInstance, query url.Values) (string, error) {
+	baseURI, err := v.formatURI(guestUserTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(baseURI)
+	if err != nil {
+		return "", err
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
@@ -181,3 +181,21 @@ func (v *vmis) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInte
 	queryParams.Add("tls", strconv.FormatBool(useTLS))
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vsock", queryParams)
 }
+
+func (v *vmis) Pcap(name string, options *v1.PcapOptions) (kvcorev1.StreamInterface, error) {
+	if options == nil || options.Interface == "" {
+		return nil, fmt.Errorf("interface is required but not provided")
+	}
+	queryParams := url.Values{}
+	queryParams.Add("interface", options.Interface)
+	if options.Filter != "" {
+		queryParams.Add("filter", options.Filter)
+	}
+	if options.Duration != nil {
+		queryParams.Add("duration", options.Duration.Duration.String())
+	}
+	if options.PacketCount > 0 {
+		queryParams.Add("packetCount", strconv.FormatUint(uint64(options.PacketCount), 10))
+	}
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "pcap", queryParams)
+}
@@ -133,6 +133,45 @@ func (c *fakeVirtualMachineInstances) VSOCK(name string, options *v1.VSOCKOption
 	return nil, nil
 }
 
+func (c *fakeVirtualMachineInstances) Pcap(name string, options *v1.PcapOptions) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
+func (c *fakeVirtualMachineInstances) NetworkStatistics(ctx context.Context, name string) (v1.VirtualMachineInstanceNetworkStatistics, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "netstat", name), &v1.VirtualMachineInstanceNetworkStatistics{})
+
+	return v1.VirtualMachineInstanceNetworkStatistics{}, err
+}
+
+func (c *fakeVirtualMachineInstances) GuestExec(ctx context.Context, name string, guestExecOptions *v1.VirtualMachineInstanceGuestExecOptions) (v1.VirtualMachineInstanceGuestExecResult, error) {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "guestexec", name, guestExecOptions), &v1.VirtualMachineInstanceGuestExecResult{})
+
+	return v1.VirtualMachineInstanceGuestExecResult{}, err
+}
+
+func (c *fakeVirtualMachineInstances) GuestFileRead(ctx context.Context, name string, readOptions *v1.VirtualMachineInstanceGuestFileReadOptions) (v1.VirtualMachineInstanceGuestFileChunk, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "guestfile", name), &v1.VirtualMachineInstanceGuestFileChunk{})
+
+	return v1.VirtualMachineInstanceGuestFileChunk{}, err
+}
+
+func (c *fakeVirtualMachineInstances) GuestFileWrite(ctx context.Context, name string, writeOptions *v1.VirtualMachineInstanceGuestFileWriteOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "guestfile", name, writeOptions), nil)
+
+	return err
+}
+
+func (c *fakeVirtualMachineInstances) GuestUser(ctx context.Context, name string, guestUserOptions *v1.VirtualMachineInstanceGuestUserOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "guestuser", name, guestUserOptions), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachineInstances) SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error) {
 	_, err := c.Fake.
 		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "sev/fetchcertchain", name), &v1.SEVPlatformInfo{})
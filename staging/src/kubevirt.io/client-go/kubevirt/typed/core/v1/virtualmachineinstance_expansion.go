@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -59,6 +60,12 @@ type VirtualMachineInstanceExpansion interface {
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
+	Pcap(name string, options *v1.PcapOptions) (StreamInterface, error)
+	NetworkStatistics(ctx context.Context, name string) (v1.VirtualMachineInstanceNetworkStatistics, error)
+	GuestExec(ctx context.Context, name string, guestExecOptions *v1.VirtualMachineInstanceGuestExecOptions) (v1.VirtualMachineInstanceGuestExecResult, error)
+	GuestFileRead(ctx context.Context, name string, readOptions *v1.VirtualMachineInstanceGuestFileReadOptions) (v1.VirtualMachineInstanceGuestFileChunk, error)
+	GuestFileWrite(ctx context.Context, name string, writeOptions *v1.VirtualMachineInstanceGuestFileWriteOptions) error
+	GuestUser(ctx context.Context, name string, guestUserOptions *v1.VirtualMachineInstanceGuestUserOptions) error
 	SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error)
 	SEVQueryLaunchMeasurement(ctx context.Context, name string) (v1.SEVMeasurementInfo, error)
 	SEVSetupSession(ctx context.Context, name string, sevSessionOptions *v1.SEVSessionOptions) error
@@ -377,6 +384,97 @@ func (c *virtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (
 	return nil, fmt.Errorf("VSOCK is not implemented yet in generated client")
 }
 
+func (c *virtualMachineInstances) Pcap(name string, options *v1.PcapOptions) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("Pcap is not implemented yet in generated client")
+}
+
+func (c *virtualMachineInstances) NetworkStatistics(ctx context.Context, name string) (v1.VirtualMachineInstanceNetworkStatistics, error) {
+	netStats := v1.VirtualMachineInstanceNetworkStatistics{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("netstat").
+		Do(ctx).
+		Into(&netStats)
+	return netStats, err
+}
+
+func (c *virtualMachineInstances) GuestExec(ctx context.Context, name string, guestExecOptions *v1.VirtualMachineInstanceGuestExecOptions) (v1.VirtualMachineInstanceGuestExecResult, error) {
+	result := v1.VirtualMachineInstanceGuestExecResult{}
+	body, err := json.Marshal(guestExecOptions)
+	if err != nil {
+		return result, err
+	}
+
+	err = c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestexec").
+		Body(body).
+		Do(ctx).
+		Into(&result)
+	return result, err
+}
+
+func (c *virtualMachineInstances) GuestFileRead(ctx context.Context, name string, readOptions *v1.VirtualMachineInstanceGuestFileReadOptions) (v1.VirtualMachineInstanceGuestFileChunk, error) {
+	chunk := v1.VirtualMachineInstanceGuestFileChunk{}
+	req := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestfile").
+		Param("path", readOptions.Path)
+	if readOptions.Offset > 0 {
+		req = req.Param("offset", strconv.FormatInt(readOptions.Offset, 10))
+	}
+	if readOptions.Length > 0 {
+		req = req.Param("length", strconv.FormatInt(readOptions.Length, 10))
+	}
+	err := req.Do(ctx).Into(&chunk)
+	return chunk, err
+}
+
+func (c *virtualMachineInstances) GuestFileWrite(ctx context.Context, name string, writeOptions *v1.VirtualMachineInstanceGuestFileWriteOptions) error {
+	body, err := json.Marshal(writeOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestfile").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachineInstances) GuestUser(ctx context.Context, name string, guestUserOptions *v1.VirtualMachineInstanceGuestUserOptions) error {
+	body, err := json.Marshal(guestUserOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestuser").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error) {
 	sevPlatformInfo := v1.SEVPlatformInfo{}
 	err := c.GetClient().Get().